@@ -43,6 +43,13 @@ type runOptions struct {
 	dataDir string
 
 	enableCorruptionCheck bool
+	quotaBackendBytes     int64
+	metricsTLSPort        int
+
+	autoCompactionMode      string
+	autoCompactionRetention string
+
+	cipherSuites []string
 }
 
 func RunCommand(logger *zap.SugaredLogger) *cobra.Command {
@@ -87,6 +94,11 @@ func RunCommand(logger *zap.SugaredLogger) *cobra.Command {
 	cmd.PersistentFlags().StringVar(&opt.podIP, "pod-ip", "", "IP address of this etcd pod")
 	cmd.PersistentFlags().StringVar(&opt.token, "token", "", "etcd database token")
 	cmd.PersistentFlags().BoolVar(&opt.enableCorruptionCheck, "enable-corruption-check", false, "enable experimental corruption check")
+	cmd.PersistentFlags().Int64Var(&opt.quotaBackendBytes, "quota-backend-bytes", 0, "size limit of the etcd database in bytes, 0 uses the etcd default")
+	cmd.PersistentFlags().StringVar(&opt.autoCompactionMode, "auto-compaction-mode", "", "etcd auto-compaction mode, periodic or revision, empty uses the etcd default")
+	cmd.PersistentFlags().StringVar(&opt.autoCompactionRetention, "auto-compaction-retention", "8", "etcd auto-compaction retention, interpreted according to the auto-compaction mode")
+	cmd.PersistentFlags().StringSliceVar(&opt.cipherSuites, "cipher-suites", nil, "comma-separated list of TLS cipher suites etcd accepts from clients and peers, empty uses the Go defaults")
+	cmd.PersistentFlags().IntVar(&opt.metricsTLSPort, "metrics-tls-port", 0, "port to serve the etcd metrics on using the dedicated metrics certificate, 0 disables the listener")
 
 	return cmd
 }
@@ -108,6 +120,12 @@ func RunFunc(log *zap.SugaredLogger, opt *runOptions) cobraFuncE {
 			DataDir:               opt.dataDir,
 			Token:                 opt.token,
 			EnableCorruptionCheck: opt.enableCorruptionCheck,
+			QuotaBackendBytes:     opt.quotaBackendBytes,
+
+			AutoCompactionMode:      opt.autoCompactionMode,
+			AutoCompactionRetention: opt.autoCompactionRetention,
+
+			CipherSuites: opt.cipherSuites,
 		}
 
 		ctx := cmd.Context()
@@ -172,6 +190,14 @@ func RunFunc(log *zap.SugaredLogger, opt *runOptions) cobraFuncE {
 			log.Panicw("failed to start etcd cmd", zap.Error(err))
 		}
 
+		if opt.metricsTLSPort > 0 {
+			go func() {
+				if err := etcd.ServeMetricsTLS(log, opt.metricsTLSPort); err != nil {
+					log.Errorw("failed to serve etcd metrics via TLS", zap.Error(err))
+				}
+			}()
+		}
+
 		if err = wait.PollUntilContextTimeout(ctx, 1*time.Second, 60*time.Second, false, func(ctx context.Context) (bool, error) {
 			return e.IsClusterHealthy(ctx, log)
 		}); err != nil {
@@ -72,6 +72,12 @@ type Cluster struct {
 	DataDir               string
 	Token                 string
 	EnableCorruptionCheck bool
+	QuotaBackendBytes     int64
+
+	AutoCompactionMode      string
+	AutoCompactionRetention string
+
+	CipherSuites []string
 
 	clusterClient ctrlruntimeclient.Client
 	namespace     string // filled in later during init()
@@ -105,7 +111,7 @@ func (e *Cluster) Init(ctx context.Context) (*kubermaticv1.Cluster, error) {
 		return nil, err
 	}
 
-	e.namespace = cluster.Status.NamespaceName
+	e.namespace = resources.EtcdNamespaceName(cluster)
 
 	return cluster, nil
 }
@@ -624,7 +630,8 @@ func (e *Cluster) removeDeadMembers(ctx context.Context, log *zap.SugaredLogger,
 
 func (e *Cluster) restoreDatadirFromBackupIfNeeded(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) error {
 	restoreList := &kubermaticv1.EtcdRestoreList{}
-	if err := seedClient.List(ctx, restoreList, &ctrlruntimeclient.ListOptions{Namespace: e.namespace}); err != nil {
+	// EtcdRestores are created in the cluster namespace, even if etcd runs in a dedicated namespace
+	if err := seedClient.List(ctx, restoreList, &ctrlruntimeclient.ListOptions{Namespace: cluster.Status.NamespaceName}); err != nil {
 		return fmt.Errorf("failed to list EtcdRestores: %w", err)
 	}
 
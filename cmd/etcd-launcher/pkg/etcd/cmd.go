@@ -66,10 +66,10 @@ func etcdCmd(config *Cluster) []string {
 		fmt.Sprintf("--trusted-ca-file=%s", resources.EtcdTrustedCAFile),
 		fmt.Sprintf("--cert-file=%s", resources.EtcdCertFile),
 		fmt.Sprintf("--key-file=%s", resources.EtcdKeyFile),
-		fmt.Sprintf("--peer-cert-file=%s", resources.EtcdCertFile),
-		fmt.Sprintf("--peer-key-file=%s", resources.EtcdKeyFile),
+		fmt.Sprintf("--peer-cert-file=%s", resources.EtcdPeerCertFile),
+		fmt.Sprintf("--peer-key-file=%s", resources.EtcdPeerKeyFile),
 		fmt.Sprintf("--peer-trusted-ca-file=%s", resources.EtcdTrustedCAFile),
-		"--auto-compaction-retention=8",
+		fmt.Sprintf("--auto-compaction-retention=%s", config.AutoCompactionRetention),
 	}
 
 	// set TLS only peer URLs
@@ -93,5 +93,18 @@ func etcdCmd(config *Cluster) []string {
 			"--experimental-corrupt-check-time=240m",
 		}...)
 	}
+
+	if config.QuotaBackendBytes > 0 {
+		cmd = append(cmd, fmt.Sprintf("--quota-backend-bytes=%d", config.QuotaBackendBytes))
+	}
+
+	if config.AutoCompactionMode != "" {
+		cmd = append(cmd, fmt.Sprintf("--auto-compaction-mode=%s", config.AutoCompactionMode))
+	}
+
+	if len(config.CipherSuites) > 0 {
+		cmd = append(cmd, fmt.Sprintf("--cipher-suites=%s", strings.Join(config.CipherSuites, ",")))
+	}
+
 	return cmd
 }
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+)
+
+// metricsUpstream is the plain HTTP metrics listener of the local etcd member.
+var metricsUpstream = &url.URL{Scheme: "http", Host: "127.0.0.1:2378"}
+
+// metricsTLSFiles are the files making up the dedicated TLS identity of the metrics
+// listener. They are read on every handshake, so rotated certificates are picked
+// up without restarting etcd.
+type metricsTLSFiles struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+}
+
+// ServeMetricsTLS serves the etcd metrics on the given port, secured by the dedicated
+// etcd metrics certificate. Only clients presenting a certificate signed by the etcd
+// metrics CA for the Prometheus scraper are allowed. This function blocks until the
+// server fails.
+func ServeMetricsTLS(log *zap.SugaredLogger, port int) error {
+	files := metricsTLSFiles{
+		certFile:     resources.EtcdMetricsCertFile,
+		keyFile:      resources.EtcdMetricsKeyFile,
+		clientCAFile: resources.EtcdMetricsClientCAFile,
+	}
+
+	server := &http.Server{
+		Addr:              net.JoinHostPort("", strconv.Itoa(port)),
+		Handler:           httputil.NewSingleHostReverseProxy(metricsUpstream),
+		ReadHeaderTimeout: 10 * time.Second,
+		TLSConfig: &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			GetConfigForClient: files.configForClient,
+		},
+	}
+
+	log.Infow("serving etcd metrics via TLS", "port", port)
+
+	// certificates are provided by GetConfigForClient
+	return server.ListenAndServeTLS("", "")
+}
+
+func (f metricsTLSFiles) configForClient(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(f.certFile, f.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load metrics certificate: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(f.clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metrics client CA: %w", err)
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("metrics client CA does not contain any certificate")
+	}
+
+	return &tls.Config{
+		MinVersion:            tls.VersionTLS12,
+		Certificates:          []tls.Certificate{cert},
+		ClientAuth:            tls.RequireAndVerifyClientCert,
+		ClientCAs:             clientCAs,
+		VerifyPeerCertificate: verifyMetricsScraper,
+	}, nil
+}
+
+// verifyMetricsScraper ensures that only the Prometheus scraper identity can access
+// the metrics, even if the metrics CA was used to sign other certificates.
+func verifyMetricsScraper(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+	for _, chain := range verifiedChains {
+		if len(chain) > 0 && chain[0].Subject.CommonName == resources.EtcdMetricsScraperCommonName {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("client certificate is not issued to %q", resources.EtcdMetricsScraperCommonName)
+}
@@ -136,6 +136,25 @@ func createKubernetesController(ctrlCtx *controllerContext) error {
 		return fmt.Errorf("failed to parse %s as duration: %w", ctrlCtx.runOptions.backupInterval, err)
 	}
 
+	saKeyRotationGracePeriod, err := time.ParseDuration(ctrlCtx.runOptions.saKeyRotationGracePeriod)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s as duration: %w", ctrlCtx.runOptions.saKeyRotationGracePeriod, err)
+	}
+
+	// an empty schedule disables the etcd defragger
+	etcdDefragSchedule := ""
+	if ctrlCtx.runOptions.enableEtcdDefrag {
+		etcdDefragSchedule = ctrlCtx.runOptions.etcdDefragSchedule
+	}
+
+	// an empty sink disables the event-exporter
+	eventExporterSink := ""
+	if ctrlCtx.runOptions.enableEventExporter {
+		eventExporterSink = ctrlCtx.runOptions.eventExporterSink
+	}
+
+	kubernetescontroller.MustRegisterMetrics(prometheus.DefaultRegisterer)
+
 	return kubernetescontroller.Add(
 		ctrlCtx.mgr,
 		ctrlCtx.log,
@@ -152,6 +171,25 @@ func createKubernetesController(ctrlCtx *controllerContext) error {
 		ctrlCtx.dockerPullConfigJSON,
 		ctrlCtx.runOptions.concurrentClusterUpdate,
 		backupInterval,
+		ctrlCtx.runOptions.backupCount,
+		etcdDefragSchedule,
+		saKeyRotationGracePeriod,
+		ctrlCtx.runOptions.nodeBootstrapTokenInterval,
+		ctrlCtx.runOptions.nodeBootstrapTokenGracePeriod,
+		ctrlCtx.runOptions.maxConcurrentEtcdRollouts,
+		ctrlCtx.runOptions.apiserverShutdownDelay,
+		ctrlCtx.runOptions.apiserverTerminationGracePeriod,
+		ctrlCtx.runOptions.nodeCleanupSkipTimeout,
+		ctrlCtx.runOptions.sidecarInjections,
+		ctrlCtx.runOptions.criticalComponents,
+		ctrlCtx.runOptions.enableEtcdVolumeExpansion,
+		ctrlCtx.runOptions.etcdVolumeExpansionThreshold,
+		ctrlCtx.runOptions.etcdVolumeExpansionMaxSize,
+		ctrlCtx.runOptions.enableEtcdMemberReplacement,
+		eventExporterSink,
+		ctrlCtx.runOptions.imageAttestationAnnotation,
+		ctrlCtx.runOptions.dryRunControlPlanes,
+		ctrlCtx.runOptions.controlPlaneIngressIsolation,
 		ctrlCtx.runOptions.oidcIssuerURL,
 		ctrlCtx.runOptions.oidcIssuerClientID,
 		ctrlCtx.runOptions.kubermaticImage,
@@ -166,6 +204,9 @@ func createKubernetesController(ctrlCtx *controllerContext) error {
 			EtcdDataCorruptionChecks:     ctrlCtx.runOptions.featureGates.Enabled(features.EtcdDataCorruptionChecks),
 			KubernetesOIDCAuthentication: ctrlCtx.runOptions.featureGates.Enabled(features.OpenIDAuthPlugin),
 			EtcdLauncher:                 ctrlCtx.runOptions.featureGates.Enabled(features.EtcdLauncher),
+			ExternalDNSAnnotations:       ctrlCtx.runOptions.featureGates.Enabled(features.ExternalDNSAnnotations),
+			ControlPlaneImageOverrides:   ctrlCtx.runOptions.featureGates.Enabled(features.ControlPlaneImageOverrides),
+			APIServerExternalProbe:       ctrlCtx.runOptions.featureGates.Enabled(features.APIServerExternalProbe),
 		},
 		ctrlCtx.versions,
 	)
@@ -220,6 +261,7 @@ func createMonitoringController(ctrlCtx *controllerContext) error {
 		ctrlCtx.runOptions.nodeAccessNetwork,
 		ctrlCtx.dockerPullConfigJSON,
 		ctrlCtx.runOptions.concurrentClusterUpdate,
+		ctrlCtx.runOptions.prometheusTokenTTL,
 		monitoring.Features{
 			VPA: ctrlCtx.runOptions.featureGates.Enabled(features.VerticalPodAutoscaler),
 		},
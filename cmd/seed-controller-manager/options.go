@@ -25,21 +25,27 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
 
+	cron "github.com/robfig/cron/v3"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v3"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/cluster/client"
+	kubernetescontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/defaulting"
 	"k8c.io/kubermatic/v2/pkg/features"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/apiserver"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+	"k8c.io/kubermatic/v2/pkg/resources/eventexporter"
 	"k8c.io/kubermatic/v2/pkg/util/flagopts"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
@@ -67,6 +73,29 @@ type controllerRunOptions struct {
 	systemAppEnforceInterval int
 	caBundle                 *certificates.CABundle
 
+	backupCount                     int
+	enableEtcdDefrag                bool
+	etcdDefragSchedule              string
+	saKeyRotationGracePeriod        string
+	nodeBootstrapTokenInterval      time.Duration
+	nodeBootstrapTokenGracePeriod   time.Duration
+	maxConcurrentEtcdRollouts       int
+	apiserverShutdownDelay          time.Duration
+	apiserverTerminationGracePeriod time.Duration
+	nodeCleanupSkipTimeout          time.Duration
+	sidecarInjections               []resources.SidecarInjection
+	criticalComponents              sets.Set[string]
+	prometheusTokenTTL              time.Duration
+	enableEtcdVolumeExpansion       bool
+	etcdVolumeExpansionThreshold    int
+	etcdVolumeExpansionMaxSize      resource.Quantity
+	enableEtcdMemberReplacement     bool
+	enableEventExporter             bool
+	eventExporterSink               string
+	imageAttestationAnnotation      string
+	dryRunControlPlanes             bool
+	controlPlaneIngressIsolation    bool
+
 	// for development purposes, a local configuration file
 	// can be used to provide the KubermaticConfiguration
 	kubermaticConfiguration *kubermaticv1.KubermaticConfiguration
@@ -103,13 +132,16 @@ func newControllerRunOptions() (controllerRunOptions, error) {
 	c := controllerRunOptions{
 		featureGates: features.FeatureGate{},
 		// Default IP used by tunneling agents
-		tunnelingAgentIP: flagopts.IPValue{IP: net.ParseIP(resources.DefaultTunnelingAgentIP)},
+		tunnelingAgentIP:   flagopts.IPValue{IP: net.ParseIP(resources.DefaultTunnelingAgentIP)},
+		criticalComponents: kubernetescontroller.DefaultCriticalComponents(),
 	}
 
 	var (
-		rawEtcdDiskSize string
-		caBundleFile    string
-		configFile      string
+		rawEtcdDiskSize               string
+		rawEtcdVolumeExpansionMaxSize string
+		caBundleFile                  string
+		configFile                    string
+		sidecarsFile                  string
 	)
 
 	flag.BoolVar(&c.enableLeaderElection, "enable-leader-election", true, "Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
@@ -123,7 +155,29 @@ func newControllerRunOptions() (controllerRunOptions, error) {
 	flag.StringVar(&c.nodeAccessNetwork, "node-access-network", kubermaticv1.DefaultNodeAccessNetwork, "A network which allows direct access to nodes via VPN. Uses CIDR notation.")
 	flag.StringVar(&c.addonsPath, "addons-path", "/opt/addons", "Path to addon manifests. Should contain sub-folders for each addon")
 	flag.StringVar(&c.backupInterval, "backup-interval", defaulting.DefaultBackupInterval, "Interval in which the etcd gets backed up")
+	flag.IntVar(&c.backupCount, "backup-count", defaulting.DefaultBackupCount, "Number of etcd backups to keep for every cluster")
+	flag.BoolVar(&c.enableEtcdDefrag, "enable-etcd-defrag", true, "Periodically defragment the etcd members of all user clusters.")
+	flag.StringVar(&c.etcdDefragSchedule, "etcd-defrag-schedule", defaulting.DefaultEtcdDefragSchedule, "Cron schedule in which the etcd members get defragmented, one after another.")
+	flag.StringVar(&c.saKeyRotationGracePeriod, "service-account-key-rotation-grace-period", defaulting.DefaultServiceAccountKeyRotationGracePeriod, "Duration for which tokens signed with a rotated service account key remain valid.")
+	flag.DurationVar(&c.nodeBootstrapTokenInterval, "node-bootstrap-token-rotation-interval", 0, "Interval in which the node bootstrap tokens in the kube-system namespace of user clusters are rotated. 0 disables node bootstrap tokens.")
+	flag.DurationVar(&c.nodeBootstrapTokenGracePeriod, "node-bootstrap-token-grace-period", 2*time.Hour, "Duration for which a rotated node bootstrap token remains valid, so that nodes which are joining with it can finish.")
+	flag.IntVar(&c.maxConcurrentEtcdRollouts, "max-concurrent-etcd-rollouts", 0, "The maximum number of user clusters whose etcd StatefulSet is rolled out at the same time. 0 means no limit.")
+	flag.DurationVar(&c.apiserverShutdownDelay, "apiserver-shutdown-delay", 0, "Duration for which user cluster apiservers keep serving requests after being asked to shut down, so load balancers can stop routing to them. 0 disables the delay.")
+	flag.DurationVar(&c.apiserverTerminationGracePeriod, "apiserver-termination-grace-period", 0, "Termination grace period for user cluster apiserver pods. Must comfortably exceed -apiserver-shutdown-delay. 0 uses the Kubernetes default.")
+	flag.DurationVar(&c.nodeCleanupSkipTimeout, "node-cleanup-skip-timeout", 0, "Duration after which the node cleanup of a deleted cluster, whose apiserver is unreachable, may be skipped if the cluster has the \"kubermatic.k8c.io/skip-node-cleanup=true\" annotation. 0 disables skipping the node cleanup.")
+	flag.Var(flagopts.SetFlag(c.criticalComponents), "critical-components", "Comma-separated list of control plane components that must be ready before a cluster is reported as ready (any of apiserver, controller-manager, etcd, scheduler).")
+	flag.StringVar(&sidecarsFile, "control-plane-sidecars-file", "", "Path to a YAML file listing sidecar containers to inject into control plane components.")
+	flag.DurationVar(&c.prometheusTokenTTL, "prometheus-token-ttl", 0, "Lifetime of the projected ServiceAccount token of user cluster Prometheus instances, which is refreshed by the kubelet before it expires. Must be at least 10m. 0 uses the Kubernetes default.")
 	flag.StringVar(&rawEtcdDiskSize, "etcd-disk-size", "5Gi", "Size for the etcd PV's. Only applies to new clusters.")
+	flag.BoolVar(&c.enableEtcdVolumeExpansion, "enable-etcd-volume-expansion", false, "Automatically expand the etcd PV's of user clusters when their disk usage is high. Requires a StorageClass that allows volume expansion.")
+	flag.IntVar(&c.etcdVolumeExpansionThreshold, "etcd-volume-expansion-threshold", 80, "Disk usage in percent at which an etcd PV is expanded.")
+	flag.StringVar(&rawEtcdVolumeExpansionMaxSize, "etcd-volume-expansion-max-size", "20Gi", "Size up to which etcd PV's are automatically expanded.")
+	flag.BoolVar(&c.enableEtcdMemberReplacement, "enable-etcd-member-replacement", false, "Automatically replace etcd members of user clusters whose PV has been lost, as long as the remaining members are healthy.")
+	flag.BoolVar(&c.enableEventExporter, "enable-event-exporter", false, "Deploy an event-exporter for every user cluster that forwards the cluster's events to the event-exporter-sink.")
+	flag.StringVar(&c.eventExporterSink, "event-exporter-sink", "", "HTTP(S) endpoint the events of user clusters are posted to as JSON. Required if the event-exporter is enabled.")
+	flag.StringVar(&c.imageAttestationAnnotation, "image-attestation-annotation", "", "If set, control plane Deployments and StatefulSets are only rolled out with images whose manifest carries this annotation, e.g. one added by a vulnerability scanner.")
+	flag.BoolVar(&c.dryRunControlPlanes, "dry-run-control-planes", false, "Do not change the control planes of user clusters, but only log the changes that would be made. Deleted clusters are not cleaned up in this mode.")
+	flag.BoolVar(&c.controlPlaneIngressIsolation, "enable-control-plane-ingress-isolation", false, "Deploy NetworkPolicies that deny ingress traffic to the control plane namespaces of user clusters, except for traffic within the control plane, from the KKP components and to the apiserver, the OpenVPN server and the nodeport-proxy of clusters exposed via LoadBalancer. Other seed components, like the seed monitoring, need additional NetworkPolicies.")
 	flag.StringVar(&c.dockerPullConfigJSONFile, "docker-pull-config-json-file", "", "The file containing the docker auth config.")
 	flag.Var(&c.featureGates, "feature-gates", "A set of key=value pairs that describe feature gates for various features.")
 	flag.StringVar(&c.oidcIssuerURL, "oidc-issuer-url", "", "URL of the OpenID token issuer. Example: http://auth.int.kubermatic.io")
@@ -159,6 +213,12 @@ func newControllerRunOptions() (controllerRunOptions, error) {
 	}
 	c.etcdDiskSize = etcdDiskSize
 
+	etcdVolumeExpansionMaxSize, err := resource.ParseQuantity(rawEtcdVolumeExpansionMaxSize)
+	if err != nil {
+		return c, fmt.Errorf("failed to parse value of flag etcd-volume-expansion-max-size (%q): %w", rawEtcdVolumeExpansionMaxSize, err)
+	}
+	c.etcdVolumeExpansionMaxSize = etcdVolumeExpansionMaxSize
+
 	if c.overwriteRegistry != "" {
 		c.overwriteRegistry = path.Clean(strings.TrimSpace(c.overwriteRegistry))
 	}
@@ -169,6 +229,12 @@ func newControllerRunOptions() (controllerRunOptions, error) {
 		}
 	}
 
+	if sidecarsFile != "" {
+		if c.sidecarInjections, err = resources.LoadSidecarInjections(sidecarsFile); err != nil {
+			return c, fmt.Errorf("invalid control plane sidecars file (%q): %w", sidecarsFile, err)
+		}
+	}
+
 	caBundle, err := certificates.NewCABundleFromFile(caBundleFile)
 	if err != nil {
 		return c, fmt.Errorf("invalid CA bundle file (%q): %w", caBundleFile, err)
@@ -197,6 +263,44 @@ func (o controllerRunOptions) validate() error {
 		}
 	}
 
+	if o.backupCount < 1 {
+		return fmt.Errorf("\"backup-count\" flag must be at least 1, but is %d", o.backupCount)
+	}
+
+	if o.enableEtcdDefrag {
+		if _, err := cron.ParseStandard(o.etcdDefragSchedule); err != nil {
+			return fmt.Errorf("invalid \"etcd-defrag-schedule\" flag: %w", err)
+		}
+	}
+
+	if err := apiserver.ValidateGracefulTermination(o.apiserverShutdownDelay, o.apiserverTerminationGracePeriod); err != nil {
+		return fmt.Errorf("invalid apiserver graceful termination flags: %w", err)
+	}
+
+	if err := kubernetescontroller.ValidateNodeBootstrapTokenRotation(o.nodeBootstrapTokenInterval, o.nodeBootstrapTokenGracePeriod); err != nil {
+		return fmt.Errorf("invalid node bootstrap token flags: %w", err)
+	}
+
+	if err := kubernetescontroller.ValidateCriticalComponents(o.criticalComponents); err != nil {
+		return fmt.Errorf("invalid \"critical-components\" flag: %w", err)
+	}
+
+	if o.enableEtcdVolumeExpansion {
+		if err := kubernetescontroller.ValidateEtcdVolumeExpansion(o.etcdVolumeExpansionThreshold, o.etcdVolumeExpansionMaxSize); err != nil {
+			return fmt.Errorf("invalid etcd volume expansion flags: %w", err)
+		}
+	}
+
+	if o.enableEventExporter {
+		if err := eventexporter.ValidateSink(o.eventExporterSink); err != nil {
+			return fmt.Errorf("invalid \"event-exporter-sink\" flag: %w", err)
+		}
+	}
+
+	if o.prometheusTokenTTL != 0 && o.prometheusTokenTTL < 10*time.Minute {
+		return fmt.Errorf("invalid \"prometheus-token-ttl\" flag: must be at least 10m")
+	}
+
 	if o.externalURL == "" {
 		return fmt.Errorf("external-url is undefined")
 	}
@@ -78,6 +78,9 @@ type controllerRunOptions struct {
 	clusterURL                        string
 	clusterName                       string
 	openvpnServerPort                 int
+	openvpnTunnelMTU                  int
+	openvpnCipher                     string
+	openvpnCompression                string
 	kasSecurePort                     int
 	tunnelingAgentIP                  flagopts.IPValue
 	overwriteRegistry                 string
@@ -94,6 +97,7 @@ type controllerRunOptions struct {
 	opaWebhookTimeout                 int
 	useSSHKeyAgent                    bool
 	networkPolicies                   bool
+	apiPriorityAndFairness            bool
 	caBundleFile                      string
 	mlaGatewayURL                     string
 	userClusterLogging                bool
@@ -129,6 +133,9 @@ func main() {
 	flag.StringVar(&runOp.dnsClusterIP, "dns-cluster-ip", "", "KubeDNS service IP for the cluster")
 	flag.BoolVar(&runOp.nodeLocalDNSCache, "node-local-dns-cache", false, "Enable NodeLocal DNS Cache in user cluster")
 	flag.IntVar(&runOp.openvpnServerPort, "openvpn-server-port", 0, "OpenVPN server port")
+	flag.IntVar(&runOp.openvpnTunnelMTU, "openvpn-tunnel-mtu", 0, "MTU of the OpenVPN tunnel, 0 uses the OpenVPN default")
+	flag.StringVar(&runOp.openvpnCipher, "openvpn-cipher", resources.DefaultOpenVPNCipher, "Data channel cipher of the OpenVPN tunnel")
+	flag.StringVar(&runOp.openvpnCompression, "openvpn-compression", "", "Compression of the OpenVPN tunnel, \"disabled\" to refuse compression, empty uses the OpenVPN default")
 	flag.IntVar(&runOp.kasSecurePort, "kas-secure-port", 6443, "Secure KAS port")
 	flag.Var(&runOp.tunnelingAgentIP, "tunneling-agent-ip", "If specified the tunneling agent will bind to this IP address, otherwise it will not be deployed.")
 	flag.StringVar(&runOp.overwriteRegistry, "overwrite-registry", "", "registry to use for all images")
@@ -143,6 +150,7 @@ func main() {
 	flag.IntVar(&runOp.opaWebhookTimeout, "opa-webhook-timeout", 1, "Timeout for OPA Integration validating webhook, in seconds")
 	flag.BoolVar(&runOp.useSSHKeyAgent, "enable-ssh-key-agent", false, "Enable UserSSHKeyAgent integration in user cluster")
 	flag.BoolVar(&runOp.networkPolicies, "enable-network-policies", false, "Enable deployment of network policies to kube-system namespace in user cluster")
+	flag.BoolVar(&runOp.apiPriorityAndFairness, "enable-api-priority-and-fairness", false, "Enable reconciling of API Priority and Fairness objects tuned to the cluster tier in user cluster")
 	flag.StringVar(&runOp.caBundleFile, "ca-bundle", "", "The path to the cluster's CA bundle (PEM-encoded).")
 	flag.StringVar(&runOp.mlaGatewayURL, "mla-gateway-url", "", "The URL of MLA (Monitoring, Logging, and Alerting) gateway endpoint.")
 	flag.BoolVar(&runOp.userClusterLogging, "user-cluster-logging", false, "Enable logging in user cluster.")
@@ -302,6 +310,9 @@ func main() {
 		isPausedChecker,
 		runOp.overwriteRegistry,
 		uint32(runOp.openvpnServerPort),
+		runOp.openvpnTunnelMTU,
+		runOp.openvpnCipher,
+		kubermaticv1.OpenVPNCompression(runOp.openvpnCompression),
 		uint32(runOp.kasSecurePort),
 		runOp.tunnelingAgentIP.IP,
 		mgr.AddReadyzCheck,
@@ -312,6 +323,7 @@ func main() {
 		versions,
 		runOp.useSSHKeyAgent,
 		runOp.networkPolicies,
+		runOp.apiPriorityAndFairness,
 		runOp.opaWebhookTimeout,
 		caBundle,
 		usercluster.UserClusterMLA{
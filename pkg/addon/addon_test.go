@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/cni"
+	"k8c.io/kubermatic/v2/pkg/defaulting"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+type kubeProxyConfig struct {
+	Mode string `json:"mode"`
+	IPVS struct {
+		Scheduler string `json:"scheduler"`
+		StrictARP bool   `json:"strictARP"`
+	} `json:"ipvs"`
+}
+
+func TestKubeProxyConfiguration(t *testing.T) {
+	kubeProxy, err := LoadAddonFromDirectory("../../addons/kube-proxy")
+	if err != nil {
+		t.Fatalf("Failed to load kube-proxy addon: %v", err)
+	}
+
+	testCases := []struct {
+		name              string
+		proxyMode         string
+		ipvs              *kubermaticv1.IPVSConfiguration
+		expectedScheduler string
+		expectedStrictARP bool
+	}{
+		{
+			name:              "ipvs with defaults",
+			proxyMode:         resources.IPVSProxyMode,
+			expectedStrictARP: true,
+		},
+		{
+			name:      "ipvs with least-connection scheduler",
+			proxyMode: resources.IPVSProxyMode,
+			ipvs: &kubermaticv1.IPVSConfiguration{
+				StrictArp: ptr.To(false),
+				Scheduler: "lc",
+			},
+			expectedScheduler: "lc",
+		},
+		{
+			name:              "iptables",
+			proxyMode:         resources.IPTablesProxyMode,
+			expectedStrictARP: true,
+		},
+	}
+
+	rollouts := map[string]string{}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version := defaulting.DefaultKubernetesVersioning.Default
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					ClusterNetwork: kubermaticv1.ClusterNetworkingConfig{
+						Pods:      kubermaticv1.NetworkRanges{CIDRBlocks: []string{"172.25.0.0/16"}},
+						Services:  kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.16.0/20"}},
+						ProxyMode: tc.proxyMode,
+						IPVS:      tc.ipvs,
+					},
+					CNIPlugin: &kubermaticv1.CNIPluginSettings{
+						Type:    kubermaticv1.CNIPluginTypeCanal,
+						Version: cni.GetDefaultCNIPluginVersion(kubermaticv1.CNIPluginTypeCanal),
+					},
+					Version: *version,
+				},
+			}
+
+			data, err := NewTemplateData(cluster, resources.Credentials{}, "", "", "", nil, nil)
+			if err != nil {
+				t.Fatalf("Failed to create template data: %v", err)
+			}
+
+			manifests, err := kubeProxy.Render("", data)
+			if err != nil {
+				t.Fatalf("Failed to render addon: %v", err)
+			}
+
+			configMap := &corev1.ConfigMap{}
+			daemonSet := &appsv1.DaemonSet{}
+			decodeManifest(t, manifests, "ConfigMap", configMap)
+			decodeManifest(t, manifests, "DaemonSet", daemonSet)
+
+			config := kubeProxyConfig{}
+			if err := yaml.Unmarshal([]byte(configMap.Data["config.conf"]), &config); err != nil {
+				t.Fatalf("Failed to parse kube-proxy config: %v", err)
+			}
+
+			if config.Mode != tc.proxyMode {
+				t.Errorf("Expected mode %q, got %q", tc.proxyMode, config.Mode)
+			}
+			if config.IPVS.Scheduler != tc.expectedScheduler {
+				t.Errorf("Expected IPVS scheduler %q, got %q", tc.expectedScheduler, config.IPVS.Scheduler)
+			}
+			if config.IPVS.StrictARP != tc.expectedStrictARP {
+				t.Errorf("Expected strictARP to be %v, got %v", tc.expectedStrictARP, config.IPVS.StrictARP)
+			}
+
+			rollout := daemonSet.Spec.Template.Annotations["kubermatic.k8c.io/proxy-config"]
+			for name, other := range rollouts {
+				if other == rollout {
+					t.Errorf("Expected DaemonSet pod template to differ from %q, but both have %q", name, rollout)
+				}
+			}
+			rollouts[tc.name] = rollout
+		})
+	}
+}
+
+func decodeManifest(t *testing.T, manifests []runtime.RawExtension, kind string, obj interface{}) {
+	t.Helper()
+
+	for _, manifest := range manifests {
+		meta := struct {
+			Kind string `json:"kind"`
+		}{}
+		if err := yaml.Unmarshal(manifest.Raw, &meta); err != nil {
+			t.Fatalf("Failed to decode manifest: %v", err)
+		}
+
+		if meta.Kind == kind {
+			if err := yaml.Unmarshal(manifest.Raw, obj); err != nil {
+				t.Fatalf("Failed to decode %s: %v", kind, err)
+			}
+			return
+		}
+	}
+
+	t.Fatalf("Rendered addon does not contain a %s", kind)
+}
@@ -147,6 +147,7 @@ func NewTemplateData(
 				ServiceCIDRBlocks:    cluster.Spec.ClusterNetwork.Services.CIDRBlocks,
 				ProxyMode:            cluster.Spec.ClusterNetwork.ProxyMode,
 				StrictArp:            ipvs.StrictArp,
+				IPVSScheduler:        ipvs.Scheduler,
 				DualStack:            cluster.IsDualStack(),
 				PodCIDRIPv4:          cluster.Spec.ClusterNetwork.Pods.GetIPv4CIDR(),
 				PodCIDRIPv6:          cluster.Spec.ClusterNetwork.Pods.GetIPv6CIDR(),
@@ -237,6 +238,7 @@ type ClusterNetwork struct {
 	ServiceCIDRBlocks    []string
 	ProxyMode            string
 	StrictArp            *bool
+	IPVSScheduler        string
 	DualStack            bool
 	PodCIDRIPv4          string
 	PodCIDRIPv6          string
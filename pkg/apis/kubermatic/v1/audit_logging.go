@@ -18,6 +18,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // +kubebuilder:validation:Enum="";metadata;recommended;minimal
@@ -51,16 +52,51 @@ type AuditLoggingSettings struct {
 	Enabled bool `json:"enabled,omitempty"`
 	// Optional: PolicyPreset can be set to utilize a pre-defined set of audit policy rules.
 	PolicyPreset AuditPolicyPreset `json:"policyPreset,omitempty"`
+	// Optional: Rotation configures the rotation and retention of the audit log file written by the kube-apiserver.
+	Rotation *AuditLogRotationSettings `json:"rotation,omitempty"`
 	// Optional: Configures the fluent-bit sidecar deployed alongside kube-apiserver.
 	SidecarSettings *AuditSidecarSettings `json:"sidecar,omitempty"`
 	// Optional: Configures the webhook backend for audit logs.
 	WebhookBackend *AuditWebhookBackendSettings `json:"webhookBackend,omitempty"`
 }
 
+// AuditLogRotationSettings configures the rotation of the audit log file. Unset fields use the
+// KKP defaults.
+type AuditLogRotationSettings struct {
+	// Optional: MaxAge is the maximum number of days to retain rotated audit log files. Defaults to 30.
+	MaxAge *int32 `json:"maxAge,omitempty"`
+	// Optional: MaxBackup is the maximum number of rotated audit log files to retain. Defaults to 3.
+	MaxBackup *int32 `json:"maxBackup,omitempty"`
+	// Optional: MaxSize is the maximum size in megabytes of the audit log file before it is rotated. Defaults to 100.
+	MaxSize *int32 `json:"maxSize,omitempty"`
+}
+
 // AuditWebhookBackendSettings configures webhook backend for audit logging functionality.
+// Exactly one of AuditWebhookConfig or Endpoint must be set.
 type AuditWebhookBackendSettings struct {
-	// Required : AuditWebhookConfig contains reference to secret holding the audit webhook config file
-	AuditWebhookConfig *corev1.SecretReference `json:"auditWebhookConfig"`
+	// Optional: AuditWebhookConfig contains reference to secret holding the audit webhook config file.
+	AuditWebhookConfig *corev1.SecretReference `json:"auditWebhookConfig,omitempty"`
 	// +kubebuilder:default="10s"
 	AuditWebhookInitialBackoff string `json:"auditWebhookInitialBackoff,omitempty"`
+	// Optional: Endpoint is the https URL the audit events are sent to, e.g. a SIEM. If set, KKP
+	// generates the audit webhook config file instead of using AuditWebhookConfig.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Optional: CABundle is a PEM encoded CA bundle used to verify the serving certificate of the Endpoint.
+	// If not set, the system trust roots are used.
+	CABundle string `json:"caBundle,omitempty"`
+	// Optional: Batch configures how audit events are batched before they are sent to the webhook.
+	Batch *AuditWebhookBatchSettings `json:"batch,omitempty"`
+}
+
+// AuditWebhookBatchSettings configures the batching of the audit webhook backend. Unset fields
+// use the kube-apiserver defaults.
+type AuditWebhookBatchSettings struct {
+	// Optional: MaxSize is the maximum number of events in a batch.
+	MaxSize *int32 `json:"maxSize,omitempty"`
+	// Optional: MaxWait is the time to wait before force-sending a batch that has not reached MaxSize.
+	MaxWait *metav1.Duration `json:"maxWait,omitempty"`
+	// Optional: ThrottleQPS is the maximum average number of batches sent per second.
+	ThrottleQPS *int32 `json:"throttleQPS,omitempty"`
+	// Optional: ThrottleBurst is the maximum number of batches sent at the same moment if ThrottleQPS was not exceeded before.
+	ThrottleBurst *int32 `json:"throttleBurst,omitempty"`
 }
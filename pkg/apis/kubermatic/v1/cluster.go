@@ -50,6 +50,10 @@ const (
 
 	// PresetInvalidatedAnnotation is key of the annotation used to indicate why the preset was invalidated.
 	PresetInvalidatedAnnotation = "presetInvalidated"
+
+	// SkipNodeCleanupAnnotation is key of the annotation used to acknowledge that the nodes of a deleted
+	// cluster, whose apiserver has become unreachable, are not cleaned up. Its value must be "true".
+	SkipNodeCleanupAnnotation = "kubermatic.k8c.io/skip-node-cleanup"
 )
 
 const (
@@ -81,6 +85,82 @@ const (
 	AzureBasicLBSKU    = LBSKU("basic")
 )
 
+// +kubebuilder:validation:Enum="";production;development
+
+// ClusterTier describes how important a cluster is. Control plane pods of clusters in a higher
+// tier are preferred when scheduling and evicting pods on the seed.
+type ClusterTier string
+
+const (
+	ClusterTierProduction  = ClusterTier("production")
+	ClusterTierDevelopment = ClusterTier("development")
+)
+
+// +kubebuilder:validation:Enum="";single;ha
+
+// ControlPlaneHATier describes how many replicas of the apiserver, controller-manager
+// and scheduler are run for a cluster.
+type ControlPlaneHATier string
+
+const (
+	ControlPlaneHATierSingle = ControlPlaneHATier("single")
+	ControlPlaneHATierHA     = ControlPlaneHATier("ha")
+)
+
+// +kubebuilder:validation:Enum="";text;json
+
+// ControlPlaneLogFormat is the format control plane components write their logs in.
+type ControlPlaneLogFormat string
+
+const (
+	ControlPlaneLogFormatText = ControlPlaneLogFormat("text")
+	ControlPlaneLogFormatJSON = ControlPlaneLogFormat("json")
+)
+
+// +kubebuilder:validation:Enum="";RequestsOnly;RequestsAndLimits;Guaranteed
+
+// ControlPlaneResourceLimitPolicy describes how the resource requirements of control plane
+// containers are shaped.
+type ControlPlaneResourceLimitPolicy string
+
+const (
+	// ControlPlaneResourceLimitPolicyRequestsOnly removes all limits, so that components are
+	// never CPU throttled.
+	ControlPlaneResourceLimitPolicyRequestsOnly = ControlPlaneResourceLimitPolicy("RequestsOnly")
+	// ControlPlaneResourceLimitPolicyRequestsAndLimits keeps both requests and limits as configured.
+	ControlPlaneResourceLimitPolicyRequestsAndLimits = ControlPlaneResourceLimitPolicy("RequestsAndLimits")
+	// ControlPlaneResourceLimitPolicyGuaranteed makes requests and limits equal, so that
+	// components run in the Guaranteed QoS class.
+	ControlPlaneResourceLimitPolicyGuaranteed = ControlPlaneResourceLimitPolicy("Guaranteed")
+)
+
+// +kubebuilder:validation:Enum="";disabled;lz4-v2;lz4;lzo
+
+// OpenVPNCompression is the compression of the OpenVPN tunnel.
+type OpenVPNCompression string
+
+const (
+	// OpenVPNCompressionDisabled makes the OpenVPN server refuse compression, which prevents
+	// compression oracle attacks like VORACLE.
+	OpenVPNCompressionDisabled = OpenVPNCompression("disabled")
+	OpenVPNCompressionLZ4v2    = OpenVPNCompression("lz4-v2")
+	OpenVPNCompressionLZ4      = OpenVPNCompression("lz4")
+	OpenVPNCompressionLZO      = OpenVPNCompression("lzo")
+)
+
+// ControlPlaneDNSSettings configures how the control plane pods resolve names.
+type ControlPlaneDNSSettings struct {
+	// Optional: Policy is the DNS policy of the control plane pods. "ClusterFirst" (the default) uses
+	// the seed cluster DNS, "Default" the resolver configuration of the seed node and "None" only the
+	// given Config.
+	// +kubebuilder:validation:Enum="";ClusterFirst;Default;None
+	Policy corev1.DNSPolicy `json:"policy,omitempty"`
+
+	// Optional: Config is merged into the resolver configuration generated from the Policy. If the
+	// Policy is "None", it has to contain at least one nameserver.
+	Config *corev1.PodDNSConfig `json:"config,omitempty"`
+}
+
 // +kubebuilder:validation:Enum=deleted;changed
 type PresetInvalidationReason string
 
@@ -150,6 +230,11 @@ type ClusterSpec struct {
 	// ContainerRuntime to use, i.e. `docker` or `containerd`. By default `containerd` will be used.
 	ContainerRuntime string `json:"containerRuntime,omitempty"`
 
+	// Optional: NodeRuntimeConfig is a containerd configuration in TOML format that is
+	// merged into the container runtime configuration of newly provisioned nodes, e.g.
+	// to configure registry mirrors or to override the sandbox image.
+	NodeRuntimeConfig string `json:"nodeRuntimeConfig,omitempty"`
+
 	// Optional: ImagePullSecret references a secret with container registry credentials. This is passed to the machine-controller which sets the registry credentials on node level.
 	ImagePullSecret *corev1.SecretReference `json:"imagePullSecret,omitempty"`
 
@@ -170,9 +255,56 @@ type ClusterSpec struct {
 	// If not configured, access to the API server is unrestricted.
 	APIServerAllowedIPRanges *NetworkRanges `json:"apiServerAllowedIPRanges,omitempty"`
 
+	// Optional: ControlPlaneProxySettings configures a HTTP proxy for control plane components that
+	// need to reach external endpoints, like cloud provider APIs. Settings that are not configured
+	// here default to the proxy settings of the seed.
+	ControlPlaneProxySettings *ProxySettings `json:"controlPlaneProxySettings,omitempty"`
+
 	// Optional: Component specific overrides that allow customization of control plane components.
 	ComponentsOverride ComponentSettings `json:"componentsOverride,omitempty"`
 
+	// Optional: ImageOverrides replaces the container images of control plane components, keyed by the
+	// component name ("apiserver", "controller-manager", "scheduler" or "etcd"). The images are used as-is,
+	// without applying the overwrite registry. This is meant for testing new images on single clusters and
+	// only takes effect if the ControlPlaneImageOverrides feature gate is enabled.
+	ImageOverrides map[string]string `json:"imageOverrides,omitempty"`
+
+	// Optional: LogVerbosity sets the log verbosity (the `-v` flag) of control plane components, keyed by
+	// the component name ("apiserver", "controller-manager" or "scheduler"). Components without an entry
+	// keep their default verbosity. This is meant for debugging single clusters.
+	LogVerbosity map[string]int32 `json:"logVerbosity,omitempty"`
+
+	// Optional: LogFormat sets the log format (the `--logging-format` flag) of the apiserver, controller-manager
+	// and scheduler, either "text" (the default) or "json" for structured logging. Changing the format rolls
+	// these components.
+	LogFormat ControlPlaneLogFormat `json:"logFormat,omitempty"`
+
+	// Optional: LogFlushFrequency is the maximum interval between log flushes (the `--log-flush-frequency` flag)
+	// of the apiserver, controller-manager and scheduler. Defaults to 5s.
+	LogFlushFrequency *metav1.Duration `json:"logFlushFrequency,omitempty"`
+
+	// Optional: Tier selects the PriorityClass of the control plane pods, so that control planes of
+	// production clusters are preferred over those of development clusters on a shared seed.
+	// Clusters without a tier do not use a PriorityClass. Changing the tier rolls the control plane.
+	Tier ClusterTier `json:"tier,omitempty"`
+
+	// Optional: ControlPlaneHATier controls the number of replicas of the apiserver, controller-manager
+	// and scheduler. Clusters in the "ha" tier run multiple replicas, which are spread across seed nodes
+	// and protected by PodDisruptionBudgets. Clusters in the "single" tier (the default) run one replica.
+	// Replica counts configured in the componentsOverride take precedence.
+	ControlPlaneHATier ControlPlaneHATier `json:"controlPlaneHATier,omitempty"`
+
+	// Optional: ControlPlaneResourceLimitPolicy shapes the resource requirements of all control plane
+	// containers. "RequestsAndLimits" (the default) keeps requests and limits as configured, "RequestsOnly"
+	// removes all limits and "Guaranteed" raises the requests to the limits. Changing the policy rolls the
+	// control plane.
+	ControlPlaneResourceLimitPolicy ControlPlaneResourceLimitPolicy `json:"controlPlaneResourceLimitPolicy,omitempty"`
+
+	// Optional: ControlPlaneDNS overrides the DNS policy and resolver configuration of the control plane
+	// pods, for example to not resolve through the seed nodes' resolvers. Pods that resolve through the
+	// user cluster DNS are not affected. Changing the settings rolls the control plane.
+	ControlPlaneDNS *ControlPlaneDNSSettings `json:"controlPlaneDNS,omitempty"`
+
 	// Optional: OIDC specifies the OIDC configuration parameters for enabling authentication mechanism for the cluster.
 	OIDC OIDCSettings `json:"oidc,omitempty"`
 
@@ -186,6 +318,12 @@ type ClusterSpec struct {
 	// applying OS updates to nodes. This is only respected on Flatcar nodes currently.
 	UpdateWindow *UpdateWindow `json:"updateWindow,omitempty"`
 
+	// Optional: ControlPlaneMaintenanceWindow restricts disruptive changes to the control plane,
+	// i.e. changes that would roll the pods of control plane components like the apiserver or etcd,
+	// to the given window. Non-disruptive changes are still applied immediately. The window uses
+	// the same format as the UpdateWindow, the reference time is the seed cluster's time in UTC.
+	ControlPlaneMaintenanceWindow *UpdateWindow `json:"controlPlaneMaintenanceWindow,omitempty"`
+
 	// Enables the admission plugin `PodSecurityPolicy`. This plugin is deprecated by Kubernetes.
 	UsePodSecurityPolicyAdmissionPlugin bool `json:"usePodSecurityPolicyAdmissionPlugin,omitempty"`
 	// Enables the admission plugin `PodNodeSelector`. Needs additional configuration via the `podNodeSelectorAdmissionPluginConfig` field.
@@ -229,6 +367,10 @@ type ClusterSpec struct {
 	// for the user cluster.
 	AuditLogging *AuditLoggingSettings `json:"auditLogging,omitempty"`
 
+	// Optional: Authorization configures additional authorizers for the user cluster's kube-apiserver.
+	// They are consulted after the built-in Node and RBAC authorizers.
+	Authorization *AuthorizationSettings `json:"authorization,omitempty"`
+
 	// Optional: OPAIntegration is a preview feature that enables OPA integration for the cluster.
 	// Enabling it causes OPA Gatekeeper and its resources to be deployed on the user cluster.
 	// By default it is disabled.
@@ -253,6 +395,10 @@ type ClusterSpec struct {
 	// PauseReason is the reason why the cluster is not being managed. This field is for informational
 	// purpose only and can be set by a user or a controller to communicate the reason for pausing the cluster.
 	PauseReason string `json:"pauseReason,omitempty"`
+	// Optional: If this is set to true while the cluster is paused, the control plane is hibernated:
+	// after taking an etcd backup, all control plane Deployments and the etcd StatefulSet are scaled
+	// to zero, keeping their volumes. The control plane is woken up once the cluster is unpaused.
+	Hibernate bool `json:"hibernate,omitempty"`
 
 	// Enables more verbose logging in KKP's user-cluster-controller-manager.
 	DebugLog bool `json:"debugLog,omitempty"`
@@ -352,6 +498,15 @@ const (
 	// ClusterFeatureEncryptionAtRest enables the experimental "encryption-at-rest" feature, which allows encrypting
 	// Kubernetes data in etcd with a user-provided encryption key or KMS service.
 	ClusterFeatureEncryptionAtRest = "encryptionAtRest"
+
+	// ClusterFeatureSplitEtcdNamespace places etcd into a dedicated namespace next to the cluster
+	// namespace, isolating it from the rest of the control plane. This can only be configured when
+	// creating a cluster.
+	ClusterFeatureSplitEtcdNamespace = "splitEtcdNamespace"
+
+	// ClusterFeatureAPIPriorityAndFairness enables the reconciling of API Priority and Fairness
+	// FlowSchemas and PriorityLevelConfigurations in the user cluster, tuned to the cluster tier.
+	ClusterFeatureAPIPriorityAndFairness = "apiPriorityAndFairness"
 )
 
 // +kubebuilder:validation:Enum="";SeedResourcesUpToDate;ClusterControllerReconciledSuccessfully;AddonControllerReconciledSuccessfully;AddonInstallerControllerReconciledSuccessfully;BackupControllerReconciledSuccessfully;CloudControllerReconciledSuccessfully;UpdateControllerReconciledSuccessfully;MonitoringControllerReconciledSuccessfully;MachineDeploymentReconciledSuccessfully;MLAControllerReconciledSuccessfully;ClusterInitialized;EtcdClusterInitialized;CSIKubeletMigrationCompleted;ClusterUpdateSuccessful;ClusterUpdateInProgress;CSIKubeletMigrationSuccess;CSIKubeletMigrationInProgress;EncryptionControllerReconciledSuccessfully;IPAMControllerReconciledSuccessfully;
@@ -388,6 +543,29 @@ type EncryptionConfiguration struct {
 	// Configuration for the `secretbox` static key encryption scheme as supported by Kubernetes.
 	// More info: https://kubernetes.io/docs/tasks/administer-cluster/encrypt-data/#providers
 	Secretbox *SecretboxEncryptionConfiguration `json:"secretbox,omitempty"`
+	// Configuration for encrypting data with an external key management service through a
+	// KMS v2 plugin that runs as a sidecar next to kube-apiserver.
+	// More info: https://kubernetes.io/docs/tasks/administer-cluster/kms-provider/
+	KMS *KMSEncryptionConfiguration `json:"kms,omitempty"`
+}
+
+// KMSEncryptionConfiguration defines envelope encryption through a KMS v2 plugin.
+type KMSEncryptionConfiguration struct {
+	// Name of the KMS provider in the apiserver's EncryptionConfiguration. This name must
+	// not be changed once data has been encrypted with it.
+	Name string `json:"name"`
+	// Image of the KMS plugin, which is run as a sidecar container in the kube-apiserver Pod.
+	Image string `json:"image"`
+	// Args are passed to the KMS plugin container.
+	Args []string `json:"args,omitempty"`
+	// Endpoint is the path of the unix socket the KMS plugin is listening on. The socket
+	// has to be located within the shared socket directory `/var/run/kmsplugin`.
+	// Defaults to `/var/run/kmsplugin/socket.sock`.
+	Endpoint string `json:"endpoint,omitempty"`
+	// KeyID identifies the key the KMS plugin is currently encrypting with. Changing it
+	// signals a key rotation, after which all encrypted resources are rewritten so that
+	// they are stored with the new key.
+	KeyID string `json:"keyID"`
 }
 
 // SecretboxEncryptionConfiguration defines static key encryption based on the 'secretbox' solution for Kubernetes.
@@ -450,6 +628,39 @@ const (
 
 	ClusterConditionUpdateProgress ClusterConditionType = "UpdateProgress"
 
+	// ClusterConditionAPIServerExternallyReachable reports whether the apiserver can be reached
+	// through its external address. It is only maintained if the APIServerExternalProbe feature
+	// gate is enabled.
+	ClusterConditionAPIServerExternallyReachable ClusterConditionType = "APIServerExternallyReachable"
+
+	// ClusterConditionControlPlaneRolloutsDeferred reports whether disruptive changes to control plane
+	// components are pending because the cluster is outside of its control plane maintenance window.
+	ClusterConditionControlPlaneRolloutsDeferred ClusterConditionType = "ControlPlaneRolloutsDeferred"
+
+	// ClusterConditionControlPlaneRolloutsBlocked reports whether control plane components are not
+	// rolled out because their images have not been attested. It is only maintained if image
+	// attestation is required by the seed-controller-manager.
+	ClusterConditionControlPlaneRolloutsBlocked ClusterConditionType = "ControlPlaneRolloutsBlocked"
+
+	// ClusterConditionNamespaceRecovering reports whether the cluster namespace was deleted while the
+	// Cluster still exists and the control plane is waiting for the namespace to be recreated.
+	ClusterConditionNamespaceRecovering ClusterConditionType = "NamespaceRecovering"
+
+	// ClusterConditionHibernated reports whether the control plane of a paused cluster has been scaled
+	// down. It is Unknown while the control plane is being scaled down and stays True until it has been
+	// woken up again.
+	ClusterConditionHibernated ClusterConditionType = "Hibernated"
+
+	// ClusterConditionClusterReady reports whether all critical control plane components (by default
+	// etcd, apiserver, controller-manager and scheduler) are actually ready, not just created. If
+	// the condition is False, its message names the components that are blocking readiness.
+	ClusterConditionClusterReady ClusterConditionType = "ClusterReady"
+
+	// ClusterConditionInvalidCredentials reports whether the cloud credentials of the cluster have
+	// been rejected by the cloud provider. As long as it is True, the machine-controller is not
+	// deployed, as it could not provision any machines anyway.
+	ClusterConditionInvalidCredentials ClusterConditionType = "InvalidCredentials"
+
 	// ClusterConditionNone is a special value indicating that no cluster condition should be set.
 	ClusterConditionNone ClusterConditionType = ""
 	// This condition is met when a CSI migration is ongoing and the CSI
@@ -526,6 +737,17 @@ type ClusterStatus struct {
 	// not re-check things like security groups, networks etc.).
 	// +optional
 	LastProviderReconciliation metav1.Time `json:"lastProviderReconciliation,omitempty"`
+	// LastReconcileTime is the time when the cluster controller last attempted to
+	// reconcile the control plane resources, regardless of whether it succeeded.
+	// It is updated at most once per minute.
+	// +optional
+	LastReconcileTime metav1.Time `json:"lastReconcileTime,omitempty"`
+	// LastSuccessfulReconcileTime is the time when the cluster controller last
+	// reconciled all control plane resources without errors. A value lagging behind
+	// LastReconcileTime indicates that the reconciliation is failing.
+	// It is updated at most once per minute.
+	// +optional
+	LastSuccessfulReconcileTime metav1.Time `json:"lastSuccessfulReconcileTime,omitempty"`
 	// NamespaceName defines the namespace the control plane of this cluster is deployed in.
 	// +optional
 	NamespaceName string `json:"namespaceName"`
@@ -571,6 +793,46 @@ type ClusterStatus struct {
 
 	// ResourceUsage shows the current usage of resources for the cluster.
 	ResourceUsage *ResourceDetails `json:"resourceUsage,omitempty"`
+
+	// APIServerRequestLimits are the in-flight request ceilings the apiserver is configured with.
+	// +optional
+	APIServerRequestLimits *APIServerRequestLimits `json:"apiServerRequestLimits,omitempty"`
+
+	// ComponentVersions maps control plane components (apiserver, controller-manager,
+	// scheduler, etcd) to the image tag that is currently fully rolled out.
+	// +optional
+	ComponentVersions map[string]string `json:"componentVersions,omitempty"`
+
+	// EtcdSnapshot is the most recent on-demand etcd snapshot, requested by setting the
+	// `kubermatic.io/snapshot-now` annotation on the cluster.
+	// +optional
+	EtcdSnapshot *EtcdSnapshotStatus `json:"etcdSnapshot,omitempty"`
+}
+
+// EtcdSnapshotStatus describes an on-demand etcd snapshot.
+type EtcdSnapshotStatus struct {
+	// Trigger is the annotation value that requested the snapshot. Each value is only
+	// ever snapshotted once.
+	Trigger string `json:"trigger"`
+	// BackupConfig is the name of the one-shot EtcdBackupConfig in the cluster namespace
+	// that takes the snapshot.
+	// +optional
+	BackupConfig string `json:"backupConfig,omitempty"`
+	// Destination is the backup destination the snapshot is written to.
+	// +optional
+	Destination string `json:"destination,omitempty"`
+	// BackupName is the name of the snapshot in the backup destination.
+	// +optional
+	BackupName string `json:"backupName,omitempty"`
+	// Phase is the phase of the snapshot job, empty until it has been started.
+	// +optional
+	Phase BackupStatusPhase `json:"phase,omitempty"`
+	// Message describes why the snapshot has failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// FinishedTime is the time at which the snapshot completed or failed.
+	// +optional
+	FinishedTime *metav1.Time `json:"finishedTime,omitempty"`
 }
 
 // ClusterVersionsStatus contains information regarding the current and desired versions
@@ -690,6 +952,25 @@ type OPAIntegrationSettings struct {
 	AuditResources *corev1.ResourceRequirements `json:"auditResources,omitempty"`
 }
 
+// AuthorizationSettings configures additional authorizers for the kube-apiserver.
+type AuthorizationSettings struct {
+	// Optional: Webhook configures a webhook authorizer (e.g. an external policy engine)
+	// which receives SubjectAccessReviews for requests that have not been allowed by Node or RBAC.
+	Webhook *AuthorizationWebhookSettings `json:"webhook,omitempty"`
+}
+
+type AuthorizationWebhookSettings struct {
+	// URL is the endpoint the SubjectAccessReviews are sent to. It must use https.
+	URL string `json:"url"`
+	// Optional: CABundle is a PEM encoded CA bundle used to verify the webhook's serving certificate.
+	// If not set, the system trust roots are used.
+	CABundle string `json:"caBundle,omitempty"`
+	// Optional: AuthorizedTTL is the duration to cache 'authorized' responses from the webhook. Defaults to 5m.
+	AuthorizedTTL *metav1.Duration `json:"authorizedTTL,omitempty"`
+	// Optional: UnauthorizedTTL is the duration to cache 'unauthorized' responses from the webhook. Defaults to 30s.
+	UnauthorizedTTL *metav1.Duration `json:"unauthorizedTTL,omitempty"`
+}
+
 type ServiceAccountSettings struct {
 	TokenVolumeProjectionEnabled bool `json:"tokenVolumeProjectionEnabled,omitempty"`
 	// Issuer is the identifier of the service account token issuer
@@ -733,9 +1014,9 @@ type ComponentSettings struct {
 	// Apiserver configures kube-apiserver settings.
 	Apiserver APIServerSettings `json:"apiserver"`
 	// ControllerManager configures kube-controller-manager settings.
-	ControllerManager ControllerSettings `json:"controllerManager"`
+	ControllerManager ControllerManagerSettings `json:"controllerManager"`
 	// Scheduler configures kube-scheduler settings.
-	Scheduler ControllerSettings `json:"scheduler"`
+	Scheduler SchedulerSettings `json:"scheduler"`
 	// Etcd configures the etcd ring used to store Kubernetes data.
 	Etcd EtcdStatefulSetSettings `json:"etcd"`
 	// Prometheus configures the Prometheus instance deployed into the cluster control plane.
@@ -759,6 +1040,52 @@ type APIServerSettings struct {
 
 	EndpointReconcilingDisabled *bool  `json:"endpointReconcilingDisabled,omitempty"`
 	NodePortRange               string `json:"nodePortRange,omitempty"`
+
+	// MaxRequestsInflight overrides the maximum number of non-mutating requests the apiserver
+	// handles at a given time. Defaults to a value based on the cluster's tier.
+	MaxRequestsInflight *int32 `json:"maxRequestsInflight,omitempty"`
+	// MaxMutatingRequestsInflight overrides the maximum number of mutating requests the apiserver
+	// handles at a given time. Must be lower than MaxRequestsInflight. Defaults to a value based
+	// on the cluster's tier.
+	MaxMutatingRequestsInflight *int32 `json:"maxMutatingRequestsInflight,omitempty"`
+
+	// +kubebuilder:validation:Enum="";VersionTLS12;VersionTLS13
+
+	// TLSMinVersion is the minimum TLS version the apiserver accepts. Defaults to "VersionTLS12".
+	TLSMinVersion string `json:"tlsMinVersion,omitempty"`
+	// TLSCipherSuites restricts the TLS cipher suites the apiserver accepts, using the Go names,
+	// e.g. "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256". Unless TLS 1.3 is enforced, it must include
+	// an ECDHE_RSA cipher suite. Defaults to a set of secure AEAD cipher suites.
+	TLSCipherSuites []string `json:"tlsCipherSuites,omitempty"`
+
+	// ExtraArgs are additional flags passed to the apiserver, e.g. "--flag=value". Flags that
+	// enable anonymous authentication or an insecure port are rejected.
+	ExtraArgs []string `json:"extraArgs,omitempty"`
+
+	// Autoscaling configures a HorizontalPodAutoscaler for the apiserver. Unset fields are
+	// taken from the datacenter's defaults.
+	Autoscaling *APIServerAutoscalingSettings `json:"autoscaling,omitempty"`
+}
+
+// APIServerAutoscalingSettings configure the HorizontalPodAutoscaler of the apiserver. The
+// apiserver is only autoscaled if MaxReplicas is set.
+type APIServerAutoscalingSettings struct {
+	// MinReplicas is the lower limit for the number of apiserver replicas. Defaults to
+	// the number of replicas the apiserver runs with without autoscaling.
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit for the number of apiserver replicas.
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// TargetCPUUtilizationPercentage is the average CPU utilization of the apiserver pods,
+	// relative to their CPU requests, that the autoscaler aims for. Defaults to 80.
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+}
+
+// APIServerRequestLimits are the ceilings for concurrently handled requests of an apiserver.
+type APIServerRequestLimits struct {
+	// MaxRequestsInflight is the maximum number of non-mutating requests in flight.
+	MaxRequestsInflight int32 `json:"maxRequestsInflight"`
+	// MaxMutatingRequestsInflight is the maximum number of mutating requests in flight.
+	MaxMutatingRequestsInflight int32 `json:"maxMutatingRequestsInflight"`
 }
 
 type KonnectivityProxySettings struct {
@@ -775,6 +1102,51 @@ type ControllerSettings struct {
 	LeaderElectionSettings `json:"leaderElection,omitempty"`
 }
 
+type ControllerManagerSettings struct {
+	ControllerSettings `json:",inline"`
+
+	// NodeMonitorGracePeriod is the time a node may be unresponsive before it is
+	// marked as NotReady. Must be less than PodEvictionTimeout. Defaults to 40s.
+	NodeMonitorGracePeriod *metav1.Duration `json:"nodeMonitorGracePeriod,omitempty"`
+	// PodEvictionTimeout is the time pods remain bound to a NotReady or unreachable
+	// node before they are evicted. As kube-controller-manager no longer supports a
+	// flag for this, it is applied as the apiserver's default toleration seconds for
+	// the not-ready and unreachable taints. Defaults to 5m.
+	PodEvictionTimeout *metav1.Duration `json:"podEvictionTimeout,omitempty"`
+	// ConcurrentSyncs overrides how many objects the controllers of kube-controller-manager
+	// sync concurrently. Defaults to values based on the cluster's tier.
+	ConcurrentSyncs *ControllerManagerConcurrentSyncs `json:"concurrentSyncs,omitempty"`
+}
+
+// ControllerManagerConcurrentSyncs configures how many objects the controllers of
+// kube-controller-manager sync concurrently. Higher values make workloads on large
+// clusters reconcile faster, at the cost of more load on the apiserver.
+type ControllerManagerConcurrentSyncs struct {
+	// Deployment is the number of Deployments synced concurrently.
+	Deployment *int32 `json:"deployment,omitempty"`
+	// ReplicaSet is the number of ReplicaSets synced concurrently.
+	ReplicaSet *int32 `json:"replicaSet,omitempty"`
+	// StatefulSet is the number of StatefulSets synced concurrently.
+	StatefulSet *int32 `json:"statefulSet,omitempty"`
+	// DaemonSet is the number of DaemonSets synced concurrently.
+	DaemonSet *int32 `json:"daemonSet,omitempty"`
+	// Endpoint is the number of endpoint syncing operations done concurrently.
+	Endpoint *int32 `json:"endpoint,omitempty"`
+	// Namespace is the number of Namespaces synced concurrently.
+	Namespace *int32 `json:"namespace,omitempty"`
+	// GarbageCollector is the number of garbage collector workers.
+	GarbageCollector *int32 `json:"garbageCollector,omitempty"`
+}
+
+type SchedulerSettings struct {
+	ControllerSettings `json:",inline"`
+
+	// Configuration is a KubeSchedulerConfiguration (kubescheduler.config.k8s.io/v1) in
+	// YAML format, e.g. to enable additional plugins or change scoring weights. Changing
+	// it restarts the scheduler.
+	Configuration string `json:"configuration,omitempty"`
+}
+
 type DeploymentSettings struct {
 	Replicas    *int32                       `json:"replicas,omitempty"`
 	Resources   *corev1.ResourceRequirements `json:"resources,omitempty"`
@@ -795,7 +1167,8 @@ type EtcdStatefulSetSettings struct {
 	StorageClass string `json:"storageClass,omitempty"`
 	// DiskSize is the volume size used when creating persistent storage from
 	// the configured StorageClass. This is inherited from KubermaticConfiguration
-	// if not set. Defaults to 5Gi.
+	// if not set. Defaults to 5Gi. On clusters, this cannot be set or changed once
+	// the etcd StatefulSet has been created, as its volumes are not recreated.
 	DiskSize *resource.Quantity `json:"diskSize,omitempty"`
 	// Resources allows to override the resource requirements for etcd Pods.
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
@@ -811,7 +1184,33 @@ type EtcdStatefulSetSettings struct {
 	ZoneAntiAffinity AntiAffinityType `json:"zoneAntiAffinity,omitempty"`
 	// NodeSelector is a selector which restricts the set of nodes where etcd Pods can run.
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
-}
+	// QuotaBackendBytes is the size limit of the etcd database. etcd switches to
+	// read-only mode once its database exceeds it. Must be well below DiskSize.
+	// Defaults to the etcd default of 2Gi.
+	QuotaBackendBytes *resource.Quantity `json:"quotaBackendBytes,omitempty"`
+	// SafeToEvict controls whether the cluster-autoscaler is allowed to evict etcd Pods
+	// when scaling down seed nodes. Defaults to false, which protects the etcd quorum.
+	SafeToEvict *bool `json:"safeToEvict,omitempty"`
+	// AutoCompactionMode selects how etcd interprets AutoCompactionRetention, either
+	// as a time window ("periodic", default) or as a number of revisions ("revision").
+	AutoCompactionMode EtcdAutoCompactionMode `json:"autoCompactionMode,omitempty"`
+	// AutoCompactionRetention is how much history etcd keeps when compacting. In
+	// periodic mode this is a duration like "30m" or a number of hours, in revision
+	// mode the number of revisions. Defaults to 8 hours.
+	AutoCompactionRetention string `json:"autoCompactionRetention,omitempty"`
+	// CipherSuites restricts the TLS 1.2 cipher suites etcd accepts from clients and peers,
+	// using the Go names, e.g. "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256". Must include an
+	// ECDHE_RSA cipher suite. Defaults to a set of secure AEAD cipher suites.
+	CipherSuites []string `json:"cipherSuites,omitempty"`
+}
+
+// +kubebuilder:validation:Enum="";periodic;revision
+type EtcdAutoCompactionMode string
+
+const (
+	EtcdAutoCompactionModePeriodic EtcdAutoCompactionMode = "periodic"
+	EtcdAutoCompactionModeRevision EtcdAutoCompactionMode = "revision"
+)
 
 type LeaderElectionSettings struct {
 	// LeaseDurationSeconds is the duration in seconds that non-leader candidates
@@ -904,6 +1303,23 @@ type ClusterNetworkingConfig struct {
 
 	// TunnelingAgentIP is the address used by the tunneling agents
 	TunnelingAgentIP string `json:"tunnelingAgentIP,omitempty"`
+
+	// +kubebuilder:validation:Minimum=576
+	// +kubebuilder:validation:Maximum=1500
+
+	// TunnelMTU is the MTU of the OpenVPN tunnel between the control plane and the user cluster.
+	// Lowering it works around connection hangs caused by path-MTU issues. Only applies to
+	// clusters that still use OpenVPN. Defaults to the OpenVPN default.
+	TunnelMTU *int32 `json:"tunnelMTU,omitempty"`
+
+	// TunnelCipher is the data channel cipher of the OpenVPN tunnel, e.g. "AES-256-GCM" or
+	// "CHACHA20-POLY1305". Only applies to clusters that still use OpenVPN. Defaults to "AES-256-GCM".
+	TunnelCipher string `json:"tunnelCipher,omitempty"`
+
+	// TunnelCompression is the compression of the OpenVPN tunnel. "disabled" makes the server
+	// refuse compression altogether. Only applies to clusters that still use OpenVPN. Defaults
+	// to the OpenVPN default.
+	TunnelCompression OpenVPNCompression `json:"tunnelCompression,omitempty"`
 }
 
 // MachineNetworkingConfig specifies the networking parameters used for IPAM.
@@ -952,6 +1368,12 @@ type IPVSConfiguration struct {
 	// StrictArp configure arp_ignore and arp_announce to avoid answering ARP queries from kube-ipvs0 interface.
 	// defaults to true.
 	StrictArp *bool `json:"strictArp,omitempty"`
+
+	// +kubebuilder:validation:Enum="";rr;lc;dh;sh;sed;nq
+
+	// Scheduler is the IPVS scheduling algorithm kube-proxy configures for virtual servers
+	// ("rr" / "lc" / "dh" / "sh" / "sed" / "nq"). Defaults to round-robin if unset.
+	Scheduler string `json:"scheduler,omitempty"`
 }
 
 // CloudSpec stores configuration options for a given cloud provider. Provider specs are mutually exclusive.
@@ -399,6 +399,10 @@ type Datacenter struct {
 	// Spec describes the cloud provider settings used to manage resources
 	// in this datacenter. Exactly one cloud provider must be defined.
 	Spec DatacenterSpec `json:"spec"`
+	// Optional: PreviousNames lists the names this datacenter was known under before
+	// it was renamed. Clusters still referencing one of these names are resolved to
+	// this datacenter, but should be migrated to its current name.
+	PreviousNames []string `json:"previousNames,omitempty"`
 }
 
 // DatacenterSpec configures a KKP datacenter. Provider configuration is mutually exclusive,
@@ -493,6 +497,10 @@ type DatacenterSpec struct {
 	// By default, the type of service that will be used is determined by the `ExposeStrategy` used for the cluster.
 	// +optional
 	APIServerServiceType *corev1.ServiceType `json:"apiServerServiceType,omitempty"`
+
+	// Optional: APIServerAutoscaling configures the default HorizontalPodAutoscaler for the
+	// apiserver of user clusters in this datacenter. It can be overridden per cluster.
+	APIServerAutoscaling *APIServerAutoscalingSettings `json:"apiServerAutoscaling,omitempty"`
 }
 
 var (
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ResolveDatacenter returns the datacenter of the given seed that is known under the given name.
+// If no datacenter with that name exists, the datacenters' previous names are considered as well.
+// The returned name is the datacenter's current name, which differs from the given name if the
+// datacenter was resolved through one of its previous names.
+func ResolveDatacenter(seed *kubermaticv1.Seed, name string) (*kubermaticv1.Datacenter, string, bool) {
+	if datacenter, ok := seed.Spec.Datacenters[name]; ok {
+		return &datacenter, name, true
+	}
+
+	for dcName, datacenter := range seed.Spec.Datacenters {
+		for _, previousName := range datacenter.PreviousNames {
+			if previousName == name {
+				return &datacenter, dcName, true
+			}
+		}
+	}
+
+	return nil, "", false
+}
+
+// DatacenterNames returns the current and previous names of all datacenters of the given seed.
+func DatacenterNames(seed *kubermaticv1.Seed) sets.Set[string] {
+	names := sets.New[string]()
+
+	for dcName, datacenter := range seed.Spec.Datacenters {
+		names.Insert(dcName)
+		names.Insert(datacenter.PreviousNames...)
+	}
+
+	return names
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+)
+
+func TestResolveDatacenter(t *testing.T) {
+	seed := &kubermaticv1.Seed{
+		Spec: kubermaticv1.SeedSpec{
+			Datacenters: map[string]kubermaticv1.Datacenter{
+				"hetzner-fsn1": {
+					Location:      "Falkenstein",
+					PreviousNames: []string{"hetzner-falkenstein", "fsn1"},
+				},
+				"hetzner-nbg1": {
+					Location: "Nuremberg",
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		datacenterName   string
+		expectedFound    bool
+		expectedName     string
+		expectedLocation string
+	}{
+		{
+			name:             "current name",
+			datacenterName:   "hetzner-fsn1",
+			expectedFound:    true,
+			expectedName:     "hetzner-fsn1",
+			expectedLocation: "Falkenstein",
+		},
+		{
+			name:             "previous name",
+			datacenterName:   "fsn1",
+			expectedFound:    true,
+			expectedName:     "hetzner-fsn1",
+			expectedLocation: "Falkenstein",
+		},
+		{
+			name:             "datacenter without previous names",
+			datacenterName:   "hetzner-nbg1",
+			expectedFound:    true,
+			expectedName:     "hetzner-nbg1",
+			expectedLocation: "Nuremberg",
+		},
+		{
+			name:           "unknown datacenter",
+			datacenterName: "hetzner-hel1",
+			expectedFound:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			datacenter, name, found := ResolveDatacenter(seed, tc.datacenterName)
+			if found != tc.expectedFound {
+				t.Fatalf("Expected found to be %v, got %v", tc.expectedFound, found)
+			}
+
+			if !found {
+				if datacenter != nil {
+					t.Errorf("Expected no datacenter, got %v", datacenter)
+				}
+				return
+			}
+
+			if name != tc.expectedName {
+				t.Errorf("Expected datacenter name %q, got %q", tc.expectedName, name)
+			}
+
+			if datacenter.Location != tc.expectedLocation {
+				t.Errorf("Expected datacenter location %q, got %q", tc.expectedLocation, datacenter.Location)
+			}
+		})
+	}
+}
+
+func TestDatacenterNames(t *testing.T) {
+	seed := &kubermaticv1.Seed{
+		Spec: kubermaticv1.SeedSpec{
+			Datacenters: map[string]kubermaticv1.Datacenter{
+				"hetzner-fsn1": {PreviousNames: []string{"fsn1"}},
+				"hetzner-nbg1": {},
+			},
+		},
+	}
+
+	names := DatacenterNames(seed)
+	for _, expected := range []string{"hetzner-fsn1", "fsn1", "hetzner-nbg1"} {
+		if !names.Has(expected) {
+			t.Errorf("Expected %q to be a datacenter name, got %v", expected, names.UnsortedList())
+		}
+	}
+
+	if names.Len() != 3 {
+		t.Errorf("Expected 3 datacenter names, got %v", names.UnsortedList())
+	}
+}
@@ -46,6 +46,51 @@ func (in *AKS) DeepCopy() *AKS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerAutoscalingSettings) DeepCopyInto(out *APIServerAutoscalingSettings) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerAutoscalingSettings.
+func (in *APIServerAutoscalingSettings) DeepCopy() *APIServerAutoscalingSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerAutoscalingSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerRequestLimits) DeepCopyInto(out *APIServerRequestLimits) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerRequestLimits.
+func (in *APIServerRequestLimits) DeepCopy() *APIServerRequestLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerRequestLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerSettings) DeepCopyInto(out *APIServerSettings) {
 	*out = *in
@@ -55,6 +100,31 @@ func (in *APIServerSettings) DeepCopyInto(out *APIServerSettings) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaxRequestsInflight != nil {
+		in, out := &in.MaxRequestsInflight, &out.MaxRequestsInflight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxMutatingRequestsInflight != nil {
+		in, out := &in.MaxMutatingRequestsInflight, &out.MaxMutatingRequestsInflight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TLSCipherSuites != nil {
+		in, out := &in.TLSCipherSuites, &out.TLSCipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(APIServerAutoscalingSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerSettings.
@@ -675,9 +745,44 @@ func (in *ApplicationSettings) DeepCopy() *ApplicationSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogRotationSettings) DeepCopyInto(out *AuditLogRotationSettings) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBackup != nil {
+		in, out := &in.MaxBackup, &out.MaxBackup
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogRotationSettings.
+func (in *AuditLogRotationSettings) DeepCopy() *AuditLogRotationSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogRotationSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuditLoggingSettings) DeepCopyInto(out *AuditLoggingSettings) {
 	*out = *in
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(AuditLogRotationSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SidecarSettings != nil {
 		in, out := &in.SidecarSettings, &out.SidecarSettings
 		*out = new(AuditSidecarSettings)
@@ -781,6 +886,11 @@ func (in *AuditWebhookBackendSettings) DeepCopyInto(out *AuditWebhookBackendSett
 		*out = new(corev1.SecretReference)
 		**out = **in
 	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(AuditWebhookBatchSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhookBackendSettings.
@@ -793,6 +903,86 @@ func (in *AuditWebhookBackendSettings) DeepCopy() *AuditWebhookBackendSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditWebhookBatchSettings) DeepCopyInto(out *AuditWebhookBatchSettings) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxWait != nil {
+		in, out := &in.MaxWait, &out.MaxWait
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ThrottleQPS != nil {
+		in, out := &in.ThrottleQPS, &out.ThrottleQPS
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ThrottleBurst != nil {
+		in, out := &in.ThrottleBurst, &out.ThrottleBurst
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhookBatchSettings.
+func (in *AuditWebhookBatchSettings) DeepCopy() *AuditWebhookBatchSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditWebhookBatchSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationSettings) DeepCopyInto(out *AuthorizationSettings) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(AuthorizationWebhookSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationSettings.
+func (in *AuthorizationSettings) DeepCopy() *AuthorizationSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationWebhookSettings) DeepCopyInto(out *AuthorizationWebhookSettings) {
+	*out = *in
+	if in.AuthorizedTTL != nil {
+		in, out := &in.AuthorizedTTL, &out.AuthorizedTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.UnauthorizedTTL != nil {
+		in, out := &in.UnauthorizedTTL, &out.UnauthorizedTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationWebhookSettings.
+func (in *AuthorizationWebhookSettings) DeepCopy() *AuthorizationWebhookSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationWebhookSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Azure) DeepCopyInto(out *Azure) {
 	*out = *in
@@ -1314,6 +1504,11 @@ func (in *ClusterNetworkingConfig) DeepCopyInto(out *ClusterNetworkingConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.TunnelMTU != nil {
+		in, out := &in.TunnelMTU, &out.TunnelMTU
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNetworkingConfig.
@@ -1354,7 +1549,36 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(NetworkRanges)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ControlPlaneProxySettings != nil {
+		in, out := &in.ControlPlaneProxySettings, &out.ControlPlaneProxySettings
+		*out = new(ProxySettings)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ComponentsOverride.DeepCopyInto(&out.ComponentsOverride)
+	if in.ControlPlaneDNS != nil {
+		in, out := &in.ControlPlaneDNS, &out.ControlPlaneDNS
+		*out = new(ControlPlaneDNSSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageOverrides != nil {
+		in, out := &in.ImageOverrides, &out.ImageOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LogVerbosity != nil {
+		in, out := &in.LogVerbosity, &out.LogVerbosity
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LogFlushFrequency != nil {
+		in, out := &in.LogFlushFrequency, &out.LogFlushFrequency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	out.OIDC = in.OIDC
 	if in.Features != nil {
 		in, out := &in.Features, &out.Features
@@ -1368,6 +1592,11 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(UpdateWindow)
 		**out = **in
 	}
+	if in.ControlPlaneMaintenanceWindow != nil {
+		in, out := &in.ControlPlaneMaintenanceWindow, &out.ControlPlaneMaintenanceWindow
+		*out = new(UpdateWindow)
+		**out = **in
+	}
 	if in.AdmissionPlugins != nil {
 		in, out := &in.AdmissionPlugins, &out.AdmissionPlugins
 		*out = make([]string, len(*in))
@@ -1410,6 +1639,11 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(AuditLoggingSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(AuthorizationSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OPAIntegration != nil {
 		in, out := &in.OPAIntegration, &out.OPAIntegration
 		*out = new(OPAIntegrationSettings)
@@ -1459,6 +1693,8 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
 	in.ExtendedHealth.DeepCopyInto(&out.ExtendedHealth)
 	in.LastProviderReconciliation.DeepCopyInto(&out.LastProviderReconciliation)
+	in.LastReconcileTime.DeepCopyInto(&out.LastReconcileTime)
+	in.LastSuccessfulReconcileTime.DeepCopyInto(&out.LastSuccessfulReconcileTime)
 	in.Versions.DeepCopyInto(&out.Versions)
 	if in.ErrorReason != nil {
 		in, out := &in.ErrorReason, &out.ErrorReason
@@ -1494,6 +1730,23 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 		*out = new(ResourceDetails)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.APIServerRequestLimits != nil {
+		in, out := &in.APIServerRequestLimits, &out.APIServerRequestLimits
+		*out = new(APIServerRequestLimits)
+		**out = **in
+	}
+	if in.ComponentVersions != nil {
+		in, out := &in.ComponentVersions, &out.ComponentVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EtcdSnapshot != nil {
+		in, out := &in.EtcdSnapshot, &out.EtcdSnapshot
+		*out = new(EtcdSnapshotStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
@@ -1989,6 +2242,107 @@ func (in *ContainerdRegistry) DeepCopy() *ContainerdRegistry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneDNSSettings) DeepCopyInto(out *ControlPlaneDNSSettings) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(corev1.PodDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneDNSSettings.
+func (in *ControlPlaneDNSSettings) DeepCopy() *ControlPlaneDNSSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneDNSSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerManagerConcurrentSyncs) DeepCopyInto(out *ControllerManagerConcurrentSyncs) {
+	*out = *in
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplicaSet != nil {
+		in, out := &in.ReplicaSet, &out.ReplicaSet
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StatefulSet != nil {
+		in, out := &in.StatefulSet, &out.StatefulSet
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DaemonSet != nil {
+		in, out := &in.DaemonSet, &out.DaemonSet
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GarbageCollector != nil {
+		in, out := &in.GarbageCollector, &out.GarbageCollector
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerManagerConcurrentSyncs.
+func (in *ControllerManagerConcurrentSyncs) DeepCopy() *ControllerManagerConcurrentSyncs {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerManagerConcurrentSyncs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerManagerSettings) DeepCopyInto(out *ControllerManagerSettings) {
+	*out = *in
+	in.ControllerSettings.DeepCopyInto(&out.ControllerSettings)
+	if in.NodeMonitorGracePeriod != nil {
+		in, out := &in.NodeMonitorGracePeriod, &out.NodeMonitorGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.PodEvictionTimeout != nil {
+		in, out := &in.PodEvictionTimeout, &out.PodEvictionTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(ControllerManagerConcurrentSyncs)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerManagerSettings.
+func (in *ControllerManagerSettings) DeepCopy() *ControllerManagerSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerManagerSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerSettings) DeepCopyInto(out *ControllerSettings) {
 	*out = *in
@@ -2065,6 +2419,11 @@ func (in *Datacenter) DeepCopyInto(out *Datacenter) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.PreviousNames != nil {
+		in, out := &in.PreviousNames, &out.PreviousNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Datacenter.
@@ -2202,6 +2561,11 @@ func (in *DatacenterSpec) DeepCopyInto(out *DatacenterSpec) {
 		*out = new(corev1.ServiceType)
 		**out = **in
 	}
+	if in.APIServerAutoscaling != nil {
+		in, out := &in.APIServerAutoscaling, &out.APIServerAutoscaling
+		*out = new(APIServerAutoscalingSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterSpec.
@@ -2753,6 +3117,11 @@ func (in *EncryptionConfiguration) DeepCopyInto(out *EncryptionConfiguration) {
 		*out = new(SecretboxEncryptionConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(KMSEncryptionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfiguration.
@@ -3040,6 +3409,25 @@ func (in *EtcdRestoreStatus) DeepCopy() *EtcdRestoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshotStatus) DeepCopyInto(out *EtcdSnapshotStatus) {
+	*out = *in
+	if in.FinishedTime != nil {
+		in, out := &in.FinishedTime, &out.FinishedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotStatus.
+func (in *EtcdSnapshotStatus) DeepCopy() *EtcdSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdStatefulSetSettings) DeepCopyInto(out *EtcdStatefulSetSettings) {
 	*out = *in
@@ -3072,6 +3460,21 @@ func (in *EtcdStatefulSetSettings) DeepCopyInto(out *EtcdStatefulSetSettings) {
 			(*out)[key] = val
 		}
 	}
+	if in.QuotaBackendBytes != nil {
+		in, out := &in.QuotaBackendBytes, &out.QuotaBackendBytes
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.SafeToEvict != nil {
+		in, out := &in.SafeToEvict, &out.SafeToEvict
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CipherSuites != nil {
+		in, out := &in.CipherSuites, &out.CipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdStatefulSetSettings.
@@ -4037,6 +4440,26 @@ func (in *Incompatibility) DeepCopy() *Incompatibility {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSEncryptionConfiguration) DeepCopyInto(out *KMSEncryptionConfiguration) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSEncryptionConfiguration.
+func (in *KMSEncryptionConfiguration) DeepCopy() *KMSEncryptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSEncryptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kind) DeepCopyInto(out *Kind) {
 	*out = *in
@@ -6242,6 +6665,22 @@ func (in *SSHKeySpec) DeepCopy() *SSHKeySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulerSettings) DeepCopyInto(out *SchedulerSettings) {
+	*out = *in
+	in.ControllerSettings.DeepCopyInto(&out.ControllerSettings)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulerSettings.
+func (in *SchedulerSettings) DeepCopy() *SchedulerSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulerSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretboxEncryptionConfiguration) DeepCopyInto(out *SecretboxEncryptionConfiguration) {
 	*out = *in
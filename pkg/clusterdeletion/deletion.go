@@ -19,6 +19,7 @@ package clusterdeletion
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -34,11 +35,14 @@ const (
 	deletedLBAnnotationName = "kubermatic.k8c.io/cleaned-up-loadbalancers"
 )
 
-func New(seedClient ctrlruntimeclient.Client, recorder record.EventRecorder, userClusterClientGetter func() (ctrlruntimeclient.Client, error)) *Deletion {
+// New returns a Deletion. If nodeCleanupSkipTimeout is positive, the node cleanup of clusters
+// whose apiserver is unreachable can be skipped once the timeout has passed, see SkipNodeCleanupAnnotation.
+func New(seedClient ctrlruntimeclient.Client, recorder record.EventRecorder, userClusterClientGetter func() (ctrlruntimeclient.Client, error), nodeCleanupSkipTimeout time.Duration) *Deletion {
 	return &Deletion{
 		seedClient:              seedClient,
 		recorder:                recorder,
 		userClusterClientGetter: userClusterClientGetter,
+		nodeCleanupSkipTimeout:  nodeCleanupSkipTimeout,
 	}
 }
 
@@ -46,6 +50,7 @@ type Deletion struct {
 	seedClient              ctrlruntimeclient.Client
 	recorder                record.EventRecorder
 	userClusterClientGetter func() (ctrlruntimeclient.Client, error)
+	nodeCleanupSkipTimeout  time.Duration
 }
 
 // CleanupCluster is responsible for cleaning up a cluster.
@@ -75,7 +80,7 @@ func (d *Deletion) CleanupCluster(ctx context.Context, log *zap.SugaredLogger, c
 		return err
 	}
 
-	if err := d.cleanupNodes(ctx, cluster); err != nil {
+	if err := d.cleanupNodes(ctx, log, cluster); err != nil {
 		return err
 	}
 
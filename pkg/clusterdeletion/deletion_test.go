@@ -18,8 +18,12 @@ package clusterdeletion
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -191,6 +195,83 @@ func TestNodesRemainUntilInClusterResourcesAreGone(t *testing.T) {
 	}
 }
 
+func TestCleanupNodesOfUnreachableCluster(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		timeout               time.Duration
+		deletedSince          time.Duration
+		acknowledged          bool
+		expectFinalizerRemove bool
+	}{
+		{
+			name:         "skipping is disabled",
+			deletedSince: 24 * time.Hour,
+			acknowledged: true,
+		},
+		{
+			name:         "timeout has not passed yet",
+			timeout:      time.Hour,
+			deletedSince: 10 * time.Minute,
+			acknowledged: true,
+		},
+		{
+			name:         "timeout has passed, but skipping was not acknowledged",
+			timeout:      time.Hour,
+			deletedSince: 2 * time.Hour,
+		},
+		{
+			name:                  "timeout has passed and skipping was acknowledged",
+			timeout:               time.Hour,
+			deletedSince:          2 * time.Hour,
+			acknowledged:          true,
+			expectFinalizerRemove: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := getClusterWithFinalizer("cluster", kubermaticv1.NodeDeletionFinalizer, kubermaticv1.CredentialsSecretsCleanupFinalizer)
+			cluster.Status.NamespaceName = testNS
+			cluster.DeletionTimestamp = &metav1.Time{Time: time.Now().Add(-tc.deletedSince)}
+			if tc.acknowledged {
+				cluster.Annotations = map[string]string{kubermaticv1.SkipNodeCleanupAnnotation: "true"}
+			}
+
+			seedClient := fake.NewClientBuilder().WithObjects(cluster).Build()
+			recorder := record.NewFakeRecorder(10)
+			deletion := New(seedClient, recorder, func() (ctrlruntimeclient.Client, error) {
+				return nil, errors.New("connection refused")
+			}, tc.timeout)
+
+			ctx := context.Background()
+			err := deletion.cleanupNodes(ctx, zap.NewNop().Sugar(), cluster)
+			if tc.expectFinalizerRemove != (err == nil) {
+				t.Fatalf("Expected error = %v, got %v", !tc.expectFinalizerRemove, err)
+			}
+
+			updated := &kubermaticv1.Cluster{}
+			if err := seedClient.Get(ctx, types.NamespacedName{Name: cluster.Name}, updated); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			if hasFinalizer := slices.Contains(updated.Finalizers, kubermaticv1.NodeDeletionFinalizer); hasFinalizer == tc.expectFinalizerRemove {
+				t.Errorf("Expected node deletion finalizer to be removed = %v, but finalizers are %v", tc.expectFinalizerRemove, updated.Finalizers)
+			}
+
+			if tc.expectFinalizerRemove {
+				select {
+				case event := <-recorder.Events:
+					if !strings.Contains(event, "NodeCleanupSkipped") {
+						t.Errorf("Expected an event about the skipped node cleanup, got %q", event)
+					}
+				default:
+					t.Error("Expected an event about the skipped node cleanup, got none")
+				}
+			}
+		})
+	}
+}
+
 func getClusterWithFinalizer(name string, finalizers ...string) *kubermaticv1.Cluster {
 	return &kubermaticv1.Cluster{
 		ObjectMeta: metav1.ObjectMeta{
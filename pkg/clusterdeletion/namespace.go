@@ -25,7 +25,7 @@ import (
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
-	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
 
 	corev1 "k8s.io/api/core/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,29 +39,38 @@ func (d *Deletion) cleanupNamespace(ctx context.Context, log *zap.SugaredLogger,
 	// It can happen that the namespace is correctly created, but the status update failed.
 	// In this case we replicate the cluster controller's defaulting behaviour to catch the
 	// default namespace name.
-	namespace := cluster.Status.NamespaceName
-	if namespace == "" {
-		namespace = kubernetesprovider.NamespaceName(cluster.Name)
+	namespace := resources.NamespaceName(cluster)
+
+	namespaces := []string{namespace}
+	if resources.IsSplitControlPlane(cluster) {
+		namespaces = append(namespaces, namespace+resources.EtcdNamespaceSuffix)
 	}
 
-	// check if the namespace still exists
-	ns := &corev1.Namespace{}
-	ns.Name = namespace
+	// check if the namespaces still exist
+	terminating := false
+	for _, name := range namespaces {
+		ns := &corev1.Namespace{}
+		ns.Name = name
 
-	err := d.seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(ns), ns)
-	if ctrlruntimeclient.IgnoreNotFound(err) != nil {
-		return fmt.Errorf("failed to check for cluster namespace: %w", err)
-	}
+		err := d.seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(ns), ns)
+		if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to check for cluster namespace: %w", err)
+		}
 
-	// namespace could still be retrieved
-	if err == nil {
-		if ns.DeletionTimestamp == nil {
-			log.Infow("deleting cluster namespace", "namespace", ns.Name)
-			if err := d.seedClient.Delete(ctx, ns); ctrlruntimeclient.IgnoreNotFound(err) != nil {
-				return fmt.Errorf("failed to delete cluster namespace: %w", err)
+		// namespace could still be retrieved
+		if err == nil {
+			if ns.DeletionTimestamp == nil {
+				log.Infow("deleting cluster namespace", "namespace", ns.Name)
+				if err := d.seedClient.Delete(ctx, ns); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+					return fmt.Errorf("failed to delete cluster namespace: %w", err)
+				}
 			}
+
+			terminating = true
 		}
+	}
 
+	if terminating {
 		d.recorder.Event(cluster, corev1.EventTypeNormal, "ClusterNamespaceCleanup", "Cluster namespace is still terminating, some resources might be blocked by finalizers.")
 		return nil
 	}
@@ -69,7 +78,7 @@ func (d *Deletion) cleanupNamespace(ctx context.Context, log *zap.SugaredLogger,
 	// Removing the NamespaceName from the Cluster will make all other controllers
 	// instantly stop reconciling this one, without even checking its DeletionTimestamp.
 	if cluster.Status.NamespaceName != "" {
-		err = kubermaticv1helper.UpdateClusterStatus(ctx, d.seedClient, cluster, func(c *kubermaticv1.Cluster) {
+		err := kubermaticv1helper.UpdateClusterStatus(ctx, d.seedClient, cluster, func(c *kubermaticv1.Cluster) {
 			c.Status.NamespaceName = ""
 		})
 		if err != nil {
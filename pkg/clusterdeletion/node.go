@@ -19,6 +19,9 @@ package clusterdeletion
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"go.uber.org/zap"
 
 	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
 	eviction "github.com/kubermatic/machine-controller/pkg/node/eviction/types"
@@ -31,7 +34,7 @@ import (
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+func (d *Deletion) cleanupNodes(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
 	if !kuberneteshelper.HasFinalizer(cluster, kubermaticv1.NodeDeletionFinalizer) {
 		return nil
 	}
@@ -42,12 +45,12 @@ func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Clust
 
 	userClusterClient, err := d.userClusterClientGetter()
 	if err != nil {
-		return err
+		return d.handleUnreachableUserCluster(ctx, log, cluster, err)
 	}
 
 	nodes := &corev1.NodeList{}
 	if err := userClusterClient.List(ctx, nodes); err != nil {
-		return fmt.Errorf("failed to get user cluster nodes: %w", err)
+		return d.handleUnreachableUserCluster(ctx, log, cluster, fmt.Errorf("failed to get user cluster nodes: %w", err))
 	}
 
 	// If we delete a cluster, we should disable the eviction on the nodes
@@ -116,3 +119,27 @@ func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Clust
 
 	return kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, kubermaticv1.NodeDeletionFinalizer)
 }
+
+// handleUnreachableUserCluster is called when the nodes cannot be cleaned up because the user cluster
+// apiserver is unreachable. Unless the cleanup may be skipped, the original error is returned. Skipping
+// might leave machines behind at the cloud provider, so it requires both the configured timeout to have
+// passed since the deletion started and an operator to have acknowledged it with an annotation.
+func (d *Deletion) handleUnreachableUserCluster(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, unreachableErr error) error {
+	if d.nodeCleanupSkipTimeout <= 0 || cluster.DeletionTimestamp == nil {
+		return unreachableErr
+	}
+
+	if deadline := cluster.DeletionTimestamp.Add(d.nodeCleanupSkipTimeout); time.Now().Before(deadline) {
+		return unreachableErr
+	}
+
+	if cluster.Annotations[kubermaticv1.SkipNodeCleanupAnnotation] != "true" {
+		d.recorder.Eventf(cluster, corev1.EventTypeWarning, "NodeCleanup", "The user cluster is unreachable, annotate the cluster with %s=true to skip the node cleanup. Machines might be left behind at the cloud provider.", kubermaticv1.SkipNodeCleanupAnnotation)
+		return unreachableErr
+	}
+
+	log.Warnw("Skipping node cleanup because the user cluster is unreachable and the skip was acknowledged", "annotation", kubermaticv1.SkipNodeCleanupAnnotation, zap.Error(unreachableErr))
+	d.recorder.Eventf(cluster, corev1.EventTypeWarning, "NodeCleanupSkipped", "Node cleanup was skipped as acknowledged by the %s annotation, machines might be left behind at the cloud provider: %v", kubermaticv1.SkipNodeCleanupAnnotation, unreachableErr)
+
+	return kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, kubermaticv1.NodeDeletionFinalizer)
+}
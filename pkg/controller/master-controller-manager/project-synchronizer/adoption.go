@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectsynchronizer
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// masterFinalizers are the finalizers that only controllers on the master cluster take care of.
+// On a dedicated seed cluster, nothing would ever remove them from a Project.
+var masterFinalizers = []string{
+	cleanupFinalizer,
+	rbac.CleanupFinalizerName,
+}
+
+// needsAdoption determines whether the Project on the seed cluster is a copy that this controller
+// does not own yet. This happens when a shared master/seed cluster is split into a dedicated master
+// and seed, as the Projects are then copied onto the seed with a new UID, but carry over the
+// annotations and finalizers of the master Projects. A Project with the same UID as the master
+// Project is the master Project itself on a shared master/seed and is never adopted.
+func needsAdoption(seedProject, project *kubermaticv1.Project) bool {
+	if seedProject.UID == "" || seedProject.UID == project.UID {
+		return false
+	}
+
+	return seedProject.Annotations[SyncedProjectAnnotation] != "true" || kuberneteshelper.HasAnyFinalizer(seedProject, masterFinalizers...)
+}
+
+// adoptSeedProject removes the master finalizers from a Project copied onto a dedicated seed
+// cluster, so that it can be synced and deleted like any other seed Project. The synced annotation
+// is set by the regular sync afterwards.
+func (r *reconciler) adoptSeedProject(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, seedProject, project *kubermaticv1.Project) error {
+	if err := kuberneteshelper.TryRemoveFinalizer(ctx, seedClient, seedProject, masterFinalizers...); err != nil {
+		return fmt.Errorf("failed to remove master finalizers: %w", err)
+	}
+
+	log.Info("Adopted project on dedicated seed cluster")
+	r.recorder.Event(project, corev1.EventTypeNormal, "ProjectAdopted", "Adopted the project copied onto a dedicated seed cluster.")
+
+	return nil
+}
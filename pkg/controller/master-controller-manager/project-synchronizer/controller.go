@@ -19,6 +19,7 @@ package projectsynchronizer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -30,13 +31,16 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlruntimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -45,6 +49,10 @@ const (
 
 	// cleanupFinalizer indicates that Kubermatic Projects on the seed clusters need cleanup.
 	cleanupFinalizer = "kubermatic.k8c.io/cleanup-seed-projects"
+
+	// SyncedProjectAnnotation marks Projects on seed clusters that have been created by this
+	// controller, so that they can be told apart from Projects created by other means.
+	SyncedProjectAnnotation = "kubermatic.k8c.io/synced-from-master"
 )
 
 type reconciler struct {
@@ -52,6 +60,7 @@ type reconciler struct {
 	recorder     record.EventRecorder
 	masterClient ctrlruntimeclient.Client
 	seedClients  kuberneteshelper.SeedClientMap
+	metrics      *metrics
 }
 
 func Add(
@@ -65,6 +74,12 @@ func Add(
 		recorder:     masterManager.GetEventRecorderFor(ControllerName),
 		masterClient: masterManager.GetClient(),
 		seedClients:  kuberneteshelper.SeedClientMap{},
+		metrics:      newMetrics(),
+	}
+
+	// register on the controller-runtime registry, which is exposed by the manager's metrics server
+	if err := r.metrics.register(ctrlruntimemetrics.Registry); err != nil {
+		return fmt.Errorf("failed to register metrics: %w", err)
 	}
 
 	for seedName, seedManager := range seedManagers {
@@ -97,6 +112,11 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	start := time.Now()
+	defer func() {
+		r.metrics.reconcileDuration.Observe(time.Since(start).Seconds())
+	}()
+
 	if !project.DeletionTimestamp.IsZero() {
 		if err := r.handleDeletion(ctx, log, project); err != nil {
 			return reconcile.Result{}, fmt.Errorf("handling deletion: %w", err)
@@ -108,63 +128,110 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
 	}
 
-	projectReconcilerFactories := []reconciling.NamedProjectReconcilerFactory{
-		projectReconcilerFactory(project),
+	err := r.syncAllSeeds(ctx, log, project)
+	if err != nil {
+		r.recorder.Event(project, corev1.EventTypeWarning, "ReconcilingError", err.Error())
 	}
 
-	err := r.seedClients.Each(ctx, log, func(_ string, seedClient ctrlruntimeclient.Client, log *zap.SugaredLogger) error {
-		seedProject := &kubermaticv1.Project{}
-		if err := seedClient.Get(ctx, request.NamespacedName, seedProject); err != nil && !apierrors.IsNotFound(err) {
-			return fmt.Errorf("failed to fetch project on seed cluster: %w", err)
-		}
+	return reconcile.Result{}, err
+}
+
+// syncAllSeeds replicates the project onto all seed clusters. A failing seed does not
+// prevent the project from being replicated onto the other seeds; the errors of all
+// failed seeds are returned together, so that the project is requeued. Seeds that are
+// being removed from the master do not get the project anymore, instead its copy is
+// deleted from them.
+func (r *reconciler) syncAllSeeds(ctx context.Context, log *zap.SugaredLogger, project *kubermaticv1.Project) error {
+	removedSeeds, err := r.removedSeeds(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
 
-		// The informer can trigger a reconciliation before the cache backing the
-		// master client has been updated; this can make the reconciler read
-		// an old state and would replicate this old state onto seeds; if master
-		// and seed are the same cluster, this would effectively overwrite the
-		// change that just happened.
-		// To prevent this from occurring, we check the UID and refuse to update
-		// the project if the UID on the seed == UID on the master.
-		// Note that in this distinction cannot be made inside the creator function
-		// further down, as the reconciling framework reads the current state
-		// from cache and even if no changes were made (because of the UID match),
-		// it would still persist the new object and might overwrite the actual,
-		// new state.
-		if seedProject.UID != "" && seedProject.UID == project.UID {
-			return nil
+	for _, seedName := range sets.List(sets.KeySet(r.seedClients)) {
+		seedLog := log.With("seed", seedName)
+
+		var err error
+		if removedSeeds.Has(seedName) {
+			err = r.deleteOrphanedProject(ctx, seedLog, r.seedClients[seedName], project)
+		} else {
+			err = r.syncSeed(ctx, seedLog, r.seedClients[seedName], project)
+			r.metrics.observeSync(seedName, project.Name, err)
 		}
 
-		err := reconciling.ReconcileProjects(ctx, projectReconcilerFactories, "", seedClient)
 		if err != nil {
-			return fmt.Errorf("failed to reconcile project: %w", err)
+			errs = append(errs, fmt.Errorf("failed processing Seed %s: %w", seedName, err))
 		}
+	}
 
-		// fetch the updated project from the cache
-		if err := seedClient.Get(ctx, request.NamespacedName, seedProject); err != nil {
-			return fmt.Errorf("failed to fetch project on seed cluster: %w", err)
-		}
+	return kerrors.NewAggregate(errs)
+}
 
-		if !equality.Semantic.DeepEqual(seedProject.Status, project.Status) {
-			oldProject := seedProject.DeepCopy()
-			seedProject.Status = project.Status
-			if err := seedClient.Status().Patch(ctx, seedProject, ctrlruntimeclient.MergeFrom(oldProject)); err != nil {
-				return fmt.Errorf("failed to update project status on seed cluster: %w", err)
-			}
-		}
+// syncSeed replicates the project onto a single seed cluster.
+func (r *reconciler) syncSeed(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, project *kubermaticv1.Project) error {
+	key := ctrlruntimeclient.ObjectKeyFromObject(project)
+
+	seedProject := &kubermaticv1.Project{}
+	if err := seedClient.Get(ctx, key, seedProject); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to fetch project on seed cluster: %w", err)
+	}
 
+	// The informer can trigger a reconciliation before the cache backing the
+	// master client has been updated; this can make the reconciler read
+	// an old state and would replicate this old state onto seeds; if master
+	// and seed are the same cluster, this would effectively overwrite the
+	// change that just happened.
+	// To prevent this from occurring, we check the UID and refuse to update
+	// the project if the UID on the seed == UID on the master.
+	// Note that in this distinction cannot be made inside the creator function
+	// further down, as the reconciling framework reads the current state
+	// from cache and even if no changes were made (because of the UID match),
+	// it would still persist the new object and might overwrite the actual,
+	// new state.
+	//
+	if seedProject.UID != "" && seedProject.UID == project.UID {
 		return nil
-	})
+	}
 
-	if err != nil {
-		r.recorder.Event(project, corev1.EventTypeWarning, "ReconcilingError", err.Error())
+	// A project copied onto the seed when a shared master/seed was split up has
+	// to be adopted before it can be synced.
+	if needsAdoption(seedProject, project) {
+		if err := r.adoptSeedProject(ctx, log, seedClient, seedProject, project); err != nil {
+			return err
+		}
 	}
 
-	return reconcile.Result{}, err
+	projectReconcilerFactories := []reconciling.NamedProjectReconcilerFactory{
+		projectReconcilerFactory(project),
+	}
+
+	if err := reconciling.ReconcileProjects(ctx, projectReconcilerFactories, "", seedClient); err != nil {
+		return fmt.Errorf("failed to reconcile project: %w", err)
+	}
+
+	// fetch the updated project from the cache
+	if err := seedClient.Get(ctx, key, seedProject); err != nil {
+		return fmt.Errorf("failed to fetch project on seed cluster: %w", err)
+	}
+
+	if !equality.Semantic.DeepEqual(seedProject.Status, project.Status) {
+		oldProject := seedProject.DeepCopy()
+		seedProject.Status = project.Status
+		if err := seedClient.Status().Patch(ctx, seedProject, ctrlruntimeclient.MergeFrom(oldProject)); err != nil {
+			return fmt.Errorf("failed to update project status on seed cluster: %w", err)
+		}
+	}
+
+	return nil
 }
 
 func (r *reconciler) handleDeletion(ctx context.Context, log *zap.SugaredLogger, project *kubermaticv1.Project) error {
-	err := r.seedClients.Each(ctx, log, func(_ string, seedClient ctrlruntimeclient.Client, log *zap.SugaredLogger) error {
-		return ctrlruntimeclient.IgnoreNotFound(seedClient.Delete(ctx, project))
+	err := r.seedClients.Each(ctx, log, func(seedName string, seedClient ctrlruntimeclient.Client, log *zap.SugaredLogger) error {
+		err := r.deleteSeedProject(ctx, log, seedClient, project)
+		r.metrics.observeDeletion(seedName, project.Name, err)
+
+		return err
 	})
 	if err != nil {
 		return err
@@ -173,6 +240,61 @@ func (r *reconciler) handleDeletion(ctx context.Context, log *zap.SugaredLogger,
 	return kuberneteshelper.TryRemoveFinalizer(ctx, r.masterClient, project, cleanupFinalizer)
 }
 
+// deleteSeedProject deletes the project on a single seed cluster. A project that has been copied
+// onto the seed when a shared master/seed was split up is adopted first, as the master finalizers
+// on it would otherwise block its deletion forever.
+func (r *reconciler) deleteSeedProject(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, project *kubermaticv1.Project) error {
+	seedProject := &kubermaticv1.Project{}
+	if err := seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(project), seedProject); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to fetch project on seed cluster: %w", err)
+		}
+	} else if needsAdoption(seedProject, project) {
+		if err := r.adoptSeedProject(ctx, log, seedClient, seedProject, project); err != nil {
+			return err
+		}
+	}
+
+	return ctrlruntimeclient.IgnoreNotFound(seedClient.Delete(ctx, project))
+}
+
+// removedSeeds returns the names of all Seeds that are being deleted from the master cluster.
+func (r *reconciler) removedSeeds(ctx context.Context) (sets.Set[string], error) {
+	seeds := &kubermaticv1.SeedList{}
+	if err := r.masterClient.List(ctx, seeds); err != nil {
+		return nil, fmt.Errorf("failed to list seeds: %w", err)
+	}
+
+	removed := sets.New[string]()
+	for _, seed := range seeds.Items {
+		if seed.DeletionTimestamp != nil {
+			removed.Insert(seed.Name)
+		}
+	}
+
+	return removed, nil
+}
+
+// deleteOrphanedProject deletes the copy of the project from a seed cluster that is being
+// removed from the master. Only copies created by this controller are deleted; on a shared
+// master/seed cluster, the project on the seed is the master project itself and is kept.
+func (r *reconciler) deleteOrphanedProject(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, project *kubermaticv1.Project) error {
+	seedProject := &kubermaticv1.Project{}
+	if err := seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(project), seedProject); err != nil {
+		return ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if seedProject.UID == project.UID || seedProject.Annotations[SyncedProjectAnnotation] != "true" || seedProject.DeletionTimestamp != nil {
+		return nil
+	}
+
+	log.Info("Seed is being removed, deleting orphaned project")
+
+	return ctrlruntimeclient.IgnoreNotFound(seedClient.Delete(ctx, seedProject))
+}
+
+// enqueueAllProjects enqueues all projects whenever a Seed changes, so that they are synced
+// onto new seeds and removed from seeds that are being deleted.
 func enqueueAllProjects(client ctrlruntimeclient.Client, log *zap.SugaredLogger) handler.EventHandler {
 	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a ctrlruntimeclient.Object) []reconcile.Request {
 		var requests []reconcile.Request
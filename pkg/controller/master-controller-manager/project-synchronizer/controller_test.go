@@ -18,11 +18,18 @@ package projectsynchronizer
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/test/diff"
 	"k8c.io/kubermatic/v2/pkg/test/fake"
@@ -31,8 +38,10 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -54,7 +63,7 @@ func TestReconcile(t *testing.T) {
 		{
 			name:            "scenario 1: sync project from master cluster to seed cluster",
 			requestName:     projectName,
-			expectedProject: generateProject(projectName, false, nil),
+			expectedProject: syncedProject(generateProject(projectName, false, nil)),
 			masterClient: fake.
 				NewClientBuilder().
 				WithObjects(generateProject(projectName, false, nil), generator.GenTestSeed()).
@@ -79,7 +88,7 @@ func TestReconcile(t *testing.T) {
 		{
 			name:            "scenario 3: sync project with labels from master cluster to seed cluster",
 			requestName:     projectName,
-			expectedProject: generateProject(projectName, false, projectLabels),
+			expectedProject: syncedProject(generateProject(projectName, false, projectLabels)),
 			masterClient: fake.
 				NewClientBuilder().
 				WithObjects(generateProject(projectName, false, projectLabels), generator.GenTestSeed()).
@@ -98,6 +107,7 @@ func TestReconcile(t *testing.T) {
 				recorder:     &record.FakeRecorder{},
 				masterClient: tc.masterClient,
 				seedClients:  map[string]ctrlruntimeclient.Client{"test": tc.seedClient},
+				metrics:      newMetrics(),
 			}
 
 			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: tc.requestName}}
@@ -130,6 +140,382 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
+func TestReconcileMetrics(t *testing.T) {
+	failingGet := interceptor.Funcs{
+		Get: func(ctx context.Context, client ctrlruntimeclient.WithWatch, key ctrlruntimeclient.ObjectKey, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.GetOption) error {
+			return errors.New("seed is unreachable")
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		deleted           bool
+		seedClient        ctrlruntimeclient.Client
+		expectErr         bool
+		expectedSuccesses float64
+		expectedFailures  float64
+		expectedInSync    float64
+		expectedOutOfSync float64
+	}{
+		{
+			name:              "successful sync",
+			seedClient:        fake.NewClientBuilder().Build(),
+			expectedSuccesses: 1,
+			expectedInSync:    1,
+		},
+		{
+			name:              "failed sync",
+			seedClient:        fake.NewClientBuilder().WithInterceptorFuncs(failingGet).Build(),
+			expectErr:         true,
+			expectedFailures:  1,
+			expectedOutOfSync: 1,
+		},
+		{
+			name:              "successful deletion",
+			deleted:           true,
+			seedClient:        fake.NewClientBuilder().WithObjects(generateProject(projectName, false, nil)).Build(),
+			expectedSuccesses: 1,
+		},
+		{
+			name:    "failed deletion",
+			deleted: true,
+			seedClient: fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+				Delete: func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.DeleteOption) error {
+					return errors.New("seed is unreachable")
+				},
+			}).Build(),
+			expectErr:        true,
+			expectedFailures: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &reconciler{
+				log:          kubermaticlog.Logger,
+				recorder:     &record.FakeRecorder{},
+				masterClient: fake.NewClientBuilder().WithObjects(generateProject(projectName, tc.deleted, nil)).Build(),
+				seedClients:  map[string]ctrlruntimeclient.Client{"test": tc.seedClient},
+				metrics:      newMetrics(),
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: projectName}}
+			if _, err := r.Reconcile(context.Background(), request); (err != nil) != tc.expectErr {
+				t.Fatalf("Expected error = %v, but got %v", tc.expectErr, err)
+			}
+
+			if got := testutil.ToFloat64(r.metrics.seedReconciles.WithLabelValues("test", resultSuccess)); got != tc.expectedSuccesses {
+				t.Errorf("Expected %v successful reconciles, but got %v", tc.expectedSuccesses, got)
+			}
+
+			if got := testutil.ToFloat64(r.metrics.seedReconciles.WithLabelValues("test", resultFailure)); got != tc.expectedFailures {
+				t.Errorf("Expected %v failed reconciles, but got %v", tc.expectedFailures, got)
+			}
+
+			if got := testutil.ToFloat64(r.metrics.projects.WithLabelValues("test", stateInSync)); got != tc.expectedInSync {
+				t.Errorf("Expected %v projects in sync, but got %v", tc.expectedInSync, got)
+			}
+
+			if got := testutil.ToFloat64(r.metrics.projects.WithLabelValues("test", stateOutOfSync)); got != tc.expectedOutOfSync {
+				t.Errorf("Expected %v projects out of sync, but got %v", tc.expectedOutOfSync, got)
+			}
+
+			duration := &dto.Metric{}
+			if err := r.metrics.reconcileDuration.Write(duration); err != nil {
+				t.Fatalf("Failed to read reconcile duration: %v", err)
+			}
+
+			if count := duration.GetHistogram().GetSampleCount(); count != 1 {
+				t.Errorf("Expected the reconcile duration to be observed once, but got %d observations", count)
+			}
+		})
+	}
+}
+
+func TestReconcileWithUnreachableSeed(t *testing.T) {
+	failingSeedClient := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, client ctrlruntimeclient.WithWatch, key ctrlruntimeclient.ObjectKey, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.GetOption) error {
+			return errors.New("seed is unreachable")
+		},
+	}).Build()
+	healthySeedClient := fake.NewClientBuilder().Build()
+
+	r := &reconciler{
+		log:          kubermaticlog.Logger,
+		recorder:     &record.FakeRecorder{},
+		masterClient: fake.NewClientBuilder().WithObjects(generateProject(projectName, false, nil)).Build(),
+		seedClients: map[string]ctrlruntimeclient.Client{
+			"a-unreachable": failingSeedClient,
+			"b-healthy":     healthySeedClient,
+		},
+		metrics: newMetrics(),
+	}
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: projectName}}
+	_, err := r.Reconcile(context.Background(), request)
+	if err == nil {
+		t.Fatal("Expected the unreachable seed to cause an error")
+	}
+	if !strings.Contains(err.Error(), "a-unreachable") {
+		t.Errorf("Expected the error to name the unreachable seed, but got %v", err)
+	}
+
+	seedProject := &kubermaticv1.Project{}
+	if err := healthySeedClient.Get(context.Background(), types.NamespacedName{Name: projectName}, seedProject); err != nil {
+		t.Fatalf("Expected the project to be synced onto the healthy seed: %v", err)
+	}
+
+	if got := testutil.ToFloat64(r.metrics.seedReconciles.WithLabelValues("b-healthy", resultSuccess)); got != 1 {
+		t.Errorf("Expected 1 successful reconcile on the healthy seed, but got %v", got)
+	}
+}
+
+func TestReconcilePropagatesMetadataChanges(t *testing.T) {
+	ctx := context.Background()
+
+	masterProject := generateProject(projectName, false, map[string]string{"team": "a", "stale": "label"})
+	masterProject.Annotations = map[string]string{"owner": "alice", "stale": "annotation"}
+	masterClient := fake.NewClientBuilder().WithObjects(masterProject, generator.GenTestSeed()).Build()
+
+	seedClients := map[string]ctrlruntimeclient.Client{
+		"seed-a": fake.NewClientBuilder().Build(),
+		"seed-b": fake.NewClientBuilder().Build(),
+	}
+
+	r := &reconciler{
+		log:          kubermaticlog.Logger,
+		recorder:     &record.FakeRecorder{},
+		masterClient: masterClient,
+		seedClients:  seedClients,
+		metrics:      newMetrics(),
+	}
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: projectName}}
+	if _, err := r.Reconcile(ctx, request); err != nil {
+		t.Fatalf("reconciling failed: %v", err)
+	}
+
+	// add a seed-local label and annotation to the copies, which must survive the sync
+	for _, seedClient := range seedClients {
+		seedProject := &kubermaticv1.Project{}
+		if err := seedClient.Get(ctx, request.NamespacedName, seedProject); err != nil {
+			t.Fatalf("failed to get project: %v", err)
+		}
+		seedProject.Labels["seed-local"] = "value"
+		seedProject.Annotations["seed-local"] = "value"
+		if err := seedClient.Update(ctx, seedProject); err != nil {
+			t.Fatalf("failed to update project: %v", err)
+		}
+	}
+
+	project := &kubermaticv1.Project{}
+	if err := masterClient.Get(ctx, request.NamespacedName, project); err != nil {
+		t.Fatalf("failed to get project: %v", err)
+	}
+	project.Labels = map[string]string{"team": "b"}
+	project.Annotations = map[string]string{"owner": "bob"}
+	if err := masterClient.Update(ctx, project); err != nil {
+		t.Fatalf("failed to update project: %v", err)
+	}
+
+	if _, err := r.Reconcile(ctx, request); err != nil {
+		t.Fatalf("reconciling failed: %v", err)
+	}
+
+	expectedLabels := map[string]string{
+		"team":       "b",
+		"seed-local": "value",
+	}
+
+	expectedAnnotations := map[string]string{
+		"owner":                     "bob",
+		"seed-local":                "value",
+		SyncedProjectAnnotation:     "true",
+		syncedLabelsAnnotation:      "team",
+		syncedAnnotationsAnnotation: "owner",
+	}
+
+	for seedName, seedClient := range seedClients {
+		seedProject := &kubermaticv1.Project{}
+		if err := seedClient.Get(ctx, request.NamespacedName, seedProject); err != nil {
+			t.Fatalf("failed to get project on seed %s: %v", seedName, err)
+		}
+
+		if !diff.SemanticallyEqual(expectedLabels, seedProject.Labels) {
+			t.Errorf("Labels on seed %s differ:\n%v", seedName, diff.ObjectDiff(expectedLabels, seedProject.Labels))
+		}
+
+		if !diff.SemanticallyEqual(expectedAnnotations, seedProject.Annotations) {
+			t.Errorf("Annotations on seed %s differ:\n%v", seedName, diff.ObjectDiff(expectedAnnotations, seedProject.Annotations))
+		}
+	}
+}
+
+func TestReconcileRemovedSeed(t *testing.T) {
+	removedSeed := generator.GenTestSeed()
+	removedSeed.Name = "removed"
+	removedSeed.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	removedSeed.Finalizers = []string{"test"}
+
+	testCases := []struct {
+		name            string
+		seedProject     *kubermaticv1.Project
+		expectedDeleted bool
+	}{
+		{
+			name:            "synced project is deleted",
+			seedProject:     syncedProject(generateProject(projectName, false, nil)),
+			expectedDeleted: true,
+		},
+		{
+			name:        "manually created project is kept",
+			seedProject: generateProject(projectName, false, nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			// the copy on the seed is a distinct object from the project on the master
+			tc.seedProject.UID = "seed-copy"
+			seedClient := fake.NewClientBuilder().WithObjects(tc.seedProject).Build()
+
+			r := &reconciler{
+				log:          kubermaticlog.Logger,
+				recorder:     &record.FakeRecorder{},
+				masterClient: fake.NewClientBuilder().WithObjects(generateProject(projectName, false, nil), removedSeed).Build(),
+				seedClients:  map[string]ctrlruntimeclient.Client{removedSeed.Name: seedClient},
+				metrics:      newMetrics(),
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: projectName}}
+			if _, err := r.Reconcile(ctx, request); err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			err := seedClient.Get(ctx, request.NamespacedName, &kubermaticv1.Project{})
+			if tc.expectedDeleted != apierrors.IsNotFound(err) {
+				t.Errorf("Expected project to be deleted = %v, but got %v", tc.expectedDeleted, err)
+			}
+		})
+	}
+}
+
+func TestReconcileSharedToDedicatedSeed(t *testing.T) {
+	const projectUID = types.UID("project-uid")
+
+	// the project as it was copied onto the seed, including the finalizers of the master;
+	// a project copied onto a dedicated seed cluster gets a new UID
+	copiedProject := func(uid types.UID) *kubermaticv1.Project {
+		project := generateProject(projectName, false, nil)
+		project.UID = uid
+		project.Finalizers = []string{cleanupFinalizer, rbac.CleanupFinalizerName}
+		return project
+	}
+
+	// a project that has been created on the seed by this controller
+	syncedProject := func() *kubermaticv1.Project {
+		project := generateProject(projectName, false, projectLabels)
+		project.UID = "synced-uid"
+		project.Annotations = map[string]string{SyncedProjectAnnotation: "true"}
+		return project
+	}
+
+	testCases := []struct {
+		name              string
+		deleted           bool
+		seedProject       *kubermaticv1.Project
+		expectedDeleted   bool
+		expectedLabels    map[string]string
+		expectedFinalizer bool
+		expectedAdoption  bool
+	}{
+		{
+			name:              "shared master and seed",
+			seedProject:       copiedProject(projectUID),
+			expectedFinalizer: true,
+		},
+		{
+			name:             "project is adopted on dedicated seed",
+			seedProject:      copiedProject("copied-uid"),
+			expectedLabels:   projectLabels,
+			expectedAdoption: true,
+		},
+		{
+			name:             "project is deleted on dedicated seed",
+			deleted:          true,
+			seedProject:      copiedProject("copied-uid"),
+			expectedDeleted:  true,
+			expectedAdoption: true,
+		},
+		{
+			name:           "synced project is not adopted",
+			seedProject:    syncedProject(),
+			expectedLabels: projectLabels,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			masterProject := generateProject(projectName, tc.deleted, projectLabels)
+			masterProject.UID = projectUID
+
+			seedClient := fake.NewClientBuilder().WithObjects(tc.seedProject).Build()
+			recorder := record.NewFakeRecorder(10)
+
+			r := &reconciler{
+				log:          kubermaticlog.Logger,
+				recorder:     recorder,
+				masterClient: fake.NewClientBuilder().WithObjects(masterProject).Build(),
+				seedClients:  map[string]ctrlruntimeclient.Client{"test": seedClient},
+				metrics:      newMetrics(),
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: projectName}}
+			if _, err := r.Reconcile(ctx, request); err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			seedProject := &kubermaticv1.Project{}
+			err := seedClient.Get(ctx, request.NamespacedName, seedProject)
+			if tc.expectedDeleted {
+				if !apierrors.IsNotFound(err) {
+					t.Fatalf("Expected project to be deleted on the seed cluster, got %v", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("failed to get project: %v", err)
+				}
+
+				if !diff.SemanticallyEqual(tc.expectedLabels, seedProject.Labels) {
+					t.Errorf("Labels differ:\n%v", diff.ObjectDiff(tc.expectedLabels, seedProject.Labels))
+				}
+
+				if hasFinalizer := kuberneteshelper.HasAnyFinalizer(seedProject, masterFinalizers...); hasFinalizer != tc.expectedFinalizer {
+					t.Errorf("Expected master finalizers to be present = %v, got finalizers %v", tc.expectedFinalizer, seedProject.Finalizers)
+				}
+
+				if tc.expectedAdoption && seedProject.Annotations[SyncedProjectAnnotation] != "true" {
+					t.Errorf("Expected adopted project to be marked as synced, got annotations %v", seedProject.Annotations)
+				}
+			}
+
+			select {
+			case event := <-recorder.Events:
+				if !tc.expectedAdoption || !strings.Contains(event, "ProjectAdopted") {
+					t.Errorf("Unexpected event %q", event)
+				}
+			default:
+				if tc.expectedAdoption {
+					t.Error("Expected the project to be adopted")
+				}
+			}
+		})
+	}
+}
+
 func generateProject(name string, deleted bool, labels map[string]string) *kubermaticv1.Project {
 	project := &kubermaticv1.Project{
 		ObjectMeta: metav1.ObjectMeta{
@@ -150,3 +536,11 @@ func generateProject(name string, deleted bool, labels map[string]string) *kuber
 	}
 	return project
 }
+
+func syncedProject(project *kubermaticv1.Project) *kubermaticv1.Project {
+	project.Annotations = map[string]string{SyncedProjectAnnotation: "true"}
+	if len(project.Labels) > 0 {
+		project.Annotations[syncedLabelsAnnotation] = strings.Join(sets.List(sets.KeySet(project.Labels)), ",")
+	}
+	return project
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectsynchronizer
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	resultSuccess = "success"
+	resultFailure = "failure"
+
+	stateInSync    = "in_sync"
+	stateOutOfSync = "out_of_sync"
+)
+
+// metrics are the custom metrics of the project-synchronizer. They are kept
+// per reconciler instead of globally so that tests do not interfere.
+type metrics struct {
+	seedReconciles    *prometheus.CounterVec
+	projects          *prometheus.GaugeVec
+	reconcileDuration prometheus.Histogram
+
+	// lock protects synced, which remembers per seed whether each project was
+	// synchronized successfully the last time, so the projects gauge can be
+	// derived from it.
+	lock   sync.Mutex
+	synced map[string]map[string]bool
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		seedReconciles: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "kubermatic",
+			Subsystem: "project_synchronizer",
+			Name:      "seed_reconciles_total",
+			Help:      "The number of times a project was synchronized to (or deleted from) a seed, by result",
+		}, []string{"seed", "result"}),
+		projects: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "kubermatic",
+			Subsystem: "project_synchronizer",
+			Name:      "projects",
+			Help:      "The number of projects per seed whose last synchronization succeeded (in_sync) or failed (out_of_sync)",
+		}, []string{"seed", "state"}),
+		reconcileDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "kubermatic",
+			Subsystem: "project_synchronizer",
+			Name:      "reconcile_duration_seconds",
+			Help:      "The time it took to reconcile a project across all seeds",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		synced: map[string]map[string]bool{},
+	}
+}
+
+func (m *metrics) register(registerer prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{m.seedReconciles, m.projects, m.reconcileDuration} {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// observeSync records the outcome of synchronizing a project to a seed.
+func (m *metrics) observeSync(seed, project string, err error) {
+	m.countReconcile(seed, err)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.synced[seed] == nil {
+		m.synced[seed] = map[string]bool{}
+	}
+	m.synced[seed][project] = err == nil
+
+	m.updateProjectsGauge(seed)
+}
+
+// observeDeletion records the outcome of deleting a project from a seed. Once
+// deleted, the project no longer counts towards the projects gauge.
+func (m *metrics) observeDeletion(seed, project string, err error) {
+	m.countReconcile(seed, err)
+
+	if err != nil {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.synced[seed], project)
+	m.updateProjectsGauge(seed)
+}
+
+func (m *metrics) countReconcile(seed string, err error) {
+	result := resultSuccess
+	if err != nil {
+		result = resultFailure
+	}
+
+	m.seedReconciles.WithLabelValues(seed, result).Inc()
+}
+
+// updateProjectsGauge must be called while holding the lock.
+func (m *metrics) updateProjectsGauge(seed string) {
+	inSync, outOfSync := 0, 0
+	for _, ok := range m.synced[seed] {
+		if ok {
+			inSync++
+		} else {
+			outOfSync++
+		}
+	}
+
+	m.projects.WithLabelValues(seed, stateInSync).Set(float64(inSync))
+	m.projects.WithLabelValues(seed, stateOutOfSync).Set(float64(outOfSync))
+}
@@ -17,21 +17,86 @@ limitations under the License.
 package projectsynchronizer
 
 import (
+	"strings"
+
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// syncedLabelsAnnotation records the keys of the labels that have been copied from the
+	// master Project onto a seed copy, so that labels removed on the master can be removed
+	// from the copy without touching seed-local labels.
+	syncedLabelsAnnotation = "kubermatic.k8c.io/synced-labels"
+
+	// syncedAnnotationsAnnotation is the equivalent of syncedLabelsAnnotation for annotations.
+	syncedAnnotationsAnnotation = "kubermatic.k8c.io/synced-annotations"
+)
+
+// unsyncedAnnotations are never copied from the master Project, either because they
+// describe the master object only or because they are managed by this controller.
+var unsyncedAnnotations = sets.New(
+	corev1.LastAppliedConfigAnnotation,
+	SyncedProjectAnnotation,
+	syncedLabelsAnnotation,
+	syncedAnnotationsAnnotation,
 )
 
 func projectReconcilerFactory(project *kubermaticv1.Project) reconciling.NamedProjectReconcilerFactory {
 	return func() (string, reconciling.ProjectReconciler) {
 		return project.Name, func(p *kubermaticv1.Project) (*kubermaticv1.Project, error) {
-			if p.ObjectMeta.Labels == nil {
-				p.ObjectMeta.Labels = map[string]string{}
-			}
-			for k, v := range project.ObjectMeta.Labels {
-				p.ObjectMeta.Labels[k] = v
+			masterAnnotations := map[string]string{}
+			for k, v := range project.Annotations {
+				if !unsyncedAnnotations.Has(k) {
+					masterAnnotations[k] = v
+				}
 			}
+
+			var syncedLabels, syncedAnnotations string
+			p.Labels, syncedLabels = mergeSyncedMetadata(p.Labels, project.Labels, p.Annotations[syncedLabelsAnnotation])
+			p.Annotations, syncedAnnotations = mergeSyncedMetadata(p.Annotations, masterAnnotations, p.Annotations[syncedAnnotationsAnnotation])
+
+			setOrDelete(p.Annotations, syncedLabelsAnnotation, syncedLabels)
+			setOrDelete(p.Annotations, syncedAnnotationsAnnotation, syncedAnnotations)
+			p.Annotations[SyncedProjectAnnotation] = "true"
+
 			p.Spec = project.Spec
 			return p, nil
 		}
 	}
 }
+
+// mergeSyncedMetadata copies the master's key/value pairs into the seed map. Keys that were
+// synced previously (a comma-separated list) but are gone from the master are removed, all
+// other keys on the seed are seed-local and left alone. It returns the updated map and the
+// new list of synced keys.
+func mergeSyncedMetadata(seed, master map[string]string, previouslySynced string) (map[string]string, string) {
+	if seed == nil {
+		seed = map[string]string{}
+	}
+
+	for _, key := range strings.Split(previouslySynced, ",") {
+		if _, exists := master[key]; !exists {
+			delete(seed, key)
+		}
+	}
+
+	synced := sets.New[string]()
+	for k, v := range master {
+		seed[k] = v
+		synced.Insert(k)
+	}
+
+	return seed, strings.Join(sets.List(synced), ",")
+}
+
+func setOrDelete(m map[string]string, key, value string) {
+	if value == "" {
+		delete(m, key)
+	} else {
+		m[key] = value
+	}
+}
@@ -468,12 +468,12 @@ func generateRBACRoleBindingForClusterNamespaceNamedResource(cluster *kubermatic
 	return binding
 }
 
-// generateRBACRoleForClusterNamespaceResourceAndServiceAccount generates per-cluster Role for the given cluster and service account in the cluster namespace.
-func generateRBACRoleForClusterNamespaceResourceAndServiceAccount(cluster *kubermaticv1.Cluster, verbs []string, serviceAccountName, policyResource, policyAPIGroups, kind string) (*rbacv1.Role, error) {
+// generateRBACRoleForClusterNamespaceResourceAndServiceAccount generates per-cluster Role for the given service account in the given control plane namespace.
+func generateRBACRoleForClusterNamespaceResourceAndServiceAccount(namespace string, verbs []string, serviceAccountName, policyResource, policyAPIGroups, kind string) (*rbacv1.Role, error) {
 	role := &rbacv1.Role{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      generateRBACRoleNameForClusterNamespaceResourceAndServiceAccount(kind, serviceAccountName),
-			Namespace: cluster.Status.NamespaceName,
+			Namespace: namespace,
 		},
 		Rules: []rbacv1.PolicyRule{
 			{
@@ -486,19 +486,20 @@ func generateRBACRoleForClusterNamespaceResourceAndServiceAccount(cluster *kuber
 	return role, nil
 }
 
-// generateRBACRoleBindingForEtcdLauncherServiceAccount generates per-cluster RoleBinding for the given cluster and service account in the cluster namespace.
-func generateRBACRoleBindingForEtcdLauncherServiceAccount(cluster *kubermaticv1.Cluster, serviceAccountName, kind string) *rbacv1.RoleBinding {
+// generateRBACRoleBindingForEtcdLauncherServiceAccount generates per-cluster RoleBinding for the given cluster and service account in
+// the given control plane namespace. The etcd-launcher ServiceAccount itself always lives in the etcd namespace.
+func generateRBACRoleBindingForEtcdLauncherServiceAccount(cluster *kubermaticv1.Cluster, namespace, serviceAccountName, kind string) *rbacv1.RoleBinding {
 	binding := &rbacv1.RoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      generateRBACRoleNameForClusterNamespaceResourceAndServiceAccount(kind, serviceAccountName),
-			Namespace: cluster.Status.NamespaceName,
+			Namespace: namespace,
 		},
 		Subjects: []rbacv1.Subject{
 			{
 				APIGroup:  "",
 				Kind:      rbacv1.ServiceAccountKind,
 				Name:      serviceAccountName,
-				Namespace: cluster.Status.NamespaceName,
+				Namespace: resources.EtcdNamespaceName(cluster),
 			},
 			{
 				APIGroup:  "",
@@ -23,6 +23,7 @@ import (
 	"go.uber.org/zap"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -568,15 +569,15 @@ func (c *resourcesController) ensureRBACRoleBindingForClusterAddons(ctx context.
 	return nil
 }
 
-func (c *resourcesController) ensureRBACRoleForEtcdLauncher(ctx context.Context, cluster *kubermaticv1.Cluster, resourceName string, groupName string, kindName string) error {
+func (c *resourcesController) ensureRBACRoleForEtcdLauncher(ctx context.Context, namespace string, resourceName string, groupName string, kindName string) error {
 	var roleList rbacv1.RoleList
-	opts := &ctrlruntimeclient.ListOptions{Namespace: cluster.Status.NamespaceName}
+	opts := &ctrlruntimeclient.ListOptions{Namespace: namespace}
 	if err := c.client.List(ctx, &roleList, opts); err != nil {
 		return err
 	}
 
 	generatedRole, err := generateRBACRoleForClusterNamespaceResourceAndServiceAccount(
-		cluster,
+		namespace,
 		[]string{"get", "list"},
 		EtcdLauncherServiceAccountName,
 		resourceName,
@@ -587,7 +588,7 @@ func (c *resourcesController) ensureRBACRoleForEtcdLauncher(ctx context.Context,
 	}
 
 	var sharedExistingRole rbacv1.Role
-	key := ctrlruntimeclient.ObjectKey{Name: generatedRole.Name, Namespace: cluster.Status.NamespaceName}
+	key := ctrlruntimeclient.ObjectKey{Name: generatedRole.Name, Namespace: namespace}
 	if err := c.client.Get(ctx, key, &sharedExistingRole); err != nil {
 		if apierrors.IsNotFound(err) {
 			if err := c.client.Create(ctx, generatedRole); err != nil {
@@ -613,15 +614,16 @@ func (c *resourcesController) ensureRBACRoleForEtcdLauncher(ctx context.Context,
 	return nil
 }
 
-func (c *resourcesController) ensureRBACRoleBindingForEtcdLauncher(ctx context.Context, cluster *kubermaticv1.Cluster, kindName string) error {
+func (c *resourcesController) ensureRBACRoleBindingForEtcdLauncher(ctx context.Context, cluster *kubermaticv1.Cluster, namespace string, kindName string) error {
 	generatedRoleBinding := generateRBACRoleBindingForEtcdLauncherServiceAccount(
 		cluster,
+		namespace,
 		EtcdLauncherServiceAccountName,
 		kindName,
 	)
 
 	var sharedExistingRoleBinding rbacv1.RoleBinding
-	key := ctrlruntimeclient.ObjectKey{Name: generatedRoleBinding.Name, Namespace: cluster.Status.NamespaceName}
+	key := ctrlruntimeclient.ObjectKey{Name: generatedRoleBinding.Name, Namespace: namespace}
 	if err := c.client.Get(ctx, key, &sharedExistingRoleBinding); err != nil {
 		if apierrors.IsNotFound(err) {
 			if err := c.client.Create(ctx, generatedRoleBinding); err != nil {
@@ -1189,37 +1191,41 @@ func (c *resourcesController) ensureRBACRoleBindingForClusterRuleGroups(ctx cont
 }
 
 func (c *resourcesController) ensureRBACForEtcdLauncher(ctx context.Context, cli ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, projectName string, rmapping *meta.RESTMapping) error {
+	// etcd-launcher runs in the etcd namespace, but EtcdRestores and their download
+	// credentials are kept in the cluster namespace
+	etcdNamespace := resources.EtcdNamespaceName(cluster)
+
 	if err := c.ensureClusterRBACRoleForEtcdLauncher(ctx, projectName, cluster); err != nil {
 		return fmt.Errorf("failed to sync RBAC ClusterRole for %s resource for %s cluster provider: %w", formatMapping(rmapping), c.providerName, err)
 	}
-	if err := c.ensureClusterRBACRoleBindingForEtcdLauncher(ctx, cluster.Name, kubermaticv1.ClusterKindName, cluster.Status.NamespaceName, projectName, cluster); err != nil {
+	if err := c.ensureClusterRBACRoleBindingForEtcdLauncher(ctx, cluster.Name, kubermaticv1.ClusterKindName, etcdNamespace, projectName, cluster); err != nil {
 		return fmt.Errorf("failed to sync RBAC ClusterRoleBinding for %s resource for %s cluster provider: %w", formatMapping(rmapping), c.providerName, err)
 	}
-	if err := c.ensureClusterRBACRoleBindingForEtcdLauncher(ctx, fmt.Sprintf("cluster-%s-ca-bundle", cluster.Name), "Configmap", cluster.Status.NamespaceName, projectName, cluster); err != nil {
+	if err := c.ensureClusterRBACRoleBindingForEtcdLauncher(ctx, fmt.Sprintf("cluster-%s-ca-bundle", cluster.Name), "Configmap", etcdNamespace, projectName, cluster); err != nil {
 		return fmt.Errorf("failed to sync RBAC ClusterRoleBinding for %s resource for %s cluster provider: %w", formatMapping(rmapping), c.providerName, err)
 	}
-	if err := c.ensureRBACRoleForEtcdLauncher(ctx, cluster, kubermaticv1.EtcdRestoreResourceName, kubermaticv1.GroupName, kubermaticv1.EtcdRestoreKindName); err != nil {
+	if err := c.ensureRBACRoleForEtcdLauncher(ctx, cluster.Status.NamespaceName, kubermaticv1.EtcdRestoreResourceName, kubermaticv1.GroupName, kubermaticv1.EtcdRestoreKindName); err != nil {
 		return fmt.Errorf("failed to sync etcd restore RBAC Role for %s resource for %s cluster provider: %w", formatMapping(rmapping), c.providerName, err)
 	}
-	if err := c.ensureRBACRoleBindingForEtcdLauncher(ctx, cluster, kubermaticv1.EtcdRestoreKindName); err != nil {
+	if err := c.ensureRBACRoleBindingForEtcdLauncher(ctx, cluster, cluster.Status.NamespaceName, kubermaticv1.EtcdRestoreKindName); err != nil {
 		return fmt.Errorf("failed to sync etcd restore RBAC ClusterRoleBinding for %s resource for %s cluster provider: %w", formatMapping(rmapping), c.providerName, err)
 	}
-	if err := c.ensureRBACRoleForEtcdLauncher(ctx, cluster, "secrets", "", "Secret"); err != nil {
+	if err := c.ensureRBACRoleForEtcdLauncher(ctx, cluster.Status.NamespaceName, "secrets", "", "Secret"); err != nil {
 		return fmt.Errorf("failed to sync etcd restore RBAC Role for %s resource for %s cluster provider: %w", formatMapping(rmapping), c.providerName, err)
 	}
-	if err := c.ensureRBACRoleBindingForEtcdLauncher(ctx, cluster, "Secret"); err != nil {
+	if err := c.ensureRBACRoleBindingForEtcdLauncher(ctx, cluster, cluster.Status.NamespaceName, "Secret"); err != nil {
 		return fmt.Errorf("failed to sync etcd restore RBAC RoleBinding for %s resource for %s cluster provider: %w", formatMapping(rmapping), c.providerName, err)
 	}
-	if err := c.ensureRBACRoleForEtcdLauncher(ctx, cluster, "pods", "", "Pod"); err != nil {
+	if err := c.ensureRBACRoleForEtcdLauncher(ctx, etcdNamespace, "pods", "", "Pod"); err != nil {
 		return fmt.Errorf("failed to sync etcd restore RBAC Role for %s resource for %s cluster provider: %w", formatMapping(rmapping), c.providerName, err)
 	}
-	if err := c.ensureRBACRoleBindingForEtcdLauncher(ctx, cluster, "Pod"); err != nil {
+	if err := c.ensureRBACRoleBindingForEtcdLauncher(ctx, cluster, etcdNamespace, "Pod"); err != nil {
 		return fmt.Errorf("failed to sync etcd restore RBAC RoleBinding for %s resource for %s cluster provider: %w", formatMapping(rmapping), c.providerName, err)
 	}
-	if err := c.ensureRBACRoleForEtcdLauncher(ctx, cluster, "statefulsets", "apps", "StatefulSet"); err != nil {
+	if err := c.ensureRBACRoleForEtcdLauncher(ctx, etcdNamespace, "statefulsets", "apps", "StatefulSet"); err != nil {
 		return fmt.Errorf("failed to sync etcd launcher RBAC Role for %s resource for %s cluster provider: %w", formatMapping(rmapping), c.providerName, err)
 	}
-	if err := c.ensureRBACRoleBindingForEtcdLauncher(ctx, cluster, "StatefulSet"); err != nil {
+	if err := c.ensureRBACRoleBindingForEtcdLauncher(ctx, cluster, etcdNamespace, "StatefulSet"); err != nil {
 		return fmt.Errorf("failed to sync etcd launcher RBAC CluclustersterRoleBinding for %s resource for %s cluster provider: %w", formatMapping(rmapping), c.providerName, err)
 	}
 
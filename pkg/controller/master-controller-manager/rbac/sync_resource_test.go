@@ -3895,3 +3895,80 @@ func TestSyncClusterRuleGroupsRBAC(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncEtcdLauncherRBACSplitNamespace(t *testing.T) {
+	const (
+		clusterNamespace = "cluster-abcd"
+		etcdNamespace    = "cluster-abcd-etcd"
+	)
+
+	cluster := &kubermaticv1.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Cluster",
+			APIVersion: "kubermatic.k8c.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "abcd",
+			UID:    "abcdID",
+			Labels: map[string]string{"project-id": "thunderball"},
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			Features: map[string]bool{
+				kubermaticv1.ClusterFeatureSplitEtcdNamespace: true,
+			},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: clusterNamespace,
+		},
+	}
+
+	ctx := context.Background()
+	client := fake.NewClientBuilder().WithObjects(cluster).Build()
+
+	target := resourcesController{
+		client:     client,
+		restMapper: getFakeRestMapper(t),
+		objectType: &kubermaticv1.Cluster{},
+		log:        zap.NewNop().Sugar(),
+	}
+
+	_, err := target.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: cluster.Name}})
+	assert.NoError(t, err)
+
+	// etcd-launcher manages its own pods and StatefulSet in the etcd namespace, but
+	// reads EtcdRestores and their credentials from the cluster namespace
+	expectedNamespaces := map[string]string{
+		kubermaticv1.EtcdRestoreKindName: clusterNamespace,
+		"Secret":                         clusterNamespace,
+		"Pod":                            etcdNamespace,
+		"StatefulSet":                    etcdNamespace,
+	}
+
+	for kind, namespace := range expectedNamespaces {
+		name := generateRBACRoleNameForClusterNamespaceResourceAndServiceAccount(kind, EtcdLauncherServiceAccountName)
+
+		role := &rbacv1.Role{}
+		if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, role); err != nil {
+			t.Errorf("Expected Role %s in namespace %s: %v", name, namespace, err)
+		}
+
+		binding := &rbacv1.RoleBinding{}
+		if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, binding); err != nil {
+			t.Errorf("Expected RoleBinding %s in namespace %s: %v", name, namespace, err)
+			continue
+		}
+
+		if binding.Subjects[0].Name != EtcdLauncherServiceAccountName || binding.Subjects[0].Namespace != etcdNamespace {
+			t.Errorf("Expected RoleBinding %s to bind the ServiceAccount in %s, got %v", name, etcdNamespace, binding.Subjects[0])
+		}
+	}
+
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{}
+	if err := client.Get(ctx, types.NamespacedName{Name: "kubermatic:cluster-abcd:etcd-launcher"}, clusterRoleBinding); err != nil {
+		t.Fatalf("Expected ClusterRoleBinding for etcd-launcher: %v", err)
+	}
+
+	if clusterRoleBinding.Subjects[0].Namespace != etcdNamespace {
+		t.Errorf("Expected ClusterRoleBinding to bind the ServiceAccount in %s, got %v", etcdNamespace, clusterRoleBinding.Subjects[0])
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package common
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -35,6 +36,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -44,6 +46,10 @@ import (
 
 const (
 	seedNameEnvVariable = "SEED_NAME"
+
+	// webhookPreviousCACertSecretKey is the key in the serving certificate Secret that
+	// holds the CA which issued the previous serving certificate.
+	webhookPreviousCACertSecretKey = "ca-previous.crt"
 )
 
 func webhookPodLabels() map[string]string {
@@ -82,6 +88,11 @@ func WebhookClusterRoleReconciler(cfg *kubermaticv1.KubermaticConfiguration) rec
 					Resources: []string{"externalclusters"},
 					Verbs:     []string{"get", "list"},
 				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"nodes"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
 			}
 
 			return r, nil
@@ -371,7 +382,37 @@ func WebhookServingCertSecretReconciler(ctx context.Context, cfg *kubermaticv1.K
 		return keypair, nil
 	}
 
-	return servingcerthelper.ServingCertSecretReconciler(caGetter, WebhookServingCertSecretName, webhookCommonName, altNames, nil)
+	name, reconciler := servingcerthelper.ServingCertSecretReconciler(caGetter, WebhookServingCertSecretName, webhookCommonName, altNames, nil)()
+
+	return func() (string, reconciling.SecretReconciler) {
+		return name, func(s *corev1.Secret) (*corev1.Secret, error) {
+			var previousCA []byte
+			if s.Data != nil {
+				previousCA = s.Data[resources.CACertSecretKey]
+			}
+
+			s, err := reconciler(s)
+			if err != nil {
+				return s, err
+			}
+
+			ca, err := caGetter()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get CA: %w", err)
+			}
+
+			// Record which CA issued the serving certificate, and keep the CA that issued
+			// the previous one: webhook pods pick up a new certificate with a delay, so the
+			// caBundle has to keep trusting the old CA until the next rotation.
+			issuingCA := triple.EncodeCertPEM(ca.Cert)
+			if len(previousCA) > 0 && !bytes.Equal(previousCA, issuingCA) {
+				s.Data[webhookPreviousCACertSecretKey] = previousCA
+			}
+			s.Data[resources.CACertSecretKey] = issuingCA
+
+			return s, nil
+		}
+	}
 }
 
 func SeedAdmissionWebhookName(cfg *kubermaticv1.KubermaticConfiguration) string {
@@ -691,6 +732,10 @@ func WebhookServiceReconciler(cfg *kubermaticv1.KubermaticConfiguration, client
 	}
 }
 
+// WebhookCABundle returns the CA bundle for all webhook configurations. Besides the
+// current webhook CA, it contains the CAs recorded in the serving certificate Secret,
+// so that a rotated CA never leaves the webhook serving a certificate the API server
+// does not trust. Delete both Secrets to stop trusting a retired CA immediately.
 func WebhookCABundle(ctx context.Context, cfg *kubermaticv1.KubermaticConfiguration, client ctrlruntimeclient.Client) ([]byte, error) {
 	secret := corev1.Secret{}
 	key := types.NamespacedName{
@@ -708,5 +753,23 @@ func WebhookCABundle(ctx context.Context, cfg *kubermaticv1.KubermaticConfigurat
 		return nil, fmt.Errorf("Secret %s does not contain CA certificate at key %s", WebhookServingCASecretName, resources.CACertSecretKey)
 	}
 
-	return cert, nil
+	servingCert := corev1.Secret{}
+	key.Name = WebhookServingCertSecretName
+
+	if err := client.Get(ctx, key, &servingCert); err != nil {
+		if apierrors.IsNotFound(err) {
+			return cert, nil
+		}
+
+		return nil, fmt.Errorf("cannot retrieve admission webhook serving certificate Secret %s: %w", WebhookServingCertSecretName, err)
+	}
+
+	bundle := cert
+	for _, ca := range [][]byte{servingCert.Data[resources.CACertSecretKey], servingCert.Data[webhookPreviousCACertSecretKey]} {
+		if len(ca) > 0 && !bytes.Contains(bundle, ca) {
+			bundle = append(append(bytes.Clone(bundle), '\n'), ca...)
+		}
+	}
+
+	return bundle, nil
 }
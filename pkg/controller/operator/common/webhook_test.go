@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	certutil "k8s.io/client-go/util/cert"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWebhookCABundleFollowsCertificateRotation(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+	cfg := &kubermaticv1.KubermaticConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kubermatic",
+			Namespace: "kubermatic",
+		},
+	}
+
+	reconcileCA := func() {
+		t.Helper()
+		if err := reconciling.ReconcileSecrets(ctx, []reconciling.NamedSecretReconcilerFactory{WebhookServingCASecretReconciler(cfg)}, cfg.Namespace, client); err != nil {
+			t.Fatalf("Failed to reconcile CA: %v", err)
+		}
+	}
+
+	reconcileServingCert := func() {
+		t.Helper()
+		if err := reconciling.ReconcileSecrets(ctx, []reconciling.NamedSecretReconcilerFactory{WebhookServingCertSecretReconciler(ctx, cfg, client)}, cfg.Namespace, client); err != nil {
+			t.Fatalf("Failed to reconcile serving certificate: %v", err)
+		}
+	}
+
+	getSecret := func(name string) *corev1.Secret {
+		t.Helper()
+		secret := &corev1.Secret{}
+		if err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: cfg.Namespace, Name: name}, secret); err != nil {
+			t.Fatalf("Failed to get Secret %s: %v", name, err)
+		}
+		return secret
+	}
+
+	// assertServingCertTrusted checks that the currently served certificate
+	// verifies against the caBundle the webhook configurations would get.
+	assertServingCertTrusted := func(step string) {
+		t.Helper()
+
+		bundle, err := WebhookCABundle(ctx, cfg, client)
+		if err != nil {
+			t.Fatalf("Failed to get CA bundle: %v", err)
+		}
+
+		roots, err := certutil.NewPoolFromBytes(bundle)
+		if err != nil {
+			t.Fatalf("Failed to parse CA bundle: %v", err)
+		}
+
+		certs, err := certutil.ParseCertsPEM(getSecret(WebhookServingCertSecretName).Data[resources.ServingCertSecretKey])
+		if err != nil {
+			t.Fatalf("Failed to parse serving certificate: %v", err)
+		}
+
+		if _, err := certs[0].Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}}); err != nil {
+			t.Errorf("%s: Expected serving certificate to be trusted by the CA bundle, but: %v", step, err)
+		}
+	}
+
+	reconcileCA()
+	reconcileServingCert()
+	assertServingCertTrusted("initial")
+
+	oldCA := getSecret(WebhookServingCASecretName).Data[resources.CACertSecretKey]
+
+	// rotate the CA by removing it, like an administrator would
+	if err := client.Delete(ctx, getSecret(WebhookServingCASecretName)); err != nil {
+		t.Fatalf("Failed to delete CA: %v", err)
+	}
+
+	// the webhook configurations are reconciled between the CA and the serving certificate,
+	// so the old certificate must still be trusted at this point
+	reconcileCA()
+	newCA := getSecret(WebhookServingCASecretName).Data[resources.CACertSecretKey]
+
+	bundle, err := WebhookCABundle(ctx, cfg, client)
+	if err != nil {
+		t.Fatalf("Failed to get CA bundle: %v", err)
+	}
+	if !containsPEM(bundle, newCA) {
+		t.Error("Expected the CA bundle to contain the new CA before the serving certificate is rotated")
+	}
+	assertServingCertTrusted("after CA rotation")
+
+	reconcileServingCert()
+	assertServingCertTrusted("after serving certificate rotation")
+
+	bundle, err = WebhookCABundle(ctx, cfg, client)
+	if err != nil {
+		t.Fatalf("Failed to get CA bundle: %v", err)
+	}
+	if !containsPEM(bundle, newCA) {
+		t.Error("Expected the CA bundle to contain the new CA")
+	}
+	if !containsPEM(bundle, oldCA) {
+		t.Error("Expected the CA bundle to keep the previous CA while webhook pods may still serve the old certificate")
+	}
+}
+
+func containsPEM(bundle, cert []byte) bool {
+	certs, err := certutil.ParseCertsPEM(cert)
+	if err != nil {
+		return false
+	}
+
+	bundleCerts, err := certutil.ParseCertsPEM(bundle)
+	if err != nil {
+		return false
+	}
+
+	for _, c := range bundleCerts {
+		if c.Equal(certs[0]) {
+			return true
+		}
+	}
+
+	return false
+}
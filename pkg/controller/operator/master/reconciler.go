@@ -149,14 +149,6 @@ func (r *Reconciler) reconcile(ctx context.Context, config *kubermaticv1.Kuberma
 		return err
 	}
 
-	if err := r.reconcileValidatingWebhooks(ctx, defaulted, logger); err != nil {
-		return err
-	}
-
-	if err := r.reconcileMutatingWebhooks(ctx, defaulted, logger); err != nil {
-		return err
-	}
-
 	if err := r.reconcileAddonConfigs(ctx, defaulted, logger); err != nil {
 		return err
 	}
@@ -166,7 +158,7 @@ func (r *Reconciler) reconcile(ctx context.Context, config *kubermaticv1.Kuberma
 	}
 
 	// Since the new standalone webhook, the old service is not required anymore.
-	// Once the webhooks are reconciled above, we can now clean up unneeded services.
+	// Once the webhooks are reconciled (together with the Secrets), we can now clean up unneeded services.
 	common.CleanupWebhookServices(ctx, r, logger, defaulted.Namespace)
 
 	return nil
@@ -231,8 +223,23 @@ func (r *Reconciler) reconcileConfigMaps(ctx context.Context, config *kubermatic
 func (r *Reconciler) reconcileSecrets(ctx context.Context, config *kubermaticv1.KubermaticConfiguration, logger *zap.SugaredLogger) error {
 	logger.Debug("Reconciling Secrets")
 
-	reconcilers := []reconciling.NamedSecretReconcilerFactory{
+	// A rotated webhook CA has to be part of the caBundle of every webhook configuration
+	// before a serving certificate signed by it is issued, or admission requests fail.
+	if err := reconciling.ReconcileSecrets(ctx, []reconciling.NamedSecretReconcilerFactory{
 		common.WebhookServingCASecretReconciler(config),
+	}, config.Namespace, r.Client, common.OwnershipModifierFactory(config, r.scheme)); err != nil {
+		return fmt.Errorf("failed to reconcile webhook CA Secret: %w", err)
+	}
+
+	if err := r.reconcileValidatingWebhooks(ctx, config, logger); err != nil {
+		return err
+	}
+
+	if err := r.reconcileMutatingWebhooks(ctx, config, logger); err != nil {
+		return err
+	}
+
+	reconcilers := []reconciling.NamedSecretReconcilerFactory{
 		common.WebhookServingCertSecretReconciler(ctx, config, r.Client),
 	}
 
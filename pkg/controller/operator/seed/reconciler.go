@@ -46,6 +46,7 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -205,6 +206,12 @@ func (r *Reconciler) cleanupDeletedSeed(ctx context.Context, cfg *kubermaticv1.K
 		return fmt.Errorf("failed to clean up ClusterRole: %w", err)
 	}
 
+	for _, name := range []string{resources.ProductionControlPlanePriorityClassName, resources.DevelopmentControlPlanePriorityClassName} {
+		if err := common.CleanupClusterResource(ctx, client, &schedulingv1.PriorityClass{}, name); err != nil {
+			return fmt.Errorf("failed to clean up PriorityClass: %w", err)
+		}
+	}
+
 	names := []string{
 		common.SeedAdmissionWebhookName(cfg),
 		common.KubermaticConfigurationAdmissionWebhookName(cfg),
@@ -304,15 +311,15 @@ func (r *Reconciler) reconcileResources(ctx context.Context, cfg *kubermaticv1.K
 		return err
 	}
 
-	if err := r.reconcileConfigMaps(ctx, cfg, seed, client, log, caBundle); err != nil {
+	if err := r.reconcilePriorityClasses(ctx, cfg, seed, client, log); err != nil {
 		return err
 	}
 
-	if err := r.reconcileSecrets(ctx, cfg, seed, client, log); err != nil {
+	if err := r.reconcileConfigMaps(ctx, cfg, seed, client, log, caBundle); err != nil {
 		return err
 	}
 
-	if err := r.reconcileAdmissionWebhooks(ctx, cfg, seed, client, log); err != nil {
+	if err := r.reconcileSecrets(ctx, cfg, seed, client, log); err != nil {
 		return err
 	}
 
@@ -495,6 +502,18 @@ func (r *Reconciler) reconcileClusterRoleBindings(ctx context.Context, cfg *kube
 	return nil
 }
 
+func (r *Reconciler) reconcilePriorityClasses(ctx context.Context, cfg *kubermaticv1.KubermaticConfiguration, seed *kubermaticv1.Seed, client ctrlruntimeclient.Client, log *zap.SugaredLogger) error {
+	log.Debug("reconciling PriorityClasses")
+
+	creators := kubermaticseed.ControlPlanePriorityClassReconcilers()
+
+	if err := kkpreconciling.ReconcilePriorityClasses(ctx, creators, "", client); err != nil {
+		return fmt.Errorf("failed to reconcile PriorityClasses: %w", err)
+	}
+
+	return nil
+}
+
 func (r *Reconciler) reconcileConfigMaps(ctx context.Context, cfg *kubermaticv1.KubermaticConfiguration, seed *kubermaticv1.Seed, client ctrlruntimeclient.Client, log *zap.SugaredLogger, caBundle *corev1.ConfigMap) error {
 	log.Debug("reconciling ConfigMaps")
 
@@ -512,8 +531,19 @@ func (r *Reconciler) reconcileConfigMaps(ctx context.Context, cfg *kubermaticv1.
 func (r *Reconciler) reconcileSecrets(ctx context.Context, cfg *kubermaticv1.KubermaticConfiguration, seed *kubermaticv1.Seed, client ctrlruntimeclient.Client, log *zap.SugaredLogger) error {
 	log.Debug("reconciling Secrets")
 
-	creators := []reconciling.NamedSecretReconcilerFactory{
+	// A rotated webhook CA has to be part of the caBundle of every webhook configuration
+	// before a serving certificate signed by it is issued, or admission requests fail.
+	if err := reconciling.ReconcileSecrets(ctx, []reconciling.NamedSecretReconcilerFactory{
 		common.WebhookServingCASecretReconciler(cfg),
+	}, cfg.Namespace, client, common.OwnershipModifierFactory(seed, r.scheme)); err != nil {
+		return fmt.Errorf("failed to reconcile webhook CA Secret: %w", err)
+	}
+
+	if err := r.reconcileAdmissionWebhooks(ctx, cfg, seed, client, log); err != nil {
+		return err
+	}
+
+	creators := []reconciling.NamedSecretReconcilerFactory{
 		common.WebhookServingCertSecretReconciler(ctx, cfg, client),
 	}
 
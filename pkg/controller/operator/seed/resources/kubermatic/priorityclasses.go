@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubermatic
+
+import (
+	"k8c.io/kubermatic/v2/pkg/resources"
+	kkpreconciling "k8c.io/kubermatic/v2/pkg/resources/reconciling"
+
+	schedulingv1 "k8s.io/api/scheduling/v1"
+)
+
+const (
+	// Both values are well below the system PriorityClasses, so that control planes never
+	// preempt system critical pods on the seed.
+	productionControlPlanePriority  = 100000
+	developmentControlPlanePriority = 10000
+)
+
+// ControlPlanePriorityClassReconcilers returns the PriorityClasses used by the control plane pods
+// of user clusters, depending on the cluster's tier.
+func ControlPlanePriorityClassReconcilers() []kkpreconciling.NamedPriorityClassReconcilerFactory {
+	return []kkpreconciling.NamedPriorityClassReconcilerFactory{
+		priorityClassReconciler(resources.ProductionControlPlanePriorityClassName, productionControlPlanePriority, "Control plane pods of production user clusters."),
+		priorityClassReconciler(resources.DevelopmentControlPlanePriorityClassName, developmentControlPlanePriority, "Control plane pods of development user clusters."),
+	}
+}
+
+func priorityClassReconciler(name string, value int32, description string) kkpreconciling.NamedPriorityClassReconcilerFactory {
+	return func() (string, kkpreconciling.PriorityClassReconciler) {
+		return name, func(pc *schedulingv1.PriorityClass) (*schedulingv1.PriorityClass, error) {
+			// the value of a PriorityClass is immutable, so it is only set on creation
+			if pc.Value == 0 {
+				pc.Value = value
+			}
+			pc.Description = description
+
+			return pc, nil
+		}
+	}
+}
@@ -137,7 +137,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 	if err != nil {
 		return nil, fmt.Errorf("failed to get seeds: %w", err)
 	}
-	datacenter, found := seed.Spec.Datacenters[cluster.Spec.Cloud.DatacenterName]
+	datacenter, _, found := kubermaticv1helper.ResolveDatacenter(seed, cluster.Spec.Cloud.DatacenterName)
 	if !found {
 		return nil, fmt.Errorf("couldn't find datacenter %q for cluster %q", cluster.Spec.Cloud.DatacenterName, cluster.Name)
 	}
@@ -320,7 +320,7 @@ func (r *Reconciler) validateSecretRef(ctx context.Context, cluster *kubermaticv
 
 	for _, key := range cluster.Spec.EncryptionConfiguration.Secretbox.Keys {
 		if key.SecretRef != nil {
-			v, err := getSecretKeyValue(ctx, r.Client, key.SecretRef, fmt.Sprintf("cluster-%s", cluster.Name))
+			v, err := getSecretKeyValue(ctx, r.Client, key.SecretRef, resources.NamespaceName(cluster))
 			if err != nil {
 				return &reconcile.Result{}, err
 			} else {
@@ -360,7 +360,7 @@ func (r *Reconciler) setInitializedCondition(ctx context.Context, cluster *kuber
 }
 
 func (r *Reconciler) getClusterTemplateData(ctx context.Context, cluster *kubermaticv1.Cluster, seed *kubermaticv1.Seed, config *kubermaticv1.KubermaticConfiguration) (*resources.TemplateData, error) {
-	datacenter, found := seed.Spec.Datacenters[cluster.Spec.Cloud.DatacenterName]
+	datacenter, _, found := kubermaticv1helper.ResolveDatacenter(seed, cluster.Spec.Cloud.DatacenterName)
 	if !found {
 		return nil, fmt.Errorf("failed to get datacenter %s", cluster.Spec.Cloud.DatacenterName)
 	}
@@ -369,7 +369,7 @@ func (r *Reconciler) getClusterTemplateData(ctx context.Context, cluster *kuberm
 		WithContext(ctx).
 		WithClient(r).
 		WithCluster(cluster).
-		WithDatacenter(&datacenter).
+		WithDatacenter(datacenter).
 		WithSeed(seed.DeepCopy()).
 		WithKubermaticConfiguration(config.DeepCopy()).
 		WithOverwriteRegistry(r.overwriteRegistry).
@@ -79,13 +79,23 @@ func (r *Reconciler) encryptData(ctx context.Context, client ctrlruntimeclient.C
 		// generate a Job that will run re-encryption on both configured and previously encrypted resources.
 		// That is done to make sure that previously encrypted resources get re-encrypted or decrypted even
 		// if they vanished from the resource list in ClusterSpec.
-		job := encryptionresources.EncryptionJobCreator(
-			data,
-			cluster,
-			&secret,
-			mergeSlice(resourceList, cluster.Status.Encryption.EncryptedResources),
-			key,
-		)
+		var job batchv1.Job
+		if isKMSKeyRotation(cluster.Status.Encryption.ActiveKey, key) {
+			job = encryptionresources.StorageMigrationJobCreator(
+				data,
+				cluster,
+				&secret,
+				mergeSlice(resourceList, cluster.Status.Encryption.EncryptedResources),
+			)
+		} else {
+			job = encryptionresources.EncryptionJobCreator(
+				data,
+				cluster,
+				&secret,
+				mergeSlice(resourceList, cluster.Status.Encryption.EncryptedResources),
+				key,
+			)
+		}
 
 		if err := r.Create(ctx, &job); err != nil {
 			return &reconcile.Result{}, err
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
@@ -111,6 +112,8 @@ func getActiveConfiguration(ctx context.Context, client ctrlruntimeclient.Client
 	switch {
 	case providerConfig.Secretbox != nil:
 		keyName = fmt.Sprintf("%s/%s", encryptionresources.SecretboxPrefix, providerConfig.Secretbox.Keys[0].Name)
+	case providerConfig.KMS != nil:
+		keyName = encryptionresources.KMSKeyHint(providerConfig.KMS.Name, secret.Annotations[encryptionresources.KMSKeyIDAnnotationKey])
 	case providerConfig.Identity != nil:
 		keyName = encryptionresources.IdentityKey
 	}
@@ -129,11 +132,24 @@ func getConfiguredKey(cluster *kubermaticv1.Cluster) (string, error) {
 	switch {
 	case cluster.Spec.EncryptionConfiguration.Secretbox != nil:
 		return fmt.Sprintf("%s/%s", encryptionresources.SecretboxPrefix, cluster.Spec.EncryptionConfiguration.Secretbox.Keys[0].Name), nil
+	case cluster.Spec.EncryptionConfiguration.KMS != nil:
+		return encryptionresources.KMSKeyHint(cluster.Spec.EncryptionConfiguration.KMS.Name, cluster.Spec.EncryptionConfiguration.KMS.KeyID), nil
 	}
 
 	return "", errors.New("no supported encryption provider found")
 }
 
+// isKMSKeyRotation returns whether moving from the active to the new key hint only rotates the
+// key of the same KMS provider.
+func isKMSKeyRotation(activeKey, newKey string) bool {
+	activeParts := strings.SplitN(activeKey, "/", 3)
+	newParts := strings.SplitN(newKey, "/", 3)
+
+	return len(activeParts) == 3 && len(newParts) == 3 &&
+		activeParts[0] == encryptionresources.KMSPrefix && newParts[0] == encryptionresources.KMSPrefix &&
+		activeParts[1] == newParts[1]
+}
+
 func isEqualSlice(a []string, b []string) bool {
 	if len(a) != len(b) {
 		return false
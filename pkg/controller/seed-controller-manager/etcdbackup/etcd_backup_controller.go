@@ -19,6 +19,7 @@ package etcdbackup
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	cron "github.com/robfig/cron/v3"
@@ -194,24 +195,34 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	var suppressedError error
 
-	// Add a wrapping here so we can emit an event on error
-	result, err := kubermaticv1helper.ClusterReconcileWrapper(
-		ctx,
-		r.Client,
-		r.workerName,
-		cluster,
-		r.versions,
-		kubermaticv1.ClusterConditionNone,
-		func() (*reconcile.Result, error) {
-			result, err := r.reconcile(ctx, log, backupConfig, cluster, seed, config)
-			if apierrors.IsConflict(err) {
-				// benign update conflict -- remember this so we can
-				// suppress log.Error and event generation below
-				suppressedError = err
-			}
-			return result, err
-		},
-	)
+	reconcileBackupConfig := func() (*reconcile.Result, error) {
+		result, err := r.reconcile(ctx, log, backupConfig, cluster, seed, config)
+		if apierrors.IsConflict(err) {
+			// benign update conflict -- remember this so we can
+			// suppress log.Error and event generation below
+			suppressedError = err
+		}
+		return result, err
+	}
+
+	var result *reconcile.Result
+
+	// the control plane of a paused cluster is only hibernated once its backup has
+	// completed, so hibernation backups must be taken although the cluster is paused
+	if isHibernationBackup(backupConfig) && cluster.Spec.Pause && cluster.Labels[kubermaticv1.WorkerNameLabelKey] == r.workerName {
+		result, err = reconcileBackupConfig()
+	} else {
+		// Add a wrapping here so we can emit an event on error
+		result, err = kubermaticv1helper.ClusterReconcileWrapper(
+			ctx,
+			r.Client,
+			r.workerName,
+			cluster,
+			r.versions,
+			kubermaticv1.ClusterConditionNone,
+			reconcileBackupConfig,
+		)
+	}
 	if err != nil {
 		if suppressedError != nil {
 			// we know that err is a 1-element Aggregate containing just suppressedError
@@ -234,6 +245,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return *result, err
 }
 
+// isHibernationBackup returns true for the one-shot backups taken before the control
+// plane of a paused cluster is hibernated.
+func isHibernationBackup(backupConfig *kubermaticv1.EtcdBackupConfig) bool {
+	return strings.HasPrefix(backupConfig.Name, resources.EtcdHibernationBackupConfigPrefix)
+}
+
 func (r *Reconciler) reconcile(
 	ctx context.Context,
 	log *zap.SugaredLogger,
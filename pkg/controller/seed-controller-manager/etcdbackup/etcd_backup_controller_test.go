@@ -1751,6 +1751,66 @@ func TestMultipleBackupDestination(t *testing.T) {
 	}
 }
 
+func TestPausedClusterBackups(t *testing.T) {
+	testCases := []struct {
+		name         string
+		backupConfig string
+		expectedJobs int
+	}{
+		{
+			name:         "regular backups of paused clusters are skipped",
+			backupConfig: "testbackup",
+			expectedJobs: 0,
+		},
+		{
+			name:         "hibernation backups of paused clusters are taken",
+			backupConfig: resources.EtcdHibernationBackupConfigPrefix + "1700000000",
+			expectedJobs: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := genTestCluster()
+			cluster.Spec.Pause = true
+			cluster.Spec.Hibernate = true
+
+			backupConfig := genBackupConfig(cluster, tc.backupConfig)
+			backupConfig.Spec.Destination = "s3"
+
+			reconciler := Reconciler{
+				log:      kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+				Client:   fake.NewClientBuilder().WithObjects(cluster, backupConfig, genClusterRootCaSecret()).Build(),
+				scheme:   scheme.Scheme,
+				recorder: record.NewFakeRecorder(10),
+				clock:    clocktesting.NewFakeClock(time.Unix(60, 0).UTC()),
+				caBundle: certificates.NewFakeCABundle(),
+				seedGetter: func() (*kubermaticv1.Seed, error) {
+					return generator.GenTestSeed(addSeedDestinations), nil
+				},
+				randStringGenerator: constRandStringGenerator("bob"),
+				configGetter:        getConfigGetter(t),
+
+				etcdLauncherImage: defaulting.DefaultEtcdLauncherImage,
+			}
+
+			ctx := context.Background()
+			if _, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: backupConfig.Namespace, Name: backupConfig.Name}}); err != nil {
+				t.Fatal(err)
+			}
+
+			jobList := batchv1.JobList{}
+			if err := reconciler.List(ctx, &jobList); err != nil {
+				t.Fatalf("Error reading created joblist: %v", err)
+			}
+
+			if len(jobList.Items) != tc.expectedJobs {
+				t.Fatalf("expected %d jobs, got %d", tc.expectedJobs, len(jobList.Items))
+			}
+		})
+	}
+}
+
 func addSeedDestinations(seed *kubermaticv1.Seed) {
 	seed.Spec.EtcdBackupRestore = &kubermaticv1.EtcdBackupRestore{
 		DefaultDestination: "s3",
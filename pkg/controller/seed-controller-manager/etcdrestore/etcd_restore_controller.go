@@ -252,7 +252,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, rest
 
 	// delete etcd sts
 	sts := &appsv1.StatefulSet{}
-	err = r.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.EtcdStatefulSetName}, sts)
+	err = r.Get(ctx, types.NamespacedName{Namespace: resources.EtcdNamespaceName(cluster), Name: resources.EtcdStatefulSetName}, sts)
 	if err == nil {
 		if err := r.Delete(ctx, sts); err != nil && !apierrors.IsNotFound(err) {
 			return nil, fmt.Errorf("failed to delete etcd statefulset: %w", err)
@@ -268,7 +268,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, rest
 	}
 
 	pvcs := &corev1.PersistentVolumeClaimList{}
-	if err := r.List(ctx, pvcs, &ctrlruntimeclient.ListOptions{Namespace: cluster.Status.NamespaceName, LabelSelector: pvcSelector}); err != nil {
+	if err := r.List(ctx, pvcs, &ctrlruntimeclient.ListOptions{Namespace: resources.EtcdNamespaceName(cluster), LabelSelector: pvcSelector}); err != nil {
 		return nil, fmt.Errorf("failed to list pvcs (%v): %w", pvcSelector.String(), err)
 	}
 
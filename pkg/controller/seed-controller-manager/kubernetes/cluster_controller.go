@@ -33,6 +33,7 @@ import (
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
 
@@ -47,7 +48,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	autoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	kubernetesclientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -71,6 +74,9 @@ type Features struct {
 	EtcdDataCorruptionChecks     bool
 	KubernetesOIDCAuthentication bool
 	EtcdLauncher                 bool
+	ExternalDNSAnnotations       bool
+	ControlPlaneImageOverrides   bool
+	APIServerExternalProbe       bool
 }
 
 // Reconciler is a controller which is responsible for managing clusters.
@@ -98,6 +104,28 @@ type Reconciler struct {
 	machineControllerImageRepository string
 	concurrentClusterUpdates         int
 	backupSchedule                   time.Duration
+	backupCount                      int
+	etcdDefragSchedule               string
+	saKeyRotationGracePeriod         time.Duration
+	nodeBootstrapTokenInterval       time.Duration
+	nodeBootstrapTokenGracePeriod    time.Duration
+	etcdRolloutLimiter               *etcdRolloutLimiter
+	apiserverShutdownDelay           time.Duration
+	apiserverTerminationGracePeriod  time.Duration
+	nodeCleanupSkipTimeout           time.Duration
+	sidecarInjections                []resources.SidecarInjection
+	criticalComponents               sets.Set[string]
+	credentialValidators             map[kubermaticv1.ProviderType]cloudCredentialValidator
+	etcdVolumeExpansion              bool
+	etcdVolumeExpansionThreshold     int
+	etcdVolumeExpansionMaxSize       resource.Quantity
+	volumeUsageGetter                volumeUsageGetter
+	etcdMemberReplacement            bool
+	etcdMemberClientFactory          etcdMemberClientFactory
+	eventExporterSink                string
+	imageVerifier                    imageVerifier
+	dryRun                           bool
+	controlPlaneIngressIsolation     bool
 
 	oidcIssuerURL      string
 	oidcIssuerClientID string
@@ -126,6 +154,25 @@ func Add(
 	dockerPullConfigJSON []byte,
 	concurrentClusterUpdates int,
 	backupSchedule time.Duration,
+	backupCount int,
+	etcdDefragSchedule string,
+	saKeyRotationGracePeriod time.Duration,
+	nodeBootstrapTokenInterval time.Duration,
+	nodeBootstrapTokenGracePeriod time.Duration,
+	maxConcurrentEtcdRollouts int,
+	apiserverShutdownDelay time.Duration,
+	apiserverTerminationGracePeriod time.Duration,
+	nodeCleanupSkipTimeout time.Duration,
+	sidecarInjections []resources.SidecarInjection,
+	criticalComponents sets.Set[string],
+	etcdVolumeExpansion bool,
+	etcdVolumeExpansionThreshold int,
+	etcdVolumeExpansionMaxSize resource.Quantity,
+	etcdMemberReplacement bool,
+	eventExporterSink string,
+	imageAttestationAnnotation string,
+	dryRun bool,
+	controlPlaneIngressIsolation bool,
 
 	oidcIssuerURL string,
 	oidcIssuerClientID string,
@@ -140,6 +187,11 @@ func Add(
 	features Features,
 	versions kubermatic.Versions,
 ) error {
+	clientset, err := kubernetesclientset.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create clientset: %w", err)
+	}
+
 	reconciler := &Reconciler{
 		log:                     log.Named(ControllerName),
 		Client:                  mgr.GetClient(),
@@ -160,6 +212,27 @@ func Add(
 		machineControllerImageRepository: machineControllerImageRepository,
 		concurrentClusterUpdates:         concurrentClusterUpdates,
 		backupSchedule:                   backupSchedule,
+		backupCount:                      backupCount,
+		etcdDefragSchedule:               etcdDefragSchedule,
+		saKeyRotationGracePeriod:         saKeyRotationGracePeriod,
+		nodeBootstrapTokenInterval:       nodeBootstrapTokenInterval,
+		nodeBootstrapTokenGracePeriod:    nodeBootstrapTokenGracePeriod,
+		etcdRolloutLimiter:               newEtcdRolloutLimiter(maxConcurrentEtcdRollouts),
+		apiserverShutdownDelay:           apiserverShutdownDelay,
+		apiserverTerminationGracePeriod:  apiserverTerminationGracePeriod,
+		nodeCleanupSkipTimeout:           nodeCleanupSkipTimeout,
+		sidecarInjections:                sidecarInjections,
+		criticalComponents:               criticalComponents,
+		credentialValidators:             defaultCloudCredentialValidators,
+		etcdVolumeExpansion:              etcdVolumeExpansion,
+		etcdVolumeExpansionThreshold:     etcdVolumeExpansionThreshold,
+		etcdVolumeExpansionMaxSize:       etcdVolumeExpansionMaxSize,
+		volumeUsageGetter:                kubeletVolumeUsageGetter(clientset.CoreV1().RESTClient()),
+		etcdMemberReplacement:            etcdMemberReplacement,
+		etcdMemberClientFactory:          newEtcdMemberClient(mgr.GetClient()),
+		eventExporterSink:                eventExporterSink,
+		dryRun:                           dryRun,
+		controlPlaneIngressIsolation:     controlPlaneIngressIsolation,
 
 		externalURL:  externalURL,
 		seedGetter:   seedGetter,
@@ -175,6 +248,10 @@ func Add(
 		versions: versions,
 	}
 
+	if imageAttestationAnnotation != "" {
+		reconciler.imageVerifier = newAnnotationImageVerifier(imageAttestationAnnotation)
+	}
+
 	typesToWatch := []ctrlruntimeclient.Object{
 		&corev1.Service{},
 		&corev1.ServiceAccount{},
@@ -223,7 +300,39 @@ func Add(
 		bldr.Watches(t, inNamespaceHandler)
 	}
 
-	_, err := bldr.Build(reconciler)
+	// The etcd backup storage credentials are shared by all clusters, so rotating them
+	// has to be rolled out into every cluster namespace.
+	backupCredentialsHandler := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a ctrlruntimeclient.Object) []reconcile.Request {
+		seed, err := seedGetter()
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to get Seed: %w", err))
+			return []reconcile.Request{}
+		}
+
+		key, ok := resources.EtcdBackupStorageCredentialsKey(defaultEtcdBackupDestination(seed))
+		if !ok || key != ctrlruntimeclient.ObjectKeyFromObject(a) {
+			return []reconcile.Request{}
+		}
+
+		clusters := &kubermaticv1.ClusterList{}
+		if err := reconciler.List(ctx, clusters); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to list Clusters: %w", err))
+			return []reconcile.Request{}
+		}
+
+		requests := []reconcile.Request{}
+		for _, cluster := range clusters.Items {
+			if cluster.DeletionTimestamp == nil {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: cluster.Name}})
+			}
+		}
+
+		return requests
+	})
+
+	bldr.Watches(&corev1.Secret{}, backupCredentialsHandler)
+
+	_, err = bldr.Build(reconciler)
 
 	return err
 }
@@ -237,11 +346,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if err := r.Get(ctx, request.NamespacedName, cluster); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.Debug("Could not find cluster")
+			r.etcdRolloutLimiter.Release(request.Name)
+			deleteClusterMetrics(request.Name)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, err
 	}
 
+	// the etcd of clusters that are not reconciled by this controller is not rolled out
+	if cluster.Spec.Pause || isDryRunCluster(cluster) || cluster.Labels[kubermaticv1.WorkerNameLabelKey] != r.workerName {
+		r.etcdRolloutLimiter.Release(cluster.Name)
+	}
+
 	// the update controller needs to determine the target version based on the spec
 	// before we can reconcile anything
 	if cluster.Status.Versions.ControlPlane == "" {
@@ -250,6 +366,34 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	if r.dryRun {
+		// cleaning up a cluster cannot be simulated, as it waits for resources to be gone
+		if cluster.DeletionTimestamp != nil {
+			log.Debug("Skipping cleanup of deleted cluster in dry-run mode")
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, r.reconcileDryRun(ctx, log, cluster)
+	}
+
+	// canary clusters are not reconciled, only the changes that would be made are measured
+	if isDryRunCluster(cluster) {
+		return reconcile.Result{}, r.reconcileDryRun(ctx, log, cluster)
+	}
+
+	// paused clusters are not reconciled, but their control plane can be hibernated
+	if cluster.Spec.Pause && cluster.Spec.Hibernate && cluster.DeletionTimestamp == nil && cluster.Labels[kubermaticv1.WorkerNameLabelKey] == r.workerName {
+		result, err := r.reconcileHibernation(ctx, log, cluster)
+		if result == nil {
+			result = &reconcile.Result{}
+		}
+		if err != nil {
+			r.recorder.Event(cluster, corev1.EventTypeWarning, "HibernationError", err.Error())
+		}
+
+		return *result, err
+	}
+
 	// Add a wrapping here so we can emit an event on error
 	result, err := kubermaticv1helper.ClusterReconcileWrapper(
 		ctx,
@@ -291,6 +435,11 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 	if cluster.DeletionTimestamp != nil {
 		log.Debug("Cleaning up cluster")
 
+		// a cluster in deletion does not roll out its etcd anymore
+		// and its control plane is not reconciled anymore
+		r.etcdRolloutLimiter.Release(cluster.Name)
+		deleteClusterMetrics(cluster.Name)
+
 		// Defer getting the client to make sure we only request it if we actually need it
 		userClusterClientGetter := func() (ctrlruntimeclient.Client, error) {
 			client, err := r.userClusterConnProvider.GetClient(ctx, cluster)
@@ -300,7 +449,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 			return client, nil
 		}
 
-		if err := clusterdeletion.New(r.Client, r.recorder, userClusterClientGetter).CleanupCluster(ctx, log, cluster); err != nil {
+		if err := clusterdeletion.New(r.Client, r.recorder, userClusterClientGetter, r.nodeCleanupSkipTimeout).CleanupCluster(ctx, log, cluster); err != nil {
 			return nil, err
 		}
 
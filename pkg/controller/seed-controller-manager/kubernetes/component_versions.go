@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
+	"k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// versionedDeployments are the control plane Deployments whose versions are exposed
+// in the cluster status. Their main container is named like the Deployment itself.
+var versionedDeployments = []string{
+	resources.ApiserverDeploymentName,
+	resources.ControllerManagerDeploymentName,
+	resources.SchedulerDeploymentName,
+}
+
+// updateComponentVersionsStatus records the image tags of the control plane components
+// in the cluster status. A component's version is only updated once its rollout has
+// completed, so the status never reports a version that is not actually serving yet.
+func (r *Reconciler) updateComponentVersionsStatus(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	namespace := cluster.Status.NamespaceName
+	versions := map[string]string{}
+
+	for _, name := range versionedDeployments {
+		deployment := &appsv1.Deployment{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, deployment); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get Deployment %s: %w", name, err)
+		}
+
+		// a Deployment stuck in its rollout is simply treated as not rolled out yet
+		complete, _ := kubernetes.IsDeploymentRolloutComplete(deployment, 0)
+
+		recordComponentVersion(versions, cluster.Status.ComponentVersions, name, deployment.Spec.Template.Spec.Containers, complete)
+	}
+
+	etcd := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: resources.EtcdNamespaceName(cluster), Name: resources.EtcdStatefulSetName}, etcd); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get StatefulSet %s: %w", resources.EtcdStatefulSetName, err)
+		}
+	} else {
+		recordComponentVersion(versions, cluster.Status.ComponentVersions, resources.EtcdStatefulSetName, etcd.Spec.Template.Spec.Containers, isStatefulSetRolloutComplete(etcd))
+	}
+
+	if len(versions) == 0 {
+		versions = nil
+	}
+
+	if maps.Equal(versions, cluster.Status.ComponentVersions) {
+		return nil
+	}
+
+	return kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.ComponentVersions = versions
+	})
+}
+
+// recordComponentVersion stores the image tag of the component's container in versions.
+// If the rollout is still in progress, the previously recorded version is kept instead.
+func recordComponentVersion(versions, previous map[string]string, component string, containers []corev1.Container, rolledOut bool) {
+	if !rolledOut {
+		if version, ok := previous[component]; ok {
+			versions[component] = version
+		}
+		return
+	}
+
+	for _, container := range containers {
+		if container.Name == component {
+			if tag := imageTag(container.Image); tag != "" {
+				versions[component] = tag
+			}
+			return
+		}
+	}
+}
+
+// isStatefulSetRolloutComplete returns true if all replicas of the StatefulSet run
+// the current revision.
+func isStatefulSetRolloutComplete(set *appsv1.StatefulSet) bool {
+	if set.Generation > set.Status.ObservedGeneration {
+		return false
+	}
+
+	replicas := int32(1)
+	if set.Spec.Replicas != nil {
+		replicas = *set.Spec.Replicas
+	}
+
+	return set.Status.UpdatedReplicas >= replicas &&
+		set.Status.CurrentRevision == set.Status.UpdateRevision
+}
+
+// imageTag returns the tag of a container image reference, ignoring any digest.
+// An empty string is returned if the image is not tagged.
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+
+	name := image[strings.LastIndex(image, "/")+1:]
+	if idx := strings.LastIndex(name, ":"); idx >= 0 {
+		return name[idx+1:]
+	}
+
+	return ""
+}
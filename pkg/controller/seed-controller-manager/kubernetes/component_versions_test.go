@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func componentVersionsTestDeployment(name, image string, rolledOut bool) *appsv1.Deployment {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: hibernationTestNamespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](2),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "openvpn-client", Image: "quay.io/kubermatic/openvpn:v2.5.2"},
+						{Name: name, Image: image},
+					},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			Replicas:          2,
+			UpdatedReplicas:   2,
+			AvailableReplicas: 2,
+		},
+	}
+
+	if !rolledOut {
+		deployment.Status.UpdatedReplicas = 1
+	}
+
+	return deployment
+}
+
+func componentVersionsTestEtcd(image string, rolledOut bool) *appsv1.StatefulSet {
+	set := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.EtcdStatefulSetName,
+			Namespace: hibernationTestNamespace,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To[int32](3),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: resources.EtcdStatefulSetName, Image: image},
+					},
+				},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{
+			UpdatedReplicas: 3,
+			CurrentRevision: "etcd-1",
+			UpdateRevision:  "etcd-1",
+		},
+	}
+
+	if !rolledOut {
+		set.Status.UpdatedReplicas = 1
+		set.Status.UpdateRevision = "etcd-2"
+	}
+
+	return set
+}
+
+func TestUpdateComponentVersionsStatus(t *testing.T) {
+	testCases := []struct {
+		name           string
+		previous       map[string]string
+		splitNamespace bool
+		objects        []ctrlruntimeclient.Object
+		expected       map[string]string
+	}{
+		{
+			name:     "no control plane yet",
+			expected: nil,
+		},
+		{
+			name: "all components rolled out",
+			objects: []ctrlruntimeclient.Object{
+				componentVersionsTestDeployment(resources.ApiserverDeploymentName, "registry.k8s.io/kube-apiserver:v1.31.1", true),
+				componentVersionsTestDeployment(resources.ControllerManagerDeploymentName, "registry.k8s.io/kube-controller-manager:v1.31.1", true),
+				componentVersionsTestDeployment(resources.SchedulerDeploymentName, "localhost:5000/kube-scheduler:v1.31.1@sha256:abcdef", true),
+				componentVersionsTestEtcd("registry.k8s.io/etcd:3.5.15-0", true),
+			},
+			expected: map[string]string{
+				resources.ApiserverDeploymentName:         "v1.31.1",
+				resources.ControllerManagerDeploymentName: "v1.31.1",
+				resources.SchedulerDeploymentName:         "v1.31.1",
+				resources.EtcdStatefulSetName:             "3.5.15-0",
+			},
+		},
+		{
+			name:           "etcd in a dedicated namespace",
+			splitNamespace: true,
+			objects: []ctrlruntimeclient.Object{
+				componentVersionsTestDeployment(resources.ApiserverDeploymentName, "registry.k8s.io/kube-apiserver:v1.31.1", true),
+				componentVersionsTestEtcd("registry.k8s.io/etcd:3.5.15-0", true),
+			},
+			expected: map[string]string{
+				resources.ApiserverDeploymentName: "v1.31.1",
+				resources.EtcdStatefulSetName:     "3.5.15-0",
+			},
+		},
+		{
+			name: "components in the middle of a rollout keep their previous version",
+			previous: map[string]string{
+				resources.ApiserverDeploymentName:         "v1.30.5",
+				resources.ControllerManagerDeploymentName: "v1.30.5",
+				resources.EtcdStatefulSetName:             "3.5.12-0",
+			},
+			objects: []ctrlruntimeclient.Object{
+				componentVersionsTestDeployment(resources.ApiserverDeploymentName, "registry.k8s.io/kube-apiserver:v1.31.1", true),
+				componentVersionsTestDeployment(resources.ControllerManagerDeploymentName, "registry.k8s.io/kube-controller-manager:v1.31.1", false),
+				componentVersionsTestDeployment(resources.SchedulerDeploymentName, "registry.k8s.io/kube-scheduler:v1.31.1", false),
+				componentVersionsTestEtcd("registry.k8s.io/etcd:3.5.15-0", false),
+			},
+			expected: map[string]string{
+				resources.ApiserverDeploymentName:         "v1.31.1",
+				resources.ControllerManagerDeploymentName: "v1.30.5",
+				resources.EtcdStatefulSetName:             "3.5.12-0",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			cluster := hibernationTestCluster()
+			cluster.Status.ComponentVersions = tc.previous
+
+			if tc.splitNamespace {
+				cluster.Spec.Features = map[string]bool{kubermaticv1.ClusterFeatureSplitEtcdNamespace: true}
+
+				for _, obj := range tc.objects {
+					if set, ok := obj.(*appsv1.StatefulSet); ok {
+						set.Namespace = resources.EtcdNamespaceName(cluster)
+					}
+				}
+			}
+
+			client := fake.NewClientBuilder().
+				WithObjects(append(tc.objects, cluster)...).
+				WithStatusSubresource(&kubermaticv1.Cluster{}).
+				Build()
+
+			r := &Reconciler{
+				log:    zap.NewNop().Sugar(),
+				Client: client,
+			}
+
+			if err := r.updateComponentVersionsStatus(ctx, cluster); err != nil {
+				t.Fatalf("Failed to update status: %v", err)
+			}
+
+			updated := &kubermaticv1.Cluster{}
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), updated); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			if !maps.Equal(updated.Status.ComponentVersions, tc.expected) {
+				t.Errorf("Expected component versions %v, but got %v", tc.expected, updated.Status.ComponentVersions)
+			}
+
+			// a second reconciliation must not touch the cluster again
+			resourceVersion := updated.ResourceVersion
+			if err := r.updateComponentVersionsStatus(ctx, updated); err != nil {
+				t.Fatalf("Failed to update status again: %v", err)
+			}
+
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), updated); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			if updated.ResourceVersion != resourceVersion {
+				t.Errorf("Expected the cluster not to be updated again, but resource version changed from %s to %s", resourceVersion, updated.ResourceVersion)
+			}
+		})
+	}
+}
+
+func TestImageTag(t *testing.T) {
+	testCases := []struct {
+		image    string
+		expected string
+	}{
+		{image: "registry.k8s.io/kube-apiserver:v1.31.1", expected: "v1.31.1"},
+		{image: "localhost:5000/kube-apiserver:v1.31.1", expected: "v1.31.1"},
+		{image: "localhost:5000/kube-apiserver", expected: ""},
+		{image: "registry.k8s.io/etcd:3.5.15-0@sha256:abcdef", expected: "3.5.15-0"},
+		{image: "etcd", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		if tag := imageTag(tc.image); tag != tc.expected {
+			t.Errorf("Expected tag %q for image %q, but got %q", tc.expected, tc.image, tag)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/hetzner"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/openstack"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// ReasonCredentialsRejected is the reason of the InvalidCredentials condition if the
+	// cloud provider did not accept the cluster's credentials.
+	ReasonCredentialsRejected = "CredentialsRejected"
+
+	// invalidCredentialsRetryPeriod is how long to wait before validating rejected
+	// credentials again.
+	invalidCredentialsRetryPeriod = time.Minute
+)
+
+// cloudCredentialValidator checks that the cloud credentials of a cluster are accepted
+// by its cloud provider.
+type cloudCredentialValidator func(ctx context.Context, data *resources.TemplateData, secretKeySelector provider.SecretKeySelectorValueFunc) error
+
+// defaultCloudCredentialValidators are the providers for which credentials are validated
+// before the machine-controller is deployed.
+var defaultCloudCredentialValidators = map[kubermaticv1.ProviderType]cloudCredentialValidator{
+	kubermaticv1.HetznerCloudProvider:   validateHetznerCredentials,
+	kubermaticv1.OpenstackCloudProvider: validateOpenstackCredentials,
+}
+
+func validateHetznerCredentials(ctx context.Context, data *resources.TemplateData, secretKeySelector provider.SecretKeySelectorValueFunc) error {
+	token, err := hetzner.GetCredentialsForCluster(data.Cluster().Spec.Cloud, secretKeySelector)
+	if err != nil {
+		return err
+	}
+
+	return hetzner.ValidateCredentials(ctx, token)
+}
+
+func validateOpenstackCredentials(_ context.Context, data *resources.TemplateData, secretKeySelector provider.SecretKeySelectorValueFunc) error {
+	dc := data.DC().Spec.Openstack
+	if dc == nil {
+		return errors.New("datacenter is not an OpenStack datacenter")
+	}
+
+	credentials, err := openstack.GetCredentialsForCluster(data.Cluster().Spec.Cloud, secretKeySelector)
+	if err != nil {
+		return err
+	}
+
+	return openstack.ValidateCredentials(dc.AuthURL, dc.Region, credentials, data.CABundle().CertPool())
+}
+
+// ensureCloudCredentialsValid validates the cloud credentials of the cluster and reflects the
+// result in the InvalidCredentials condition. The validation only happens as long as the
+// machine-controller has not been deployed yet or the credentials have been rejected before,
+// so that the cloud provider API is not queried on every reconciliation. It returns false if
+// the credentials have been rejected.
+func (r *Reconciler) ensureCloudCredentialsValid(ctx context.Context, cluster *kubermaticv1.Cluster, data *resources.TemplateData) (bool, error) {
+	validate, ok := r.credentialValidators[kubermaticv1.ProviderType(cluster.Spec.Cloud.ProviderName)]
+	if !ok {
+		return true, nil
+	}
+
+	cond, hasCondition := cluster.Status.Conditions[kubermaticv1.ClusterConditionInvalidCredentials]
+	if hasCondition && cond.Status != corev1.ConditionTrue {
+		deployed, err := r.machineControllerDeployed(ctx, cluster)
+		if err != nil {
+			return false, err
+		}
+
+		if deployed {
+			return true, nil
+		}
+	}
+
+	status := corev1.ConditionFalse
+	reason := ""
+	message := "The cloud credentials have been accepted by the cloud provider."
+
+	if err := validate(ctx, data, provider.SecretKeySelectorValueFuncFactory(ctx, r)); err != nil {
+		status = corev1.ConditionTrue
+		reason = ReasonCredentialsRejected
+		message = fmt.Sprintf("The cloud credentials have been rejected by the cloud provider, machine-controller will not be deployed: %v", err)
+	}
+
+	err := kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		kubermaticv1helper.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionInvalidCredentials, status, reason, message)
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to update credentials condition: %w", err)
+	}
+
+	return status == corev1.ConditionFalse, nil
+}
+
+func (r *Reconciler) machineControllerDeployed(ctx context.Context, cluster *kubermaticv1.Cluster) (bool, error) {
+	key := types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.MachineControllerDeploymentName}
+	if err := r.Get(ctx, key, &appsv1.Deployment{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get machine-controller Deployment: %w", err)
+	}
+
+	return true, nil
+}
+
+// withoutDeployment removes the reconciler for the named Deployment from the given factories.
+func withoutDeployment(factories []reconciling.NamedDeploymentReconcilerFactory, name string) []reconciling.NamedDeploymentReconcilerFactory {
+	result := make([]reconciling.NamedDeploymentReconcilerFactory, 0, len(factories))
+	for _, factory := range factories {
+		if factoryName, _ := factory(); factoryName != name {
+			result = append(result, factory)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hetznercloud/hcloud-go/hcloud"
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestEnsureCloudCredentialsValid(t *testing.T) {
+	rejected := hcloud.Error{Code: hcloud.ErrorCodeUnauthorized, Message: "unable to authenticate"}
+
+	machineController := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.MachineControllerDeploymentName,
+			Namespace: hibernationTestNamespace,
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		provider          kubermaticv1.ProviderType
+		previousStatus    corev1.ConditionStatus
+		objects           []ctrlruntimeclient.Object
+		validationErr     error
+		expectValidation  bool
+		expectValid       bool
+		expectedCondition corev1.ConditionStatus
+		expectedMessage   string
+	}{
+		{
+			name:        "provider without credential validation",
+			provider:    kubermaticv1.AWSCloudProvider,
+			expectValid: true,
+		},
+		{
+			name:              "valid credentials",
+			provider:          kubermaticv1.HetznerCloudProvider,
+			expectValidation:  true,
+			expectValid:       true,
+			expectedCondition: corev1.ConditionFalse,
+		},
+		{
+			name:              "rejected credentials",
+			provider:          kubermaticv1.OpenstackCloudProvider,
+			validationErr:     rejected,
+			expectValidation:  true,
+			expectValid:       false,
+			expectedCondition: corev1.ConditionTrue,
+			expectedMessage:   "unable to authenticate",
+		},
+		{
+			name:              "valid credentials are not checked again once machine-controller is deployed",
+			provider:          kubermaticv1.HetznerCloudProvider,
+			previousStatus:    corev1.ConditionFalse,
+			objects:           []ctrlruntimeclient.Object{machineController},
+			validationErr:     rejected,
+			expectValidation:  false,
+			expectValid:       true,
+			expectedCondition: corev1.ConditionFalse,
+		},
+		{
+			name:              "rejected credentials are checked again until they are accepted",
+			provider:          kubermaticv1.HetznerCloudProvider,
+			previousStatus:    corev1.ConditionTrue,
+			objects:           []ctrlruntimeclient.Object{machineController},
+			expectValidation:  true,
+			expectValid:       true,
+			expectedCondition: corev1.ConditionFalse,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			cluster := hibernationTestCluster()
+			cluster.Spec.Cloud.ProviderName = string(tc.provider)
+			if tc.previousStatus != "" {
+				cluster.Status.Conditions = map[kubermaticv1.ClusterConditionType]kubermaticv1.ClusterCondition{
+					kubermaticv1.ClusterConditionInvalidCredentials: {Status: tc.previousStatus},
+				}
+			}
+
+			client := fake.NewClientBuilder().
+				WithObjects(append(tc.objects, cluster)...).
+				WithStatusSubresource(&kubermaticv1.Cluster{}).
+				Build()
+
+			validated := false
+			validator := func(_ context.Context, _ *resources.TemplateData, _ provider.SecretKeySelectorValueFunc) error {
+				validated = true
+				return tc.validationErr
+			}
+
+			r := &Reconciler{
+				log:    zap.NewNop().Sugar(),
+				Client: client,
+				credentialValidators: map[kubermaticv1.ProviderType]cloudCredentialValidator{
+					kubermaticv1.HetznerCloudProvider:   validator,
+					kubermaticv1.OpenstackCloudProvider: validator,
+				},
+			}
+
+			valid, err := r.ensureCloudCredentialsValid(ctx, cluster, nil)
+			if err != nil {
+				t.Fatalf("Failed to validate credentials: %v", err)
+			}
+
+			if valid != tc.expectValid {
+				t.Errorf("Expected credentials to be valid = %v, but got %v", tc.expectValid, valid)
+			}
+
+			if validated != tc.expectValidation {
+				t.Errorf("Expected validation to be performed = %v, but got %v", tc.expectValidation, validated)
+			}
+
+			updated := &kubermaticv1.Cluster{}
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), updated); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			cond, ok := updated.Status.Conditions[kubermaticv1.ClusterConditionInvalidCredentials]
+			if tc.expectedCondition == "" {
+				if ok {
+					t.Errorf("Expected no InvalidCredentials condition, but got %+v", cond)
+				}
+				return
+			}
+
+			if cond.Status != tc.expectedCondition {
+				t.Errorf("Expected condition status %q, but got %q", tc.expectedCondition, cond.Status)
+			}
+
+			if !strings.Contains(cond.Message, tc.expectedMessage) {
+				t.Errorf("Expected condition message to contain %q, but got %q", tc.expectedMessage, cond.Message)
+			}
+		})
+	}
+}
+
+func TestWithoutDeployment(t *testing.T) {
+	factory := func(name string) reconciling.NamedDeploymentReconcilerFactory {
+		return func() (string, reconciling.DeploymentReconciler) {
+			return name, func(d *appsv1.Deployment) (*appsv1.Deployment, error) { return d, nil }
+		}
+	}
+
+	factories := withoutDeployment([]reconciling.NamedDeploymentReconcilerFactory{
+		factory(resources.ApiserverDeploymentName),
+		factory(resources.MachineControllerDeploymentName),
+		factory(resources.MachineControllerWebhookDeploymentName),
+	}, resources.MachineControllerDeploymentName)
+
+	var names []string
+	for _, f := range factories {
+		name, _ := f()
+		names = append(names, name)
+	}
+
+	expected := []string{resources.ApiserverDeploymentName, resources.MachineControllerWebhookDeploymentName}
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected Deployments %v, but got %v", expected, names)
+	}
+}
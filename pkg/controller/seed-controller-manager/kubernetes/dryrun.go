@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	// DryRunLabelKey is the label that, when set to "true" on a Cluster, makes the controller only
+	// determine which control plane objects it would change. Nothing is applied; instead the number
+	// of changed objects per kind is exported as a metric. This allows to measure the impact of a
+	// controller upgrade on canary clusters before rolling it out.
+	DryRunLabelKey = "kubermatic.k8c.io/dry-run"
+)
+
+func isDryRunCluster(cluster *kubermaticv1.Cluster) bool {
+	return cluster.Labels[DryRunLabelKey] == "true" && cluster.DeletionTimestamp == nil
+}
+
+// reconcileDryRun runs the control plane reconciliation against a client that discards all
+// writes, logs every change that would have been made and updates the dry-run metrics for
+// the cluster with the recorded changes.
+func (r *Reconciler) reconcileDryRun(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
+	namespace := &corev1.Namespace{}
+	if err := r.Get(ctx, types.NamespacedName{Name: cluster.Status.NamespaceName}, namespace); err != nil {
+		return fmt.Errorf("failed to get cluster namespace: %w", err)
+	}
+
+	client := newDryRunClient(r.Client)
+	client.log = log
+
+	dryRunReconciler := *r
+	dryRunReconciler.Client = client
+	// no etcd is rolled out, so no rollout slot must be taken
+	dryRunReconciler.etcdRolloutLimiter = nil
+	// nothing is rolled out, so the registries do not need to be queried for attestations
+	dryRunReconciler.imageVerifier = nil
+
+	if _, err := dryRunReconciler.ensureResourcesAreDeployed(ctx, cluster.DeepCopy(), namespace); err != nil {
+		return fmt.Errorf("failed to determine changes: %w", err)
+	}
+
+	dryRunObjectChanges.DeletePartialMatch(map[string]string{"cluster": cluster.Name})
+	for kind, count := range client.changes {
+		dryRunObjectChanges.WithLabelValues(cluster.Name, kind).Set(float64(count))
+	}
+
+	log.Debugw("Finished dry-run reconciliation", "changes", client.changes)
+
+	return nil
+}
+
+// dryRunClient records all write operations per kind instead of sending them to the API
+// server. Written objects are kept in memory so that subsequent reads, like when waiting
+// for the cache to contain the latest changes, see them. The client is safe for concurrent
+// use, as some resources are reconciled concurrently.
+type dryRunClient struct {
+	ctrlruntimeclient.Client
+
+	// log is used to log all changes, if set.
+	log *zap.SugaredLogger
+
+	// lock protects the recorded changes below.
+	lock     sync.Mutex
+	written  map[string]ctrlruntimeclient.Object
+	changes  map[string]int
+	revision int
+}
+
+var _ ctrlruntimeclient.Client = &dryRunClient{}
+
+func newDryRunClient(client ctrlruntimeclient.Client) *dryRunClient {
+	return &dryRunClient{
+		Client:  client,
+		written: map[string]ctrlruntimeclient.Object{},
+		changes: map[string]int{},
+	}
+}
+
+func (c *dryRunClient) key(obj ctrlruntimeclient.Object, name types.NamespacedName) (string, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s", gvk.Kind, name), nil
+}
+
+// record counts the change to the given object. Unless the object is deleted, it is
+// remembered with a new ResourceVersion, just like the API server would do.
+func (c *dryRunClient) record(obj ctrlruntimeclient.Object, deleted bool) error {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return err
+	}
+
+	key, err := c.key(obj, ctrlruntimeclient.ObjectKeyFromObject(obj))
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.changes[gvk.Kind]++
+
+	if deleted {
+		delete(c.written, key)
+		return nil
+	}
+
+	c.revision++
+
+	written := obj.DeepCopyObject().(ctrlruntimeclient.Object)
+	written.SetResourceVersion(fmt.Sprintf("dry-run-%d", c.revision))
+	c.written[key] = written
+
+	return nil
+}
+
+func (c *dryRunClient) Get(ctx context.Context, key ctrlruntimeclient.ObjectKey, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.GetOption) error {
+	k, err := c.key(obj, key)
+	if err != nil {
+		return err
+	}
+
+	if written, ok := c.writtenObject(k); ok {
+		reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(written).Elem())
+		return nil
+	}
+
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+// writtenObject returns a copy of the object recorded under the given key, if any.
+func (c *dryRunClient) writtenObject(key string) (ctrlruntimeclient.Object, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	written, ok := c.written[key]
+	if !ok {
+		return nil, false
+	}
+
+	return written.DeepCopyObject().(ctrlruntimeclient.Object), true
+}
+
+func (c *dryRunClient) Create(_ context.Context, obj ctrlruntimeclient.Object, _ ...ctrlruntimeclient.CreateOption) error {
+	c.logChange("create", obj, nil)
+	return c.record(obj, false)
+}
+
+func (c *dryRunClient) Update(ctx context.Context, obj ctrlruntimeclient.Object, _ ...ctrlruntimeclient.UpdateOption) error {
+	c.logChange("update", obj, c.updatePatch(ctx, obj))
+	return c.record(obj, false)
+}
+
+func (c *dryRunClient) Patch(_ context.Context, obj ctrlruntimeclient.Object, patch ctrlruntimeclient.Patch, _ ...ctrlruntimeclient.PatchOption) error {
+	c.logChange("patch", obj, patch)
+	return c.record(obj, false)
+}
+
+func (c *dryRunClient) Delete(_ context.Context, obj ctrlruntimeclient.Object, _ ...ctrlruntimeclient.DeleteOption) error {
+	c.logChange("delete", obj, nil)
+	return c.record(obj, true)
+}
+
+func (c *dryRunClient) DeleteAllOf(_ context.Context, obj ctrlruntimeclient.Object, _ ...ctrlruntimeclient.DeleteAllOfOption) error {
+	c.logChange("delete", obj, nil)
+	return c.record(obj, true)
+}
+
+// updatePatch returns the changes an update would make to the current object.
+func (c *dryRunClient) updatePatch(ctx context.Context, obj ctrlruntimeclient.Object) ctrlruntimeclient.Patch {
+	if c.log == nil {
+		return nil
+	}
+
+	current := obj.DeepCopyObject().(ctrlruntimeclient.Object)
+	if err := c.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(obj), current); err != nil {
+		return nil
+	}
+
+	return ctrlruntimeclient.MergeFrom(current)
+}
+
+// logChange logs a write operation together with the patch body, if one is known.
+func (c *dryRunClient) logChange(operation string, obj ctrlruntimeclient.Object, patch ctrlruntimeclient.Patch) {
+	if c.log == nil {
+		return
+	}
+
+	kind := fmt.Sprintf("%T", obj)
+	if gvk, err := apiutil.GVKForObject(obj, c.Scheme()); err == nil {
+		kind = gvk.Kind
+	}
+
+	log := c.log.With("operation", operation, "kind", kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+
+	if patch != nil {
+		data, err := patch.Data(obj)
+		if err != nil {
+			log.Infow("Would change object, but failed to compute the patch", zap.Error(err))
+			return
+		}
+
+		log = log.With("patch", string(data))
+	}
+
+	log.Info("Would change object")
+}
+
+func (c *dryRunClient) Status() ctrlruntimeclient.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+func (c *dryRunClient) SubResource(subResource string) ctrlruntimeclient.SubResourceClient {
+	return &dryRunSubResourceClient{
+		SubResourceClient: c.Client.SubResource(subResource),
+		client:            c,
+	}
+}
+
+// dryRunSubResourceClient records all writes to subresources (like the status) of objects.
+type dryRunSubResourceClient struct {
+	ctrlruntimeclient.SubResourceClient
+
+	client *dryRunClient
+}
+
+func (w *dryRunSubResourceClient) Create(_ context.Context, obj ctrlruntimeclient.Object, _ ctrlruntimeclient.Object, _ ...ctrlruntimeclient.SubResourceCreateOption) error {
+	w.client.logChange("create subresource", obj, nil)
+	return w.client.record(obj, false)
+}
+
+func (w *dryRunSubResourceClient) Update(ctx context.Context, obj ctrlruntimeclient.Object, _ ...ctrlruntimeclient.SubResourceUpdateOption) error {
+	w.client.logChange("update subresource", obj, w.client.updatePatch(ctx, obj))
+	return w.client.record(obj, false)
+}
+
+func (w *dryRunSubResourceClient) Patch(_ context.Context, obj ctrlruntimeclient.Object, patch ctrlruntimeclient.Patch, _ ...ctrlruntimeclient.SubResourcePatchOption) error {
+	w.client.logChange("patch subresource", obj, patch)
+	return w.client.record(obj, false)
+}
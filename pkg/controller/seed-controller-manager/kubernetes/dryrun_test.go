@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func configMapReconciler(name string, data map[string]string) reconciling.NamedConfigMapReconcilerFactory {
+	return func() (string, reconciling.ConfigMapReconciler) {
+		return name, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			cm.Data = data
+			return cm, nil
+		}
+	}
+}
+
+func TestDryRunClientRecordsChanges(t *testing.T) {
+	const namespace = "cluster-test"
+
+	ctx := context.Background()
+	unchanged := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "unchanged", Namespace: namespace},
+		Data:       map[string]string{"foo": "bar"},
+	}
+	changed := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "changed", Namespace: namespace},
+		Data:       map[string]string{"foo": "bar"},
+	}
+	client := fake.NewClientBuilder().WithObjects(unchanged, changed).Build()
+	dryRunClient := newDryRunClient(client)
+
+	reconcilers := []reconciling.NamedConfigMapReconcilerFactory{
+		configMapReconciler("unchanged", map[string]string{"foo": "bar"}),
+		configMapReconciler("changed", map[string]string{"foo": "baz"}),
+		configMapReconciler("new", map[string]string{"foo": "bar"}),
+	}
+	if err := reconciling.ReconcileConfigMaps(ctx, reconcilers, namespace, dryRunClient); err != nil {
+		t.Fatalf("Failed to reconcile ConfigMaps: %v", err)
+	}
+
+	if count := dryRunClient.changes["ConfigMap"]; count != 2 {
+		t.Errorf("Expected 2 ConfigMaps to change, got %d (%v)", count, dryRunClient.changes)
+	}
+
+	// nothing must have been written
+	cm := &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "changed"}, cm); err != nil {
+		t.Fatalf("Failed to get ConfigMap: %v", err)
+	}
+	if cm.Data["foo"] != "bar" {
+		t.Errorf("Expected ConfigMap to not be updated, but got data %v", cm.Data)
+	}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "new"}, cm); err == nil {
+		t.Error("Expected ConfigMap to not be created")
+	}
+}
+
+func TestDryRunClientLogsChanges(t *testing.T) {
+	const namespace = "cluster-test"
+
+	ctx := context.Background()
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "changed", Namespace: namespace},
+		Data:       map[string]string{"foo": "bar"},
+	}
+
+	core, logs := observer.New(zap.InfoLevel)
+	dryRunClient := newDryRunClient(fake.NewClientBuilder().WithObjects(existing).Build())
+	dryRunClient.log = zap.New(core).Sugar()
+
+	reconcilers := []reconciling.NamedConfigMapReconcilerFactory{
+		configMapReconciler("changed", map[string]string{"foo": "baz"}),
+		configMapReconciler("new", map[string]string{"foo": "bar"}),
+	}
+	if err := reconciling.ReconcileConfigMaps(ctx, reconcilers, namespace, dryRunClient); err != nil {
+		t.Fatalf("Failed to reconcile ConfigMaps: %v", err)
+	}
+
+	entries := logs.All()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 changes to be logged, got %d", len(entries))
+	}
+
+	for _, entry := range entries {
+		fields := entry.ContextMap()
+		if fields["kind"] != "ConfigMap" || fields["namespace"] != namespace {
+			t.Errorf("Expected change to a ConfigMap in %s to be logged, got %v", namespace, fields)
+		}
+
+		switch fields["name"] {
+		case "new":
+			if fields["operation"] != "create" {
+				t.Errorf("Expected creation of new ConfigMap to be logged, got %v", fields)
+			}
+		case "changed":
+			patch, _ := fields["patch"].(string)
+			if fields["operation"] != "update" || !strings.Contains(patch, `"foo":"baz"`) {
+				t.Errorf("Expected update of changed ConfigMap to be logged with its patch, got %v", fields)
+			}
+		default:
+			t.Errorf("Unexpected change logged: %v", fields)
+		}
+	}
+}
+
+// TestDryRunEnsureSecrets reconciles all control plane Secrets, which happens concurrently,
+// through the dry-run client. Run with -race to detect unsynchronized access to the client.
+func TestDryRunEnsureSecrets(t *testing.T) {
+	const namespace = "cluster-test"
+
+	ctx := context.Background()
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: kubermaticv1.ClusterSpec{
+			ExposeStrategy: kubermaticv1.ExposeStrategyNodePort,
+			ClusterNetwork: kubermaticv1.ClusterNetworkingConfig{
+				Pods:      kubermaticv1.NetworkRanges{CIDRBlocks: []string{"172.25.0.0/16"}},
+				Services:  kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.16.0/20"}},
+				DNSDomain: "cluster.local",
+			},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: namespace,
+			Address: kubermaticv1.ClusterAddress{
+				URL:          "https://test.example.com:30000",
+				Port:         30000,
+				ExternalName: "test.example.com",
+				InternalName: "apiserver-external.cluster-test.svc.cluster.local.",
+				AdminToken:   "admin-token",
+				IP:           "192.0.2.10",
+			},
+		},
+	}
+
+	apiserverService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: resources.ApiserverServiceName, Namespace: namespace},
+		Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeNodePort},
+	}
+
+	client := fake.NewClientBuilder().WithObjects(apiserverService).Build()
+	dryRunClient := newDryRunClient(client)
+
+	r := &Reconciler{
+		log:    zap.NewNop().Sugar(),
+		Client: dryRunClient,
+	}
+
+	data := resources.NewTemplateDataBuilder().
+		WithContext(ctx).
+		WithClient(dryRunClient).
+		WithCluster(cluster).
+		WithCABundle(certificates.NewFakeCABundle()).
+		Build()
+
+	if err := r.ensureSecrets(ctx, cluster, data); err != nil {
+		t.Fatalf("Failed to ensure Secrets: %v", err)
+	}
+
+	if expected := len(r.GetSecretReconcilers(ctx, data)); dryRunClient.changes["Secret"] < expected {
+		t.Errorf("Expected at least %d Secrets to change, got %d", expected, dryRunClient.changes["Secret"])
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := client.List(ctx, secrets); err != nil {
+		t.Fatalf("Failed to list Secrets: %v", err)
+	}
+	if len(secrets.Items) > 0 {
+		t.Errorf("Expected no Secrets to be created, got %d", len(secrets.Items))
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	etcdbackup "k8c.io/kubermatic/v2/pkg/resources/etcd/backup"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// etcdBackupInProgressRetryPeriod is how long to wait before checking again
+	// whether a running etcd backup has finished.
+	etcdBackupInProgressRetryPeriod = 30 * time.Second
+)
+
+// etcdBackupInProgress returns true if a backup job is currently taking a snapshot of
+// the cluster's etcd. Restarting etcd during that time would break the snapshot.
+func (r *Reconciler) etcdBackupInProgress(ctx context.Context, cluster *kubermaticv1.Cluster) (bool, error) {
+	// jobs created before the cluster and job type labels were introduced only
+	// carry the app label, so the remaining checks are done on the jobs themselves
+	jobs := &batchv1.JobList{}
+	if err := r.List(ctx, jobs, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem), ctrlruntimeclient.MatchingLabels{
+		resources.AppLabelKey: etcdbackup.BackupJobLabel,
+	}); err != nil {
+		return false, fmt.Errorf("failed to list etcd backup jobs: %w", err)
+	}
+
+	for _, job := range jobs.Items {
+		if !isClusterBackupJob(&job, cluster) || !isEtcdSnapshotJob(&job) {
+			continue
+		}
+
+		if job.DeletionTimestamp == nil && !isJobFinished(&job) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isClusterBackupJob returns true if the job belongs to the given cluster, either by its
+// cluster label or, for older jobs, by its owner reference.
+func isClusterBackupJob(job *batchv1.Job, cluster *kubermaticv1.Cluster) bool {
+	if name, ok := job.Labels[resources.ClusterLabelKey]; ok {
+		return name == cluster.Name
+	}
+
+	for _, ref := range job.OwnerReferences {
+		if ref.Kind == kubermaticv1.ClusterKindName && ref.Name == cluster.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isEtcdSnapshotJob returns true if the job takes a snapshot, as opposed to deleting a
+// backup. Older jobs without the job type label are told apart by their environment.
+func isEtcdSnapshotJob(job *batchv1.Job) bool {
+	if jobType, ok := job.Labels[etcdbackup.BackupJobTypeLabelKey]; ok {
+		return jobType == etcdbackup.BackupJobTypeCreate
+	}
+
+	for _, container := range job.Spec.Template.Spec.Containers {
+		for _, env := range container.Env {
+			if env.Name == etcdbackup.BackupToCreateEnvVarKey {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isJobFinished(job *batchv1.Job) bool {
+	for _, cond := range job.Status.Conditions {
+		if (cond.Type == batchv1.JobComplete || cond.Type == batchv1.JobFailed) && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+
+	return false
+}
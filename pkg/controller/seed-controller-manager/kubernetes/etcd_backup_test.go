@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	etcdbackup "k8c.io/kubermatic/v2/pkg/resources/etcd/backup"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func genEtcdBackupJob(clusterName, jobType string, conditions ...batchv1.JobConditionType) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterName + "-backup-" + jobType,
+			Namespace: metav1.NamespaceSystem,
+			Labels: map[string]string{
+				resources.AppLabelKey:            etcdbackup.BackupJobLabel,
+				resources.ClusterLabelKey:        clusterName,
+				etcdbackup.BackupJobTypeLabelKey: jobType,
+			},
+		},
+	}
+
+	for _, cond := range conditions {
+		job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{
+			Type:   cond,
+			Status: corev1.ConditionTrue,
+		})
+	}
+
+	return job
+}
+
+// genLegacyEtcdBackupJob returns a job as created by older versions, which only carries
+// the app label and is owned by the cluster.
+func genLegacyEtcdBackupJob(clusterName, envVar string) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterName + "-legacy-backup",
+			Namespace: metav1.NamespaceSystem,
+			Labels: map[string]string{
+				resources.AppLabelKey: etcdbackup.BackupJobLabel,
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+				Kind:       kubermaticv1.ClusterKindName,
+				Name:       clusterName,
+			}},
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "store-container",
+						Env:  []corev1.EnvVar{{Name: envVar, Value: "backup"}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestEtcdBackupInProgress(t *testing.T) {
+	testCases := []struct {
+		name     string
+		jobs     []ctrlruntimeclient.Object
+		expected bool
+	}{
+		{
+			name:     "no backup jobs",
+			expected: false,
+		},
+		{
+			name:     "running backup job",
+			jobs:     []ctrlruntimeclient.Object{genEtcdBackupJob("test-cluster", etcdbackup.BackupJobTypeCreate)},
+			expected: true,
+		},
+		{
+			name:     "completed backup job",
+			jobs:     []ctrlruntimeclient.Object{genEtcdBackupJob("test-cluster", etcdbackup.BackupJobTypeCreate, batchv1.JobComplete)},
+			expected: false,
+		},
+		{
+			name:     "failed backup job",
+			jobs:     []ctrlruntimeclient.Object{genEtcdBackupJob("test-cluster", etcdbackup.BackupJobTypeCreate, batchv1.JobFailed)},
+			expected: false,
+		},
+		{
+			name:     "running backup delete job",
+			jobs:     []ctrlruntimeclient.Object{genEtcdBackupJob("test-cluster", etcdbackup.BackupJobTypeDelete)},
+			expected: false,
+		},
+		{
+			name:     "running backup job of another cluster",
+			jobs:     []ctrlruntimeclient.Object{genEtcdBackupJob("other-cluster", etcdbackup.BackupJobTypeCreate)},
+			expected: false,
+		},
+		{
+			name:     "running legacy backup job",
+			jobs:     []ctrlruntimeclient.Object{genLegacyEtcdBackupJob("test-cluster", etcdbackup.BackupToCreateEnvVarKey)},
+			expected: true,
+		},
+		{
+			name:     "running legacy backup delete job",
+			jobs:     []ctrlruntimeclient.Object{genLegacyEtcdBackupJob("test-cluster", etcdbackup.BackupToDeleteEnvVarKey)},
+			expected: false,
+		},
+		{
+			name:     "running legacy backup job of another cluster",
+			jobs:     []ctrlruntimeclient.Object{genLegacyEtcdBackupJob("other-cluster", etcdbackup.BackupToCreateEnvVarKey)},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-cluster",
+				},
+			}
+			r := &Reconciler{
+				Client: fake.NewClientBuilder().WithObjects(tc.jobs...).Build(),
+			}
+
+			inProgress, err := r.etcdBackupInProgress(context.Background(), cluster)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if inProgress != tc.expected {
+				t.Errorf("Expected backup in progress to be %v, got %v", tc.expected, inProgress)
+			}
+		})
+	}
+}
+
+func TestEnsureStatefulSetsIsDeferredDuringBackup(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "backup-cluster",
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: "cluster-backup-cluster",
+		},
+	}
+	r := &Reconciler{
+		Client: fake.NewClientBuilder().WithObjects(genEtcdBackupJob(cluster.Name, etcdbackup.BackupJobTypeCreate)).Build(),
+		log:    zap.NewNop().Sugar(),
+	}
+
+	// the template data is not needed, as no StatefulSet must be reconciled
+	result, err := r.ensureStatefulSets(context.Background(), cluster, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result == nil || result.RequeueAfter != etcdBackupInProgressRetryPeriod {
+		t.Errorf("Expected requeue after %v, got %+v", etcdBackupInProgressRetryPeriod, result)
+	}
+
+	if count := testutil.ToFloat64(etcdReconcilesDeferredForBackup.WithLabelValues(cluster.Name)); count != 1 {
+		t.Errorf("Expected deferred reconciles metric to be 1, got %v", count)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// checkEtcdDiskSize warns if the etcd disk size configured for the cluster differs from
+// the size of the existing etcd StatefulSet. The volumeClaimTemplates of a StatefulSet
+// are immutable, so the new size is never applied to the etcd volumes.
+func (r *Reconciler) checkEtcdDiskSize(ctx context.Context, cluster *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	if cluster.Spec.ComponentsOverride.Etcd.DiskSize == nil {
+		return nil
+	}
+
+	sts := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: data.EtcdNamespace(), Name: resources.EtcdStatefulSetName}, sts); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get etcd StatefulSet: %w", err)
+	}
+
+	if len(sts.Spec.VolumeClaimTemplates) == 0 {
+		return nil
+	}
+
+	current := sts.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests[corev1.ResourceStorage]
+	if desired := data.EtcdDiskSize(); current.Cmp(desired) != 0 {
+		r.recorder.Eventf(cluster, corev1.EventTypeWarning, "EtcdDiskSizeChangeIgnored",
+			"Etcd disk size is configured as %s, but the existing etcd volumes have been created with %s. The disk size of an existing etcd StatefulSet cannot be changed.", desired.String(), current.String())
+	}
+
+	return nil
+}
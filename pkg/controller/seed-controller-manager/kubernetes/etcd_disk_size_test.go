@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCheckEtcdDiskSize(t *testing.T) {
+	const namespace = "cluster-test"
+
+	etcdStatefulSet := func(size string) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.EtcdStatefulSetName,
+				Namespace: namespace,
+			},
+			Spec: appsv1.StatefulSetSpec{
+				VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{
+					ObjectMeta: metav1.ObjectMeta{Name: "data"},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+						},
+					},
+				}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		diskSize      string
+		objects       []ctrlruntimeclient.Object
+		expectedEvent bool
+	}{
+		{
+			name:     "no StatefulSet yet",
+			diskSize: "10Gi",
+		},
+		{
+			name:     "disk size unchanged",
+			diskSize: "10Gi",
+			objects:  []ctrlruntimeclient.Object{etcdStatefulSet("10Gi")},
+		},
+		{
+			name:    "disk size not configured for the cluster",
+			objects: []ctrlruntimeclient.Object{etcdStatefulSet("10Gi")},
+		},
+		{
+			name:          "disk size changed",
+			diskSize:      "20Gi",
+			objects:       []ctrlruntimeclient.Object{etcdStatefulSet("10Gi")},
+			expectedEvent: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Status:     kubermaticv1.ClusterStatus{NamespaceName: namespace},
+			}
+			if tc.diskSize != "" {
+				cluster.Spec.ComponentsOverride.Etcd.DiskSize = ptr.To(resource.MustParse(tc.diskSize))
+			}
+
+			client := fake.NewClientBuilder().WithObjects(tc.objects...).Build()
+			recorder := record.NewFakeRecorder(10)
+
+			r := &Reconciler{
+				Client:   client,
+				recorder: recorder,
+			}
+
+			data := resources.NewTemplateDataBuilder().
+				WithContext(context.Background()).
+				WithClient(client).
+				WithCluster(cluster).
+				WithEtcdDiskSize(resource.MustParse("5Gi")).
+				Build()
+
+			if err := r.checkEtcdDiskSize(context.Background(), cluster, data); err != nil {
+				t.Fatalf("Failed to check etcd disk size: %v", err)
+			}
+
+			select {
+			case event := <-recorder.Events:
+				if !tc.expectedEvent || !strings.Contains(event, "EtcdDiskSizeChangeIgnored") {
+					t.Errorf("Unexpected event %q", event)
+				}
+			default:
+				if tc.expectedEvent {
+					t.Error("Expected a warning event, got none")
+				}
+			}
+		})
+	}
+}
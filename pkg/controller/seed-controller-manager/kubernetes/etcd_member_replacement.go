@@ -0,0 +1,294 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/etcd"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	podutil "k8s.io/kubectl/pkg/util/podutils"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// etcdMemberReplacementInterval is how often etcd members are checked for lost volumes.
+	etcdMemberReplacementInterval = time.Minute
+
+	// etcdMemberHealthTimeout is how long a single etcd member may take to report its status.
+	etcdMemberHealthTimeout = 2 * time.Second
+)
+
+// etcdMember is a member of an etcd cluster.
+type etcdMember struct {
+	ID      uint64
+	Name    string
+	Healthy bool
+}
+
+// etcdMemberClient manages the membership of an etcd cluster.
+type etcdMemberClient interface {
+	MemberList(ctx context.Context) ([]etcdMember, error)
+	MemberRemove(ctx context.Context, id uint64) error
+	Close() error
+}
+
+// etcdMemberClientFactory returns a client for the etcd cluster of the given user cluster.
+type etcdMemberClientFactory func(ctx context.Context, cluster *kubermaticv1.Cluster) (etcdMemberClient, error)
+
+// reconcileEtcdMemberReplacement replaces etcd members whose volume has been lost, e.g. because
+// the node or disk it was provisioned on is gone. Such a member can never start again, so it is
+// removed from the etcd cluster, its PVC and Pod are deleted and the StatefulSet recreates both,
+// after which the etcd-launcher joins the cluster as a fresh member. Only one member is replaced
+// at a time and only while all other members are healthy, so that quorum is never at risk.
+func (r *Reconciler) reconcileEtcdMemberReplacement(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	if !r.etcdMemberReplacement {
+		return nil, nil
+	}
+
+	// the etcd client bypasses the dry-run client, so members must not be replaced during a dry run
+	if _, ok := r.Client.(*dryRunClient); ok {
+		return nil, nil
+	}
+
+	result := &reconcile.Result{RequeueAfter: etcdMemberReplacementInterval}
+
+	pod, pvc, err := r.findEtcdMemberWithLostVolume(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if pod == nil {
+		return result, nil
+	}
+
+	log = log.With("member", pod.Name, "pvc", pvc.Name)
+
+	client, err := r.etcdMemberClientFactory(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+	defer client.Close()
+
+	members, err := client.MemberList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list etcd members: %w", err)
+	}
+
+	var failed *etcdMember
+	healthy := 0
+	for i, member := range members {
+		switch {
+		case member.Name == pod.Name:
+			failed = &members[i]
+		case member.Healthy:
+			healthy++
+		}
+	}
+
+	if failed != nil {
+		if failed.Healthy {
+			log.Warn("Volume of etcd member is lost, but the member is still healthy, not replacing it")
+			return result, nil
+		}
+
+		// Removing the failed member is only safe if all remaining members are healthy and
+		// form a quorum on their own, both of the current and of the shrunk cluster.
+		if healthy != len(members)-1 || healthy < len(members)/2+1 {
+			log.Warnw("Not replacing etcd member with lost volume, quorum would be at risk", "members", len(members), "healthy", healthy)
+			r.recorder.Eventf(cluster, corev1.EventTypeWarning, "EtcdMemberReplacementBlocked",
+				"Etcd member %s has lost its volume, but cannot be replaced as only %d of %d members are healthy.", pod.Name, healthy, len(members))
+			return result, nil
+		}
+
+		if err := client.MemberRemove(ctx, failed.ID); err != nil {
+			return nil, fmt.Errorf("failed to remove etcd member %s: %w", pod.Name, err)
+		}
+
+		log.Info("Removed etcd member with lost volume from the etcd cluster")
+	}
+
+	// the PVC is only removed once no Pod uses it anymore, so both are deleted
+	if err := r.Delete(ctx, pvc); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf("failed to delete PersistentVolumeClaim %s: %w", pvc.Name, err)
+	}
+
+	if err := r.Delete(ctx, pod); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf("failed to delete Pod %s: %w", pod.Name, err)
+	}
+
+	log.Info("Replacing etcd member with lost volume")
+	r.recorder.Eventf(cluster, corev1.EventTypeNormal, "EtcdMemberReplaced", "Etcd member %s has lost its volume and is being replaced by a fresh member.", pod.Name)
+
+	return result, nil
+}
+
+// findEtcdMemberWithLostVolume returns the first etcd Pod that is not ready and whose PVC
+// has lost its PersistentVolume, together with that PVC.
+func (r *Reconciler) findEtcdMemberWithLostVolume(ctx context.Context, cluster *kubermaticv1.Cluster) (*corev1.Pod, *corev1.PersistentVolumeClaim, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, ctrlruntimeclient.InNamespace(resources.EtcdNamespaceName(cluster)), ctrlruntimeclient.MatchingLabels(etcd.GetBasePodLabels(cluster))); err != nil {
+		return nil, nil, fmt.Errorf("failed to list etcd pods: %w", err)
+	}
+
+	for i, pod := range pods.Items {
+		if podutil.IsPodReady(&pod) {
+			continue
+		}
+
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim == nil {
+				continue
+			}
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			if err := r.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: volume.PersistentVolumeClaim.ClaimName}, pvc); err != nil {
+				if apierrors.IsNotFound(err) {
+					// the StatefulSet controller recreates missing PVCs on its own
+					continue
+				}
+				return nil, nil, fmt.Errorf("failed to get PersistentVolumeClaim %s: %w", volume.PersistentVolumeClaim.ClaimName, err)
+			}
+
+			lost, err := r.isVolumeLost(ctx, pvc)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if lost {
+				return &pods.Items[i], pvc, nil
+			}
+		}
+	}
+
+	return nil, nil, nil
+}
+
+func (r *Reconciler) isVolumeLost(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	if pvc.Status.Phase == corev1.ClaimLost {
+		return true, nil
+	}
+
+	if pvc.Status.Phase != corev1.ClaimBound || pvc.Spec.VolumeName == "" {
+		return false, nil
+	}
+
+	pv := &corev1.PersistentVolume{}
+	if err := r.Get(ctx, types.NamespacedName{Name: pvc.Spec.VolumeName}, pv); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get PersistentVolume %s: %w", pvc.Spec.VolumeName, err)
+	}
+
+	return pv.Status.Phase == corev1.VolumeFailed, nil
+}
+
+// etcdClient implements the etcdMemberClient using the apiserver's etcd client certificate.
+type etcdClient struct {
+	client *clientv3.Client
+}
+
+func newEtcdMemberClient(seedClient ctrlruntimeclient.Client) etcdMemberClientFactory {
+	return func(ctx context.Context, cluster *kubermaticv1.Cluster) (etcdMemberClient, error) {
+		// the apiserver's etcd client certificate is always kept in the cluster namespace
+		namespace := cluster.Status.NamespaceName
+
+		caSecret := &corev1.Secret{}
+		if err := seedClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: resources.CASecretName}, caSecret); err != nil {
+			return nil, fmt.Errorf("failed to get CA: %w", err)
+		}
+
+		certSecret := &corev1.Secret{}
+		if err := seedClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: resources.ApiserverEtcdClientCertificateSecretName}, certSecret); err != nil {
+			return nil, fmt.Errorf("failed to get etcd client certificate: %w", err)
+		}
+
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(caSecret.Data[resources.CACertSecretKey]) {
+			return nil, fmt.Errorf("no valid certificate found in %s", resources.CASecretName)
+		}
+
+		cert, err := tls.X509KeyPair(certSecret.Data[resources.ApiserverEtcdClientCertificateCertSecretKey], certSecret.Data[resources.ApiserverEtcdClientCertificateKeySecretKey])
+		if err != nil {
+			return nil, fmt.Errorf("failed to load etcd client certificate: %w", err)
+		}
+
+		client, err := clientv3.New(clientv3.Config{
+			Endpoints:   etcd.GetClientEndpoints(resources.EtcdNamespaceName(cluster)),
+			DialTimeout: 5 * time.Second,
+			Context:     ctx,
+			TLS: &tls.Config{
+				RootCAs:      rootCAs,
+				Certificates: []tls.Certificate{cert},
+				MinVersion:   tls.VersionTLS12,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &etcdClient{client: client}, nil
+	}
+}
+
+func (c *etcdClient) MemberList(ctx context.Context) ([]etcdMember, error) {
+	resp, err := c.client.MemberList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]etcdMember, 0, len(resp.Members))
+	for _, m := range resp.Members {
+		member := etcdMember{ID: m.ID, Name: m.Name}
+
+		// members that have been added but never started have no name and no client URLs
+		if len(m.ClientURLs) > 0 {
+			statusCtx, cancel := context.WithTimeout(ctx, etcdMemberHealthTimeout)
+			_, err := c.client.Status(statusCtx, m.ClientURLs[0])
+			cancel()
+
+			member.Healthy = err == nil
+		}
+
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+func (c *etcdClient) MemberRemove(ctx context.Context, id uint64) error {
+	_, err := c.client.MemberRemove(ctx, id)
+	return err
+}
+
+func (c *etcdClient) Close() error {
+	return c.client.Close()
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/etcd"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const etcdMemberTestNamespace = "cluster-test"
+
+// fakeEtcdMemberClient records the calls made against the etcd cluster.
+type fakeEtcdMemberClient struct {
+	members []etcdMember
+	calls   *[]string
+}
+
+func (c *fakeEtcdMemberClient) MemberList(context.Context) ([]etcdMember, error) {
+	*c.calls = append(*c.calls, "member-list")
+	return c.members, nil
+}
+
+func (c *fakeEtcdMemberClient) MemberRemove(_ context.Context, id uint64) error {
+	*c.calls = append(*c.calls, fmt.Sprintf("member-remove %d", id))
+	return nil
+}
+
+func (c *fakeEtcdMemberClient) Close() error {
+	return nil
+}
+
+// etcdMemberTestObjects returns a cluster with three etcd members, of which the
+// first one is not ready and has the given PVC phase.
+func etcdMemberTestObjects(phase corev1.PersistentVolumeClaimPhase, withPV bool, splitNamespace bool) []ctrlruntimeclient.Object {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			Features: map[string]bool{
+				kubermaticv1.ClusterFeatureSplitEtcdNamespace: splitNamespace,
+			},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: etcdMemberTestNamespace,
+		},
+	}
+	namespace := resources.EtcdNamespaceName(cluster)
+
+	objects := []ctrlruntimeclient.Object{cluster}
+
+	for i := 0; i < 3; i++ {
+		name := fmt.Sprintf("etcd-%d", i)
+		ready := corev1.ConditionTrue
+		if i == 0 {
+			ready = corev1.ConditionFalse
+		}
+
+		objects = append(objects,
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels:    etcd.GetBasePodLabels(cluster),
+				},
+				Spec: corev1.PodSpec{
+					Volumes: []corev1.Volume{{
+						Name: "data",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "data-" + name,
+							},
+						},
+					}},
+				},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+				},
+			},
+			&corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "data-" + name,
+					Namespace: namespace,
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					VolumeName: "pv-" + name,
+				},
+				Status: corev1.PersistentVolumeClaimStatus{
+					Phase: corev1.ClaimBound,
+				},
+			},
+		)
+
+		if i != 0 || withPV {
+			objects = append(objects, &corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pv-" + name,
+				},
+			})
+		}
+	}
+
+	objects[2].(*corev1.PersistentVolumeClaim).Status.Phase = phase
+
+	return objects
+}
+
+func TestReconcileEtcdMemberReplacement(t *testing.T) {
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	testCases := []struct {
+		name           string
+		phase          corev1.PersistentVolumeClaimPhase
+		withPV         bool
+		splitNamespace bool
+		members        []etcdMember
+		expectedCalls  []string
+		expectedEvent  string
+	}{
+		{
+			name:   "volume is available",
+			phase:  corev1.ClaimBound,
+			withPV: true,
+		},
+		{
+			name:   "claim is lost",
+			phase:  corev1.ClaimLost,
+			withPV: true,
+			members: []etcdMember{
+				{ID: 1, Name: "etcd-0"},
+				{ID: 2, Name: "etcd-1", Healthy: true},
+				{ID: 3, Name: "etcd-2", Healthy: true},
+			},
+			expectedCalls: []string{"member-list", "member-remove 1", "delete pvc data-etcd-0", "delete pod etcd-0"},
+			expectedEvent: "EtcdMemberReplaced",
+		},
+		{
+			name:           "claim is lost in split etcd namespace",
+			phase:          corev1.ClaimLost,
+			withPV:         true,
+			splitNamespace: true,
+			members: []etcdMember{
+				{ID: 1, Name: "etcd-0"},
+				{ID: 2, Name: "etcd-1", Healthy: true},
+				{ID: 3, Name: "etcd-2", Healthy: true},
+			},
+			expectedCalls: []string{"member-list", "member-remove 1", "delete pvc data-etcd-0", "delete pod etcd-0"},
+			expectedEvent: "EtcdMemberReplaced",
+		},
+		{
+			name:  "persistent volume is gone",
+			phase: corev1.ClaimBound,
+			members: []etcdMember{
+				{ID: 1, Name: "etcd-0"},
+				{ID: 2, Name: "etcd-1", Healthy: true},
+				{ID: 3, Name: "etcd-2", Healthy: true},
+			},
+			expectedCalls: []string{"member-list", "member-remove 1", "delete pvc data-etcd-0", "delete pod etcd-0"},
+			expectedEvent: "EtcdMemberReplaced",
+		},
+		{
+			name:  "member has already been removed",
+			phase: corev1.ClaimLost,
+			members: []etcdMember{
+				{ID: 2, Name: "etcd-1", Healthy: true},
+				{ID: 3, Name: "etcd-2", Healthy: true},
+			},
+			expectedCalls: []string{"member-list", "delete pvc data-etcd-0", "delete pod etcd-0"},
+			expectedEvent: "EtcdMemberReplaced",
+		},
+		{
+			name:  "quorum is at risk",
+			phase: corev1.ClaimLost,
+			members: []etcdMember{
+				{ID: 1, Name: "etcd-0"},
+				{ID: 2, Name: "etcd-1", Healthy: true},
+				{ID: 3, Name: "etcd-2"},
+			},
+			expectedCalls: []string{"member-list"},
+			expectedEvent: "EtcdMemberReplacementBlocked",
+		},
+		{
+			name:  "failed member is still healthy",
+			phase: corev1.ClaimLost,
+			members: []etcdMember{
+				{ID: 1, Name: "etcd-0", Healthy: true},
+				{ID: 2, Name: "etcd-1", Healthy: true},
+				{ID: 3, Name: "etcd-2", Healthy: true},
+			},
+			expectedCalls: []string{"member-list"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := etcdMemberTestObjects(tc.phase, tc.withPV, tc.splitNamespace)
+			cluster := objects[0].(*kubermaticv1.Cluster)
+			recorder := record.NewFakeRecorder(10)
+
+			calls := []string{}
+
+			client := fake.NewClientBuilder().
+				WithObjects(objects...).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Delete: func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.DeleteOption) error {
+						kind := "pod"
+						if _, ok := obj.(*corev1.PersistentVolumeClaim); ok {
+							kind = "pvc"
+						}
+						calls = append(calls, fmt.Sprintf("delete %s %s", kind, obj.GetName()))
+						return client.Delete(ctx, obj, opts...)
+					},
+				}).
+				Build()
+
+			r := &Reconciler{
+				Client:                client,
+				recorder:              recorder,
+				etcdMemberReplacement: true,
+				etcdMemberClientFactory: func(context.Context, *kubermaticv1.Cluster) (etcdMemberClient, error) {
+					return &fakeEtcdMemberClient{members: tc.members, calls: &calls}, nil
+				},
+			}
+
+			res, err := r.reconcileEtcdMemberReplacement(ctx, log, cluster)
+			if err != nil {
+				t.Fatalf("Failed to reconcile etcd member replacement: %v", err)
+			}
+			if res == nil || res.RequeueAfter == 0 {
+				t.Errorf("Expected etcd members to be checked periodically, got %v", res)
+			}
+
+			if tc.expectedCalls == nil {
+				tc.expectedCalls = []string{}
+			}
+			if diff := deep.Equal(tc.expectedCalls, calls); diff != nil {
+				t.Errorf("Unexpected replacement sequence:\n%v", diff)
+			}
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			err = r.Get(ctx, types.NamespacedName{Namespace: resources.EtcdNamespaceName(cluster), Name: "data-etcd-1"}, pvc)
+			if err != nil {
+				t.Errorf("Expected PVC of healthy member to be kept: %v", err)
+			}
+
+			select {
+			case event := <-recorder.Events:
+				if tc.expectedEvent == "" || !strings.Contains(event, tc.expectedEvent) {
+					t.Errorf("Expected event %q, got %q", tc.expectedEvent, event)
+				}
+			default:
+				if tc.expectedEvent != "" {
+					t.Errorf("Expected event %q, got none", tc.expectedEvent)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcileEtcdMemberReplacementDisabled(t *testing.T) {
+	objects := etcdMemberTestObjects(corev1.ClaimLost, true, false)
+
+	r := &Reconciler{
+		Client: fake.NewClientBuilder().WithObjects(objects...).Build(),
+		etcdMemberClientFactory: func(context.Context, *kubermaticv1.Cluster) (etcdMemberClient, error) {
+			t.Fatal("Expected etcd not to be queried")
+			return nil, nil
+		},
+	}
+
+	res, err := r.reconcileEtcdMemberReplacement(context.Background(), zap.NewNop().Sugar(), objects[0].(*kubermaticv1.Cluster))
+	if err != nil {
+		t.Fatalf("Failed to reconcile etcd member replacement: %v", err)
+	}
+	if res != nil {
+		t.Errorf("Expected no requeue when the replacement is disabled, got %v", res)
+	}
+}
+
+func TestReconcileEtcdMemberReplacementDryRun(t *testing.T) {
+	objects := etcdMemberTestObjects(corev1.ClaimLost, true, false)
+
+	calls := []string{}
+
+	r := &Reconciler{
+		Client:                newDryRunClient(fake.NewClientBuilder().WithObjects(objects...).Build()),
+		recorder:              record.NewFakeRecorder(10),
+		etcdMemberReplacement: true,
+		etcdMemberClientFactory: func(context.Context, *kubermaticv1.Cluster) (etcdMemberClient, error) {
+			return &fakeEtcdMemberClient{
+				members: []etcdMember{
+					{ID: 1, Name: "etcd-0"},
+					{ID: 2, Name: "etcd-1", Healthy: true},
+					{ID: 3, Name: "etcd-2", Healthy: true},
+				},
+				calls: &calls,
+			}, nil
+		},
+	}
+
+	if _, err := r.reconcileEtcdMemberReplacement(context.Background(), zap.NewNop().Sugar(), objects[0].(*kubermaticv1.Cluster)); err != nil {
+		t.Fatalf("Failed to reconcile etcd member replacement: %v", err)
+	}
+
+	for _, call := range calls {
+		if strings.HasPrefix(call, "member-remove") {
+			t.Errorf("Expected no etcd member to be removed during a dry run, got %q", call)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// etcdRolloutSlotTTL is how long a cluster keeps its rollout slot without being reconciled.
+// Clusters renew their slot on every reconcile while etcd is rolling, which is triggered by
+// the changing StatefulSet status. Slots of clusters that are not reconciled anymore, e.g.
+// because they have been moved to another worker, are freed once the TTL has passed.
+const etcdRolloutSlotTTL = 15 * time.Minute
+
+// etcdRolloutLimiter is a semaphore that bounds the number of clusters whose etcd
+// StatefulSet is rolled out at the same time, so that the seed storage is not
+// overwhelmed when many clusters are updated at once. A nil limiter allows any
+// number of concurrent rollouts.
+type etcdRolloutLimiter struct {
+	lock    sync.Mutex
+	limit   int
+	ttl     time.Duration
+	clock   clock.PassiveClock
+	rolling map[string]time.Time
+}
+
+func newEtcdRolloutLimiter(limit int) *etcdRolloutLimiter {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &etcdRolloutLimiter{
+		limit:   limit,
+		ttl:     etcdRolloutSlotTTL,
+		clock:   clock.RealClock{},
+		rolling: map[string]time.Time{},
+	}
+}
+
+// TryAcquire returns true if the cluster is allowed to roll out its etcd, either because
+// it already holds a slot or because a free slot was assigned to it. In both cases, the
+// slot is renewed.
+func (l *etcdRolloutLimiter) TryAcquire(cluster string) bool {
+	if l == nil {
+		return true
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	// free the slots of clusters that have not been reconciled for too long
+	now := l.clock.Now()
+	for name, renewed := range l.rolling {
+		if name != cluster && now.Sub(renewed) >= l.ttl {
+			delete(l.rolling, name)
+		}
+	}
+
+	_, holding := l.rolling[cluster]
+	if holding || len(l.rolling) < l.limit {
+		l.rolling[cluster] = now
+	}
+	etcdRollingClusters.Set(float64(len(l.rolling)))
+
+	_, acquired := l.rolling[cluster]
+
+	return acquired
+}
+
+// Release frees the slot of the cluster, if it holds one.
+func (l *etcdRolloutLimiter) Release(cluster string) {
+	if l == nil {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.rolling, cluster)
+	etcdRollingClusters.Set(float64(len(l.rolling)))
+}
+
+// etcdRolloutPending returns true if reconciling the given StatefulSets would change the pod
+// template of one of the existing StatefulSets, which rolls its pods. The reconcilers are
+// applied to the live objects, including the given modifiers, without changing anything.
+func etcdRolloutPending(ctx context.Context, client ctrlruntimeclient.Client, namespace string, creators []reconciling.NamedStatefulSetReconcilerFactory, modifiers ...reconciling.ObjectModifier) (bool, error) {
+	for _, factory := range creators {
+		name, reconciler := factory()
+
+		live := &appsv1.StatefulSet{}
+		if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, live); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, fmt.Errorf("failed to get StatefulSet %s/%s: %w", namespace, name, err)
+		}
+
+		reconcileObject := reconciling.StatefulSetObjectWrapper(reconciling.DefaultStatefulSet(reconciler))
+		for _, modifier := range modifiers {
+			reconcileObject = modifier(reconcileObject)
+		}
+
+		desired, err := reconcileObject(live.DeepCopy())
+		if err != nil {
+			return false, fmt.Errorf("failed to build StatefulSet %s/%s: %w", namespace, name, err)
+		}
+
+		if !equality.Semantic.DeepEqual(desired.(*appsv1.StatefulSet).Spec.Template, live.Spec.Template) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// etcdRolloutInProgress returns true if the etcd StatefulSet has not yet finished rolling
+// out its latest revision.
+func (r *Reconciler) etcdRolloutInProgress(ctx context.Context, cluster *kubermaticv1.Cluster) (bool, error) {
+	sts := &appsv1.StatefulSet{}
+	key := types.NamespacedName{Namespace: resources.EtcdNamespaceName(cluster), Name: resources.EtcdStatefulSetName}
+	if err := r.Get(ctx, key, sts); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+
+	return sts.Status.ObservedGeneration < sts.Generation ||
+		sts.Status.UpdatedReplicas < replicas ||
+		sts.Status.CurrentRevision != sts.Status.UpdateRevision, nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestEtcdRolloutLimiter(t *testing.T) {
+	limiter := newEtcdRolloutLimiter(2)
+
+	if !limiter.TryAcquire("cluster-a") || !limiter.TryAcquire("cluster-b") {
+		t.Fatal("Expected clusters to acquire free slots")
+	}
+	if limiter.TryAcquire("cluster-c") {
+		t.Fatal("Expected no slot to be available")
+	}
+	if !limiter.TryAcquire("cluster-a") {
+		t.Fatal("Expected cluster holding a slot to keep it")
+	}
+
+	limiter.Release("cluster-a")
+	if !limiter.TryAcquire("cluster-c") {
+		t.Fatal("Expected released slot to be available")
+	}
+}
+
+func TestEtcdRolloutLimiterBoundsConcurrency(t *testing.T) {
+	const (
+		limit    = 3
+		clusters = 50
+	)
+
+	limiter := newEtcdRolloutLimiter(limit)
+
+	var (
+		wg       sync.WaitGroup
+		acquired atomic.Int32
+	)
+
+	for i := 0; i < clusters; i++ {
+		wg.Add(1)
+		go func(cluster string) {
+			defer wg.Done()
+			if limiter.TryAcquire(cluster) {
+				acquired.Add(1)
+			}
+		}(fmt.Sprintf("cluster-%d", i))
+	}
+	wg.Wait()
+
+	if n := acquired.Load(); n != limit {
+		t.Errorf("Expected exactly %d clusters to roll out etcd concurrently, got %d", limit, n)
+	}
+}
+
+func TestEtcdRolloutLimiterUnlimited(t *testing.T) {
+	limiter := newEtcdRolloutLimiter(0)
+
+	for i := 0; i < 10; i++ {
+		if !limiter.TryAcquire(fmt.Sprintf("cluster-%d", i)) {
+			t.Fatal("Expected no limit to be applied")
+		}
+	}
+}
+
+func TestEtcdRolloutLimiterSlotsExpire(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	limiter := newEtcdRolloutLimiter(1)
+	limiter.clock = clock
+
+	if !limiter.TryAcquire("cluster-a") {
+		t.Fatal("Expected cluster to acquire a free slot")
+	}
+
+	// the slot is renewed as long as the cluster is reconciled
+	clock.Step(etcdRolloutSlotTTL - time.Minute)
+	if !limiter.TryAcquire("cluster-a") {
+		t.Fatal("Expected cluster holding a slot to keep it")
+	}
+
+	clock.Step(etcdRolloutSlotTTL - time.Minute)
+	if limiter.TryAcquire("cluster-b") {
+		t.Fatal("Expected the renewed slot not to be available")
+	}
+
+	// a cluster that is not reconciled anymore loses its slot
+	clock.Step(time.Minute)
+	if !limiter.TryAcquire("cluster-b") {
+		t.Fatal("Expected the expired slot to be available")
+	}
+}
+
+func TestEtcdRolloutPending(t *testing.T) {
+	const namespace = "cluster-test"
+
+	live := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "etcd",
+			Namespace: namespace,
+		},
+	}
+	reconciling.DefaultStatefulSet(func(set *appsv1.StatefulSet) (*appsv1.StatefulSet, error) {
+		set.Spec.Replicas = ptr.To[int32](3)
+		set.Spec.Template.Spec.Containers = []corev1.Container{{Name: "etcd", Image: "etcd:v3.5.16"}}
+		return set, nil
+	})(live)
+
+	creator := func(image string, replicas int32) reconciling.NamedStatefulSetReconcilerFactory {
+		return func() (string, reconciling.StatefulSetReconciler) {
+			return "etcd", func(set *appsv1.StatefulSet) (*appsv1.StatefulSet, error) {
+				set.Spec.Replicas = ptr.To(replicas)
+				set.Spec.Template.Spec.Containers = []corev1.Container{{Name: "etcd", Image: image}}
+				return set, nil
+			}
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		namespace string
+		creator   reconciling.NamedStatefulSetReconcilerFactory
+		expected  bool
+	}{
+		{
+			name:      "unchanged",
+			namespace: namespace,
+			creator:   creator("etcd:v3.5.16", 3),
+		},
+		{
+			name:      "scaled without changing the pods",
+			namespace: namespace,
+			creator:   creator("etcd:v3.5.16", 5),
+		},
+		{
+			name:      "changed pod template",
+			namespace: namespace,
+			creator:   creator("etcd:v3.5.17", 3),
+			expected:  true,
+		},
+		{
+			name:      "not yet created",
+			namespace: "cluster-other",
+			creator:   creator("etcd:v3.5.17", 3),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithObjects(live.DeepCopy()).Build()
+
+			pending, err := etcdRolloutPending(context.Background(), client, tc.namespace, []reconciling.NamedStatefulSetReconcilerFactory{tc.creator})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if pending != tc.expected {
+				t.Errorf("Expected pending rollout to be %v, got %v", tc.expected, pending)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/etcd"
+	kkpreconciling "k8c.io/kubermatic/v2/pkg/resources/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// etcdSnapshotRetryPeriod is how long to wait before checking again whether an
+	// on-demand etcd snapshot has finished.
+	etcdSnapshotRetryPeriod = 15 * time.Second
+)
+
+// reconcileEtcdSnapshot takes a one-off etcd snapshot whenever the EtcdSnapshotAnnotation is set
+// to a value that has not been snapshotted before. The snapshot is taken by a one-shot
+// EtcdBackupConfig, so the backup Job uses the etcd backup client certificates and does not
+// restart etcd. Once it has finished, the result is recorded in the cluster status and the
+// annotation is removed. A non-nil result is returned while the snapshot is still running.
+func (r *Reconciler) reconcileEtcdSnapshot(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	trigger := cluster.Annotations[resources.EtcdSnapshotAnnotation]
+	if trigger == "" {
+		return nil, nil
+	}
+
+	// every value is only snapshotted once, even if the annotation is set to it again
+	if last := cluster.Status.EtcdSnapshot; last != nil && last.Trigger == trigger && isEtcdSnapshotFinished(last) {
+		return nil, r.removeEtcdSnapshotAnnotation(ctx, cluster)
+	}
+
+	seed, err := r.seedGetter()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &kubermaticv1.EtcdSnapshotStatus{
+		Trigger: trigger,
+	}
+
+	if !seed.IsDefaultEtcdAutomaticBackupEnabled() {
+		status.Phase = kubermaticv1.BackupStatusPhaseFailed
+		status.Message = "no default etcd backup destination is configured for the seed"
+
+		return nil, r.finishEtcdSnapshot(ctx, log, cluster, status)
+	}
+
+	status.BackupConfig = etcdSnapshotBackupConfigName(trigger)
+	status.Destination = seed.Spec.EtcdBackupRestore.DefaultDestination
+
+	reconcilers := []kkpreconciling.NamedEtcdBackupConfigReconcilerFactory{
+		etcd.OneShotBackupConfigReconciler(cluster, seed, status.BackupConfig),
+	}
+	if err := kkpreconciling.ReconcileEtcdBackupConfigs(ctx, reconcilers, cluster.Status.NamespaceName, r); err != nil {
+		return nil, fmt.Errorf("failed to reconcile etcd snapshot EtcdBackupConfig: %w", err)
+	}
+
+	config := &kubermaticv1.EtcdBackupConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: status.BackupConfig}, config); err != nil {
+		return nil, fmt.Errorf("failed to get etcd snapshot EtcdBackupConfig: %w", err)
+	}
+
+	// a one-shot EtcdBackupConfig only ever has a single backup
+	for _, backup := range config.Status.CurrentBackups {
+		status.BackupName = backup.BackupName
+		status.Phase = backup.BackupPhase
+		status.Message = backup.BackupMessage
+
+		if isEtcdSnapshotFinished(status) {
+			status.FinishedTime = backup.BackupFinishedTime.DeepCopy()
+		}
+	}
+
+	if isEtcdSnapshotFinished(status) {
+		return nil, r.finishEtcdSnapshot(ctx, log, cluster, status)
+	}
+
+	if err := r.updateEtcdSnapshotStatus(ctx, cluster, status); err != nil {
+		return nil, err
+	}
+
+	log.Debugw("Waiting for etcd snapshot", "backupconfig", status.BackupConfig)
+
+	return &reconcile.Result{RequeueAfter: etcdSnapshotRetryPeriod}, nil
+}
+
+// finishEtcdSnapshot records the final state of the snapshot and acknowledges the request by
+// removing the annotation. The status is written first, so the snapshot is not taken again
+// if removing the annotation fails.
+func (r *Reconciler) finishEtcdSnapshot(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, status *kubermaticv1.EtcdSnapshotStatus) error {
+	if err := r.updateEtcdSnapshotStatus(ctx, cluster, status); err != nil {
+		return err
+	}
+
+	if status.Phase == kubermaticv1.BackupStatusPhaseCompleted {
+		log.Infow("Etcd snapshot has completed", "backup", status.BackupName)
+		r.recorder.Eventf(cluster, corev1.EventTypeNormal, "EtcdSnapshotCompleted", "Etcd snapshot %s has been written to %s.", status.BackupName, status.Destination)
+	} else {
+		log.Infow("Etcd snapshot has failed", "reason", status.Message)
+		r.recorder.Eventf(cluster, corev1.EventTypeWarning, "EtcdSnapshotFailed", "Etcd snapshot has failed: %s", status.Message)
+	}
+
+	return r.removeEtcdSnapshotAnnotation(ctx, cluster)
+}
+
+func (r *Reconciler) updateEtcdSnapshotStatus(ctx context.Context, cluster *kubermaticv1.Cluster, status *kubermaticv1.EtcdSnapshotStatus) error {
+	if equality.Semantic.DeepEqual(cluster.Status.EtcdSnapshot, status) {
+		return nil
+	}
+
+	err := kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.EtcdSnapshot = status
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update etcd snapshot status: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) removeEtcdSnapshotAnnotation(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	err := r.updateCluster(ctx, cluster, func(c *kubermaticv1.Cluster) {
+		delete(c.Annotations, resources.EtcdSnapshotAnnotation)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove %s annotation: %w", resources.EtcdSnapshotAnnotation, err)
+	}
+
+	return nil
+}
+
+func isEtcdSnapshotFinished(status *kubermaticv1.EtcdSnapshotStatus) bool {
+	return status.Phase == kubermaticv1.BackupStatusPhaseCompleted || status.Phase == kubermaticv1.BackupStatusPhaseFailed
+}
+
+// etcdSnapshotBackupConfigName derives the name of the EtcdBackupConfig from the annotation
+// value, which can be an arbitrary string.
+func etcdSnapshotBackupConfigName(trigger string) string {
+	hash := sha256.Sum256([]byte(trigger))
+
+	return resources.EtcdSnapshotBackupConfigPrefix + hex.EncodeToString(hash[:])[:10]
+}
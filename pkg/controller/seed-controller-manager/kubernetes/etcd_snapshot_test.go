@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const etcdSnapshotTestNamespace = "cluster-test"
+
+func etcdSnapshotTestSeed() *kubermaticv1.Seed {
+	return &kubermaticv1.Seed{
+		Spec: kubermaticv1.SeedSpec{
+			EtcdBackupRestore: &kubermaticv1.EtcdBackupRestore{
+				Destinations: map[string]*kubermaticv1.BackupDestination{
+					"s3": {},
+				},
+				DefaultDestination: "s3",
+			},
+		},
+	}
+}
+
+func etcdSnapshotTestCluster(trigger string) *kubermaticv1.Cluster {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: etcdSnapshotTestNamespace,
+		},
+	}
+
+	if trigger != "" {
+		cluster.Annotations = map[string]string{resources.EtcdSnapshotAnnotation: trigger}
+	}
+
+	return cluster
+}
+
+func listEtcdSnapshotBackupConfigs(t *testing.T, r *Reconciler) []kubermaticv1.EtcdBackupConfig {
+	t.Helper()
+
+	configs := &kubermaticv1.EtcdBackupConfigList{}
+	if err := r.List(context.Background(), configs, ctrlruntimeclient.InNamespace(etcdSnapshotTestNamespace)); err != nil {
+		t.Fatalf("Failed to list EtcdBackupConfigs: %v", err)
+	}
+
+	return configs.Items
+}
+
+func finishEtcdSnapshotBackup(t *testing.T, r *Reconciler, name string, phase kubermaticv1.BackupStatusPhase) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	config := &kubermaticv1.EtcdBackupConfig{}
+	if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: etcdSnapshotTestNamespace, Name: name}, config); err != nil {
+		t.Fatalf("Failed to get EtcdBackupConfig: %v", err)
+	}
+
+	config.Status.CurrentBackups = []kubermaticv1.BackupStatus{{
+		BackupName:         name + ".db.gz",
+		BackupPhase:        phase,
+		BackupFinishedTime: metav1.Now(),
+	}}
+	if err := r.Update(ctx, config); err != nil {
+		t.Fatalf("Failed to finish backup: %v", err)
+	}
+}
+
+func TestReconcileEtcdSnapshot(t *testing.T) {
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	testCases := []struct {
+		name          string
+		seed          *kubermaticv1.Seed
+		backupPhase   kubermaticv1.BackupStatusPhase
+		expectedPhase kubermaticv1.BackupStatusPhase
+	}{
+		{
+			name:          "snapshot completes",
+			seed:          etcdSnapshotTestSeed(),
+			backupPhase:   kubermaticv1.BackupStatusPhaseCompleted,
+			expectedPhase: kubermaticv1.BackupStatusPhaseCompleted,
+		},
+		{
+			name:          "snapshot fails",
+			seed:          etcdSnapshotTestSeed(),
+			backupPhase:   kubermaticv1.BackupStatusPhaseFailed,
+			expectedPhase: kubermaticv1.BackupStatusPhaseFailed,
+		},
+		{
+			name:          "seed without backup destination",
+			seed:          &kubermaticv1.Seed{},
+			expectedPhase: kubermaticv1.BackupStatusPhaseFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := etcdSnapshotTestCluster("before-upgrade")
+			r := newHibernationTestReconciler(tc.seed, cluster)
+
+			res, err := r.reconcileEtcdSnapshot(ctx, log, cluster)
+			if err != nil {
+				t.Fatalf("Failed to reconcile snapshot: %v", err)
+			}
+
+			if tc.backupPhase != "" {
+				if res == nil || res.RequeueAfter == 0 {
+					t.Fatalf("Expected to wait for the snapshot, got %v", res)
+				}
+				if _, ok := cluster.Annotations[resources.EtcdSnapshotAnnotation]; !ok {
+					t.Fatal("Expected annotation to be kept while the snapshot is running")
+				}
+
+				configs := listEtcdSnapshotBackupConfigs(t, r)
+				if len(configs) != 1 || configs[0].Spec.Schedule != "" || configs[0].Spec.Destination != "s3" {
+					t.Fatalf("Expected a single one-shot EtcdBackupConfig, got %v", configs)
+				}
+
+				finishEtcdSnapshotBackup(t, r, configs[0].Name, tc.backupPhase)
+
+				if res, err = r.reconcileEtcdSnapshot(ctx, log, cluster); err != nil {
+					t.Fatalf("Failed to reconcile snapshot: %v", err)
+				}
+			}
+
+			if res != nil {
+				t.Errorf("Expected snapshot to be finished, got %v", res)
+			}
+
+			if err := r.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), cluster); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			if _, ok := cluster.Annotations[resources.EtcdSnapshotAnnotation]; ok {
+				t.Error("Expected annotation to be removed once the snapshot has finished")
+			}
+
+			status := cluster.Status.EtcdSnapshot
+			if status == nil {
+				t.Fatal("Expected snapshot to be recorded in the cluster status")
+			}
+			if status.Trigger != "before-upgrade" || status.Phase != tc.expectedPhase {
+				t.Errorf("Expected %s snapshot for trigger %q, got %+v", tc.expectedPhase, "before-upgrade", status)
+			}
+			if tc.backupPhase == kubermaticv1.BackupStatusPhaseCompleted && (status.BackupName == "" || status.FinishedTime == nil) {
+				t.Errorf("Expected completed snapshot to reference its backup, got %+v", status)
+			}
+		})
+	}
+}
+
+func TestReconcileEtcdSnapshotOncePerValue(t *testing.T) {
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	cluster := etcdSnapshotTestCluster("first")
+	r := newHibernationTestReconciler(etcdSnapshotTestSeed(), cluster)
+
+	if _, err := r.reconcileEtcdSnapshot(ctx, log, cluster); err != nil {
+		t.Fatalf("Failed to reconcile snapshot: %v", err)
+	}
+
+	// reconciling repeatedly while the snapshot is running must not start another one
+	for range 3 {
+		if _, err := r.reconcileEtcdSnapshot(ctx, log, cluster); err != nil {
+			t.Fatalf("Failed to reconcile snapshot: %v", err)
+		}
+	}
+
+	configs := listEtcdSnapshotBackupConfigs(t, r)
+	if len(configs) != 1 {
+		t.Fatalf("Expected the snapshot to be taken once, got %d EtcdBackupConfigs", len(configs))
+	}
+
+	finishEtcdSnapshotBackup(t, r, configs[0].Name, kubermaticv1.BackupStatusPhaseCompleted)
+
+	if _, err := r.reconcileEtcdSnapshot(ctx, log, cluster); err != nil {
+		t.Fatalf("Failed to reconcile snapshot: %v", err)
+	}
+
+	// setting the same value again is acknowledged without taking another snapshot
+	setEtcdSnapshotAnnotation(t, r, cluster, "first")
+
+	res, err := r.reconcileEtcdSnapshot(ctx, log, cluster)
+	if err != nil {
+		t.Fatalf("Failed to reconcile snapshot: %v", err)
+	}
+	if res != nil {
+		t.Errorf("Expected repeated value not to wait for a snapshot, got %v", res)
+	}
+	if configs := listEtcdSnapshotBackupConfigs(t, r); len(configs) != 1 {
+		t.Fatalf("Expected no snapshot for a repeated value, got %d EtcdBackupConfigs", len(configs))
+	}
+	if _, ok := cluster.Annotations[resources.EtcdSnapshotAnnotation]; ok {
+		t.Error("Expected repeated value to be acknowledged")
+	}
+
+	// a new value takes a new snapshot
+	setEtcdSnapshotAnnotation(t, r, cluster, "second")
+
+	if _, err := r.reconcileEtcdSnapshot(ctx, log, cluster); err != nil {
+		t.Fatalf("Failed to reconcile snapshot: %v", err)
+	}
+	if configs := listEtcdSnapshotBackupConfigs(t, r); len(configs) != 2 {
+		t.Fatalf("Expected a new snapshot for a new value, got %d EtcdBackupConfigs", len(configs))
+	}
+	if trigger := cluster.Status.EtcdSnapshot.Trigger; trigger != "second" {
+		t.Errorf("Expected status to track the new value, got %q", trigger)
+	}
+}
+
+func setEtcdSnapshotAnnotation(t *testing.T, r *Reconciler, cluster *kubermaticv1.Cluster, trigger string) {
+	t.Helper()
+
+	err := r.updateCluster(context.Background(), cluster, func(c *kubermaticv1.Cluster) {
+		if c.Annotations == nil {
+			c.Annotations = map[string]string{}
+		}
+		c.Annotations[resources.EtcdSnapshotAnnotation] = trigger
+	})
+	if err != nil {
+		t.Fatalf("Failed to set annotation: %v", err)
+	}
+}
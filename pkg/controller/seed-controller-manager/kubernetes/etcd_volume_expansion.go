@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/etcd"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// etcdVolumeExpansionInterval is how often the disk usage of etcd volumes is checked.
+	etcdVolumeExpansionInterval = 5 * time.Minute
+
+	// etcdVolumeExpansionFactor is the percentage by which an etcd volume grows when it is expanded.
+	etcdVolumeExpansionFactor = 50
+)
+
+// volumeUsage is the disk usage of a single PersistentVolumeClaim.
+type volumeUsage struct {
+	UsedBytes     int64
+	CapacityBytes int64
+}
+
+// volumeUsageGetter returns the usage of all PersistentVolumeClaims mounted on the given node.
+type volumeUsageGetter func(ctx context.Context, nodeName string) (map[types.NamespacedName]volumeUsage, error)
+
+// ValidateEtcdVolumeExpansion ensures that the threshold is a percentage at which a volume
+// can still be expanded and that the maximum size is positive.
+func ValidateEtcdVolumeExpansion(threshold int, maxSize resource.Quantity) error {
+	if threshold <= 0 || threshold >= 100 {
+		return fmt.Errorf("threshold must be between 1 and 99 percent, got %d", threshold)
+	}
+
+	if maxSize.Sign() <= 0 {
+		return fmt.Errorf("maximum size must be positive, got %s", maxSize.String())
+	}
+
+	return nil
+}
+
+// kubeletSummary is the subset of the kubelet stats summary that is needed to
+// determine the usage of PersistentVolumeClaims.
+type kubeletSummary struct {
+	Pods []struct {
+		Volumes []struct {
+			UsedBytes     *uint64 `json:"usedBytes"`
+			CapacityBytes *uint64 `json:"capacityBytes"`
+			PVCRef        *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+// kubeletVolumeUsageGetter reads the volume usage from the kubelet stats summary, which
+// is reachable via the node proxy of the seed apiserver.
+func kubeletVolumeUsageGetter(restClient rest.Interface) volumeUsageGetter {
+	return func(ctx context.Context, nodeName string) (map[types.NamespacedName]volumeUsage, error) {
+		raw, err := restClient.Get().AbsPath("/api/v1/nodes", nodeName, "proxy", "stats", "summary").DoRaw(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stats summary: %w", err)
+		}
+
+		summary := kubeletSummary{}
+		if err := json.Unmarshal(raw, &summary); err != nil {
+			return nil, fmt.Errorf("failed to decode stats summary: %w", err)
+		}
+
+		usage := map[types.NamespacedName]volumeUsage{}
+		for _, pod := range summary.Pods {
+			for _, volume := range pod.Volumes {
+				if volume.PVCRef == nil || volume.UsedBytes == nil || volume.CapacityBytes == nil {
+					continue
+				}
+
+				usage[types.NamespacedName{Namespace: volume.PVCRef.Namespace, Name: volume.PVCRef.Name}] = volumeUsage{
+					UsedBytes:     int64(*volume.UsedBytes),
+					CapacityBytes: int64(*volume.CapacityBytes),
+				}
+			}
+		}
+
+		return usage, nil
+	}
+}
+
+// reconcileEtcdVolumeExpansion expands the PersistentVolumeClaims of etcd members whose
+// disk usage has reached the configured threshold, as long as their StorageClass allows
+// volume expansion. Volumes are never grown beyond the configured maximum size.
+func (r *Reconciler) reconcileEtcdVolumeExpansion(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	if !r.etcdVolumeExpansion {
+		return nil, nil
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, ctrlruntimeclient.InNamespace(resources.EtcdNamespaceName(cluster)), ctrlruntimeclient.MatchingLabels(etcd.GetBasePodLabels(cluster))); err != nil {
+		return nil, fmt.Errorf("failed to list etcd pods: %w", err)
+	}
+
+	nodeUsage := map[string]map[types.NamespacedName]volumeUsage{}
+
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+
+		usage, ok := nodeUsage[pod.Spec.NodeName]
+		if !ok {
+			var err error
+
+			// not being able to determine the usage must not block the cluster reconciliation
+			usage, err = r.volumeUsageGetter(ctx, pod.Spec.NodeName)
+			if err != nil {
+				log.Infow("Failed to get etcd volume usage", "node", pod.Spec.NodeName, zap.Error(err))
+			}
+			nodeUsage[pod.Spec.NodeName] = usage
+		}
+
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim == nil {
+				continue
+			}
+
+			key := types.NamespacedName{Namespace: pod.Namespace, Name: volume.PersistentVolumeClaim.ClaimName}
+
+			current, ok := usage[key]
+			if !ok || current.CapacityBytes <= 0 || current.UsedBytes*100 < current.CapacityBytes*int64(r.etcdVolumeExpansionThreshold) {
+				continue
+			}
+
+			if err := r.expandEtcdVolume(ctx, log, cluster, key, current); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &reconcile.Result{RequeueAfter: etcdVolumeExpansionInterval}, nil
+}
+
+func (r *Reconciler) expandEtcdVolume(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, key types.NamespacedName, usage volumeUsage) error {
+	log = log.With("pvc", key.Name)
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.Get(ctx, key, pvc); err != nil {
+		return fmt.Errorf("failed to get PersistentVolumeClaim %s: %w", key.Name, err)
+	}
+
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+
+	// a previous expansion has not been completed yet
+	if actual, ok := pvc.Status.Capacity[corev1.ResourceStorage]; !ok || actual.Cmp(requested) < 0 {
+		log.Debug("Waiting for etcd volume to finish resizing")
+		return nil
+	}
+
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return nil
+	}
+
+	storageClass := &storagev1.StorageClass{}
+	if err := r.Get(ctx, types.NamespacedName{Name: *pvc.Spec.StorageClassName}, storageClass); err != nil {
+		return fmt.Errorf("failed to get StorageClass %s: %w", *pvc.Spec.StorageClassName, err)
+	}
+
+	if storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion {
+		log.Debugw("Etcd volume usage is high, but its StorageClass does not allow expansion", "storageclass", storageClass.Name)
+		return nil
+	}
+
+	if requested.Cmp(r.etcdVolumeExpansionMaxSize) >= 0 {
+		r.recorder.Eventf(cluster, corev1.EventTypeWarning, "EtcdVolumeExpansionLimitReached",
+			"Etcd volume %s is %d%% full, but has already reached the maximum size of %s.", key.Name, usage.UsedBytes*100/usage.CapacityBytes, r.etcdVolumeExpansionMaxSize.String())
+		return nil
+	}
+
+	newSize := expandedEtcdVolumeSize(requested, r.etcdVolumeExpansionMaxSize)
+
+	oldPVC := pvc.DeepCopy()
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = newSize
+	if err := r.Patch(ctx, pvc, ctrlruntimeclient.MergeFrom(oldPVC)); err != nil {
+		return fmt.Errorf("failed to expand PersistentVolumeClaim %s: %w", key.Name, err)
+	}
+
+	log.Infow("Expanded etcd volume", "from", requested.String(), "to", newSize.String())
+	r.recorder.Eventf(cluster, corev1.EventTypeNormal, "EtcdVolumeExpanded", "Etcd volume %s has been expanded from %s to %s.", key.Name, requested.String(), newSize.String())
+
+	return nil
+}
+
+// expandedEtcdVolumeSize grows the given size by etcdVolumeExpansionFactor, rounded up
+// to the next GiB, but never beyond maxSize.
+func expandedEtcdVolumeSize(size, maxSize resource.Quantity) resource.Quantity {
+	const gib = 1 << 30
+
+	grown := size.Value() + size.Value()*etcdVolumeExpansionFactor/100
+	grown = (grown + gib - 1) / gib * gib
+
+	if grown >= maxSize.Value() {
+		return maxSize.DeepCopy()
+	}
+
+	return *resource.NewQuantity(grown, resource.BinarySI)
+}
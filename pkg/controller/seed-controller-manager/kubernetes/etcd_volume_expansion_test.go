@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/etcd"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	etcdVolumeTestNamespace    = "cluster-test"
+	etcdVolumeTestPVC          = "data-etcd-0"
+	etcdVolumeTestStorageClass = "kubermatic-fast"
+)
+
+func etcdVolumeTestObjects(size string, allowExpansion bool, splitNamespace bool) []ctrlruntimeclient.Object {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			Features: map[string]bool{
+				kubermaticv1.ClusterFeatureSplitEtcdNamespace: splitNamespace,
+			},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: etcdVolumeTestNamespace,
+		},
+	}
+	namespace := resources.EtcdNamespaceName(cluster)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "etcd-0",
+			Namespace: namespace,
+			Labels:    etcd.GetBasePodLabels(cluster),
+		},
+		Spec: corev1.PodSpec{
+			NodeName: "node-a",
+			Volumes: []corev1.Volume{{
+				Name: "data",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: etcdVolumeTestPVC,
+					},
+				},
+			}},
+		},
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      etcdVolumeTestPVC,
+			Namespace: namespace,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: ptr.To(etcdVolumeTestStorageClass),
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+			},
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+		},
+	}
+
+	storageClass := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: etcdVolumeTestStorageClass,
+		},
+		AllowVolumeExpansion: ptr.To(allowExpansion),
+	}
+
+	return []ctrlruntimeclient.Object{cluster, pod, pvc, storageClass}
+}
+
+func TestReconcileEtcdVolumeExpansion(t *testing.T) {
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	testCases := []struct {
+		name           string
+		size           string
+		usedPercent    int64
+		allowExpansion bool
+		splitNamespace bool
+		expectedSize   string
+		expectedEvent  string
+	}{
+		{
+			name:           "usage below threshold",
+			size:           "5Gi",
+			usedPercent:    79,
+			allowExpansion: true,
+			expectedSize:   "5Gi",
+		},
+		{
+			name:           "usage above threshold",
+			size:           "5Gi",
+			usedPercent:    85,
+			allowExpansion: true,
+			expectedSize:   "8Gi",
+			expectedEvent:  "EtcdVolumeExpanded",
+		},
+		{
+			name:           "usage above threshold in split etcd namespace",
+			size:           "5Gi",
+			usedPercent:    85,
+			allowExpansion: true,
+			splitNamespace: true,
+			expectedSize:   "8Gi",
+			expectedEvent:  "EtcdVolumeExpanded",
+		},
+		{
+			name:           "storage class does not allow expansion",
+			size:           "5Gi",
+			usedPercent:    85,
+			allowExpansion: false,
+			expectedSize:   "5Gi",
+		},
+		{
+			name:           "expansion is capped at the maximum size",
+			size:           "8Gi",
+			usedPercent:    90,
+			allowExpansion: true,
+			expectedSize:   "10Gi",
+			expectedEvent:  "EtcdVolumeExpanded",
+		},
+		{
+			name:           "volume has reached the maximum size",
+			size:           "10Gi",
+			usedPercent:    90,
+			allowExpansion: true,
+			expectedSize:   "10Gi",
+			expectedEvent:  "EtcdVolumeExpansionLimitReached",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := etcdVolumeTestObjects(tc.size, tc.allowExpansion, tc.splitNamespace)
+			cluster := objects[0].(*kubermaticv1.Cluster)
+			recorder := record.NewFakeRecorder(10)
+
+			capacity := resource.MustParse(tc.size)
+			pvcKey := types.NamespacedName{Namespace: resources.EtcdNamespaceName(cluster), Name: etcdVolumeTestPVC}
+
+			r := &Reconciler{
+				Client:                       fake.NewClientBuilder().WithObjects(objects...).Build(),
+				recorder:                     recorder,
+				etcdVolumeExpansion:          true,
+				etcdVolumeExpansionThreshold: 80,
+				etcdVolumeExpansionMaxSize:   resource.MustParse("10Gi"),
+				volumeUsageGetter: func(context.Context, string) (map[types.NamespacedName]volumeUsage, error) {
+					return map[types.NamespacedName]volumeUsage{
+						pvcKey: {
+							UsedBytes:     capacity.Value() * tc.usedPercent / 100,
+							CapacityBytes: capacity.Value(),
+						},
+					}, nil
+				},
+			}
+
+			res, err := r.reconcileEtcdVolumeExpansion(ctx, log, cluster)
+			if err != nil {
+				t.Fatalf("Failed to reconcile etcd volume expansion: %v", err)
+			}
+			if res == nil || res.RequeueAfter == 0 {
+				t.Errorf("Expected volume usage to be checked periodically, got %v", res)
+			}
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			if err := r.Get(ctx, pvcKey, pvc); err != nil {
+				t.Fatalf("Failed to get PVC: %v", err)
+			}
+
+			size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			if expected := resource.MustParse(tc.expectedSize); size.Cmp(expected) != 0 {
+				t.Errorf("Expected PVC to request %s, got %s", tc.expectedSize, size.String())
+			}
+
+			select {
+			case event := <-recorder.Events:
+				if tc.expectedEvent == "" || !strings.Contains(event, tc.expectedEvent) {
+					t.Errorf("Expected event %q, got %q", tc.expectedEvent, event)
+				}
+			default:
+				if tc.expectedEvent != "" {
+					t.Errorf("Expected event %q, got none", tc.expectedEvent)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcileEtcdVolumeExpansionDisabled(t *testing.T) {
+	objects := etcdVolumeTestObjects("5Gi", true, false)
+
+	r := &Reconciler{
+		Client: fake.NewClientBuilder().WithObjects(objects...).Build(),
+		volumeUsageGetter: func(context.Context, string) (map[types.NamespacedName]volumeUsage, error) {
+			t.Fatal("Expected volume usage not to be queried")
+			return nil, nil
+		},
+	}
+
+	res, err := r.reconcileEtcdVolumeExpansion(context.Background(), zap.NewNop().Sugar(), objects[0].(*kubermaticv1.Cluster))
+	if err != nil {
+		t.Fatalf("Failed to reconcile etcd volume expansion: %v", err)
+	}
+	if res != nil {
+		t.Errorf("Expected no requeue when the expansion is disabled, got %v", res)
+	}
+}
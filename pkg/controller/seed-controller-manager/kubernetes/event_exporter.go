@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ensureEventExporterIsRemoved removes the event-exporter Deployment and its ConfigMap
+// after the event-exporter has been disabled.
+func (r *Reconciler) ensureEventExporterIsRemoved(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	objects := []ctrlruntimeclient.Object{
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.EventExporterDeploymentName,
+				Namespace: cluster.Status.NamespaceName,
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.EventExporterConfigMapName,
+				Namespace: cluster.Status.NamespaceName,
+			},
+		},
+	}
+
+	for _, obj := range objects {
+		if err := r.Delete(ctx, obj); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete %q: %w", obj.GetName(), err)
+		}
+	}
+
+	return nil
+}
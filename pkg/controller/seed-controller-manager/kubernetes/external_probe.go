@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// apiserverExternalReachability returns the status, reason and message for the
+// APIServerExternallyReachable condition, based on the readiness of the probe Deployment.
+func (r *Reconciler) apiserverExternalReachability(ctx context.Context, cluster *kubermaticv1.Cluster) (corev1.ConditionStatus, string, string, error) {
+	key := types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.ApiserverExternalProbeDeploymentName}
+
+	status, err := resources.HealthyDeployment(ctx, r, key, 1)
+	if err != nil {
+		return corev1.ConditionUnknown, "", "", fmt.Errorf("failed to determine health of %q: %w", resources.ApiserverExternalProbeDeploymentName, err)
+	}
+
+	// the probe is ready as long as at least one replica can reach the apiserver, even during rollouts
+	if status == kubermaticv1.HealthStatusDown {
+		return corev1.ConditionFalse, "ExternalProbeFailing", "The apiserver cannot be reached through its external address", nil
+	}
+
+	return corev1.ConditionTrue, "", "The apiserver is reachable through its external address", nil
+}
+
+// ensureAPIServerExternalProbeIsRemoved removes the probe Deployment after the
+// APIServerExternalProbe feature gate has been disabled.
+func (r *Reconciler) ensureAPIServerExternalProbeIsRemoved(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.ApiserverExternalProbeDeploymentName,
+			Namespace: cluster.Status.NamespaceName,
+		},
+	}
+
+	if err := r.Delete(ctx, dep); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete %q: %w", resources.ApiserverExternalProbeDeploymentName, err)
+	}
+
+	return nil
+}
@@ -72,7 +72,7 @@ func (r *Reconciler) clusterHealth(ctx context.Context, cluster *kubermaticv1.Cl
 	}
 
 	var err error
-	key := types.NamespacedName{Namespace: ns, Name: resources.EtcdStatefulSetName}
+	key := types.NamespacedName{Namespace: resources.EtcdNamespaceName(cluster), Name: resources.EtcdStatefulSetName}
 
 	etcdHealthStatus, err := resources.HealthyStatefulSet(ctx, r, key, 2)
 	if err != nil {
@@ -131,9 +131,33 @@ func (r *Reconciler) syncHealth(ctx context.Context, cluster *kubermaticv1.Clust
 		return err
 	}
 
+	var (
+		externalProbeStatus  corev1.ConditionStatus
+		externalProbeReason  string
+		externalProbeMessage string
+	)
+
+	if r.features.APIServerExternalProbe {
+		externalProbeStatus, externalProbeReason, externalProbeMessage, err = r.apiserverExternalReachability(ctx, cluster)
+		if err != nil {
+			return err
+		}
+	}
+
 	return kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
 		c.Status.ExtendedHealth = *extendedHealth
 
+		if r.features.APIServerExternalProbe {
+			kubermaticv1helper.SetClusterCondition(
+				c,
+				r.versions,
+				kubermaticv1.ClusterConditionAPIServerExternallyReachable,
+				externalProbeStatus,
+				externalProbeReason,
+				externalProbeMessage,
+			)
+		}
+
 		// set ClusterConditionEtcdClusterInitialized, this should be done only once
 		// when etcd becomes healthy for the first time.
 		if extendedHealth.Etcd == kubermaticv1.HealthStatusUp {
@@ -285,7 +309,7 @@ func (r *Reconciler) applicationControllerHealthCheck(ctx context.Context, clust
 func (r *Reconciler) statefulSetHealthCheck(ctx context.Context, c *kubermaticv1.Cluster) (bool, error) {
 	// check the etcd
 	statefulSet := &appsv1.StatefulSet{}
-	err := r.Client.Get(ctx, types.NamespacedName{Namespace: c.Status.NamespaceName, Name: resources.EtcdStatefulSetName}, statefulSet)
+	err := r.Client.Get(ctx, types.NamespacedName{Namespace: resources.EtcdNamespaceName(c), Name: resources.EtcdStatefulSetName}, statefulSet)
 
 	if err != nil {
 		// if the StatefulSet for etcd doesn't exist yet, there's nothing to worry about
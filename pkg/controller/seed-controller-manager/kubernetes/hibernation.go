@@ -0,0 +1,297 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/etcd"
+	kkpreconciling "k8c.io/kubermatic/v2/pkg/resources/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// hibernatedReplicasAnnotation records the number of replicas a workload had
+	// before the control plane was hibernated.
+	hibernatedReplicasAnnotation = "kubermatic.k8c.io/hibernated-replicas"
+
+	// hibernationRetryPeriod is how long to wait before checking again whether a
+	// hibernation backup has finished or a woken up component has become ready.
+	hibernationRetryPeriod = 10 * time.Second
+)
+
+// isHibernated returns true if the control plane has been (or is being) scaled down
+// and has not yet been woken up again.
+func isHibernated(cluster *kubermaticv1.Cluster) bool {
+	cond, ok := cluster.Status.Conditions[kubermaticv1.ClusterConditionHibernated]
+	return ok && cond.Status != corev1.ConditionFalse
+}
+
+// reconcileHibernation scales down the control plane of a paused cluster. The Deployments
+// are stopped first, so that the etcd backup that is taken afterwards contains the final
+// state, and etcd is only stopped once the backup has completed. PersistentVolumeClaims
+// are not touched, so the cluster can be woken up with all its data.
+func (r *Reconciler) reconcileHibernation(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	cond := cluster.Status.Conditions[kubermaticv1.ClusterConditionHibernated]
+	if cond.Status == corev1.ConditionTrue {
+		return nil, nil
+	}
+
+	if cond.Status != corev1.ConditionUnknown {
+		log.Info("Hibernating control plane")
+		r.recorder.Event(cluster, corev1.EventTypeNormal, "Hibernating", "Scaling down the control plane.")
+
+		if err := r.setHibernatedCondition(ctx, cluster, corev1.ConditionUnknown, "Hibernating", "The control plane is being scaled down."); err != nil {
+			return nil, err
+		}
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list Deployments: %w", err)
+	}
+
+	for i := range deployments.Items {
+		if err := r.hibernateWorkload(ctx, &deployments.Items[i], &deployments.Items[i].Spec.Replicas); err != nil {
+			return nil, fmt.Errorf("failed to scale down Deployment %s: %w", deployments.Items[i].Name, err)
+		}
+	}
+
+	if res, err := r.ensureHibernationBackup(ctx, log, cluster); err != nil || res != nil {
+		return res, err
+	}
+
+	statefulSet := &appsv1.StatefulSet{}
+	key := types.NamespacedName{Namespace: resources.EtcdNamespaceName(cluster), Name: resources.EtcdStatefulSetName}
+	if err := r.Get(ctx, key, statefulSet); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf("failed to get etcd StatefulSet: %w", err)
+	} else if err == nil {
+		if err := r.hibernateWorkload(ctx, statefulSet, &statefulSet.Spec.Replicas); err != nil {
+			return nil, fmt.Errorf("failed to scale down etcd: %w", err)
+		}
+	}
+
+	log.Info("Control plane has been hibernated")
+	r.recorder.Event(cluster, corev1.EventTypeNormal, "Hibernated", "The control plane has been scaled down.")
+
+	return nil, r.setHibernatedCondition(ctx, cluster, corev1.ConditionTrue, "Hibernated", "The control plane has been scaled down, its volumes are preserved.")
+}
+
+// ensureHibernationBackup takes a one-shot etcd backup for the current hibernation and returns
+// a non-nil result as long as it has not completed. Seeds without a default backup destination
+// cannot take backups, so hibernation proceeds without one.
+func (r *Reconciler) ensureHibernationBackup(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	seed, err := r.seedGetter()
+	if err != nil {
+		return nil, err
+	}
+
+	if !seed.IsDefaultEtcdAutomaticBackupEnabled() {
+		log.Debug("No default etcd backup destination configured, hibernating without backup")
+		return nil, nil
+	}
+
+	// every hibernation gets its own backup, identified by the time it was started
+	started := cluster.Status.Conditions[kubermaticv1.ClusterConditionHibernated].LastHeartbeatTime
+	name := fmt.Sprintf("%s%d", resources.EtcdHibernationBackupConfigPrefix, started.Unix())
+
+	reconcilers := []kkpreconciling.NamedEtcdBackupConfigReconcilerFactory{
+		etcd.OneShotBackupConfigReconciler(cluster, seed, name),
+	}
+	if err := kkpreconciling.ReconcileEtcdBackupConfigs(ctx, reconcilers, cluster.Status.NamespaceName, r); err != nil {
+		return nil, fmt.Errorf("failed to reconcile hibernation EtcdBackupConfig: %w", err)
+	}
+
+	config := &kubermaticv1.EtcdBackupConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: name}, config); err != nil {
+		return nil, fmt.Errorf("failed to get hibernation EtcdBackupConfig: %w", err)
+	}
+
+	for _, backup := range config.Status.CurrentBackups {
+		switch backup.BackupPhase {
+		case kubermaticv1.BackupStatusPhaseCompleted:
+			return nil, nil
+		case kubermaticv1.BackupStatusPhaseFailed:
+			return nil, fmt.Errorf("etcd backup %s failed, not scaling down etcd: %s", backup.BackupName, backup.BackupMessage)
+		}
+	}
+
+	log.Debugw("Waiting for etcd backup before scaling down etcd", "backupconfig", name)
+
+	return &reconcile.Result{RequeueAfter: hibernationRetryPeriod}, nil
+}
+
+// wakeUpControlPlane scales a hibernated control plane back up in order: etcd first,
+// then the apiserver and only once that is ready, all remaining Deployments. It returns
+// a non-nil result while waiting for a component to become ready.
+func (r *Reconciler) wakeUpControlPlane(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	etcdKey := types.NamespacedName{Namespace: resources.EtcdNamespaceName(cluster), Name: resources.EtcdStatefulSetName}
+
+	statefulSet := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, etcdKey, statefulSet); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf("failed to get etcd StatefulSet: %w", err)
+	} else if err == nil {
+		if err := r.wakeUpWorkload(ctx, statefulSet, &statefulSet.Spec.Replicas); err != nil {
+			return nil, fmt.Errorf("failed to scale up etcd: %w", err)
+		}
+	}
+
+	etcdHealth, err := resources.HealthyStatefulSet(ctx, r, etcdKey, -1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get etcd health: %w", err)
+	}
+	if etcdHealth != kubermaticv1.HealthStatusUp {
+		log.Debug("Waking up control plane, waiting for etcd")
+		return &reconcile.Result{RequeueAfter: hibernationRetryPeriod}, nil
+	}
+
+	apiserverKey := types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.ApiserverDeploymentName}
+
+	apiserver := &appsv1.Deployment{}
+	if err := r.Get(ctx, apiserverKey, apiserver); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf("failed to get apiserver Deployment: %w", err)
+	} else if err == nil {
+		if err := r.wakeUpWorkload(ctx, apiserver, &apiserver.Spec.Replicas); err != nil {
+			return nil, fmt.Errorf("failed to scale up apiserver: %w", err)
+		}
+	}
+
+	apiserverHealth, err := resources.HealthyDeployment(ctx, r, apiserverKey, 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get apiserver health: %w", err)
+	}
+	if apiserverHealth == kubermaticv1.HealthStatusDown {
+		log.Debug("Waking up control plane, waiting for apiserver")
+		return &reconcile.Result{RequeueAfter: hibernationRetryPeriod}, nil
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+		return nil, fmt.Errorf("failed to list Deployments: %w", err)
+	}
+
+	for i := range deployments.Items {
+		if err := r.wakeUpWorkload(ctx, &deployments.Items[i], &deployments.Items[i].Spec.Replicas); err != nil {
+			return nil, fmt.Errorf("failed to scale up Deployment %s: %w", deployments.Items[i].Name, err)
+		}
+	}
+
+	if err := r.deleteHibernationBackupConfigs(ctx, cluster); err != nil {
+		return nil, err
+	}
+
+	log.Info("Control plane has been woken up")
+	r.recorder.Event(cluster, corev1.EventTypeNormal, "WokenUp", "The control plane has been scaled up again.")
+
+	return nil, r.setHibernatedCondition(ctx, cluster, corev1.ConditionFalse, "WokenUp", "The control plane is running.")
+}
+
+// deleteHibernationBackupConfigs removes the EtcdBackupConfigs created by ensureHibernationBackup.
+// They are kept until the control plane is running again, because deleting an EtcdBackupConfig
+// also deletes its backups from the backup destination.
+func (r *Reconciler) deleteHibernationBackupConfigs(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	configs := &kubermaticv1.EtcdBackupConfigList{}
+	if err := r.List(ctx, configs, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+		return fmt.Errorf("failed to list EtcdBackupConfigs: %w", err)
+	}
+
+	for i, config := range configs.Items {
+		if !strings.HasPrefix(config.Name, resources.EtcdHibernationBackupConfigPrefix) {
+			continue
+		}
+
+		if err := r.Delete(ctx, &configs.Items[i]); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete hibernation EtcdBackupConfig %s: %w", config.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// hibernateWorkload scales the given Deployment or StatefulSet to zero and remembers its
+// previous number of replicas. Workloads that are already scaled to zero are left alone,
+// so they are not scaled up when waking up.
+func (r *Reconciler) hibernateWorkload(ctx context.Context, obj ctrlruntimeclient.Object, replicas **int32) error {
+	current := int32(1)
+	if *replicas != nil {
+		current = **replicas
+	}
+
+	if current == 0 {
+		return nil
+	}
+
+	oldObj := obj.DeepCopyObject().(ctrlruntimeclient.Object)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[hibernatedReplicasAnnotation] = strconv.Itoa(int(current))
+	obj.SetAnnotations(annotations)
+	*replicas = ptr.To[int32](0)
+
+	return r.Patch(ctx, obj, ctrlruntimeclient.MergeFrom(oldObj))
+}
+
+// wakeUpWorkload restores the number of replicas recorded by hibernateWorkload.
+func (r *Reconciler) wakeUpWorkload(ctx context.Context, obj ctrlruntimeclient.Object, replicas **int32) error {
+	value, ok := obj.GetAnnotations()[hibernatedReplicasAnnotation]
+	if !ok {
+		return nil
+	}
+
+	previous, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid %s annotation %q: %w", hibernatedReplicasAnnotation, value, err)
+	}
+
+	oldObj := obj.DeepCopyObject().(ctrlruntimeclient.Object)
+
+	annotations := obj.GetAnnotations()
+	delete(annotations, hibernatedReplicasAnnotation)
+	obj.SetAnnotations(annotations)
+	*replicas = ptr.To(int32(previous))
+
+	if err := r.Patch(ctx, obj, ctrlruntimeclient.MergeFrom(oldObj)); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Reconciler) setHibernatedCondition(ctx context.Context, cluster *kubermaticv1.Cluster, status corev1.ConditionStatus, reason, message string) error {
+	return kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		kubermaticv1helper.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionHibernated, status, reason, message)
+	})
+}
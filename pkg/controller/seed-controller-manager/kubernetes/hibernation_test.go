@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const hibernationTestNamespace = "cluster-test"
+
+func hibernationTestCluster() *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			Pause:     true,
+			Hibernate: true,
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: hibernationTestNamespace,
+		},
+	}
+}
+
+func hibernationTestDeployment(name string, replicas int32, annotations map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   hibernationTestNamespace,
+			Annotations: annotations,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(replicas),
+		},
+	}
+}
+
+func hibernationTestEtcd(replicas int32, annotations map[string]string) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resources.EtcdStatefulSetName,
+			Namespace:   hibernationTestNamespace,
+			Annotations: annotations,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(replicas),
+		},
+	}
+}
+
+func newHibernationTestReconciler(seed *kubermaticv1.Seed, objects ...ctrlruntimeclient.Object) *Reconciler {
+	client := fake.NewClientBuilder().
+		WithObjects(objects...).
+		WithStatusSubresource(&kubermaticv1.Cluster{}).
+		Build()
+
+	return &Reconciler{
+		log:      zap.NewNop().Sugar(),
+		Client:   client,
+		recorder: record.NewFakeRecorder(10),
+		seedGetter: func() (*kubermaticv1.Seed, error) {
+			return seed, nil
+		},
+	}
+}
+
+func getReplicas(t *testing.T, r *Reconciler, obj ctrlruntimeclient.Object) int32 {
+	t.Helper()
+
+	if err := r.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(obj), obj); err != nil {
+		t.Fatalf("Failed to get %s: %v", obj.GetName(), err)
+	}
+
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		return *o.Spec.Replicas
+	case *appsv1.StatefulSet:
+		return *o.Spec.Replicas
+	}
+
+	t.Fatalf("Unexpected object %T", obj)
+	return 0
+}
+
+func TestReconcileHibernation(t *testing.T) {
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	seedWithBackups := &kubermaticv1.Seed{
+		Spec: kubermaticv1.SeedSpec{
+			EtcdBackupRestore: &kubermaticv1.EtcdBackupRestore{
+				Destinations: map[string]*kubermaticv1.BackupDestination{
+					"s3": {},
+				},
+				DefaultDestination: "s3",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		seed         *kubermaticv1.Seed
+		expectBackup bool
+	}{
+		{
+			name: "without backup destination",
+			seed: &kubermaticv1.Seed{},
+		},
+		{
+			name:         "etcd is scaled down after the backup",
+			seed:         seedWithBackups,
+			expectBackup: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := hibernationTestCluster()
+			apiserver := hibernationTestDeployment(resources.ApiserverDeploymentName, 2, nil)
+			disabled := hibernationTestDeployment("disabled", 0, nil)
+			etcd := hibernationTestEtcd(3, nil)
+
+			r := newHibernationTestReconciler(tc.seed, cluster, apiserver, disabled, etcd)
+
+			res, err := r.reconcileHibernation(ctx, log, cluster)
+			if err != nil {
+				t.Fatalf("Failed to hibernate: %v", err)
+			}
+
+			if replicas := getReplicas(t, r, apiserver); replicas != 0 {
+				t.Errorf("Expected apiserver to be scaled down, but has %d replicas", replicas)
+			}
+			if apiserver.Annotations[hibernatedReplicasAnnotation] != "2" {
+				t.Errorf("Expected apiserver to remember 2 replicas, got %q", apiserver.Annotations[hibernatedReplicasAnnotation])
+			}
+			if getReplicas(t, r, disabled); disabled.Annotations[hibernatedReplicasAnnotation] != "" {
+				t.Error("Expected Deployment without replicas not to be woken up later")
+			}
+
+			if tc.expectBackup {
+				if res == nil || res.RequeueAfter == 0 {
+					t.Fatalf("Expected to wait for the etcd backup, got %v", res)
+				}
+				if replicas := getReplicas(t, r, etcd); replicas != 3 {
+					t.Fatalf("Expected etcd to keep running until the backup has completed, but has %d replicas", replicas)
+				}
+
+				configs := &kubermaticv1.EtcdBackupConfigList{}
+				if err := r.List(ctx, configs, ctrlruntimeclient.InNamespace(hibernationTestNamespace)); err != nil {
+					t.Fatalf("Failed to list EtcdBackupConfigs: %v", err)
+				}
+				if len(configs.Items) != 1 || configs.Items[0].Spec.Schedule != "" {
+					t.Fatalf("Expected a single one-shot EtcdBackupConfig, got %v", configs.Items)
+				}
+
+				config := configs.Items[0]
+				config.Status.CurrentBackups = []kubermaticv1.BackupStatus{{BackupPhase: kubermaticv1.BackupStatusPhaseCompleted}}
+				if err := r.Update(ctx, &config); err != nil {
+					t.Fatalf("Failed to complete backup: %v", err)
+				}
+
+				if res, err = r.reconcileHibernation(ctx, log, cluster); err != nil {
+					t.Fatalf("Failed to hibernate: %v", err)
+				}
+			}
+
+			if res != nil {
+				t.Errorf("Expected hibernation to be finished, got %v", res)
+			}
+			if replicas := getReplicas(t, r, etcd); replicas != 0 {
+				t.Errorf("Expected etcd to be scaled down, but has %d replicas", replicas)
+			}
+			if !cluster.Status.HasConditionValue(kubermaticv1.ClusterConditionHibernated, corev1.ConditionTrue) {
+				t.Errorf("Expected %s condition to be true, got %v", kubermaticv1.ClusterConditionHibernated, cluster.Status.Conditions)
+			}
+		})
+	}
+}
+
+func TestWakeUpControlPlane(t *testing.T) {
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	hibernated := map[string]string{hibernatedReplicasAnnotation: "3"}
+
+	cluster := hibernationTestCluster()
+	cluster.Spec.Pause = false
+	cluster.Status.Conditions = map[kubermaticv1.ClusterConditionType]kubermaticv1.ClusterCondition{
+		kubermaticv1.ClusterConditionHibernated: {Status: corev1.ConditionTrue},
+	}
+
+	etcd := hibernationTestEtcd(0, hibernated)
+	apiserver := hibernationTestDeployment(resources.ApiserverDeploymentName, 0, map[string]string{hibernatedReplicasAnnotation: "2"})
+	scheduler := hibernationTestDeployment(resources.SchedulerDeploymentName, 0, map[string]string{hibernatedReplicasAnnotation: "1"})
+
+	backupConfig := &kubermaticv1.EtcdBackupConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.EtcdHibernationBackupConfigPrefix + "1700000000",
+			Namespace: hibernationTestNamespace,
+		},
+	}
+	scheduledConfig := &kubermaticv1.EtcdBackupConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "daily",
+			Namespace: hibernationTestNamespace,
+		},
+	}
+
+	r := newHibernationTestReconciler(&kubermaticv1.Seed{}, cluster, etcd, apiserver, scheduler, backupConfig, scheduledConfig)
+
+	// etcd comes first
+	res, err := r.wakeUpControlPlane(ctx, log, cluster)
+	if err != nil {
+		t.Fatalf("Failed to wake up: %v", err)
+	}
+	if res == nil {
+		t.Fatal("Expected to wait for etcd")
+	}
+	if replicas := getReplicas(t, r, etcd); replicas != 3 {
+		t.Errorf("Expected etcd to be scaled up to 3 replicas, got %d", replicas)
+	}
+	if replicas := getReplicas(t, r, apiserver); replicas != 0 {
+		t.Errorf("Expected apiserver to wait for etcd, but has %d replicas", replicas)
+	}
+	if err := r.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(backupConfig), backupConfig); err != nil {
+		t.Errorf("Expected hibernation EtcdBackupConfig to be kept while waking up: %v", err)
+	}
+
+	etcd.Status = appsv1.StatefulSetStatus{Replicas: 3, ReadyReplicas: 3, UpdatedReplicas: 3}
+	if err := r.Status().Update(ctx, etcd); err != nil {
+		t.Fatalf("Failed to update etcd status: %v", err)
+	}
+
+	// then the apiserver
+	if res, err = r.wakeUpControlPlane(ctx, log, cluster); err != nil {
+		t.Fatalf("Failed to wake up: %v", err)
+	}
+	if res == nil {
+		t.Fatal("Expected to wait for the apiserver")
+	}
+	if replicas := getReplicas(t, r, apiserver); replicas != 2 {
+		t.Errorf("Expected apiserver to be scaled up to 2 replicas, got %d", replicas)
+	}
+	if replicas := getReplicas(t, r, scheduler); replicas != 0 {
+		t.Errorf("Expected scheduler to wait for the apiserver, but has %d replicas", replicas)
+	}
+
+	apiserver.Status = appsv1.DeploymentStatus{Replicas: 2, ReadyReplicas: 2, UpdatedReplicas: 2}
+	if err := r.Status().Update(ctx, apiserver); err != nil {
+		t.Fatalf("Failed to update apiserver status: %v", err)
+	}
+
+	// and finally everything else
+	if res, err = r.wakeUpControlPlane(ctx, log, cluster); err != nil {
+		t.Fatalf("Failed to wake up: %v", err)
+	}
+	if res != nil {
+		t.Errorf("Expected the control plane to be woken up, got %v", res)
+	}
+	if replicas := getReplicas(t, r, scheduler); replicas != 1 {
+		t.Errorf("Expected scheduler to be scaled up to 1 replica, got %d", replicas)
+	}
+	if _, ok := scheduler.Annotations[hibernatedReplicasAnnotation]; ok {
+		t.Error("Expected hibernation annotation to be removed")
+	}
+	if isHibernated(cluster) {
+		t.Errorf("Expected cluster not to be hibernated anymore, got %v", cluster.Status.Conditions)
+	}
+
+	configs := &kubermaticv1.EtcdBackupConfigList{}
+	if err := r.List(ctx, configs, ctrlruntimeclient.InNamespace(hibernationTestNamespace)); err != nil {
+		t.Fatalf("Failed to list EtcdBackupConfigs: %v", err)
+	}
+	if len(configs.Items) != 1 || configs.Items[0].Name != scheduledConfig.Name {
+		t.Errorf("Expected only the hibernation EtcdBackupConfig to be deleted, got %v", configs.Items)
+	}
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReasonImageNotAttested is the reason of the ControlPlaneRolloutsBlocked condition if
+// a control plane image could not be verified.
+const ReasonImageNotAttested = "ImageNotAttested"
+
+// imageVerifier checks that an image has been attested before it is deployed into
+// a control plane.
+type imageVerifier interface {
+	VerifyImage(ctx context.Context, image string) error
+}
+
+const (
+	// imageVerificationMinBackoff is how long a failed verification is cached before the
+	// image is checked again. The backoff doubles with every failure, up to the maximum.
+	imageVerificationMinBackoff = 30 * time.Second
+	imageVerificationMaxBackoff = 10 * time.Minute
+)
+
+// annotationImageVerifier accepts images whose manifest (or image index) carries a
+// configured annotation, which is usually added by the pipeline that scanned the image.
+// Tags are resolved to their digest on every check, as they can be moved, but the result
+// of the verification is cached on the digest. Failures are cached with a backoff, so
+// that unattested images or unreachable registries are not queried on every reconcile.
+type annotationImageVerifier struct {
+	annotation string
+	options    []remote.Option
+	clock      clock.PassiveClock
+
+	lock     sync.Mutex
+	verified sets.Set[string]
+	failures map[string]*imageVerificationFailure
+}
+
+// imageVerificationFailure is a cached negative result.
+type imageVerificationFailure struct {
+	err     error
+	backoff time.Duration
+	retryAt time.Time
+}
+
+func newAnnotationImageVerifier(annotation string) *annotationImageVerifier {
+	return &annotationImageVerifier{
+		annotation: annotation,
+		options:    []remote.Option{remote.WithAuthFromKeychain(authn.DefaultKeychain)},
+		clock:      clock.RealClock{},
+		verified:   sets.New[string](),
+		failures:   map[string]*imageVerificationFailure{},
+	}
+}
+
+func (v *annotationImageVerifier) VerifyImage(ctx context.Context, image string) error {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return fmt.Errorf("invalid image reference: %w", err)
+	}
+
+	digest, err := v.resolveDigest(ctx, image, ref)
+	if err != nil {
+		return err
+	}
+
+	key := digest.String()
+	if checked, err := v.cached(key); checked {
+		return err
+	}
+
+	if err := v.verifyDigest(ctx, digest); err != nil {
+		return v.recordFailure(key, err)
+	}
+
+	v.lock.Lock()
+	v.verified.Insert(key)
+	delete(v.failures, key)
+	v.lock.Unlock()
+
+	return nil
+}
+
+// resolveDigest returns the digest the image currently points to.
+func (v *annotationImageVerifier) resolveDigest(ctx context.Context, image string, ref name.Reference) (name.Digest, error) {
+	if digest, ok := ref.(name.Digest); ok {
+		return digest, nil
+	}
+
+	if _, err := v.cached(image); err != nil {
+		return name.Digest{}, err
+	}
+
+	desc, err := remote.Head(ref, v.remoteOptions(ctx)...)
+	if err != nil {
+		return name.Digest{}, v.recordFailure(image, fmt.Errorf("failed to resolve digest: %w", err))
+	}
+
+	v.lock.Lock()
+	delete(v.failures, image)
+	v.lock.Unlock()
+
+	return ref.Context().Digest(desc.Digest.String()), nil
+}
+
+func (v *annotationImageVerifier) verifyDigest(ctx context.Context, digest name.Digest) error {
+	desc, err := remote.Get(digest, v.remoteOptions(ctx)...)
+	if err != nil {
+		return fmt.Errorf("failed to get manifest: %w", err)
+	}
+
+	// image manifests and indexes both carry their annotations at the top level
+	manifest := struct {
+		Annotations map[string]string `json:"annotations"`
+	}{}
+	if err := json.Unmarshal(desc.Manifest, &manifest); err != nil {
+		return fmt.Errorf("failed to decode manifest: %w", err)
+	}
+
+	if _, ok := manifest.Annotations[v.annotation]; !ok {
+		return fmt.Errorf("manifest is missing the %s annotation", v.annotation)
+	}
+
+	return nil
+}
+
+func (v *annotationImageVerifier) remoteOptions(ctx context.Context) []remote.Option {
+	return append([]remote.Option{remote.WithContext(ctx)}, v.options...)
+}
+
+// cached returns whether the key has been checked recently and the result of that check.
+func (v *annotationImageVerifier) cached(key string) (bool, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if v.verified.Has(key) {
+		return true, nil
+	}
+
+	if failure, ok := v.failures[key]; ok && v.clock.Now().Before(failure.retryAt) {
+		return true, failure.err
+	}
+
+	return false, nil
+}
+
+// recordFailure caches the failed check of the key and returns the error.
+func (v *annotationImageVerifier) recordFailure(key string, err error) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	backoff := imageVerificationMinBackoff
+	if previous, ok := v.failures[key]; ok {
+		backoff = min(2*previous.backoff, imageVerificationMaxBackoff)
+	}
+
+	v.failures[key] = &imageVerificationFailure{
+		err:     err,
+		backoff: backoff,
+		retryAt: v.clock.Now().Add(backoff),
+	}
+
+	return err
+}
+
+// imageGate keeps Deployments and StatefulSets from rolling out images that have not
+// been attested. Existing objects keep their current pod template, new objects are
+// created without any replicas. A nil gate never blocks anything.
+type imageGate struct {
+	ctx      context.Context
+	verifier imageVerifier
+	blocked  map[string]string
+}
+
+func newImageGate(ctx context.Context, verifier imageVerifier) *imageGate {
+	if verifier == nil {
+		return nil
+	}
+
+	return &imageGate{
+		ctx:      ctx,
+		verifier: verifier,
+		blocked:  map[string]string{},
+	}
+}
+
+// Modifier verifies all images of the reconciled pod template.
+func (g *imageGate) Modifier() reconciling.ObjectModifier {
+	return func(create reconciling.ObjectReconciler) reconciling.ObjectReconciler {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			if g == nil {
+				return create(existing)
+			}
+
+			// reconcilers usually modify the existing object in-place
+			var current *corev1.PodTemplateSpec
+			if existing != nil && existing.GetResourceVersion() != "" {
+				if _, template := rolloutPodTemplate(existing); template != nil {
+					current = template.DeepCopy()
+				}
+			}
+
+			obj, err := create(existing)
+			if err != nil {
+				return obj, err
+			}
+
+			kind, desired := rolloutPodTemplate(obj)
+			if desired == nil {
+				return obj, nil
+			}
+
+			var failures []string
+			for _, image := range podTemplateImages(desired) {
+				if err := g.verifier.VerifyImage(g.ctx, image); err != nil {
+					failures = append(failures, fmt.Sprintf("%s (%v)", image, err))
+				}
+			}
+
+			key := fmt.Sprintf("%s/%s", kind, obj.GetName())
+			if len(failures) == 0 {
+				delete(g.blocked, key)
+				return obj, nil
+			}
+
+			g.blocked[key] = strings.Join(failures, ", ")
+
+			if current != nil {
+				*desired = *current
+				return obj, nil
+			}
+
+			// there is nothing to keep running, so the new object must not start any pods
+			switch o := obj.(type) {
+			case *appsv1.Deployment:
+				o.Spec.Replicas = ptr.To[int32](0)
+			case *appsv1.StatefulSet:
+				o.Spec.Replicas = ptr.To[int32](0)
+			}
+
+			return obj, nil
+		}
+	}
+}
+
+// Blocked returns the sorted list of objects whose images could not be verified.
+func (g *imageGate) Blocked() []string {
+	if g == nil {
+		return nil
+	}
+
+	blocked := make([]string, 0, len(g.blocked))
+	for key, failures := range g.blocked {
+		blocked = append(blocked, fmt.Sprintf("%s: %s", key, failures))
+	}
+	sort.Strings(blocked)
+
+	return blocked
+}
+
+func podTemplateImages(template *corev1.PodTemplateSpec) []string {
+	images := sets.New[string]()
+	for _, container := range template.Spec.InitContainers {
+		images.Insert(container.Image)
+	}
+	for _, container := range template.Spec.Containers {
+		images.Insert(container.Image)
+	}
+
+	return sets.List(images)
+}
+
+// updateRolloutsBlockedCondition reflects the images that could not be verified in the
+// cluster status. The condition is only maintained if image verification is enabled (or
+// has been enabled before).
+func (r *Reconciler) updateRolloutsBlockedCondition(ctx context.Context, cluster *kubermaticv1.Cluster, gate *imageGate) error {
+	// images are not verified during a dry run, so the condition is left alone
+	if _, ok := r.Client.(*dryRunClient); ok {
+		return nil
+	}
+
+	_, hasCondition := cluster.Status.Conditions[kubermaticv1.ClusterConditionControlPlaneRolloutsBlocked]
+	if gate == nil && !hasCondition {
+		return nil
+	}
+
+	status := corev1.ConditionFalse
+	reason := ""
+	message := "All control plane images have been attested."
+
+	if blocked := gate.Blocked(); len(blocked) > 0 {
+		status = corev1.ConditionTrue
+		reason = ReasonImageNotAttested
+		message = fmt.Sprintf("Rollouts are blocked because images have not been attested: %s.", strings.Join(blocked, "; "))
+	}
+
+	return kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		kubermaticv1helper.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionControlPlaneRolloutsBlocked, status, reason, message)
+	})
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// stubImageVerifier only accepts the configured images.
+type stubImageVerifier struct {
+	attested sets.Set[string]
+}
+
+func (v *stubImageVerifier) VerifyImage(_ context.Context, image string) error {
+	if !v.attested.Has(image) {
+		return errors.New("image has not been attested")
+	}
+
+	return nil
+}
+
+func TestAnnotationImageVerifier(t *testing.T) {
+	const annotation = "scanned.example.com/passed"
+
+	var manifestGets atomic.Int32
+	handler := registry.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/manifests/") {
+			manifestGets.Add(1)
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	push := func(tag string, annotations map[string]string) {
+		t.Helper()
+
+		img, err := random.Image(64, 1)
+		if err != nil {
+			t.Fatalf("Failed to create image: %v", err)
+		}
+		img = mutate.Annotations(img, annotations).(v1.Image)
+
+		ref, err := name.ParseReference(fmt.Sprintf("%s/kube-apiserver:%s", host, tag))
+		if err != nil {
+			t.Fatalf("Failed to parse reference: %v", err)
+		}
+		if err := remote.Write(ref, img); err != nil {
+			t.Fatalf("Failed to push image: %v", err)
+		}
+	}
+
+	push("attested", map[string]string{annotation: "true"})
+	push("unattested", nil)
+
+	clock := clocktesting.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	verifier := newAnnotationImageVerifier(annotation)
+	verifier.options = nil
+	verifier.clock = clock
+
+	ctx := context.Background()
+	attested := host + "/kube-apiserver:attested"
+	unattested := host + "/kube-apiserver:unattested"
+
+	verify := func(image string, expectValid bool, expectedGets int32) {
+		t.Helper()
+
+		before := manifestGets.Load()
+		err := verifier.VerifyImage(ctx, image)
+		if expectValid && err != nil {
+			t.Errorf("Expected %s to be attested, got %v", image, err)
+		}
+		if !expectValid && err == nil {
+			t.Errorf("Expected %s not to be attested", image)
+		}
+		if gets := manifestGets.Load() - before; gets != expectedGets {
+			t.Errorf("Expected %d manifest downloads for %s, got %d", expectedGets, image, gets)
+		}
+	}
+
+	verify(attested, true, 1)
+	// the verification is cached on the digest
+	verify(attested, true, 0)
+
+	verify(unattested, false, 1)
+	// failures are cached until the backoff has passed
+	verify(unattested, false, 0)
+	clock.Step(imageVerificationMinBackoff)
+	verify(unattested, false, 1)
+	clock.Step(imageVerificationMinBackoff)
+	verify(unattested, false, 0)
+	clock.Step(imageVerificationMinBackoff)
+	verify(unattested, false, 1)
+
+	// moving the tag to another image invalidates the cached result
+	push("attested", nil)
+	verify(attested, false, 1)
+}
+
+func TestImageGate(t *testing.T) {
+	const (
+		currentImage = "registry.k8s.io/kube-apiserver:v1.31.0"
+		newImage     = "registry.k8s.io/kube-apiserver:v1.31.1"
+	)
+
+	existing := func() *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "apiserver",
+				ResourceVersion: "1",
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To[int32](2),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  "apiserver",
+							Image: currentImage,
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	reconcile := func(obj ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		dep := obj.(*appsv1.Deployment)
+		dep.Name = "apiserver"
+		dep.Spec.Replicas = ptr.To[int32](3)
+		dep.Spec.Template.Spec.Containers = []corev1.Container{{
+			Name:  "apiserver",
+			Image: newImage,
+		}}
+		return dep, nil
+	}
+
+	testCases := []struct {
+		name             string
+		existing         *appsv1.Deployment
+		attested         sets.Set[string]
+		expectedImage    string
+		expectedReplicas int32
+		expectedBlocked  bool
+	}{
+		{
+			name:             "attested image is rolled out",
+			existing:         existing(),
+			attested:         sets.New(newImage),
+			expectedImage:    newImage,
+			expectedReplicas: 3,
+		},
+		{
+			name:             "unattested image is not rolled out",
+			existing:         existing(),
+			attested:         sets.New(currentImage),
+			expectedImage:    currentImage,
+			expectedReplicas: 3,
+			expectedBlocked:  true,
+		},
+		{
+			name:             "new deployment with attested image is created",
+			existing:         &appsv1.Deployment{},
+			attested:         sets.New(newImage),
+			expectedImage:    newImage,
+			expectedReplicas: 3,
+		},
+		{
+			name:             "new deployment with unattested image is created without replicas",
+			existing:         &appsv1.Deployment{},
+			attested:         sets.New[string](),
+			expectedImage:    newImage,
+			expectedReplicas: 0,
+			expectedBlocked:  true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			gate := newImageGate(context.Background(), &stubImageVerifier{attested: test.attested})
+
+			obj, err := gate.Modifier()(reconcile)(test.existing)
+			if err != nil {
+				t.Fatalf("Failed to reconcile: %v", err)
+			}
+
+			dep := obj.(*appsv1.Deployment)
+			if image := dep.Spec.Template.Spec.Containers[0].Image; image != test.expectedImage {
+				t.Errorf("Expected image %q, got %q", test.expectedImage, image)
+			}
+
+			if *dep.Spec.Replicas != test.expectedReplicas {
+				t.Errorf("Expected %d replicas, got %d", test.expectedReplicas, *dep.Spec.Replicas)
+			}
+
+			if blocked := len(gate.Blocked()) > 0; blocked != test.expectedBlocked {
+				t.Errorf("Expected blocked to be %v, got %v (%v)", test.expectedBlocked, blocked, gate.Blocked())
+			}
+		})
+	}
+}
+
+func TestNilImageGate(t *testing.T) {
+	var gate *imageGate
+
+	obj, err := gate.Modifier()(func(obj ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		obj.(*appsv1.Deployment).Spec.Replicas = ptr.To[int32](3)
+		return obj, nil
+	})(&appsv1.Deployment{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	if replicas := *obj.(*appsv1.Deployment).Spec.Replicas; replicas != 3 {
+		t.Errorf("Expected a disabled gate not to change the replicas, got %d", replicas)
+	}
+}
+
+func TestUpdateRolloutsBlockedCondition(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+	}
+
+	client := fake.NewClientBuilder().
+		WithObjects(cluster).
+		WithStatusSubresource(cluster).
+		Build()
+
+	r := &Reconciler{
+		Client: client,
+	}
+
+	gate := newImageGate(ctx, &stubImageVerifier{attested: sets.New[string]()})
+	gate.blocked["Deployment/apiserver"] = "registry.k8s.io/kube-apiserver:v1.31.1 (image has not been attested)"
+
+	if err := r.updateRolloutsBlockedCondition(ctx, cluster, gate); err != nil {
+		t.Fatalf("Failed to update condition: %v", err)
+	}
+
+	updated := &kubermaticv1.Cluster{}
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), updated); err != nil {
+		t.Fatalf("Failed to get cluster: %v", err)
+	}
+
+	cond := updated.Status.Conditions[kubermaticv1.ClusterConditionControlPlaneRolloutsBlocked]
+	if cond.Status != corev1.ConditionTrue || cond.Reason != ReasonImageNotAttested {
+		t.Errorf("Expected condition to be true with reason %s, got %v", ReasonImageNotAttested, cond)
+	}
+
+	// a dry run does not verify any images and must not reset the condition
+	dryRunClient := newDryRunClient(client)
+	r.Client = dryRunClient
+
+	if err := r.updateRolloutsBlockedCondition(ctx, updated, nil); err != nil {
+		t.Fatalf("Failed to update condition: %v", err)
+	}
+
+	if len(dryRunClient.changes) > 0 {
+		t.Errorf("Expected no changes during a dry run, got %v", dryRunClient.changes)
+	}
+}
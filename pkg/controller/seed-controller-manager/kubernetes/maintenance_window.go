@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var weekdays = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// maintenanceWindowOpen returns whether now is within the given window. If it is not,
+// the duration until the window opens next is returned as well. A window without
+// start or length is always open.
+func maintenanceWindowOpen(window *kubermaticv1.UpdateWindow, now time.Time) (bool, time.Duration, error) {
+	if window == nil || window.Start == "" || window.Length == "" {
+		return true, 0, nil
+	}
+
+	length, err := time.ParseDuration(window.Length)
+	if err != nil {
+		return false, 0, fmt.Errorf("invalid window length %q: %w", window.Length, err)
+	}
+
+	clock := window.Start
+	period := 24 * time.Hour
+	weekly := false
+	weekday := time.Sunday
+
+	if day, timeOfDay, found := strings.Cut(window.Start, " "); found {
+		weekday, weekly = weekdays[day]
+		if !weekly {
+			return false, 0, fmt.Errorf("invalid week day %q", day)
+		}
+
+		clock = timeOfDay
+		period = 7 * 24 * time.Hour
+	}
+
+	startTime, err := time.Parse("15:04", clock)
+	if err != nil {
+		return false, 0, fmt.Errorf("invalid window start %q: %w", window.Start, err)
+	}
+
+	now = now.UTC()
+
+	// find the most recent start of the window
+	lastStart := time.Date(now.Year(), now.Month(), now.Day(), startTime.Hour(), startTime.Minute(), 0, 0, time.UTC)
+	if weekly {
+		daysSince := (int(now.Weekday()) - int(weekday) + 7) % 7
+		lastStart = lastStart.AddDate(0, 0, -daysSince)
+	}
+	if lastStart.After(now) {
+		lastStart = lastStart.Add(-period)
+	}
+
+	if length >= period || now.Before(lastStart.Add(length)) {
+		return true, 0, nil
+	}
+
+	return false, lastStart.Add(period).Sub(now), nil
+}
+
+// rolloutGate defers changes to Deployments and StatefulSets that would roll their
+// pods while the cluster is outside of its control plane maintenance window. All
+// other changes are applied immediately. A nil gate never defers anything.
+type rolloutGate struct {
+	open bool
+	// retryAfter is the duration until the maintenance window opens next.
+	retryAfter time.Duration
+	deferred   sets.Set[string]
+}
+
+func newRolloutGate(cluster *kubermaticv1.Cluster, now time.Time) (*rolloutGate, error) {
+	open, retryAfter, err := maintenanceWindowOpen(cluster.Spec.ControlPlaneMaintenanceWindow, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate control plane maintenance window: %w", err)
+	}
+
+	return &rolloutGate{
+		open:       open,
+		retryAfter: retryAfter,
+		deferred:   sets.New[string](),
+	}, nil
+}
+
+// Modifier keeps the current pod template of existing objects if the reconciled
+// one would differ and the maintenance window is closed.
+func (g *rolloutGate) Modifier() reconciling.ObjectModifier {
+	return func(create reconciling.ObjectReconciler) reconciling.ObjectReconciler {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			// new objects are always created right away
+			if g == nil || g.open || existing == nil || existing.GetResourceVersion() == "" {
+				return create(existing)
+			}
+
+			// reconcilers usually modify the existing object in-place
+			_, current := rolloutPodTemplate(existing)
+			if current != nil {
+				current = current.DeepCopy()
+			}
+
+			obj, err := create(existing)
+			if err != nil || current == nil {
+				return obj, err
+			}
+
+			kind, desired := rolloutPodTemplate(obj)
+			if desired == nil {
+				return obj, nil
+			}
+
+			// fields that are not set by the reconciler, but defaulted by the API server, do not count as changes
+			if !equality.Semantic.DeepDerivative(*desired, *current) {
+				*desired = *current
+				g.deferred.Insert(fmt.Sprintf("%s/%s", kind, obj.GetName()))
+			}
+
+			return obj, nil
+		}
+	}
+}
+
+// Deferred returns the sorted list of objects with pending disruptive changes.
+func (g *rolloutGate) Deferred() []string {
+	if g == nil {
+		return nil
+	}
+
+	return sets.List(g.deferred)
+}
+
+func rolloutPodTemplate(obj ctrlruntimeclient.Object) (string, *corev1.PodTemplateSpec) {
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		return "Deployment", &o.Spec.Template
+	case *appsv1.StatefulSet:
+		return "StatefulSet", &o.Spec.Template
+	default:
+		return "", nil
+	}
+}
+
+// updateRolloutsDeferredCondition reflects the pending disruptive changes in the
+// cluster status. The condition is only maintained for clusters that have (or had)
+// a control plane maintenance window configured.
+func (r *Reconciler) updateRolloutsDeferredCondition(ctx context.Context, cluster *kubermaticv1.Cluster, gate *rolloutGate) error {
+	_, hasCondition := cluster.Status.Conditions[kubermaticv1.ClusterConditionControlPlaneRolloutsDeferred]
+	if cluster.Spec.ControlPlaneMaintenanceWindow == nil && !hasCondition {
+		return nil
+	}
+
+	status := corev1.ConditionFalse
+	reason := ""
+	message := "No control plane changes are pending."
+
+	if deferred := gate.Deferred(); len(deferred) > 0 {
+		status = corev1.ConditionTrue
+		reason = "OutsideMaintenanceWindow"
+		message = fmt.Sprintf("Changes to %s are deferred until the next maintenance window.", strings.Join(deferred, ", "))
+	}
+
+	return kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		kubermaticv1helper.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionControlPlaneRolloutsDeferred, status, reason, message)
+	})
+}
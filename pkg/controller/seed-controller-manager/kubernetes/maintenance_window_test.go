@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestMaintenanceWindowOpen(t *testing.T) {
+	// 2026-10-14 is a Wednesday
+	now := time.Date(2026, 10, 14, 23, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		window     *kubermaticv1.UpdateWindow
+		open       bool
+		retryAfter time.Duration
+	}{
+		{
+			name: "no window",
+			open: true,
+		},
+		{
+			name:   "daily window, inside",
+			window: &kubermaticv1.UpdateWindow{Start: "22:00", Length: "2h"},
+			open:   true,
+		},
+		{
+			name:   "daily window spanning midnight, before start",
+			window: &kubermaticv1.UpdateWindow{Start: "23:30", Length: "2h"},
+			// the window from the previous day ended at 01:30
+			open:       false,
+			retryAfter: 30 * time.Minute,
+		},
+		{
+			name:       "daily window, outside",
+			window:     &kubermaticv1.UpdateWindow{Start: "02:00", Length: "1h"},
+			open:       false,
+			retryAfter: 3 * time.Hour,
+		},
+		{
+			name:   "weekly window, inside",
+			window: &kubermaticv1.UpdateWindow{Start: "Tue 22:00", Length: "26h"},
+			open:   true,
+		},
+		{
+			name:       "weekly window, outside",
+			window:     &kubermaticv1.UpdateWindow{Start: "Thu 01:00", Length: "1h"},
+			open:       false,
+			retryAfter: 2 * time.Hour,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			open, retryAfter, err := maintenanceWindowOpen(test.window, now)
+			if err != nil {
+				t.Fatalf("Failed to evaluate window: %v", err)
+			}
+
+			if open != test.open {
+				t.Errorf("Expected window open to be %v, got %v", test.open, open)
+			}
+
+			if retryAfter != test.retryAfter {
+				t.Errorf("Expected window to open in %v, got %v", test.retryAfter, retryAfter)
+			}
+		})
+	}
+}
+
+func TestRolloutGate(t *testing.T) {
+	// 2026-10-14 is a Wednesday
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+	existing := func() *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "apiserver",
+				ResourceVersion: "1",
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To[int32](2),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  "apiserver",
+							Image: "registry.k8s.io/kube-apiserver:v1.31.0",
+							// defaulted by the API server
+							TerminationMessagePath: corev1.TerminationMessagePathDefault,
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	reconcile := func(replicas int32, image string) func(ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		return func(obj ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			dep := obj.(*appsv1.Deployment)
+			dep.Spec.Replicas = ptr.To(replicas)
+			dep.Spec.Template.Spec.Containers = []corev1.Container{{
+				Name:  "apiserver",
+				Image: image,
+			}}
+			return dep, nil
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		window           *kubermaticv1.UpdateWindow
+		image            string
+		expectedImage    string
+		expectedDeferral bool
+	}{
+		{
+			name:          "disruptive change inside the window is applied",
+			window:        &kubermaticv1.UpdateWindow{Start: "11:00", Length: "2h"},
+			image:         "registry.k8s.io/kube-apiserver:v1.31.1",
+			expectedImage: "registry.k8s.io/kube-apiserver:v1.31.1",
+		},
+		{
+			name:             "disruptive change outside the window is deferred",
+			window:           &kubermaticv1.UpdateWindow{Start: "22:00", Length: "2h"},
+			image:            "registry.k8s.io/kube-apiserver:v1.31.1",
+			expectedImage:    "registry.k8s.io/kube-apiserver:v1.31.0",
+			expectedDeferral: true,
+		},
+		{
+			name:          "non-disruptive change outside the window is applied",
+			window:        &kubermaticv1.UpdateWindow{Start: "22:00", Length: "2h"},
+			image:         "registry.k8s.io/kube-apiserver:v1.31.0",
+			expectedImage: "registry.k8s.io/kube-apiserver:v1.31.0",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					ControlPlaneMaintenanceWindow: test.window,
+				},
+			}
+
+			gate, err := newRolloutGate(cluster, now)
+			if err != nil {
+				t.Fatalf("Failed to create gate: %v", err)
+			}
+
+			obj, err := gate.Modifier()(reconcile(3, test.image))(existing())
+			if err != nil {
+				t.Fatalf("Failed to reconcile: %v", err)
+			}
+
+			dep := obj.(*appsv1.Deployment)
+			if image := dep.Spec.Template.Spec.Containers[0].Image; image != test.expectedImage {
+				t.Errorf("Expected image %q, got %q", test.expectedImage, image)
+			}
+
+			if *dep.Spec.Replicas != 3 {
+				t.Errorf("Expected non-disruptive replica change to be applied, got %d replicas", *dep.Spec.Replicas)
+			}
+
+			if deferred := len(gate.Deferred()) > 0; deferred != test.expectedDeferral {
+				t.Errorf("Expected deferral to be %v, got %v (%v)", test.expectedDeferral, deferred, gate.Deferred())
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+)
+
+var (
+	etcdReconcilesDeferredForBackup = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "kubermatic",
+		Subsystem: "cluster_controller",
+		Name:      "etcd_reconciles_deferred_for_backup_total",
+		Help:      "The number of times updating the etcd StatefulSet of a usercluster was deferred because a backup was in progress",
+	}, []string{"cluster"})
+
+	etcdRollingClusters = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "cluster_controller",
+		Name:      "etcd_rolling_clusters",
+		Help:      "The number of userclusters whose etcd StatefulSet is currently being rolled out",
+	})
+
+	dryRunObjectChanges = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "cluster_controller",
+		Name:      "dry_run_object_changes",
+		Help:      "The number of control plane objects per kind that the last dry-run reconciliation of a usercluster would have changed",
+	}, []string{"cluster", "kind"})
+
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "kubermatic",
+		Subsystem: "cluster_controller",
+		Name:      "reconcile_duration_seconds",
+		Help:      "The time it took to reconcile one type of control plane resources of a usercluster",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"cluster", "resource"})
+)
+
+func MustRegisterMetrics(c prometheus.Registerer) {
+	c.MustRegister(etcdReconcilesDeferredForBackup)
+	c.MustRegister(etcdRollingClusters)
+	c.MustRegister(dryRunObjectChanges)
+	c.MustRegister(reconcileDuration)
+}
+
+// newReconcileDurationTimer returns a timer that, once stopped, records how long reconciling
+// the given type of resources of a usercluster took.
+func newReconcileDurationTimer(cluster *kubermaticv1.Cluster, resource string) *prometheus.Timer {
+	return prometheus.NewTimer(reconcileDuration.WithLabelValues(cluster.Name, resource))
+}
+
+// deleteClusterMetrics removes all series of a usercluster, so that deleted clusters do not
+// keep their series around until the controller restarts.
+func deleteClusterMetrics(cluster string) {
+	labels := prometheus.Labels{"cluster": cluster}
+
+	etcdReconcilesDeferredForBackup.DeletePartialMatch(labels)
+	dryRunObjectChanges.DeletePartialMatch(labels)
+	reconcileDuration.DeletePartialMatch(labels)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeleteClusterMetrics(t *testing.T) {
+	deleted := &kubermaticv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "deleted"}}
+	remaining := &kubermaticv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "remaining"}}
+
+	for _, cluster := range []*kubermaticv1.Cluster{deleted, remaining} {
+		newReconcileDurationTimer(cluster, "deployments").ObserveDuration()
+		newReconcileDurationTimer(cluster, "statefulsets").ObserveDuration()
+		dryRunObjectChanges.WithLabelValues(cluster.Name, "Deployment").Set(1)
+		etcdReconcilesDeferredForBackup.WithLabelValues(cluster.Name).Inc()
+	}
+
+	reconcileDurations := testutil.CollectAndCount(reconcileDuration)
+	objectChanges := testutil.CollectAndCount(dryRunObjectChanges)
+	deferredReconciles := testutil.CollectAndCount(etcdReconcilesDeferredForBackup)
+
+	deleteClusterMetrics(deleted.Name)
+
+	if removed := reconcileDurations - testutil.CollectAndCount(reconcileDuration); removed != 2 {
+		t.Errorf("Expected the 2 reconcile duration series of the deleted cluster to be removed, got %d", removed)
+	}
+	if removed := objectChanges - testutil.CollectAndCount(dryRunObjectChanges); removed != 1 {
+		t.Errorf("Expected the dry-run changes of the deleted cluster to be removed, got %d series", removed)
+	}
+	if removed := deferredReconciles - testutil.CollectAndCount(etcdReconcilesDeferredForBackup); removed != 1 {
+		t.Errorf("Expected the deferred reconciles of the deleted cluster to be removed, got %d series", removed)
+	}
+}
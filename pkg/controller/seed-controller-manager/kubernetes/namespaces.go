@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/etcd"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// reconcileByNamespace groups the given reconciler factories by the namespace hinted for
+// each of their objects and reconciles every group into its namespace. For clusters
+// without a split control plane, all objects end up in the cluster namespace.
+func reconcileByNamespace[F ~func() (string, R), R any](cluster *kubermaticv1.Cluster, factories []F, reconcile func(namespace string, factories []F) error) error {
+	grouped := map[string][]F{}
+	for _, factory := range factories {
+		name, _ := factory()
+		namespace := etcd.ObjectNamespace(cluster, name)
+		grouped[namespace] = append(grouped[namespace], factory)
+	}
+
+	for _, namespace := range resources.ControlPlaneNamespaces(cluster) {
+		if len(grouped[namespace]) == 0 {
+			continue
+		}
+
+		if err := reconcile(namespace, grouped[namespace]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureEtcdNamespaceExists creates the dedicated etcd namespace for clusters with a split
+// control plane. Like the cluster namespace, it is owned by the Cluster object.
+func (r *Reconciler) ensureEtcdNamespaceExists(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
+	if !resources.IsSplitControlPlane(cluster) {
+		return nil
+	}
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            resources.EtcdNamespaceName(cluster),
+			OwnerReferences: []metav1.OwnerReference{r.getOwnerRefForCluster(cluster)},
+		},
+	}
+
+	err := r.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(ns), &corev1.Namespace{})
+	if err == nil {
+		return nil
+	}
+	if ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	log.Infow("Creating etcd namespace", "namespace", ns.Name)
+	if err := r.Create(ctx, ns); ctrlruntimeclient.IgnoreAlreadyExists(err) != nil {
+		return fmt.Errorf("failed to create Namespace %s: %w", ns.Name, err)
+	}
+
+	return nil
+}
+
+const (
+	// namespaceRecoveryMinBackoff is the initial delay before checking again whether a
+	// terminating cluster namespace is gone.
+	namespaceRecoveryMinBackoff = 5 * time.Second
+	// namespaceRecoveryMaxBackoff caps the delay for namespaces that take long to finalize.
+	namespaceRecoveryMaxBackoff = time.Minute
+)
+
+func namespaceIsTerminating(ns *corev1.Namespace) bool {
+	return ns.DeletionTimestamp != nil || ns.Status.Phase == corev1.NamespaceTerminating
+}
+
+// namespaceRecoveryBackoff returns the delay before checking a terminating namespace again.
+// The delay doubles with the time the namespace has been terminating.
+func namespaceRecoveryBackoff(ns *corev1.Namespace, now time.Time) time.Duration {
+	var terminating time.Duration
+	if ns.DeletionTimestamp != nil {
+		terminating = now.Sub(ns.DeletionTimestamp.Time)
+	}
+
+	backoff := namespaceRecoveryMinBackoff
+	for backoff < terminating && backoff < namespaceRecoveryMaxBackoff {
+		backoff *= 2
+	}
+
+	return min(backoff, namespaceRecoveryMaxBackoff)
+}
+
+// reconcileNamespaceRecovery defers reconciling the control plane while the cluster namespace
+// is terminating, e.g. because it was deleted out-of-band. Objects cannot be created in a
+// terminating namespace, so the cluster is requeued until the namespace is gone and can be
+// recreated by ensureNamespaceExists. A nil result means the namespace is usable.
+func (r *Reconciler) reconcileNamespaceRecovery(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, namespace *corev1.Namespace, now time.Time) (*reconcile.Result, error) {
+	if !namespaceIsTerminating(namespace) {
+		if !cluster.Status.HasConditionValue(kubermaticv1.ClusterConditionNamespaceRecovering, corev1.ConditionTrue) {
+			return nil, nil
+		}
+
+		log.Infow("Cluster namespace has been recreated", "namespace", namespace.Name)
+
+		return nil, kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+			kubermaticv1helper.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionNamespaceRecovering, corev1.ConditionFalse, "NamespaceRecreated", "The cluster namespace has been recreated.")
+		})
+	}
+
+	backoff := namespaceRecoveryBackoff(namespace, now)
+	log.Infow("Cluster namespace is terminating, deferring reconciliation", "namespace", namespace.Name, "retry-after", backoff)
+
+	if !cluster.Status.HasConditionValue(kubermaticv1.ClusterConditionNamespaceRecovering, corev1.ConditionTrue) {
+		r.recorder.Eventf(cluster, corev1.EventTypeWarning, "NamespaceTerminating", "Cluster namespace %s is terminating, it will be recreated once it is gone", namespace.Name)
+	}
+
+	err := kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		kubermaticv1helper.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionNamespaceRecovering, corev1.ConditionTrue, "NamespaceTerminating",
+			fmt.Sprintf("The cluster namespace %s is terminating, the control plane will be recreated once it is gone.", namespace.Name))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update cluster status: %w", err)
+	}
+
+	return &reconcile.Result{RequeueAfter: backoff}, nil
+}
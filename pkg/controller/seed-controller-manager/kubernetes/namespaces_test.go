@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNamespaceRecoveryBackoff(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		terminatingFor  time.Duration
+		expectedBackoff time.Duration
+	}{
+		{
+			name:            "just deleted",
+			expectedBackoff: 5 * time.Second,
+		},
+		{
+			name:            "terminating for a while",
+			terminatingFor:  15 * time.Second,
+			expectedBackoff: 20 * time.Second,
+		},
+		{
+			name:            "terminating for long",
+			terminatingFor:  time.Hour,
+			expectedBackoff: time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					DeletionTimestamp: &metav1.Time{Time: now.Add(-tc.terminatingFor)},
+				},
+			}
+
+			if backoff := namespaceRecoveryBackoff(ns, now); backoff != tc.expectedBackoff {
+				t.Errorf("Expected backoff %v, got %v", tc.expectedBackoff, backoff)
+			}
+		})
+	}
+}
+
+func TestEnsureResourcesAreDeployedDefersForTerminatingNamespace(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: "cluster-test",
+		},
+	}
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              cluster.Status.NamespaceName,
+			DeletionTimestamp: &metav1.Time{Time: time.Now()},
+			Finalizers:        []string{"kubernetes"},
+		},
+		Status: corev1.NamespaceStatus{
+			Phase: corev1.NamespaceTerminating,
+		},
+	}
+
+	client := fake.NewClientBuilder().
+		WithObjects(cluster, namespace).
+		WithStatusSubresource(cluster).
+		Build()
+
+	r := &Reconciler{
+		log:      zap.NewNop().Sugar(),
+		Client:   client,
+		recorder: record.NewFakeRecorder(10),
+		seedGetter: func() (*kubermaticv1.Seed, error) {
+			t.Error("Expected reconciliation to be deferred while the namespace is terminating")
+			return nil, errors.New("reconciliation was not deferred")
+		},
+	}
+
+	ctx := context.Background()
+
+	res, err := r.ensureResourcesAreDeployed(ctx, cluster, namespace)
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	if res == nil || res.RequeueAfter <= 0 {
+		t.Fatalf("Expected the cluster to be requeued, got %v", res)
+	}
+
+	updated := &kubermaticv1.Cluster{}
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), updated); err != nil {
+		t.Fatalf("Failed to get cluster: %v", err)
+	}
+
+	if !updated.Status.HasConditionValue(kubermaticv1.ClusterConditionNamespaceRecovering, corev1.ConditionTrue) {
+		t.Errorf("Expected %s condition to be true, got %v", kubermaticv1.ClusterConditionNamespaceRecovering, updated.Status.Conditions)
+	}
+
+	// once the namespace has been recreated, the condition is cleared
+	recreated := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: cluster.Status.NamespaceName}}
+
+	res, err = r.reconcileNamespaceRecovery(ctx, r.log, updated, recreated, time.Now())
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+	if res != nil {
+		t.Errorf("Expected reconciliation to continue, got %v", res)
+	}
+
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), updated); err != nil {
+		t.Fatalf("Failed to get cluster: %v", err)
+	}
+
+	if !updated.Status.HasConditionValue(kubermaticv1.ClusterConditionNamespaceRecovering, corev1.ConditionFalse) {
+		t.Errorf("Expected %s condition to be false, got %v", kubermaticv1.ClusterConditionNamespaceRecovering, updated.Status.Conditions)
+	}
+}
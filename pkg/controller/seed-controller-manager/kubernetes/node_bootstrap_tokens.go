@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// nodeBootstrapTokenLabel marks the bootstrap token Secrets in the user cluster that have
+	// been issued by this controller.
+	nodeBootstrapTokenLabel = "kubermatic.k8c.io/node-bootstrap-token"
+	// nodeBootstrapTokenCreatedAnnotation records when a node bootstrap token has been issued.
+	nodeBootstrapTokenCreatedAnnotation = "kubermatic.k8c.io/node-bootstrap-token-created"
+
+	// nodeBootstrapTokenGroups are the extra groups of node bootstrap tokens. The machine-controller
+	// group is bound to the permissions nodes need to join the cluster.
+	nodeBootstrapTokenGroups = "system:bootstrappers:machine-controller:default-node-token"
+)
+
+// ValidateNodeBootstrapTokenRotation ensures that rotated node bootstrap tokens remain valid
+// for some time, as otherwise nodes which are joining while a token is rotated would fail.
+func ValidateNodeBootstrapTokenRotation(interval, gracePeriod time.Duration) error {
+	if interval < 0 {
+		return errors.New("rotation interval must not be negative")
+	}
+
+	if interval > 0 && gracePeriod <= 0 {
+		return errors.New("grace period must be positive when node bootstrap tokens are rotated")
+	}
+
+	return nil
+}
+
+// reconcileNodeBootstrapTokens issues the node bootstrap tokens of a user cluster once its
+// apiserver is up.
+func (r *Reconciler) reconcileNodeBootstrapTokens(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	if cluster.Status.ExtendedHealth.Apiserver != kubermaticv1.HealthStatusUp {
+		return nil
+	}
+
+	client, err := r.userClusterConnProvider.GetClient(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to get user cluster client: %w", err)
+	}
+
+	return ensureNodeBootstrapTokens(ctx, client, r.nodeBootstrapTokenInterval, r.nodeBootstrapTokenGracePeriod, time.Now())
+}
+
+// ensureNodeBootstrapTokens issues a new bootstrap token Secret in the kube-system namespace of the
+// user cluster whenever the current one is older than the rotation interval. Every token expires
+// once its successor has been valid for the grace period, so nodes which are joining with it can
+// finish. The apiserver rejects expired tokens and the token cleaner of the controller-manager
+// deletes them; tokens that are still around are deleted here as well. The tokens are signing
+// the cluster-info ConfigMap, so nodes can join using them.
+func ensureNodeBootstrapTokens(ctx context.Context, client ctrlruntimeclient.Client, interval, gracePeriod time.Duration, now time.Time) error {
+	secrets := &corev1.SecretList{}
+	if err := client.List(ctx, secrets, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem), ctrlruntimeclient.HasLabels{nodeBootstrapTokenLabel}); err != nil {
+		return fmt.Errorf("failed to list node bootstrap tokens: %w", err)
+	}
+
+	var latest time.Time
+	for i, secret := range secrets.Items {
+		created, createdErr := time.Parse(time.RFC3339, secret.Annotations[nodeBootstrapTokenCreatedAnnotation])
+		expiration, expirationErr := time.Parse(time.RFC3339, string(secret.Data["expiration"]))
+
+		if interval <= 0 || createdErr != nil || expirationErr != nil || !now.Before(expiration) {
+			if err := client.Delete(ctx, &secrets.Items[i]); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed to delete node bootstrap token %s: %w", secret.Name, err)
+			}
+			continue
+		}
+
+		if created.After(latest) {
+			latest = created
+		}
+	}
+
+	if interval <= 0 || (!latest.IsZero() && now.Sub(latest) < interval) {
+		return nil
+	}
+
+	id, token, _ := strings.Cut(kubernetes.GenerateToken(), ".")
+	now = now.UTC().Truncate(time.Second)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bootstrap-token-" + id,
+			Namespace: metav1.NamespaceSystem,
+			Labels: map[string]string{
+				nodeBootstrapTokenLabel: "true",
+			},
+			Annotations: map[string]string{
+				nodeBootstrapTokenCreatedAnnotation: now.Format(time.RFC3339),
+			},
+		},
+		Type: corev1.SecretTypeBootstrapToken,
+		Data: map[string][]byte{
+			"description":                    []byte("Node bootstrap token issued by Kubermatic."),
+			"token-id":                       []byte(id),
+			"token-secret":                   []byte(token),
+			"expiration":                     []byte(now.Add(interval + gracePeriod).Format(time.RFC3339)),
+			"usage-bootstrap-authentication": []byte("true"),
+			"usage-bootstrap-signing":        []byte("true"),
+			"auth-extra-groups":              []byte(nodeBootstrapTokenGroups),
+		},
+	}
+
+	if err := client.Create(ctx, secret); err != nil {
+		return fmt.Errorf("failed to create node bootstrap token: %w", err)
+	}
+
+	return nil
+}
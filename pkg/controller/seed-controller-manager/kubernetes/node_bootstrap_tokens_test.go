@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func listNodeBootstrapTokens(t *testing.T, client ctrlruntimeclient.Client) []corev1.Secret {
+	t.Helper()
+
+	secrets := &corev1.SecretList{}
+	if err := client.List(context.Background(), secrets, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		t.Fatalf("Failed to list Secrets: %v", err)
+	}
+
+	sort.Slice(secrets.Items, func(i, j int) bool {
+		return secrets.Items[i].Annotations[nodeBootstrapTokenCreatedAnnotation] < secrets.Items[j].Annotations[nodeBootstrapTokenCreatedAnnotation]
+	})
+
+	return secrets.Items
+}
+
+func TestNodeBootstrapTokenRotation(t *testing.T) {
+	const (
+		interval    = 24 * time.Hour
+		gracePeriod = 2 * time.Hour
+	)
+
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ensure := func(now time.Time) []corev1.Secret {
+		t.Helper()
+
+		if err := ensureNodeBootstrapTokens(ctx, client, interval, gracePeriod, now); err != nil {
+			t.Fatalf("Failed to ensure node bootstrap tokens: %v", err)
+		}
+
+		return listNodeBootstrapTokens(t, client)
+	}
+
+	// the first token is issued right away
+	tokens := ensure(start)
+	if len(tokens) != 1 {
+		t.Fatalf("Expected one token to be issued, got %d", len(tokens))
+	}
+	first := tokens[0]
+
+	if first.Type != corev1.SecretTypeBootstrapToken {
+		t.Errorf("Expected a bootstrap token Secret, got type %q", first.Type)
+	}
+	if name := "bootstrap-token-" + string(first.Data["token-id"]); first.Name != name {
+		t.Errorf("Expected the Secret to be named %q, got %q", name, first.Name)
+	}
+	if expiration := string(first.Data["expiration"]); expiration != start.Add(interval+gracePeriod).Format(time.RFC3339) {
+		t.Errorf("Expected the token to expire after the interval and the grace period, got %q", expiration)
+	}
+	if groups := string(first.Data["auth-extra-groups"]); groups != nodeBootstrapTokenGroups {
+		t.Errorf("Expected the token to be bound to the node bootstrapper group, got %q", groups)
+	}
+
+	// nothing changes within the rotation interval
+	tokens = ensure(start.Add(interval - time.Minute))
+	if len(tokens) != 1 || tokens[0].Name != first.Name {
+		t.Fatalf("Expected the token to be kept within the rotation interval, got %d tokens", len(tokens))
+	}
+
+	// once the interval has passed, a new token is issued, but nodes which are
+	// currently joining with the old one must still be able to authenticate
+	rotatedAt := start.Add(interval)
+	tokens = ensure(rotatedAt)
+	if len(tokens) != 2 || tokens[0].Name != first.Name {
+		t.Fatalf("Expected the old token to remain valid after the rotation, got %d tokens", len(tokens))
+	}
+	second := tokens[1]
+
+	tokens = ensure(rotatedAt.Add(gracePeriod - time.Minute))
+	if len(tokens) != 2 {
+		t.Fatalf("Expected the old token to remain valid during the grace period, got %d tokens", len(tokens))
+	}
+
+	// after the grace period, the old token is deleted
+	tokens = ensure(rotatedAt.Add(gracePeriod))
+	if len(tokens) != 1 || tokens[0].Name != second.Name {
+		t.Fatalf("Expected only the new token to remain after the grace period, got %d tokens", len(tokens))
+	}
+
+	// disabling the rotation revokes all node bootstrap tokens
+	if err := ensureNodeBootstrapTokens(ctx, client, 0, gracePeriod, rotatedAt.Add(interval)); err != nil {
+		t.Fatalf("Failed to ensure node bootstrap tokens: %v", err)
+	}
+	if tokens := listNodeBootstrapTokens(t, client); len(tokens) != 0 {
+		t.Fatalf("Expected no tokens when the rotation is disabled, got %d", len(tokens))
+	}
+}
+
+func TestNodeBootstrapTokensIgnoreOtherSecrets(t *testing.T) {
+	foreign := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bootstrap-token-abcdef",
+			Namespace: metav1.NamespaceSystem,
+		},
+		Type: corev1.SecretTypeBootstrapToken,
+		Data: map[string][]byte{
+			"token-id":     []byte("abcdef"),
+			"token-secret": []byte("0123456789abcdef"),
+		},
+	}
+
+	client := fake.NewClientBuilder().WithObjects(foreign).Build()
+	if err := ensureNodeBootstrapTokens(context.Background(), client, 0, time.Hour, time.Now()); err != nil {
+		t.Fatalf("Failed to ensure node bootstrap tokens: %v", err)
+	}
+
+	if err := client.Get(context.Background(), ctrlruntimeclient.ObjectKeyFromObject(foreign), &corev1.Secret{}); err != nil {
+		t.Errorf("Expected bootstrap tokens not issued by Kubermatic to be kept: %v", err)
+	}
+}
+
+func TestValidateNodeBootstrapTokenRotation(t *testing.T) {
+	tests := []struct {
+		name        string
+		interval    time.Duration
+		gracePeriod time.Duration
+		valid       bool
+	}{
+		{
+			name:  "disabled",
+			valid: true,
+		},
+		{
+			name:        "rotation with grace period",
+			interval:    24 * time.Hour,
+			gracePeriod: 2 * time.Hour,
+			valid:       true,
+		},
+		{
+			name:     "rotation without grace period",
+			interval: 24 * time.Hour,
+			valid:    false,
+		},
+		{
+			name:        "negative interval",
+			interval:    -time.Hour,
+			gracePeriod: time.Hour,
+			valid:       false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateNodeBootstrapTokenRotation(test.interval, test.gracePeriod)
+
+			if (err == nil) != test.valid {
+				t.Errorf("Expected valid to be %v, got error %v", test.valid, err)
+			}
+		})
+	}
+}
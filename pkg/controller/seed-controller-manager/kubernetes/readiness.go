@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
+	"k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// ReasonComponentsNotReady is the reason of the ClusterReady condition if at least one
+	// critical control plane component is not ready.
+	ReasonComponentsNotReady = "ComponentsNotReady"
+)
+
+// readinessChecks maps every control plane component that can be declared critical to
+// the function determining whether it is ready. A check returns an empty string if the
+// component is ready and otherwise a human readable explanation why it is not.
+var readinessChecks = map[string]func(ctx context.Context, r *Reconciler, cluster *kubermaticv1.Cluster) (string, error){
+	resources.EtcdStatefulSetName:             etcdReadiness,
+	resources.ApiserverDeploymentName:         apiserverReadiness,
+	resources.ControllerManagerDeploymentName: deploymentReadiness(resources.ControllerManagerDeploymentName),
+	resources.SchedulerDeploymentName:         deploymentReadiness(resources.SchedulerDeploymentName),
+}
+
+// DefaultCriticalComponents returns the control plane components that need to be ready
+// before a cluster is considered ready, if nothing else is configured.
+func DefaultCriticalComponents() sets.Set[string] {
+	return sets.KeySet(readinessChecks)
+}
+
+// ValidateCriticalComponents ensures that the given set of critical components is not
+// empty and only contains components whose readiness can be determined.
+func ValidateCriticalComponents(components sets.Set[string]) error {
+	if components.Len() == 0 {
+		return fmt.Errorf("at least one critical component must be configured")
+	}
+
+	if unknown := components.Difference(sets.KeySet(readinessChecks)); unknown.Len() > 0 {
+		return fmt.Errorf("unknown components %v, must be one of %v", sets.List(unknown), sets.List(sets.KeySet(readinessChecks)))
+	}
+
+	return nil
+}
+
+// updateClusterReadyCondition aggregates the readiness of all critical control plane
+// components into the ClusterReady condition.
+func (r *Reconciler) updateClusterReadyCondition(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	components := r.criticalComponents
+	if components.Len() == 0 {
+		components = DefaultCriticalComponents()
+	}
+
+	var blocking []string
+
+	for _, component := range sets.List(components) {
+		check, ok := readinessChecks[component]
+		if !ok {
+			return fmt.Errorf("unknown critical component %q", component)
+		}
+
+		problem, err := check(ctx, r, cluster)
+		if err != nil {
+			return fmt.Errorf("failed to determine readiness of %s: %w", component, err)
+		}
+
+		if problem != "" {
+			blocking = append(blocking, problem)
+		}
+	}
+
+	status := corev1.ConditionTrue
+	reason := ""
+	message := "All critical control plane components are ready."
+
+	if len(blocking) > 0 {
+		status = corev1.ConditionFalse
+		reason = ReasonComponentsNotReady
+		message = fmt.Sprintf("Waiting for control plane components: %s.", strings.Join(blocking, "; "))
+	}
+
+	return kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		kubermaticv1helper.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionClusterReady, status, reason, message)
+	})
+}
+
+// etcdReadiness requires enough ready etcd members to form a quorum.
+func etcdReadiness(ctx context.Context, r *Reconciler, cluster *kubermaticv1.Cluster) (string, error) {
+	name := resources.EtcdStatefulSetName
+
+	set := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: resources.EtcdNamespaceName(cluster), Name: name}, set); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Sprintf("%s does not exist yet", name), nil
+		}
+		return "", err
+	}
+
+	replicas := int32(1)
+	if set.Spec.Replicas != nil {
+		replicas = *set.Spec.Replicas
+	}
+
+	quorum := replicas/2 + 1
+	if set.Status.ReadyReplicas < quorum {
+		return fmt.Sprintf("%s has %d of %d members ready, %d are required for quorum", name, set.Status.ReadyReplicas, replicas, quorum), nil
+	}
+
+	return "", nil
+}
+
+// apiserverReadiness requires the apiserver Deployment to report itself as available.
+func apiserverReadiness(ctx context.Context, r *Reconciler, cluster *kubermaticv1.Cluster) (string, error) {
+	name := resources.ApiserverDeploymentName
+
+	deployment := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: name}, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Sprintf("%s does not exist yet", name), nil
+		}
+		return "", err
+	}
+
+	cond := kubernetes.GetDeploymentCondition(deployment.Status, appsv1.DeploymentAvailable)
+	if cond == nil || cond.Status != corev1.ConditionTrue || deployment.Status.AvailableReplicas == 0 {
+		return fmt.Sprintf("%s is not available", name), nil
+	}
+
+	return "", nil
+}
+
+// deploymentReadiness requires at least one ready replica of the given Deployment.
+func deploymentReadiness(name string) func(ctx context.Context, r *Reconciler, cluster *kubermaticv1.Cluster) (string, error) {
+	return func(ctx context.Context, r *Reconciler, cluster *kubermaticv1.Cluster) (string, error) {
+		deployment := &appsv1.Deployment{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: name}, deployment); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Sprintf("%s does not exist yet", name), nil
+			}
+			return "", err
+		}
+
+		if deployment.Status.ReadyReplicas == 0 {
+			return fmt.Sprintf("%s has no ready replicas", name), nil
+		}
+
+		return "", nil
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func readinessTestDeployment(name string, ready int32, available bool) *appsv1.Deployment {
+	availableStatus := corev1.ConditionFalse
+	if available {
+		availableStatus = corev1.ConditionTrue
+	}
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: hibernationTestNamespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](2),
+		},
+		Status: appsv1.DeploymentStatus{
+			ReadyReplicas:     ready,
+			AvailableReplicas: ready,
+			Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: availableStatus},
+			},
+		},
+	}
+}
+
+func readinessTestEtcd(ready int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.EtcdStatefulSetName,
+			Namespace: hibernationTestNamespace,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To[int32](3),
+		},
+		Status: appsv1.StatefulSetStatus{
+			ReadyReplicas: ready,
+		},
+	}
+}
+
+func TestUpdateClusterReadyCondition(t *testing.T) {
+	testCases := []struct {
+		name               string
+		criticalComponents sets.Set[string]
+		objects            []ctrlruntimeclient.Object
+		expectedStatus     corev1.ConditionStatus
+		expectedMessage    string
+	}{
+		{
+			name:            "nothing has been created yet",
+			expectedStatus:  corev1.ConditionFalse,
+			expectedMessage: "Waiting for control plane components: apiserver does not exist yet; controller-manager does not exist yet; etcd does not exist yet; scheduler does not exist yet.",
+		},
+		{
+			name: "all components are ready",
+			objects: []ctrlruntimeclient.Object{
+				readinessTestEtcd(3),
+				readinessTestDeployment(resources.ApiserverDeploymentName, 2, true),
+				readinessTestDeployment(resources.ControllerManagerDeploymentName, 1, true),
+				readinessTestDeployment(resources.SchedulerDeploymentName, 1, true),
+			},
+			expectedStatus:  corev1.ConditionTrue,
+			expectedMessage: "All critical control plane components are ready.",
+		},
+		{
+			name: "etcd has quorum while one member is still starting",
+			objects: []ctrlruntimeclient.Object{
+				readinessTestEtcd(2),
+				readinessTestDeployment(resources.ApiserverDeploymentName, 2, true),
+				readinessTestDeployment(resources.ControllerManagerDeploymentName, 1, true),
+				readinessTestDeployment(resources.SchedulerDeploymentName, 1, true),
+			},
+			expectedStatus:  corev1.ConditionTrue,
+			expectedMessage: "All critical control plane components are ready.",
+		},
+		{
+			name: "etcd has lost quorum",
+			objects: []ctrlruntimeclient.Object{
+				readinessTestEtcd(1),
+				readinessTestDeployment(resources.ApiserverDeploymentName, 2, true),
+				readinessTestDeployment(resources.ControllerManagerDeploymentName, 1, true),
+				readinessTestDeployment(resources.SchedulerDeploymentName, 1, true),
+			},
+			expectedStatus:  corev1.ConditionFalse,
+			expectedMessage: "Waiting for control plane components: etcd has 1 of 3 members ready, 2 are required for quorum.",
+		},
+		{
+			name: "created but not ready components are blocking",
+			objects: []ctrlruntimeclient.Object{
+				readinessTestEtcd(3),
+				readinessTestDeployment(resources.ApiserverDeploymentName, 0, false),
+				readinessTestDeployment(resources.ControllerManagerDeploymentName, 1, true),
+				readinessTestDeployment(resources.SchedulerDeploymentName, 0, false),
+			},
+			expectedStatus:  corev1.ConditionFalse,
+			expectedMessage: "Waiting for control plane components: apiserver is not available; scheduler has no ready replicas.",
+		},
+		{
+			name:               "only configured critical components are considered",
+			criticalComponents: sets.New(resources.EtcdStatefulSetName, resources.ApiserverDeploymentName),
+			objects: []ctrlruntimeclient.Object{
+				readinessTestEtcd(3),
+				readinessTestDeployment(resources.ApiserverDeploymentName, 2, true),
+				readinessTestDeployment(resources.SchedulerDeploymentName, 0, false),
+			},
+			expectedStatus:  corev1.ConditionTrue,
+			expectedMessage: "All critical control plane components are ready.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			cluster := hibernationTestCluster()
+
+			client := fake.NewClientBuilder().
+				WithObjects(append(tc.objects, cluster)...).
+				WithStatusSubresource(&kubermaticv1.Cluster{}).
+				Build()
+
+			r := &Reconciler{
+				log:                zap.NewNop().Sugar(),
+				Client:             client,
+				criticalComponents: tc.criticalComponents,
+			}
+
+			if err := r.updateClusterReadyCondition(ctx, cluster); err != nil {
+				t.Fatalf("Failed to update condition: %v", err)
+			}
+
+			updated := &kubermaticv1.Cluster{}
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), updated); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			cond, ok := updated.Status.Conditions[kubermaticv1.ClusterConditionClusterReady]
+			if !ok {
+				t.Fatal("Expected ClusterReady condition to be set, but it is missing")
+			}
+
+			if cond.Status != tc.expectedStatus {
+				t.Errorf("Expected condition status %q, but got %q", tc.expectedStatus, cond.Status)
+			}
+
+			if cond.Message != tc.expectedMessage {
+				t.Errorf("Expected condition message %q, but got %q", tc.expectedMessage, cond.Message)
+			}
+		})
+	}
+}
+
+func TestValidateCriticalComponents(t *testing.T) {
+	testCases := []struct {
+		name       string
+		components sets.Set[string]
+		expectErr  bool
+	}{
+		{
+			name:       "defaults",
+			components: DefaultCriticalComponents(),
+		},
+		{
+			name:       "subset",
+			components: sets.New(resources.EtcdStatefulSetName),
+		},
+		{
+			name:       "empty",
+			components: sets.New[string](),
+			expectErr:  true,
+		},
+		{
+			name:       "unknown component",
+			components: sets.New(resources.EtcdStatefulSetName, "konnectivity"),
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateCriticalComponents(tc.components)
+			if (err != nil) != tc.expectErr {
+				t.Errorf("Expected error = %v, but got %v", tc.expectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reconcileTimeResolution is how often the reconcile timestamps in the cluster status
+// are updated at most. Recording every single pass would cause a status write for each
+// reconciliation of every cluster.
+const reconcileTimeResolution = time.Minute
+
+// recordReconcileTime updates the last (successful) reconcile time in the cluster status.
+// Failing to do so is only logged, as it must not affect the reconciliation itself.
+func (r *Reconciler) recordReconcileTime(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, now time.Time, successful bool) {
+	updateAttempt := isReconcileTimeOutdated(cluster.Status.LastReconcileTime, now)
+	updateSuccess := successful && isReconcileTimeOutdated(cluster.Status.LastSuccessfulReconcileTime, now)
+
+	// save the status update if neither timestamp is outdated yet
+	if !updateAttempt && !updateSuccess {
+		return
+	}
+
+	err := kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		if updateAttempt {
+			c.Status.LastReconcileTime = metav1.NewTime(now)
+		}
+		if updateSuccess {
+			c.Status.LastSuccessfulReconcileTime = metav1.NewTime(now)
+		}
+	})
+	if err != nil {
+		log.Infow("Failed to record reconcile time", zap.Error(err))
+	}
+}
+
+func isReconcileTimeOutdated(last metav1.Time, now time.Time) bool {
+	return last.IsZero() || now.Sub(last.Time) >= reconcileTimeResolution
+}
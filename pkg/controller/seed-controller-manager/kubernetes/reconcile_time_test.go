@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRecordReconcileTime(t *testing.T) {
+	last := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name                   string
+		now                    time.Time
+		successful             bool
+		expectedAttemptTime    time.Time
+		expectedSuccessfulTime time.Time
+	}{
+		{
+			name:                   "successful reconciliation",
+			now:                    last.Add(5 * time.Minute),
+			successful:             true,
+			expectedAttemptTime:    last.Add(5 * time.Minute),
+			expectedSuccessfulTime: last.Add(5 * time.Minute),
+		},
+		{
+			name:                   "failed reconciliation",
+			now:                    last.Add(5 * time.Minute),
+			successful:             false,
+			expectedAttemptTime:    last.Add(5 * time.Minute),
+			expectedSuccessfulTime: last,
+		},
+		{
+			name:                   "successful reconciliation within the resolution",
+			now:                    last.Add(30 * time.Second),
+			successful:             true,
+			expectedAttemptTime:    last,
+			expectedSuccessfulTime: last,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Status: kubermaticv1.ClusterStatus{
+					LastReconcileTime:           metav1.NewTime(last),
+					LastSuccessfulReconcileTime: metav1.NewTime(last),
+				},
+			}
+
+			client := fake.NewClientBuilder().
+				WithObjects(cluster).
+				WithStatusSubresource(cluster).
+				Build()
+
+			r := &Reconciler{
+				Client: client,
+			}
+
+			r.recordReconcileTime(ctx, zap.NewNop().Sugar(), cluster, tc.now, tc.successful)
+
+			updated := &kubermaticv1.Cluster{}
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), updated); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			if !updated.Status.LastReconcileTime.Time.Equal(tc.expectedAttemptTime) {
+				t.Errorf("Expected last reconcile time to be %v, got %v", tc.expectedAttemptTime, updated.Status.LastReconcileTime)
+			}
+
+			if !updated.Status.LastSuccessfulReconcileTime.Time.Equal(tc.expectedSuccessfulTime) {
+				t.Errorf("Expected last successful reconcile time to be %v, got %v", tc.expectedSuccessfulTime, updated.Status.LastSuccessfulReconcileTime)
+			}
+		})
+	}
+}
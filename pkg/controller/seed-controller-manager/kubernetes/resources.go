@@ -26,11 +26,11 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
-	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/apiserver"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
@@ -40,6 +40,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/resources/csi"
 	"k8c.io/kubermatic/v2/pkg/resources/dns"
 	"k8c.io/kubermatic/v2/pkg/resources/etcd"
+	"k8c.io/kubermatic/v2/pkg/resources/eventexporter"
 	"k8c.io/kubermatic/v2/pkg/resources/gatekeeper"
 	"k8c.io/kubermatic/v2/pkg/resources/konnectivity"
 	kubernetesdashboard "k8c.io/kubermatic/v2/pkg/resources/kubernetes-dashboard"
@@ -56,12 +57,16 @@ import (
 	"k8c.io/reconciler/pkg/reconciling"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -69,9 +74,23 @@ import (
 
 const (
 	clusterIPUnknownRetryTimeout = 5 * time.Second
+	etcdRolloutLimitRetryPeriod  = 30 * time.Second
 )
 
 func (r *Reconciler) ensureResourcesAreDeployed(ctx context.Context, cluster *kubermaticv1.Cluster, namespace *corev1.Namespace) (*reconcile.Result, error) {
+	// the reconcile time is recorded for every pass, the successful reconcile
+	// time only if all resources have been reconciled
+	reconciled := false
+	defer func() {
+		r.recordReconcileTime(ctx, r.log.With("cluster", cluster.Name), cluster, time.Now(), reconciled)
+	}()
+
+	// the cluster namespace might have been deleted out-of-band, in which case
+	// no objects can be created until it is gone and has been recreated
+	if res, err := r.reconcileNamespaceRecovery(ctx, r.log.With("cluster", cluster.Name), cluster, namespace, time.Now()); err != nil || res != nil {
+		return res, err
+	}
+
 	seed, err := r.seedGetter()
 	if err != nil {
 		return nil, err
@@ -123,17 +142,59 @@ func (r *Reconciler) ensureResourcesAreDeployed(ctx context.Context, cluster *ku
 		return nil, err
 	}
 
-	// check that all StatefulSets are created
+	// disruptive changes to control plane components are only rolled out
+	// during the cluster's maintenance window
+	gate, err := newRolloutGate(cluster, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// images that have not been attested are never rolled out
+	imageGate := newImageGate(ctx, r.imageVerifier)
+
+	// a hibernated control plane is scaled up in order, before anything else
+	// gets to restore the number of replicas
+	if isHibernated(cluster) {
+		if res, err := r.wakeUpControlPlane(ctx, r.log.With("cluster", cluster.Name), cluster); err != nil || res != nil {
+			return res, err
+		}
+	}
+
+	// check that all StatefulSets are created; the result is only non-empty
+	// if updating them had to be deferred
+	result := &reconcile.Result{}
 	if ok, err := r.statefulSetHealthCheck(ctx, cluster); !ok || err != nil {
 		r.log.Debug("Skipping reconcile for StatefulSets, etcd is not healthy yet")
-	} else if err := r.ensureStatefulSets(ctx, cluster, data); err != nil {
+	} else if res, err := r.ensureStatefulSets(ctx, cluster, data, gate, imageGate); err != nil {
 		return nil, err
+	} else if res != nil {
+		result = res
 	}
 
 	if err := r.ensureEtcdBackupConfigs(ctx, cluster, data, seed); err != nil {
 		return nil, err
 	}
 
+	// an on-demand etcd snapshot only delays the next reconciliation, it does not
+	// block rolling out anything else
+	if res, err := r.reconcileEtcdSnapshot(ctx, r.log.With("cluster", cluster.Name), cluster); err != nil {
+		return nil, fmt.Errorf("failed to reconcile etcd snapshot: %w", err)
+	} else if res != nil && (result.RequeueAfter == 0 || res.RequeueAfter < result.RequeueAfter) {
+		result.RequeueAfter = res.RequeueAfter
+	}
+
+	if res, err := r.reconcileEtcdVolumeExpansion(ctx, r.log.With("cluster", cluster.Name), cluster); err != nil {
+		return nil, fmt.Errorf("failed to reconcile etcd volume expansion: %w", err)
+	} else if res != nil && (result.RequeueAfter == 0 || res.RequeueAfter < result.RequeueAfter) {
+		result.RequeueAfter = res.RequeueAfter
+	}
+
+	if res, err := r.reconcileEtcdMemberReplacement(ctx, r.log.With("cluster", cluster.Name), cluster); err != nil {
+		return nil, fmt.Errorf("failed to reconcile etcd member replacement: %w", err)
+	} else if res != nil && (result.RequeueAfter == 0 || res.RequeueAfter < result.RequeueAfter) {
+		result.RequeueAfter = res.RequeueAfter
+	}
+
 	// Wait until the cloud provider infra is ready before attempting
 	// to render the cloud-config
 	// TODO: Model resource deployment as a DAG so we don't need hacks
@@ -149,11 +210,60 @@ func (r *Reconciler) ensureResourcesAreDeployed(ctx context.Context, cluster *ku
 		return nil, err
 	}
 
+	// make sure all components agree on the service network before rolling any of them out
+	if err := r.ensureServiceCIDRConsistency(data); err != nil {
+		return nil, err
+	}
+
+	// machine-controller is only deployed once the cloud provider accepts the credentials
+	credentialsValid, err := r.ensureCloudCredentialsValid(ctx, cluster, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if !credentialsValid && (result.RequeueAfter == 0 || invalidCredentialsRetryPeriod < result.RequeueAfter) {
+		result.RequeueAfter = invalidCredentialsRetryPeriod
+	}
+
 	// check that all Deployments are available
-	if err := r.ensureDeployments(ctx, cluster, data); err != nil {
+	if err := r.ensureDeployments(ctx, cluster, data, gate, imageGate, credentialsValid); err != nil {
 		return nil, err
 	}
 
+	if err := r.reconcileNodeBootstrapTokens(ctx, cluster); err != nil {
+		return nil, fmt.Errorf("failed to reconcile node bootstrap tokens: %w", err)
+	}
+
+	if err := r.updateAPIServerRequestLimitsStatus(ctx, cluster, data); err != nil {
+		return nil, fmt.Errorf("failed to update apiserver request limits status: %w", err)
+	}
+
+	if err := r.updateComponentVersionsStatus(ctx, cluster); err != nil {
+		return nil, fmt.Errorf("failed to update component versions status: %w", err)
+	}
+
+	if err := r.updateClusterReadyCondition(ctx, cluster); err != nil {
+		return nil, fmt.Errorf("failed to update cluster ready condition: %w", err)
+	}
+
+	if err := r.updateRolloutsDeferredCondition(ctx, cluster, gate); err != nil {
+		return nil, fmt.Errorf("failed to update deferred rollouts condition: %w", err)
+	}
+
+	if err := r.updateRolloutsBlockedCondition(ctx, cluster, imageGate); err != nil {
+		return nil, fmt.Errorf("failed to update blocked rollouts condition: %w", err)
+	}
+
+	if len(gate.Deferred()) > 0 && (result.RequeueAfter == 0 || gate.retryAfter < result.RequeueAfter) {
+		result.RequeueAfter = gate.retryAfter
+	}
+
+	// node bootstrap tokens are rotated and pruned based on their age, so they have to be
+	// reconciled at least once per grace period
+	if r.nodeBootstrapTokenInterval > 0 && (result.RequeueAfter == 0 || r.nodeBootstrapTokenGracePeriod < result.RequeueAfter) {
+		result.RequeueAfter = r.nodeBootstrapTokenGracePeriod
+	}
+
 	// check that all CronJobs are created
 	if err := r.ensureCronJobs(ctx, cluster, data); err != nil {
 		return nil, err
@@ -164,6 +274,11 @@ func (r *Reconciler) ensureResourcesAreDeployed(ctx context.Context, cluster *ku
 		return nil, err
 	}
 
+	// check that all HorizontalPodAutoscalers are created
+	if err := r.ensureHorizontalPodAutoscalers(ctx, cluster, data); err != nil {
+		return nil, err
+	}
+
 	// check that all VerticalPodAutoscalers are created
 	if err := r.ensureVerticalPodAutoscalers(ctx, cluster, data); err != nil {
 		return nil, err
@@ -214,30 +329,56 @@ func (r *Reconciler) ensureResourcesAreDeployed(ctx context.Context, cluster *ku
 		}
 	}
 
-	return &reconcile.Result{}, nil
+	reconciled = true
+
+	return result, nil
 }
 
 func (r *Reconciler) getClusterTemplateData(ctx context.Context, cluster *kubermaticv1.Cluster, seed *kubermaticv1.Seed, config *kubermaticv1.KubermaticConfiguration) (*resources.TemplateData, error) {
-	datacenter, found := seed.Spec.Datacenters[cluster.Spec.Cloud.DatacenterName]
+	datacenter, datacenterName, found := kubermaticv1helper.ResolveDatacenter(seed, cluster.Spec.Cloud.DatacenterName)
 	if !found {
 		return nil, fmt.Errorf("failed to get datacenter %s", cluster.Spec.Cloud.DatacenterName)
 	}
 
-	supportsFailureDomainZoneAntiAffinity, err := resources.SupportsFailureDomainZoneAntiAffinity(ctx, r.Client)
+	if datacenterName != cluster.Spec.Cloud.DatacenterName {
+		r.recorder.Eventf(cluster, corev1.EventTypeWarning, "DatacenterRenamed",
+			"Datacenter %q has been renamed to %q, the cluster spec should be migrated to the new name", cluster.Spec.Cloud.DatacenterName, datacenterName)
+	}
+
+	failureDomainZones, err := resources.FailureDomainZones(ctx, r.Client)
 	if err != nil {
 		return nil, err
 	}
 
+	resourceOverrides, err := resources.GetResourceOverrides(ctx, r.Client, cluster.Status.NamespaceName)
+	if err != nil {
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "InvalidResourceOverrides", err.Error())
+		return nil, err
+	}
+
 	konnectivityEnabled := cluster.Spec.ClusterNetwork.KonnectivityEnabled != nil && *cluster.Spec.ClusterNetwork.KonnectivityEnabled //nolint:staticcheck
 
+	var imageOverrides map[string]string
+	if r.features.ControlPlaneImageOverrides {
+		imageOverrides = cluster.Spec.ImageOverrides
+	}
+
+	// refuse to deploy a control plane whose components would run skewed versions, e.g. after a
+	// botched update, instead of rolling out some components and leaving others behind
+	if err := resources.ValidateControlPlaneVersions(cluster, imageOverrides); err != nil {
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "ControlPlaneVersionSkew", err.Error())
+		return nil, fmt.Errorf("control plane versions are skewed: %w", err)
+	}
+
 	return resources.NewTemplateDataBuilder().
 		WithContext(ctx).
 		WithClient(r).
 		WithCluster(cluster).
-		WithDatacenter(&datacenter).
+		WithDatacenter(datacenter).
 		WithSeed(seed.DeepCopy()).
 		WithKubermaticConfiguration(config.DeepCopy()).
 		WithOverwriteRegistry(r.overwriteRegistry).
+		WithImageOverrides(imageOverrides).
 		WithNodePortRange(config.Spec.UserCluster.NodePortRange).
 		WithNodeAccessNetwork(r.nodeAccessNetwork).
 		WithEtcdDiskSize(r.etcdDiskSize).
@@ -253,11 +394,30 @@ func (r *Reconciler) getClusterTemplateData(ctx context.Context, cluster *kuberm
 		WithMachineControllerImageTag(r.machineControllerImageTag).
 		WithMachineControllerImageRepository(r.machineControllerImageRepository).
 		WithBackupPeriod(r.backupSchedule).
-		WithFailureDomainZoneAntiaffinity(supportsFailureDomainZoneAntiAffinity).
+		WithBackupCount(r.backupCount).
+		WithEtcdBackupDestination(defaultEtcdBackupDestination(seed)).
+		WithEtcdDefragSchedule(r.etcdDefragSchedule).
+		WithServiceAccountKeyRotationGracePeriod(r.saKeyRotationGracePeriod).
+		WithAPIServerGracefulTermination(r.apiserverShutdownDelay, r.apiserverTerminationGracePeriod).
+		WithSidecarInjections(r.sidecarInjections).
+		WithResourceOverrides(resourceOverrides).
+		WithEventExporterSink(r.eventExporterSink).
+		WithExternalDNSAnnotations(r.features.ExternalDNSAnnotations).
+		WithFailureDomainZoneAntiaffinity(failureDomainZones > 0).
+		WithFailureDomainZones(failureDomainZones).
 		WithVersions(r.versions).
 		Build(), nil
 }
 
+// defaultEtcdBackupDestination returns the seed's default etcd backup destination, if any.
+func defaultEtcdBackupDestination(seed *kubermaticv1.Seed) *kubermaticv1.BackupDestination {
+	if seed.Spec.EtcdBackupRestore == nil {
+		return nil
+	}
+
+	return seed.GetEtcdBackupDestination(seed.Spec.EtcdBackupRestore.DefaultDestination)
+}
+
 // reconcileClusterNamespace will ensure that the cluster namespace is
 // correctly initialized and created.
 func (r *Reconciler) reconcileClusterNamespace(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*corev1.Namespace, error) {
@@ -279,15 +439,29 @@ func (r *Reconciler) reconcileClusterNamespace(ctx context.Context, log *zap.Sug
 		return nil, fmt.Errorf("failed to update cluster namespace status: %w", err)
 	}
 
+	if err := r.ensureEtcdNamespaceExists(ctx, log, cluster); err != nil {
+		return nil, fmt.Errorf("failed to ensure etcd namespace: %w", err)
+	}
+
 	return namespace, nil
 }
 
+// updateAPIServerRequestLimitsStatus exposes the in-flight request ceilings the apiserver
+// has been configured with in the cluster status.
+func (r *Reconciler) updateAPIServerRequestLimitsStatus(ctx context.Context, cluster *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	limits := data.APIServerRequestLimits()
+	if current := cluster.Status.APIServerRequestLimits; current != nil && *current == limits {
+		return nil
+	}
+
+	return kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		c.Status.APIServerRequestLimits = &limits
+	})
+}
+
 // ensureNamespaceExists will create the cluster namespace.
 func (r *Reconciler) ensureNamespaceExists(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*corev1.Namespace, error) {
-	namespace := cluster.Status.NamespaceName
-	if namespace == "" {
-		namespace = kubernetesprovider.NamespaceName(cluster.Name)
-	}
+	namespace := resources.NamespaceName(cluster)
 
 	ns := &corev1.Namespace{}
 	err := r.Get(ctx, types.NamespacedName{Name: namespace}, ns)
@@ -345,7 +519,7 @@ func GetServiceReconcilers(data *resources.TemplateData) []reconciling.NamedServ
 	apiServerServiceType := data.DC().Spec.APIServerServiceType
 
 	creators := []reconciling.NamedServiceReconcilerFactory{
-		apiserver.ServiceReconciler(data.Cluster().Spec.ExposeStrategy, extName, apiServerServiceType),
+		apiserver.ServiceReconciler(data.Cluster().Spec.ExposeStrategy, extName, apiServerServiceType, data.ExternalDNSHostname()),
 		etcd.ServiceReconciler(data),
 		userclusterwebhook.ServiceReconciler(),
 		operatingsystemmanager.ServiceReconciler(),
@@ -373,8 +547,13 @@ func GetServiceReconcilers(data *resources.TemplateData) []reconciling.NamedServ
 }
 
 func (r *Reconciler) ensureServices(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer newReconcileDurationTimer(c, "services").ObserveDuration()
+
 	creators := GetServiceReconcilers(data)
-	return reconciling.ReconcileServices(ctx, creators, c.Status.NamespaceName, r)
+
+	return reconcileByNamespace(c, creators, func(namespace string, creators []reconciling.NamedServiceReconcilerFactory) error {
+		return reconciling.ReconcileServices(ctx, creators, namespace, r, resources.ManagedByModifier())
+	})
 }
 
 // GetDeploymentReconcilers returns all DeploymentReconcilers that are currently in use.
@@ -427,10 +606,16 @@ func GetDeploymentReconcilers(data *resources.TemplateData, enableAPIserverOIDCA
 		)
 	}
 
+	if data.EventExporterSink() != "" {
+		deployments = append(deployments, eventexporter.DeploymentReconciler(data))
+	}
+
 	return deployments
 }
 
-func (r *Reconciler) ensureDeployments(ctx context.Context, cluster *kubermaticv1.Cluster, data *resources.TemplateData) error {
+func (r *Reconciler) ensureDeployments(ctx context.Context, cluster *kubermaticv1.Cluster, data *resources.TemplateData, gate *rolloutGate, imageGate *imageGate, deployMachineController bool) error {
+	defer newReconcileDurationTimer(cluster, "deployments").ObserveDuration()
+
 	if cluster.Spec.Cloud.ProviderName == string(kubermaticv1.AzureCloudProvider) {
 		if err := r.migrateAzureCCM(ctx, cluster); err != nil {
 			return fmt.Errorf("failed to migrate Azure CCM Deployment: %w", err)
@@ -438,7 +623,24 @@ func (r *Reconciler) ensureDeployments(ctx context.Context, cluster *kubermaticv
 	}
 
 	creators := GetDeploymentReconcilers(data, r.features.KubernetesOIDCAuthentication, r.versions)
-	return reconciling.ReconcileDeployments(ctx, creators, cluster.Status.NamespaceName, r)
+
+	if !deployMachineController {
+		creators = withoutDeployment(creators, resources.MachineControllerDeploymentName)
+	}
+
+	if r.features.APIServerExternalProbe {
+		creators = append(creators, apiserver.ExternalProbeDeploymentReconciler(data))
+	} else if err := r.ensureAPIServerExternalProbeIsRemoved(ctx, cluster); err != nil {
+		return err
+	}
+
+	if data.EventExporterSink() == "" {
+		if err := r.ensureEventExporterIsRemoved(ctx, cluster); err != nil {
+			return err
+		}
+	}
+
+	return reconciling.ReconcileDeployments(ctx, creators, cluster.Status.NamespaceName, r, resources.ManagedByModifier(), resources.SidecarInjectionModifier(data.SidecarInjections()), resources.ResourceOverridesModifier(data.ResourceOverrides()), resources.ResourceLimitPolicyModifier(data.ResourceLimitPolicy()), resources.ControlPlaneDNSModifier(data.ControlPlaneDNS()), imageGate.Modifier(), gate.Modifier())
 }
 
 // In #13180 and its backports the label selectors for the Azure CCM were fixed, but since they are
@@ -474,13 +676,17 @@ func (r *Reconciler) GetSecretReconcilers(ctx context.Context, data *resources.T
 		cloudconfig.SecretReconciler(data, resources.CloudConfigSecretName),
 		certificates.RootCAReconciler(data),
 		certificates.FrontProxyCAReconciler(),
+		certificates.EtcdMetricsCAReconciler(),
+		certificates.ClusterCABundleSecretReconciler(data),
 		resources.ImagePullSecretReconciler(r.dockerPullConfigJSON),
 		apiserver.FrontProxyClientCertificateReconciler(data),
 		etcd.TLSCertificateReconciler(data),
+		etcd.PeerTLSCertificateReconciler(data),
+		etcd.MetricsTLSCertificateReconciler(data),
 		apiserver.EtcdClientCertificateReconciler(data),
 		apiserver.TLSServingCertificateReconciler(data),
 		apiserver.KubeletClientCertificateReconciler(data),
-		apiserver.ServiceAccountKeyReconciler(),
+		apiserver.ServiceAccountKeyReconciler(data),
 		userclusterwebhook.TLSServingCertificateReconciler(data),
 
 		// Kubeconfigs
@@ -494,6 +700,7 @@ func (r *Reconciler) GetSecretReconcilers(ctx context.Context, data *resources.T
 		resources.GetInternalKubeconfigReconciler(namespace, resources.VMwareCloudDirectorCSIKubeconfigSecretName, resources.VMwareCloudDirectorCSICertUsername, nil, data, r.log),
 		resources.AdminKubeconfigReconciler(data),
 		apiserver.TokenViewerReconciler(),
+		apiserver.HealthCheckTokenReconciler(),
 		apiserver.TokenUsersReconciler(data),
 		resources.ViewerKubeconfigReconciler(data),
 
@@ -536,12 +743,21 @@ func (r *Reconciler) GetSecretReconcilers(ctx context.Context, data *resources.T
 			resources.GetInternalKubeconfigReconciler(namespace, resources.MetricsServerKubeconfigSecretName, resources.MetricsServerCertUsername, nil, data, r.log),
 			resources.GetInternalKubeconfigReconciler(namespace, resources.KubeletDnatControllerKubeconfigSecretName, resources.KubeletDnatControllerCertUsername, nil, data, r.log),
 		)
+		creators = append(creators, openvpn.MachineNetworkClientCertificateReconcilers(data)...)
 	}
 
 	if data.Cluster().Spec.AuditLogging != nil && data.Cluster().Spec.AuditLogging.Enabled {
 		creators = append(creators, apiserver.FluentBitSecretReconciler(data))
 	}
 
+	if data.Cluster().Spec.Authorization != nil && data.Cluster().Spec.Authorization.Webhook != nil {
+		creators = append(creators, apiserver.AuthorizationWebhookSecretReconciler(data))
+	}
+
+	if data.Cluster().Spec.AuditLogging != nil && data.Cluster().Spec.AuditLogging.WebhookBackend != nil && data.Cluster().Spec.AuditLogging.WebhookBackend.Endpoint != "" {
+		creators = append(creators, apiserver.AuditWebhookConfigSecretReconciler(data))
+	}
+
 	if data.Cluster().IsEncryptionEnabled() || data.Cluster().IsEncryptionActive() {
 		creators = append(creators, apiserver.EncryptionConfigurationSecretReconciler(data))
 	}
@@ -560,19 +776,112 @@ func (r *Reconciler) GetSecretReconcilers(ctx context.Context, data *resources.T
 		creators = append(creators, resources.ServiceAccountSecretReconciler(data))
 	}
 
+	if _, ok := resources.EtcdBackupStorageCredentialsKey(data.EtcdBackupDestination()); ok {
+		creators = append(creators, etcd.BackupStorageSecretReconciler(data))
+	}
+
 	return creators
 }
 
+// secretDependencies are the Secrets which are read while reconciling other Secrets, like
+// the CAs signing the certificates. They have to exist before all other Secrets.
+var secretDependencies = sets.New(
+	resources.CASecretName,
+	resources.FrontProxyCASecretName,
+	resources.EtcdMetricsCASecretName,
+	resources.OpenVPNCASecretName,
+	resources.ViewerTokenSecretName,
+	resources.ApiserverHealthCheckTokenSecretName,
+)
+
+// secretReconcilerTiers splits the Secrets into the secretDependencies and all remaining Secrets.
+// The Secrets within a tier do not depend on each other and can be reconciled concurrently.
+func secretReconcilerTiers(factories []reconciling.NamedSecretReconcilerFactory) [][]reconciling.NamedSecretReconcilerFactory {
+	var dependencies, dependents []reconciling.NamedSecretReconcilerFactory
+	for _, factory := range factories {
+		if name, _ := factory(); secretDependencies.Has(name) {
+			dependencies = append(dependencies, factory)
+		} else {
+			dependents = append(dependents, factory)
+		}
+	}
+
+	return [][]reconciling.NamedSecretReconcilerFactory{dependencies, dependents}
+}
+
+// reconcileSecretsConcurrently reconciles every Secret in its own goroutine, as reconciling
+// a new Secret waits until it has appeared in the cache.
+func (r *Reconciler) reconcileSecretsConcurrently(ctx context.Context, namespace string, factories []reconciling.NamedSecretReconcilerFactory) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for _, factory := range factories {
+		g.Go(func() error {
+			return reconciling.ReconcileSecrets(ctx, []reconciling.NamedSecretReconcilerFactory{factory}, namespace, r.Client, resources.ManagedByModifier(), resources.SecretRotationModifier())
+		})
+	}
+
+	return g.Wait()
+}
+
 func (r *Reconciler) ensureSecrets(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
-	namedSecretReconcilerFactories := r.GetSecretReconcilers(ctx, data)
+	defer newReconcileDurationTimer(c, "secrets").ObserveDuration()
+
+	for _, tier := range secretReconcilerTiers(r.GetSecretReconcilers(ctx, data)) {
+		err := reconcileByNamespace(c, tier, func(namespace string, creators []reconciling.NamedSecretReconcilerFactory) error {
+			return r.reconcileSecretsConcurrently(ctx, namespace, creators)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to ensure that the Secret exists: %w", err)
+		}
+	}
+
+	if !data.IsKonnectivityEnabled() {
+		if err := r.ensureRevokedMachineNetworkClientCertificatesAreRemoved(ctx, c); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := resources.EtcdBackupStorageCredentialsKey(data.EtcdBackupDestination()); !ok {
+		if err := r.ensureEtcdBackupStorageSecretIsRemoved(ctx, c); err != nil {
+			return err
+		}
+	}
 
-	if err := reconciling.ReconcileSecrets(ctx, namedSecretReconcilerFactories, c.Status.NamespaceName, r.Client); err != nil {
-		return fmt.Errorf("failed to ensure that the Secret exists: %w", err)
+	if resources.IsSplitControlPlane(c) {
+		if err := reconciling.ReconcileSecrets(ctx, r.getEtcdNamespaceSecretReconcilers(data), data.EtcdNamespace(), r.Client, resources.ManagedByModifier(), resources.SecretRotationModifier()); err != nil {
+			return fmt.Errorf("failed to ensure that the Secret exists in the etcd namespace: %w", err)
+		}
 	}
 
 	return nil
 }
 
+// ensureEtcdBackupStorageSecretIsRemoved removes the copy of the shared etcd backup
+// storage credentials once the seed no longer configures a backup destination with credentials.
+func (r *Reconciler) ensureEtcdBackupStorageSecretIsRemoved(ctx context.Context, c *kubermaticv1.Cluster) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.EtcdBackupStorageSecretName,
+			Namespace: c.Status.NamespaceName,
+		},
+	}
+
+	if err := r.Client.Delete(ctx, secret); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete etcd backup storage Secret: %w", err)
+	}
+
+	return nil
+}
+
+// getEtcdNamespaceSecretReconcilers returns the Secrets that etcd shares with the rest of
+// the control plane and that therefore also need to exist in a dedicated etcd namespace.
+func (r *Reconciler) getEtcdNamespaceSecretReconcilers(data *resources.TemplateData) []reconciling.NamedSecretReconcilerFactory {
+	return []reconciling.NamedSecretReconcilerFactory{
+		etcd.RootCACertificateReconciler(data),
+		resources.ImagePullSecretReconciler(r.dockerPullConfigJSON),
+		apiserver.EtcdClientCertificateReconciler(data),
+	}
+}
+
 func (r *Reconciler) ensureServiceAccounts(ctx context.Context, c *kubermaticv1.Cluster) error {
 	namedServiceAccountReconcilerFactories := []reconciling.NamedServiceAccountReconcilerFactory{
 		etcd.ServiceAccountReconciler,
@@ -594,15 +903,25 @@ func (r *Reconciler) ensureServiceAccounts(ctx context.Context, c *kubermaticv1.
 		namedServiceAccountReconcilerFactories = append(namedServiceAccountReconcilerFactories, nodeportproxy.ServiceAccountReconciler)
 	}
 
-	if err := reconciling.ReconcileServiceAccounts(ctx, namedServiceAccountReconcilerFactories, c.Status.NamespaceName, r.Client); err != nil {
+	if err := reconciling.ReconcileServiceAccounts(ctx, namedServiceAccountReconcilerFactories, c.Status.NamespaceName, r.Client, resources.ManagedByModifier()); err != nil {
 		return fmt.Errorf("failed to ensure ServiceAccounts: %w", err)
 	}
 
+	if resources.IsSplitControlPlane(c) {
+		namedEtcdServiceAccountReconcilerFactories := []reconciling.NamedServiceAccountReconcilerFactory{
+			etcd.ServiceAccountReconciler,
+		}
+
+		if err := reconciling.ReconcileServiceAccounts(ctx, namedEtcdServiceAccountReconcilerFactories, resources.EtcdNamespaceName(c), r.Client, resources.ManagedByModifier()); err != nil {
+			return fmt.Errorf("failed to ensure ServiceAccounts in etcd namespace: %w", err)
+		}
+	}
+
 	namedKubeSystemServiceAccountReconcilerFactories := []reconciling.NamedServiceAccountReconcilerFactory{
 		etcd.KubeSystemServiceAccountReconciler(c),
 	}
 
-	if err := reconciling.ReconcileServiceAccounts(ctx, namedKubeSystemServiceAccountReconcilerFactories, metav1.NamespaceSystem, r.Client); err != nil {
+	if err := reconciling.ReconcileServiceAccounts(ctx, namedKubeSystemServiceAccountReconcilerFactories, metav1.NamespaceSystem, r.Client, resources.ManagedByModifier()); err != nil {
 		return fmt.Errorf("failed to ensure ServiceAccounts in %s namespace: %w", metav1.NamespaceSystem, err)
 	}
 
@@ -612,13 +931,15 @@ func (r *Reconciler) ensureServiceAccounts(ctx context.Context, c *kubermaticv1.
 func (r *Reconciler) ensureRoles(ctx context.Context, c *kubermaticv1.Cluster) error {
 	namedRoleReconcilerFactories := []reconciling.NamedRoleReconcilerFactory{
 		usercluster.RoleReconciler,
+		resources.LeaderElectionRoleReconciler(resources.SchedulerLeaderElectionLeaseName),
+		resources.LeaderElectionRoleReconciler(resources.ControllerManagerLeaderElectionLeaseName),
 	}
 
 	if c.Spec.ExposeStrategy == kubermaticv1.ExposeStrategyLoadBalancer {
 		namedRoleReconcilerFactories = append(namedRoleReconcilerFactories, nodeportproxy.RoleReconciler)
 	}
 
-	if err := reconciling.ReconcileRoles(ctx, namedRoleReconcilerFactories, c.Status.NamespaceName, r.Client); err != nil {
+	if err := reconciling.ReconcileRoles(ctx, namedRoleReconcilerFactories, c.Status.NamespaceName, r.Client, resources.ManagedByModifier()); err != nil {
 		return fmt.Errorf("failed to ensure Roles: %w", err)
 	}
 
@@ -628,6 +949,8 @@ func (r *Reconciler) ensureRoles(ctx context.Context, c *kubermaticv1.Cluster) e
 func (r *Reconciler) ensureRoleBindings(ctx context.Context, c *kubermaticv1.Cluster) error {
 	namedRoleBindingReconcilerFactories := []reconciling.NamedRoleBindingReconcilerFactory{
 		usercluster.RoleBindingReconciler,
+		resources.LeaderElectionRoleBindingReconciler(resources.SchedulerLeaderElectionLeaseName, "system:kube-scheduler"),
+		resources.LeaderElectionRoleBindingReconciler(resources.ControllerManagerLeaderElectionLeaseName, "system:kube-controller-manager"),
 	}
 	if !c.Spec.DisableCSIDriver {
 		namedRoleBindingReconcilerFactories = append(namedRoleBindingReconcilerFactories, csi.RoleBindingsReconcilers(c)...)
@@ -637,7 +960,7 @@ func (r *Reconciler) ensureRoleBindings(ctx context.Context, c *kubermaticv1.Clu
 		namedRoleBindingReconcilerFactories = append(namedRoleBindingReconcilerFactories, nodeportproxy.RoleBindingReconciler)
 	}
 
-	if err := reconciling.ReconcileRoleBindings(ctx, namedRoleBindingReconcilerFactories, c.Status.NamespaceName, r.Client); err != nil {
+	if err := reconciling.ReconcileRoleBindings(ctx, namedRoleBindingReconcilerFactories, c.Status.NamespaceName, r.Client, resources.ManagedByModifier()); err != nil {
 		return fmt.Errorf("failed to ensure RoleBindings: %w", err)
 	}
 	return nil
@@ -653,7 +976,7 @@ func (r *Reconciler) ensureClusterRoles(ctx context.Context, c *kubermaticv1.Clu
 		namedClusterRoleReconcilerFactories = append(namedClusterRoleReconcilerFactories, csi.ClusterRolesReconcilers(c)...)
 	}
 
-	if err := reconciling.ReconcileClusterRoles(ctx, namedClusterRoleReconcilerFactories, "", r.Client); err != nil {
+	if err := reconciling.ReconcileClusterRoles(ctx, namedClusterRoleReconcilerFactories, "", r.Client, resources.ManagedByModifier()); err != nil {
 		return fmt.Errorf("failed to ensure Cluster Roles: %w", err)
 	}
 
@@ -665,7 +988,7 @@ func (r *Reconciler) ensureClusterRoleBindings(ctx context.Context, namespace *c
 		usercluster.ClusterRoleBinding(namespace),
 		userclusterwebhook.ClusterRoleBinding(namespace),
 	}
-	if err := reconciling.ReconcileClusterRoleBindings(ctx, namedClusterRoleBindingsReconcilerFactories, "", r.Client); err != nil {
+	if err := reconciling.ReconcileClusterRoleBindings(ctx, namedClusterRoleBindingsReconcilerFactories, "", r.Client, resources.ManagedByModifier()); err != nil {
 		return fmt.Errorf("failed to ensure Cluster Role Bindings: %w", err)
 	}
 
@@ -673,6 +996,8 @@ func (r *Reconciler) ensureClusterRoleBindings(ctx context.Context, namespace *c
 }
 
 func (r *Reconciler) ensureNetworkPolicies(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData, cfg *kubermaticv1.KubermaticConfiguration) error {
+	defer newReconcileDurationTimer(c, "network_policies").ObserveDuration()
+
 	if c.Spec.Features[kubermaticv1.ApiserverNetworkPolicy] {
 		namedNetworkPolicyReconcilerFactories := []reconciling.NamedNetworkPolicyReconcilerFactory{
 			apiserver.DenyAllPolicyReconciler(),
@@ -722,14 +1047,84 @@ func (r *Reconciler) ensureNetworkPolicies(ctx context.Context, c *kubermaticv1.
 
 		namedNetworkPolicyReconcilerFactories = append(namedNetworkPolicyReconcilerFactories, apiserver.SeedApiServerAllowReconciler(apiIPs))
 
-		if err := reconciling.ReconcileNetworkPolicies(ctx, namedNetworkPolicyReconcilerFactories, c.Status.NamespaceName, r.Client); err != nil {
+		if err := reconciling.ReconcileNetworkPolicies(ctx, namedNetworkPolicyReconcilerFactories, c.Status.NamespaceName, r.Client, resources.ManagedByModifier()); err != nil {
 			return fmt.Errorf("failed to ensure Network Policies: %w", err)
 		}
 	}
 
+	return r.ensureIngressNetworkPolicies(ctx, c, data, cfg.Namespace)
+}
+
+// ingressNetworkPolicies are the names of all NetworkPolicies that can be returned by
+// GetIngressNetworkPolicyReconcilers.
+var ingressNetworkPolicies = sets.New(
+	resources.NetworkPolicyDefaultDenyAllIngress,
+	resources.NetworkPolicyControlPlaneIngressAllow,
+	resources.NetworkPolicyApiserverIngressAllow,
+	resources.NetworkPolicyEtcdBackupIngressAllow,
+	resources.NetworkPolicyOpenVPNServerIngressAllow,
+	resources.NetworkPolicyNodePortProxyIngressAllow,
+)
+
+// ensureIngressNetworkPolicies isolates every control plane namespace on its own if the ingress
+// isolation is enabled. Policies that are not needed (anymore) are removed, so that disabling the
+// isolation or changing the cluster does not leave pods unreachable.
+func (r *Reconciler) ensureIngressNetworkPolicies(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData, kubermaticNamespace string) error {
+	var creators []reconciling.NamedNetworkPolicyReconcilerFactory
+	if r.controlPlaneIngressIsolation {
+		creators = GetIngressNetworkPolicyReconcilers(data, kubermaticNamespace)
+	}
+
+	obsolete := ingressNetworkPolicies.Clone()
+	for _, creator := range creators {
+		name, _ := creator()
+		obsolete.Delete(name)
+	}
+
+	for _, namespace := range resources.ControlPlaneNamespaces(c) {
+		if len(creators) > 0 {
+			if err := reconciling.ReconcileNetworkPolicies(ctx, creators, namespace, r.Client, resources.ManagedByModifier()); err != nil {
+				return fmt.Errorf("failed to ensure ingress Network Policies in namespace %s: %w", namespace, err)
+			}
+		}
+
+		for _, name := range sets.List(obsolete) {
+			if err := r.Client.Delete(ctx, &networkingv1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+			}); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to remove ingress Network Policy %s in namespace %s: %w", name, namespace, err)
+			}
+		}
+	}
+
 	return nil
 }
 
+// GetIngressNetworkPolicyReconcilers returns the NetworkPolicies that isolate the control plane
+// namespaces of a cluster. Only the externally exposed components, i.e. the apiserver, the
+// OpenVPN server and the Envoy of the nodeport-proxy, are reachable from anywhere.
+func GetIngressNetworkPolicyReconcilers(data *resources.TemplateData, kubermaticNamespace string) []reconciling.NamedNetworkPolicyReconcilerFactory {
+	creators := []reconciling.NamedNetworkPolicyReconcilerFactory{
+		resources.DefaultDenyAllIngressReconciler(),
+		resources.ControlPlaneIngressAllowReconciler(data.Cluster(), kubermaticNamespace),
+		apiserver.IngressAllowReconciler(),
+		etcd.BackupIngressAllowReconciler(data.Cluster()),
+	}
+
+	if !data.IsKonnectivityEnabled() {
+		creators = append(creators, openvpn.IngressAllowReconciler())
+	}
+
+	if data.Cluster().Spec.ExposeStrategy == kubermaticv1.ExposeStrategyLoadBalancer {
+		creators = append(creators, nodeportproxy.IngressAllowReconciler())
+	}
+
+	return creators
+}
+
 // GetConfigMapReconcilers returns all ConfigMapReconcilers that are currently in use.
 func GetConfigMapReconcilers(data *resources.TemplateData) []reconciling.NamedConfigMapReconcilerFactory {
 	creators := []reconciling.NamedConfigMapReconcilerFactory{
@@ -737,6 +1132,9 @@ func GetConfigMapReconcilers(data *resources.TemplateData) []reconciling.NamedCo
 		apiserver.AdmissionControlReconciler(data),
 		apiserver.CABundleReconciler(data),
 	}
+	if data.Cluster().Spec.ComponentsOverride.Scheduler.Configuration != "" {
+		creators = append(creators, scheduler.ConfigMapReconciler(data))
+	}
 	if !data.Cluster().Spec.DisableCSIDriver {
 		creators = append(creators, csi.ConfigMapsReconcilers(data)...)
 	}
@@ -750,16 +1148,39 @@ func GetConfigMapReconcilers(data *resources.TemplateData) []reconciling.NamedCo
 		)
 	}
 
+	if data.NodeRuntimeConfig() != "" {
+		creators = append(creators, operatingsystemmanager.NodeRuntimeConfigConfigMapReconciler(data))
+	}
+
+	if data.EventExporterSink() != "" {
+		creators = append(creators, eventexporter.ConfigMapReconciler(data))
+	}
+
 	return creators
 }
 
 func (r *Reconciler) ensureConfigMaps(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer newReconcileDurationTimer(c, "configmaps").ObserveDuration()
+
 	creators := GetConfigMapReconcilers(data)
 
-	if err := reconciling.ReconcileConfigMaps(ctx, creators, c.Status.NamespaceName, r.Client); err != nil {
+	if err := reconciling.ReconcileConfigMaps(ctx, creators, c.Status.NamespaceName, r.Client, resources.ManagedByModifier()); err != nil {
 		return fmt.Errorf("failed to ensure that the ConfigMap exists: %w", err)
 	}
 
+	if data.NodeRuntimeConfig() == "" {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.NodeRuntimeConfigConfigMapName,
+				Namespace: c.Status.NamespaceName,
+			},
+		}
+
+		if err := r.Delete(ctx, cm); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete node runtime config ConfigMap: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -782,7 +1203,13 @@ func GetEtcdBackupConfigReconcilers(data *resources.TemplateData, seed *kubermat
 func GetPodDisruptionBudgetReconcilers(data *resources.TemplateData) []reconciling.NamedPodDisruptionBudgetReconcilerFactory {
 	creators := []reconciling.NamedPodDisruptionBudgetReconcilerFactory{
 		etcd.PodDisruptionBudgetReconciler(data),
-		apiserver.PodDisruptionBudgetReconciler(),
+		apiserver.PodDisruptionBudgetReconciler(data),
+	}
+	if controllermanager.NeedsPodDisruptionBudget(data.Cluster()) {
+		creators = append(creators, controllermanager.PodDisruptionBudgetReconciler())
+	}
+	if scheduler.NeedsPodDisruptionBudget(data.Cluster()) {
+		creators = append(creators, scheduler.PodDisruptionBudgetReconciler())
 	}
 	if !data.IsKonnectivityEnabled() {
 		creators = append(creators,
@@ -799,33 +1226,132 @@ func GetPodDisruptionBudgetReconcilers(data *resources.TemplateData) []reconcili
 }
 
 func (r *Reconciler) ensurePodDisruptionBudgets(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer newReconcileDurationTimer(c, "pod_disruption_budgets").ObserveDuration()
+
 	creators := GetPodDisruptionBudgetReconcilers(data)
 
-	if err := reconciling.ReconcilePodDisruptionBudgets(ctx, creators, c.Status.NamespaceName, r.Client); err != nil {
+	err := reconcileByNamespace(c, creators, func(namespace string, creators []reconciling.NamedPodDisruptionBudgetReconcilerFactory) error {
+		return reconciling.ReconcilePodDisruptionBudgets(ctx, creators, namespace, r.Client, resources.ManagedByModifier())
+	})
+	if err != nil {
 		return fmt.Errorf("failed to ensure that the PodDisruptionBudget exists: %w", err)
 	}
 
+	return r.ensureControlPlanePodDisruptionBudgetsAreRemoved(ctx, c)
+}
+
+// ensureControlPlanePodDisruptionBudgetsAreRemoved removes the PDBs of the controller-manager
+// and scheduler once they are scaled down to a single replica again.
+func (r *Reconciler) ensureControlPlanePodDisruptionBudgetsAreRemoved(ctx context.Context, c *kubermaticv1.Cluster) error {
+	var names []string
+	if !controllermanager.NeedsPodDisruptionBudget(c) {
+		names = append(names, resources.ControllerManagerPodDisruptionBudgetName)
+	}
+	if !scheduler.NeedsPodDisruptionBudget(c) {
+		names = append(names, resources.SchedulerPodDisruptionBudgetName)
+	}
+
+	for _, name := range names {
+		pdb := &policyv1.PodDisruptionBudget{}
+		if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: c.Status.NamespaceName}, pdb); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get PodDisruptionBudget %s: %w", name, err)
+		}
+
+		if err := r.Client.Delete(ctx, pdb); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete PodDisruptionBudget %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// GetHorizontalPodAutoscalerReconcilers returns all HorizontalPodAutoscalerReconcilers that are currently in use.
+func GetHorizontalPodAutoscalerReconcilers(data *resources.TemplateData) []kkpreconciling.NamedHorizontalPodAutoscalerReconcilerFactory {
+	creators := []kkpreconciling.NamedHorizontalPodAutoscalerReconcilerFactory{}
+
+	if data.APIServerAutoscaling() != nil {
+		creators = append(creators, apiserver.HorizontalPodAutoscalerReconciler(data))
+	}
+
+	return creators
+}
+
+func (r *Reconciler) ensureHorizontalPodAutoscalers(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer newReconcileDurationTimer(c, "horizontal_pod_autoscalers").ObserveDuration()
+
+	creators := GetHorizontalPodAutoscalerReconcilers(data)
+
+	if err := kkpreconciling.ReconcileHorizontalPodAutoscalers(ctx, creators, c.Status.NamespaceName, r.Client, resources.ManagedByModifier()); err != nil {
+		return fmt.Errorf("failed to ensure that the HorizontalPodAutoscaler exists: %w", err)
+	}
+
+	if data.APIServerAutoscaling() != nil {
+		return nil
+	}
+
+	// the apiserver is not autoscaled (anymore)
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	if err := r.Get(ctx, types.NamespacedName{Name: resources.ApiserverHorizontalPodAutoscalerName, Namespace: c.Status.NamespaceName}, hpa); err != nil {
+		return ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if err := r.Delete(ctx, hpa); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete HorizontalPodAutoscaler %s: %w", hpa.Name, err)
+	}
+
 	return nil
 }
 
 // GetCronJobReconcilers returns all CronJobReconcilers that are currently in use.
 func GetCronJobReconcilers(data *resources.TemplateData) []reconciling.NamedCronJobReconcilerFactory {
-	return []reconciling.NamedCronJobReconcilerFactory{
-		etcd.CronJobReconciler(data),
+	creators := []reconciling.NamedCronJobReconcilerFactory{}
+
+	if data.IsEtcdDefragEnabled() {
+		creators = append(creators, etcd.CronJobReconciler(data))
 	}
+
+	return creators
 }
 
 func (r *Reconciler) ensureCronJobs(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer newReconcileDurationTimer(c, "cronjobs").ObserveDuration()
+
 	creators := GetCronJobReconcilers(data)
 
-	if err := reconciling.ReconcileCronJobs(ctx, creators, c.Status.NamespaceName, r.Client); err != nil {
+	err := reconcileByNamespace(c, creators, func(namespace string, creators []reconciling.NamedCronJobReconcilerFactory) error {
+		return reconciling.ReconcileCronJobs(ctx, creators, namespace, r.Client, resources.ManagedByModifier())
+	})
+	if err != nil {
 		return fmt.Errorf("failed to ensure that the CronJobs exists: %w", err)
 	}
 
+	if !data.IsEtcdDefragEnabled() {
+		if err := r.ensureEtcdDefragCronJobIsRemoved(ctx, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) ensureEtcdDefragCronJobIsRemoved(ctx context.Context, data *resources.TemplateData) error {
+	if err := r.Client.Delete(ctx, &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.EtcdDefragCronJobName,
+			Namespace: data.EtcdNamespace(),
+		},
+	}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to ensure etcd defragger CronJob is removed/not present: %w", err)
+	}
 	return nil
 }
 
 func (r *Reconciler) ensureVerticalPodAutoscalers(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer newReconcileDurationTimer(c, "vertical_pod_autoscalers").ObserveDuration()
+
 	controlPlaneDeploymentNames := []string{
 		resources.ApiserverDeploymentName,
 		resources.ControllerManagerDeploymentName,
@@ -848,30 +1374,108 @@ func (r *Reconciler) ensureVerticalPodAutoscalers(ctx context.Context, c *kuberm
 		)
 	}
 
-	creators, err := resources.GetVerticalPodAutoscalersForAll(ctx, r.Client, controlPlaneDeploymentNames, []string{resources.EtcdStatefulSetName}, c.Status.NamespaceName, r.features.VPA)
+	// in a split control plane, etcd is autoscaled in its own namespace
+	controlPlaneStatefulSetNames := []string{resources.EtcdStatefulSetName}
+	if resources.IsSplitControlPlane(c) {
+		controlPlaneStatefulSetNames = nil
+	}
+
+	creators, err := resources.GetVerticalPodAutoscalersForAll(ctx, r.Client, controlPlaneDeploymentNames, controlPlaneStatefulSetNames, c.Status.NamespaceName, r.features.VPA)
 	if err != nil {
 		return fmt.Errorf("failed to create the functions to handle VPA resources: %w", err)
 	}
 
-	return kkpreconciling.ReconcileVerticalPodAutoscalers(ctx, creators, c.Status.NamespaceName, r.Client)
+	if err := kkpreconciling.ReconcileVerticalPodAutoscalers(ctx, creators, c.Status.NamespaceName, r.Client, resources.ManagedByModifier()); err != nil {
+		return err
+	}
+
+	if resources.IsSplitControlPlane(c) {
+		etcdCreators, err := resources.GetVerticalPodAutoscalersForAll(ctx, r.Client, nil, []string{resources.EtcdStatefulSetName}, data.EtcdNamespace(), r.features.VPA)
+		if err != nil {
+			return fmt.Errorf("failed to create the functions to handle etcd VPA resources: %w", err)
+		}
+
+		return kkpreconciling.ReconcileVerticalPodAutoscalers(ctx, etcdCreators, data.EtcdNamespace(), r.Client, resources.ManagedByModifier())
+	}
+
+	return nil
 }
 
-func (r *Reconciler) ensureStatefulSets(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+// ensureStatefulSets reconciles the etcd StatefulSet. As long as an etcd backup is running,
+// no changes are made and a requeue is requested instead, so that the backup is not
+// corrupted by etcd being rolled.
+func (r *Reconciler) ensureStatefulSets(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData, gate *rolloutGate, imageGate *imageGate) (*reconcile.Result, error) {
+	defer newReconcileDurationTimer(c, "statefulsets").ObserveDuration()
+
+	backupInProgress, err := r.etcdBackupInProgress(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if backupInProgress {
+		r.log.Debugw("Deferring reconcile for StatefulSets, etcd backup is in progress", "cluster", c.Name)
+		etcdReconcilesDeferredForBackup.WithLabelValues(c.Name).Inc()
+		return &reconcile.Result{RequeueAfter: etcdBackupInProgressRetryPeriod}, nil
+	}
+
+	if err := r.checkEtcdDiskSize(ctx, c, data); err != nil {
+		return nil, err
+	}
+
 	useTLSOnly, err := r.etcdUseStrictTLS(ctx, c)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	creators := GetStatefulSetReconcilers(data, r.features.EtcdDataCorruptionChecks, useTLSOnly)
+	modifiers := []reconciling.ObjectModifier{
+		resources.ManagedByModifier(),
+		resources.SidecarInjectionModifier(data.SidecarInjections()),
+		resources.ResourceOverridesModifier(data.ResourceOverrides()),
+		resources.ResourceLimitPolicyModifier(data.ResourceLimitPolicy()),
+		resources.ControlPlaneDNSModifier(data.ControlPlaneDNS()),
+		imageGate.Modifier(),
+		gate.Modifier(),
+	}
+
+	if r.etcdRolloutLimiter != nil {
+		// find out if reconciling would roll etcd, without applying anything yet
+		pending := false
+		err := reconcileByNamespace(c, creators, func(namespace string, creators []reconciling.NamedStatefulSetReconcilerFactory) error {
+			namespacePending, err := etcdRolloutPending(ctx, r.Client, namespace, creators, modifiers...)
+			pending = pending || namespacePending
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to check for pending etcd rollout: %w", err)
+		}
+
+		rolling, err := r.etcdRolloutInProgress(ctx, c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check etcd rollout: %w", err)
+		}
 
-	return reconciling.ReconcileStatefulSets(ctx, creators, c.Status.NamespaceName, r.Client)
+		if rolling || pending {
+			if !r.etcdRolloutLimiter.TryAcquire(c.Name) {
+				r.log.Debugw("Deferring reconcile for StatefulSets, too many etcd rollouts in progress", "cluster", c.Name)
+				return &reconcile.Result{RequeueAfter: etcdRolloutLimitRetryPeriod}, nil
+			}
+		} else {
+			r.etcdRolloutLimiter.Release(c.Name)
+		}
+	}
+
+	return nil, reconcileByNamespace(c, creators, func(namespace string, creators []reconciling.NamedStatefulSetReconcilerFactory) error {
+		return reconciling.ReconcileStatefulSets(ctx, creators, namespace, r.Client, modifiers...)
+	})
 }
 
 func (r *Reconciler) ensureEtcdBackupConfigs(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData,
 	seed *kubermaticv1.Seed) error {
+	defer newReconcileDurationTimer(c, "etcd_backup_configs").ObserveDuration()
+
 	if seed.IsDefaultEtcdAutomaticBackupEnabled() {
 		creators := GetEtcdBackupConfigReconcilers(data, seed)
-		return kkpreconciling.ReconcileEtcdBackupConfigs(ctx, creators, c.Status.NamespaceName, r.Client)
+		return kkpreconciling.ReconcileEtcdBackupConfigs(ctx, creators, c.Status.NamespaceName, r.Client, resources.ManagedByModifier())
 	}
 	// If default etcd automatic backups are not enabled, remove them if any
 	ebc := &kubermaticv1.EtcdBackupConfig{}
@@ -931,6 +1535,12 @@ func (r *Reconciler) ensureOpenVPNSetupIsRemoved(ctx context.Context, data *reso
 			return fmt.Errorf("failed to ensure dns-resolver resources are removed/not present: %w", err)
 		}
 	}
+	if err := r.Client.DeleteAllOf(ctx, &corev1.Secret{},
+		ctrlruntimeclient.InNamespace(data.Cluster().Status.NamespaceName),
+		ctrlruntimeclient.MatchingLabels(openvpn.MachineNetworkClientLabels()),
+	); err != nil {
+		return fmt.Errorf("failed to remove OpenVPN machine network client certificates: %w", err)
+	}
 	dnatControllerSecret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      resources.KubeletDnatControllerKubeconfigSecretName,
@@ -943,6 +1553,35 @@ func (r *Reconciler) ensureOpenVPNSetupIsRemoved(ctx context.Context, data *reso
 	return nil
 }
 
+// ensureRevokedMachineNetworkClientCertificatesAreRemoved deletes the OpenVPN client certificates
+// of machine networks that have been removed from the cluster, revoking their access.
+func (r *Reconciler) ensureRevokedMachineNetworkClientCertificatesAreRemoved(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	wanted := sets.New[string]()
+	for _, network := range cluster.Spec.MachineNetworks {
+		wanted.Insert(openvpn.MachineNetworkClientCertificatesSecretName(network.CIDR))
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := r.List(ctx, secrets,
+		ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName),
+		ctrlruntimeclient.MatchingLabels(openvpn.MachineNetworkClientLabels()),
+	); err != nil {
+		return fmt.Errorf("failed to list OpenVPN machine network client certificates: %w", err)
+	}
+
+	for i, secret := range secrets.Items {
+		if wanted.Has(secret.Name) {
+			continue
+		}
+
+		if err := r.Delete(ctx, &secrets.Items[i]); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete OpenVPN client certificate %q of removed machine network: %w", secret.Name, err)
+		}
+	}
+
+	return nil
+}
+
 func (r *Reconciler) ensureKonnectivitySetupIsRemoved(ctx context.Context, data *resources.TemplateData) error {
 	for _, resource := range konnectivity.ResourcesForDeletion(data.Cluster().Status.NamespaceName) {
 		if err := r.Client.Delete(ctx, resource); err != nil && !apierrors.IsNotFound(err) {
@@ -977,6 +1616,8 @@ func (r *Reconciler) ensureEncryptionConfigurationIsRemoved(ctx context.Context,
 }
 
 func (r *Reconciler) ensureRBAC(ctx context.Context, cluster *kubermaticv1.Cluster, namespace *corev1.Namespace) error {
+	defer newReconcileDurationTimer(cluster, "rbac").ObserveDuration()
+
 	if err := r.ensureServiceAccounts(ctx, cluster); err != nil {
 		return err
 	}
@@ -1062,10 +1703,13 @@ func hostnameToIPList(ctx context.Context, hostname string) ([]net.IP, error) {
 }
 
 func (r *Reconciler) ensureAuditWebhook(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
-	if data.DC().Spec.EnforcedAuditWebhookSettings != nil {
+	defer newReconcileDurationTimer(c, "audit_webhook").ObserveDuration()
+
+	// enforced settings with an endpoint are rendered into a secret along with the other secrets
+	if data.DC().Spec.EnforcedAuditWebhookSettings != nil && data.DC().Spec.EnforcedAuditWebhookSettings.AuditWebhookConfig != nil {
 		// if webhook backend is enabled on the DC then create the auditwebhookconfig secret in the user cluster ns.
 		creators := []reconciling.NamedSecretReconcilerFactory{r.auditWebhookSecretReconciler(ctx, data)}
-		if err := reconciling.ReconcileSecrets(ctx, creators, c.Status.NamespaceName, r.Client); err != nil {
+		if err := reconciling.ReconcileSecrets(ctx, creators, c.Status.NamespaceName, r.Client, resources.ManagedByModifier()); err != nil {
 			return err
 		}
 	}
@@ -18,6 +18,7 @@ package kubernetes
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
@@ -25,13 +26,20 @@ import (
 	"k8c.io/kubermatic/v2/pkg/resources/apiserver"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
 	"k8c.io/kubermatic/v2/pkg/resources/cloudcontroller"
+	"k8c.io/kubermatic/v2/pkg/resources/openvpn"
+	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
+	"k8c.io/kubermatic/v2/pkg/semver"
 	"k8c.io/kubermatic/v2/pkg/test/fake"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 )
 
 func TestCloudControllerManagerDeployment(t *testing.T) {
@@ -367,3 +375,358 @@ func (k KCMDeploymentConfig) Create(td *resources.TemplateData) *appsv1.Deployme
 	d.Spec.Template, _ = apiserver.IsRunningWrapper(td, d.Spec.Template, sets.New(resources.ControllerManagerDeploymentName))
 	return &d
 }
+
+func TestGetClusterTemplateDataResolvesRenamedDatacenter(t *testing.T) {
+	seed := &kubermaticv1.Seed{
+		Spec: kubermaticv1.SeedSpec{
+			Datacenters: map[string]kubermaticv1.Datacenter{
+				"hetzner-fsn1": {
+					Location:      "Falkenstein",
+					PreviousNames: []string{"fsn1"},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		datacenterName     string
+		expectedErr        bool
+		expectedLocation   string
+		expectedWarningNum int
+	}{
+		{
+			name:             "current datacenter name",
+			datacenterName:   "hetzner-fsn1",
+			expectedLocation: "Falkenstein",
+		},
+		{
+			name:               "previous datacenter name",
+			datacenterName:     "fsn1",
+			expectedLocation:   "Falkenstein",
+			expectedWarningNum: 1,
+		},
+		{
+			name:           "unknown datacenter",
+			datacenterName: "hetzner-hel1",
+			expectedErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-cluster",
+				},
+				Spec: kubermaticv1.ClusterSpec{
+					Cloud: kubermaticv1.CloudSpec{
+						DatacenterName: tc.datacenterName,
+					},
+					Version: *semver.NewSemverOrDie("1.31.0"),
+				},
+				Status: kubermaticv1.ClusterStatus{
+					Versions: kubermaticv1.ClusterVersionsStatus{
+						ControlPlane:      *semver.NewSemverOrDie("1.31.0"),
+						Apiserver:         *semver.NewSemverOrDie("1.31.0"),
+						ControllerManager: *semver.NewSemverOrDie("1.31.0"),
+						Scheduler:         *semver.NewSemverOrDie("1.31.0"),
+					},
+				},
+			}
+
+			recorder := record.NewFakeRecorder(10)
+			r := &Reconciler{
+				Client:   fake.NewClientBuilder().Build(),
+				recorder: recorder,
+			}
+
+			data, err := r.getClusterTemplateData(context.Background(), cluster, seed, &kubermaticv1.KubermaticConfiguration{})
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("Expected an error for an unknown datacenter, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if location := data.DC().Location; location != tc.expectedLocation {
+				t.Errorf("Expected datacenter location %q, got %q", tc.expectedLocation, location)
+			}
+
+			if len(recorder.Events) != tc.expectedWarningNum {
+				t.Errorf("Expected %d warning events, got %d", tc.expectedWarningNum, len(recorder.Events))
+			}
+		})
+	}
+}
+
+func TestEnsureRevokedMachineNetworkClientCertificatesAreRemoved(t *testing.T) {
+	const namespace = "cluster-test"
+
+	certificateSecret := func(cidr string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      openvpn.MachineNetworkClientCertificatesSecretName(cidr),
+				Namespace: namespace,
+				Labels:    openvpn.MachineNetworkClientLabels(),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		machineNetworks []string
+		existing        []string
+		expectedSecrets []string
+	}{
+		{
+			name:            "machine network added",
+			machineNetworks: []string{"192.168.1.0/24", "10.10.0.0/16"},
+			existing:        []string{"192.168.1.0/24"},
+			expectedSecrets: []string{
+				openvpn.MachineNetworkClientCertificatesSecretName("192.168.1.0/24"),
+				resources.OpenVPNClientCertificatesSecretName,
+			},
+		},
+		{
+			name:            "machine network removed",
+			machineNetworks: []string{"10.10.0.0/16"},
+			existing:        []string{"192.168.1.0/24", "10.10.0.0/16"},
+			expectedSecrets: []string{
+				openvpn.MachineNetworkClientCertificatesSecretName("10.10.0.0/16"),
+				resources.OpenVPNClientCertificatesSecretName,
+			},
+		},
+		{
+			name:            "all machine networks removed",
+			existing:        []string{"192.168.1.0/24", "10.10.0.0/16"},
+			expectedSecrets: []string{resources.OpenVPNClientCertificatesSecretName},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{}
+			cluster.Status.NamespaceName = namespace
+			for _, cidr := range tc.machineNetworks {
+				cluster.Spec.MachineNetworks = append(cluster.Spec.MachineNetworks, kubermaticv1.MachineNetworkingConfig{CIDR: cidr})
+			}
+
+			// the shared client certificate must never be touched
+			builder := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: resources.OpenVPNClientCertificatesSecretName, Namespace: namespace},
+			})
+			for _, cidr := range tc.existing {
+				builder.WithObjects(certificateSecret(cidr))
+			}
+
+			r := &Reconciler{Client: builder.Build()}
+
+			ctx := context.Background()
+			if err := r.ensureRevokedMachineNetworkClientCertificatesAreRemoved(ctx, cluster); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			secrets := &corev1.SecretList{}
+			if err := r.List(ctx, secrets); err != nil {
+				t.Fatalf("Failed to list Secrets: %v", err)
+			}
+
+			names := sets.New[string]()
+			for _, secret := range secrets.Items {
+				names.Insert(secret.Name)
+			}
+
+			if !names.Equal(sets.New(tc.expectedSecrets...)) {
+				t.Errorf("Expected Secrets %v, got %v", tc.expectedSecrets, sets.List(names))
+			}
+		})
+	}
+}
+
+func TestSecretReconcilerTiers(t *testing.T) {
+	secret := func(name string) reconciling.NamedSecretReconcilerFactory {
+		return func() (string, reconciling.SecretReconciler) {
+			return name, func(s *corev1.Secret) (*corev1.Secret, error) { return s, nil }
+		}
+	}
+
+	tiers := secretReconcilerTiers([]reconciling.NamedSecretReconcilerFactory{
+		secret(resources.ApiserverTLSSecretName),
+		secret(resources.CASecretName),
+		secret(resources.ViewerKubeconfigSecretName),
+		secret(resources.ViewerTokenSecretName),
+		secret(resources.ApiserverHealthCheckTokenSecretName),
+		secret(resources.OpenVPNCASecretName),
+	})
+
+	expected := [][]string{
+		{resources.CASecretName, resources.ViewerTokenSecretName, resources.ApiserverHealthCheckTokenSecretName, resources.OpenVPNCASecretName},
+		{resources.ApiserverTLSSecretName, resources.ViewerKubeconfigSecretName},
+	}
+
+	if len(tiers) != len(expected) {
+		t.Fatalf("Expected %d tiers, got %d", len(expected), len(tiers))
+	}
+
+	for i, tier := range tiers {
+		var names []string
+		for _, factory := range tier {
+			name, _ := factory()
+			names = append(names, name)
+		}
+
+		if !slices.Equal(names, expected[i]) {
+			t.Errorf("Expected tier %d to contain %v, got %v", i, expected[i], names)
+		}
+	}
+}
+
+func TestEnsureIngressNetworkPolicies(t *testing.T) {
+	const namespace = "cluster-test"
+
+	testCases := []struct {
+		name             string
+		isolation        bool
+		exposeStrategy   kubermaticv1.ExposeStrategy
+		konnectivity     bool
+		existing         []string
+		expectedPolicies []string
+	}{
+		{
+			name:           "NodePort expose strategy",
+			isolation:      true,
+			exposeStrategy: kubermaticv1.ExposeStrategyNodePort,
+			expectedPolicies: []string{
+				resources.NetworkPolicyDefaultDenyAllIngress,
+				resources.NetworkPolicyControlPlaneIngressAllow,
+				resources.NetworkPolicyApiserverIngressAllow,
+				resources.NetworkPolicyEtcdBackupIngressAllow,
+				resources.NetworkPolicyOpenVPNServerIngressAllow,
+			},
+		},
+		{
+			name:           "LoadBalancer expose strategy allows ingress to the nodeport-proxy",
+			isolation:      true,
+			exposeStrategy: kubermaticv1.ExposeStrategyLoadBalancer,
+			konnectivity:   true,
+			existing:       []string{resources.NetworkPolicyOpenVPNServerIngressAllow},
+			expectedPolicies: []string{
+				resources.NetworkPolicyDefaultDenyAllIngress,
+				resources.NetworkPolicyControlPlaneIngressAllow,
+				resources.NetworkPolicyApiserverIngressAllow,
+				resources.NetworkPolicyEtcdBackupIngressAllow,
+				resources.NetworkPolicyNodePortProxyIngressAllow,
+			},
+		},
+		{
+			name:           "isolation disabled",
+			exposeStrategy: kubermaticv1.ExposeStrategyLoadBalancer,
+			existing: []string{
+				resources.NetworkPolicyDefaultDenyAllIngress,
+				resources.NetworkPolicyControlPlaneIngressAllow,
+				resources.NetworkPolicyApiserverIngressAllow,
+				resources.NetworkPolicyNodePortProxyIngressAllow,
+				resources.NetworkPolicyApiserverInternalAllow,
+			},
+			// policies that are not part of the isolation must not be touched
+			expectedPolicies: []string{resources.NetworkPolicyApiserverInternalAllow},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			cluster := &kubermaticv1.Cluster{}
+			cluster.Status.NamespaceName = namespace
+			cluster.Spec.ExposeStrategy = tc.exposeStrategy
+
+			builder := fake.NewClientBuilder()
+			for _, name := range tc.existing {
+				builder.WithObjects(&networkingv1.NetworkPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				})
+			}
+
+			r := &Reconciler{
+				Client:                       builder.Build(),
+				controlPlaneIngressIsolation: tc.isolation,
+			}
+
+			data := resources.NewTemplateDataBuilder().
+				WithContext(ctx).
+				WithClient(r.Client).
+				WithCluster(cluster).
+				WithKonnectivityEnabled(tc.konnectivity).
+				Build()
+
+			if err := r.ensureIngressNetworkPolicies(ctx, cluster, data, "kubermatic"); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			policies := &networkingv1.NetworkPolicyList{}
+			if err := r.List(ctx, policies); err != nil {
+				t.Fatalf("Failed to list NetworkPolicies: %v", err)
+			}
+
+			names := sets.New[string]()
+			for _, policy := range policies.Items {
+				names.Insert(policy.Name)
+			}
+
+			if !names.Equal(sets.New(tc.expectedPolicies...)) {
+				t.Errorf("Expected NetworkPolicies %v, got %v", tc.expectedPolicies, sets.List(names))
+			}
+		})
+	}
+}
+
+func TestEnsureLeaderElectionRoles(t *testing.T) {
+	const namespace = "cluster-test"
+
+	ctx := context.Background()
+
+	cluster := &kubermaticv1.Cluster{}
+	cluster.Status.NamespaceName = namespace
+
+	r := &Reconciler{
+		Client: fake.NewClientBuilder().Build(),
+	}
+
+	if err := r.ensureRoles(ctx, cluster); err != nil {
+		t.Fatalf("Failed to ensure Roles: %v", err)
+	}
+
+	if err := r.ensureRoleBindings(ctx, cluster); err != nil {
+		t.Fatalf("Failed to ensure RoleBindings: %v", err)
+	}
+
+	for _, leaseName := range []string{resources.SchedulerLeaderElectionLeaseName, resources.ControllerManagerLeaderElectionLeaseName} {
+		key := types.NamespacedName{Namespace: namespace, Name: resources.LeaderElectionRoleName(leaseName)}
+
+		role := &rbacv1.Role{}
+		if err := r.Get(ctx, key, role); err != nil {
+			t.Fatalf("Failed to get Role for Lease %q: %v", leaseName, err)
+		}
+
+		for _, rule := range role.Rules {
+			if !slices.Equal(rule.Resources, []string{"leases"}) {
+				t.Errorf("Expected Role for Lease %q to only grant access to leases, got %v", leaseName, rule.Resources)
+			}
+		}
+
+		binding := &rbacv1.RoleBinding{}
+		if err := r.Get(ctx, key, binding); err != nil {
+			t.Fatalf("Failed to get RoleBinding for Lease %q: %v", leaseName, err)
+		}
+
+		if binding.RoleRef.Name != role.Name {
+			t.Errorf("Expected RoleBinding to reference Role %q, got %q", role.Name, binding.RoleRef.Name)
+		}
+	}
+}
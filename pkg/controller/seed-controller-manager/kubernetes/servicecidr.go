@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const serviceClusterIPRangeFlag = "--service-cluster-ip-range"
+
+// serviceCIDRConsumers are the Deployments (and their containers) which are configured
+// with the cluster's service CIDR via the --service-cluster-ip-range flag.
+var serviceCIDRConsumers = []string{
+	resources.ApiserverDeploymentName,
+	resources.ControllerManagerDeploymentName,
+}
+
+// ensureServiceCIDRConsistency fails if any of the control plane components would be
+// configured with a service network that differs from the cluster's service CIDR.
+func (r *Reconciler) ensureServiceCIDRConsistency(data *resources.TemplateData) error {
+	deployments := GetDeploymentReconcilers(data, r.features.KubernetesOIDCAuthentication, r.versions)
+	configMaps := GetConfigMapReconcilers(data)
+
+	return verifyServiceCIDRConsistency(data, deployments, configMaps)
+}
+
+func verifyServiceCIDRConsistency(data *resources.TemplateData, deployments []reconciling.NamedDeploymentReconcilerFactory, configMaps []reconciling.NamedConfigMapReconcilerFactory) error {
+	expected := data.ServiceClusterIPRange()
+
+	for _, factory := range deployments {
+		name, reconciler := factory()
+		if !slices.Contains(serviceCIDRConsumers, name) {
+			continue
+		}
+
+		dep, err := reconciler(&appsv1.Deployment{})
+		if err != nil {
+			return fmt.Errorf("failed to render %s Deployment: %w", name, err)
+		}
+
+		for _, container := range dep.Spec.Template.Spec.Containers {
+			if container.Name != name {
+				continue
+			}
+
+			// the controller-manager does not allocate node CIDRs for some CNIs and
+			// is then not configured with the service network at all
+			value, ok := getFlagValue(containerArgs(container), serviceClusterIPRangeFlag)
+			if ok && value != expected {
+				return fmt.Errorf("%s would be configured with service cluster IP range %q, but the cluster's service CIDR is %q", name, value, expected)
+			}
+		}
+	}
+
+	for _, factory := range configMaps {
+		name, reconciler := factory()
+		if name != resources.DNSResolverConfigMapName {
+			continue
+		}
+
+		dnsIP, err := data.ClusterDNSIP()
+		if err != nil {
+			return err
+		}
+
+		cm, err := reconciler(&corev1.ConfigMap{})
+		if err != nil {
+			return fmt.Errorf("failed to render %s ConfigMap: %w", name, err)
+		}
+
+		if !strings.Contains(cm.Data["Corefile"], "forward . "+dnsIP+"\n") {
+			return fmt.Errorf("%s ConfigMap does not forward to the cluster DNS IP %s of the service CIDR %q", name, dnsIP, expected)
+		}
+	}
+
+	return nil
+}
+
+// containerArgs returns the arguments of the container, looking through the
+// http-prober that waits for the apiserver if the container has been wrapped.
+func containerArgs(container corev1.Container) []string {
+	if wrapped, command := resources.UnwrapCommand(container); wrapped {
+		return command.Args
+	}
+
+	return container.Args
+}
+
+// getFlagValue returns the value of the given flag, which can be passed either as
+// "--flag value" or as "--flag=value".
+func getFlagValue(args []string, flag string) (string, bool) {
+	for i, arg := range args {
+		if value, ok := strings.CutPrefix(arg, flag+"="); ok {
+			return value, true
+		}
+
+		if arg == flag && i+1 < len(args) {
+			return args[i+1], true
+		}
+	}
+
+	return "", false
+}
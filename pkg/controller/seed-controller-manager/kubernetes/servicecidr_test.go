@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/apiserver"
+	"k8c.io/kubermatic/v2/pkg/resources/controllermanager"
+	"k8c.io/kubermatic/v2/pkg/resources/dns"
+	"k8c.io/kubermatic/v2/pkg/semver"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func serviceCIDRTestData(serviceCIDR string) *resources.TemplateData {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster-a",
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			Version: *semver.NewSemverOrDie("1.31.0"),
+			CNIPlugin: &kubermaticv1.CNIPluginSettings{
+				Type: kubermaticv1.CNIPluginTypeCanal,
+			},
+			ClusterNetwork: kubermaticv1.ClusterNetworkingConfig{
+				Pods: kubermaticv1.NetworkRanges{
+					CIDRBlocks: []string{"172.25.0.0/16"},
+				},
+				Services: kubermaticv1.NetworkRanges{
+					CIDRBlocks: []string{serviceCIDR},
+				},
+				DNSDomain: "cluster.local",
+			},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: "cluster-a",
+			Versions: kubermaticv1.ClusterVersionsStatus{
+				Apiserver:         *semver.NewSemverOrDie("1.31.0"),
+				ControllerManager: *semver.NewSemverOrDie("1.31.0"),
+			},
+		},
+	}
+
+	// the creators only look up Secrets and ConfigMaps to compute pod template
+	// revisions, so their content does not matter here
+	dnsResolver := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.DNSResolverServiceName,
+			Namespace: cluster.Status.NamespaceName,
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: "10.10.10.10",
+		},
+	}
+
+	client := fake.NewClientBuilder().WithObjects(dnsResolver).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, c ctrlruntimeclient.WithWatch, key ctrlruntimeclient.ObjectKey, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.GetOption) error {
+			return ctrlruntimeclient.IgnoreNotFound(c.Get(ctx, key, obj, opts...))
+		},
+	}).Build()
+
+	return resources.NewTemplateDataBuilder().
+		WithContext(context.Background()).
+		WithClient(client).
+		WithEtcdLauncherImage("quay.io/kubermatic/etcd-launcher").
+		WithKubermaticImage("quay.io/kubermatic/kubermatic").
+		WithDnatControllerImage("quay.io/kubermatic/kubeletdnat-controller").
+		WithCluster(cluster).
+		WithSeed(&kubermaticv1.Seed{}).
+		WithDatacenter(&kubermaticv1.Datacenter{}).
+		Build()
+}
+
+func TestServiceCIDRConsumersAgree(t *testing.T) {
+	const serviceCIDR = "10.240.16.0/20"
+
+	data := serviceCIDRTestData(serviceCIDR)
+
+	deployments := []reconciling.NamedDeploymentReconcilerFactory{
+		apiserver.DeploymentReconciler(data, false),
+		controllermanager.DeploymentReconciler(data),
+	}
+	configMaps := []reconciling.NamedConfigMapReconcilerFactory{
+		dns.ConfigMapReconciler(data),
+	}
+
+	for _, factory := range deployments {
+		name, reconciler := factory()
+
+		dep, err := reconciler(&appsv1.Deployment{})
+		if err != nil {
+			t.Fatalf("Failed to render %s Deployment: %v", name, err)
+		}
+
+		for _, container := range dep.Spec.Template.Spec.Containers {
+			if container.Name != name {
+				continue
+			}
+
+			value, _ := getFlagValue(containerArgs(container), serviceClusterIPRangeFlag)
+			if value != serviceCIDR {
+				t.Errorf("Expected %s to use service CIDR %q, got %q", name, serviceCIDR, value)
+			}
+		}
+	}
+
+	if err := verifyServiceCIDRConsistency(data, deployments, configMaps); err != nil {
+		t.Errorf("Expected consumers to be consistent, got %v", err)
+	}
+}
+
+func TestServiceCIDRMismatchIsDetected(t *testing.T) {
+	data := serviceCIDRTestData("10.240.16.0/20")
+
+	staleDeployment := func(name string) reconciling.NamedDeploymentReconcilerFactory {
+		return func() (string, reconciling.DeploymentReconciler) {
+			return name, func(dep *appsv1.Deployment) (*appsv1.Deployment, error) {
+				dep.Spec.Template.Spec.Containers = []corev1.Container{{
+					Name: name,
+					Args: []string{"--service-cluster-ip-range=10.96.0.0/12"},
+				}}
+				return dep, nil
+			}
+		}
+	}
+
+	staleConfigMap := func() (string, reconciling.ConfigMapReconciler) {
+		return resources.DNSResolverConfigMapName, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			cm.Data = map[string]string{
+				"Corefile": "cluster.local {\n    forward . 10.96.0.10\n    errors\n}\n",
+			}
+			return cm, nil
+		}
+	}
+
+	tests := []struct {
+		name        string
+		deployments []reconciling.NamedDeploymentReconcilerFactory
+		configMaps  []reconciling.NamedConfigMapReconcilerFactory
+	}{
+		{
+			name:        "apiserver",
+			deployments: []reconciling.NamedDeploymentReconcilerFactory{staleDeployment(resources.ApiserverDeploymentName)},
+		},
+		{
+			name:        "controller-manager",
+			deployments: []reconciling.NamedDeploymentReconcilerFactory{staleDeployment(resources.ControllerManagerDeploymentName)},
+		},
+		{
+			name:       "dns resolver",
+			configMaps: []reconciling.NamedConfigMapReconcilerFactory{staleConfigMap},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := verifyServiceCIDRConsistency(data, test.deployments, test.configMaps); err == nil {
+				t.Error("Expected a service CIDR mismatch to be detected, but got no error")
+			}
+		})
+	}
+}
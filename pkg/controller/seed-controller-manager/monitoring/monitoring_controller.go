@@ -76,6 +76,7 @@ type Reconciler struct {
 	nodeAccessNetwork        string
 	dockerPullConfigJSON     []byte
 	concurrentClusterUpdates int
+	prometheusTokenTTL       time.Duration
 
 	features Features
 	versions kubermatic.Versions
@@ -96,6 +97,7 @@ func Add(
 	nodeAccessNetwork string,
 	dockerPullConfigJSON []byte,
 	concurrentClusterUpdates int,
+	prometheusTokenTTL time.Duration,
 
 	features Features,
 	versions kubermatic.Versions,
@@ -114,6 +116,7 @@ func Add(
 		nodeAccessNetwork:        nodeAccessNetwork,
 		dockerPullConfigJSON:     dockerPullConfigJSON,
 		concurrentClusterUpdates: concurrentClusterUpdates,
+		prometheusTokenTTL:       prometheusTokenTTL,
 		seedGetter:               seedGetter,
 		configGetter:             configGetter,
 
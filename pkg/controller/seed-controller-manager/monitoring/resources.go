@@ -22,6 +22,7 @@ import (
 	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
 	"k8c.io/kubermatic/v2/pkg/resources/kubestatemetrics"
@@ -29,7 +30,9 @@ import (
 	kkpreconciling "k8c.io/kubermatic/v2/pkg/resources/reconciling"
 	"k8c.io/reconciler/pkg/reconciling"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -43,18 +46,23 @@ func (r *Reconciler) getClusterTemplateData(ctx context.Context, client ctrlrunt
 		return nil, err
 	}
 
-	datacenter, found := seed.Spec.Datacenters[cluster.Spec.Cloud.DatacenterName]
+	datacenter, _, found := kubermaticv1helper.ResolveDatacenter(seed, cluster.Spec.Cloud.DatacenterName)
 	if !found {
 		return nil, fmt.Errorf("failed to get datacenter %s", cluster.Spec.Cloud.DatacenterName)
 	}
 
+	resourceOverrides, err := resources.GetResourceOverrides(ctx, client, cluster.Status.NamespaceName)
+	if err != nil {
+		return nil, err
+	}
+
 	konnectivityEnabled := cluster.Spec.ClusterNetwork.KonnectivityEnabled != nil && *cluster.Spec.ClusterNetwork.KonnectivityEnabled //nolint:staticcheck
 
 	return resources.NewTemplateDataBuilder().
 		WithContext(ctx).
 		WithClient(client).
 		WithCluster(cluster).
-		WithDatacenter(&datacenter).
+		WithDatacenter(datacenter).
 		WithSeed(seed.DeepCopy()).
 		WithKubermaticConfiguration(config.DeepCopy()).
 		WithOverwriteRegistry(r.overwriteRegistry).
@@ -64,6 +72,8 @@ func (r *Reconciler) getClusterTemplateData(ctx context.Context, client ctrlrunt
 		WithBackupPeriod(20 * time.Minute).
 		WithVersions(r.versions).
 		WithKonnectivityEnabled(konnectivityEnabled).
+		WithResourceOverrides(resourceOverrides).
+		WithPrometheusTokenExpiration(r.prometheusTokenTTL).
 		Build(), nil
 }
 
@@ -108,6 +118,13 @@ func GetSecretReconcilerOperations(data *resources.TemplateData) []reconciling.N
 			resources.PrometheusClientCertificateKeySecretKey,
 			data.GetRootCA,
 		),
+		certificates.GetClientCertificateReconciler(
+			resources.PrometheusEtcdMetricsClientCertificateSecretName,
+			resources.EtcdMetricsScraperCommonName, nil,
+			resources.PrometheusEtcdMetricsClientCertSecretKey,
+			resources.PrometheusEtcdMetricsClientKeySecretKey,
+			data.GetEtcdMetricsCA,
+		),
 	}
 }
 
@@ -118,6 +135,17 @@ func (r *Reconciler) ensureSecrets(ctx context.Context, cluster *kubermaticv1.Cl
 		return fmt.Errorf("failed to ensure that the Secret exists: %w", err)
 	}
 
+	// Prometheus used to mount a legacy token Secret, which holds a token that never expires.
+	legacyTokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.PrometheusTokenSecretName,
+			Namespace: cluster.Status.NamespaceName,
+		},
+	}
+	if err := r.Delete(ctx, legacyTokenSecret); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete legacy Prometheus token Secret: %w", err)
+	}
+
 	return nil
 }
 
@@ -148,7 +176,7 @@ func GetStatefulSetReconcilers(data *resources.TemplateData) []reconciling.Named
 func (r *Reconciler) ensureStatefulSets(ctx context.Context, cluster *kubermaticv1.Cluster, data *resources.TemplateData) error {
 	creators := GetStatefulSetReconcilers(data)
 
-	return reconciling.ReconcileStatefulSets(ctx, creators, cluster.Status.NamespaceName, r.Client)
+	return reconciling.ReconcileStatefulSets(ctx, creators, cluster.Status.NamespaceName, r.Client, resources.ResourceOverridesModifier(data.ResourceOverrides()), resources.ResourceLimitPolicyModifier(data.ResourceLimitPolicy()), resources.ControlPlaneDNSModifier(data.ControlPlaneDNS()))
 }
 
 func (r *Reconciler) ensureVerticalPodAutoscalers(ctx context.Context, cluster *kubermaticv1.Cluster) error {
@@ -19,14 +19,19 @@ package monitoring
 import (
 	"context"
 	"testing"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
 	"k8c.io/kubermatic/v2/pkg/semver"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -128,3 +133,124 @@ func TestCreateConfigMap(t *testing.T) {
 		})
 	}
 }
+
+func TestPrometheusTokenProjection(t *testing.T) {
+	const tokenTTL = time.Hour
+
+	ctx := context.Background()
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nico1",
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			Cloud: kubermaticv1.CloudSpec{
+				DatacenterName: TestDC,
+			},
+			Version: *semver.NewSemverOrDie("v1.11.3"),
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: "cluster-nico1",
+		},
+	}
+
+	ca, err := triple.NewCA("test")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	controller := newTestReconciler(t, []ctrlruntimeclient.Object{
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.ApiserverServiceName,
+				Namespace: cluster.Status.NamespaceName,
+			},
+			Spec: corev1.ServiceSpec{
+				Ports:     []corev1.ServicePort{{NodePort: 99}},
+				ClusterIP: "192.0.2.10",
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.CASecretName,
+				Namespace: cluster.Status.NamespaceName,
+			},
+			Data: map[string][]byte{
+				resources.CACertSecretKey: triple.EncodeCertPEM(ca.Cert),
+				resources.CAKeySecretKey:  triple.EncodePrivateKeyPEM(ca.Key),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.EtcdMetricsCASecretName,
+				Namespace: cluster.Status.NamespaceName,
+			},
+			Data: map[string][]byte{
+				resources.CACertSecretKey: triple.EncodeCertPEM(ca.Cert),
+				resources.CAKeySecretKey:  triple.EncodePrivateKeyPEM(ca.Key),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.ApiserverEtcdClientCertificateSecretName,
+				Namespace: cluster.Status.NamespaceName,
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.PrometheusTokenSecretName,
+				Namespace: cluster.Status.NamespaceName,
+			},
+			Type: corev1.SecretTypeServiceAccountToken,
+		},
+		cluster,
+	})
+	controller.prometheusTokenTTL = tokenTTL
+
+	data, err := controller.getClusterTemplateData(ctx, controller.Client, cluster)
+	if err != nil {
+		t.Fatalf("Failed to get template data: %v", err)
+	}
+	if err := controller.ensureSecrets(ctx, cluster, data); err != nil {
+		t.Fatalf("Failed to ensure Secrets: %v", err)
+	}
+	if err := controller.ensureConfigMaps(ctx, cluster, data); err != nil {
+		t.Fatalf("Failed to ensure ConfigMaps: %v", err)
+	}
+	if err := controller.ensureStatefulSets(ctx, cluster, data); err != nil {
+		t.Fatalf("Failed to ensure StatefulSets: %v", err)
+	}
+
+	key := types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.PrometheusTokenSecretName}
+	if err := controller.Get(ctx, key, &corev1.Secret{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected the legacy token Secret to be deleted, got %v", err)
+	}
+
+	set := &appsv1.StatefulSet{}
+	key = types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.PrometheusStatefulSetName}
+	if err := controller.Get(ctx, key, set); err != nil {
+		t.Fatalf("Failed to get StatefulSet: %v", err)
+	}
+
+	if automount := set.Spec.Template.Spec.AutomountServiceAccountToken; automount == nil || *automount {
+		t.Error("Expected the default ServiceAccount token not to be mounted")
+	}
+
+	var projection *corev1.ServiceAccountTokenProjection
+	for _, volume := range set.Spec.Template.Spec.Volumes {
+		if volume.Projected == nil {
+			continue
+		}
+		for _, source := range volume.Projected.Sources {
+			if source.ServiceAccountToken != nil {
+				projection = source.ServiceAccountToken
+			}
+		}
+	}
+
+	if projection == nil {
+		t.Fatal("Expected the ServiceAccount token to be projected")
+	}
+	if expiration := ptr.Deref(projection.ExpirationSeconds, 0); expiration != int64(tokenTTL/time.Second) {
+		t.Errorf("Expected the projected token to expire after %v, got %d seconds", tokenTTL, expiration)
+	}
+}
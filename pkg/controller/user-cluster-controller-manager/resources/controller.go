@@ -82,6 +82,9 @@ func Add(
 	clusterIsPaused userclustercontrollermanager.IsPausedChecker,
 	overwriteRegistry string,
 	openvpnServerPort uint32,
+	openvpnTunnelMTU int,
+	openvpnCipher string,
+	openvpnCompression kubermaticv1.OpenVPNCompression,
 	kasSecurePort uint32,
 	tunnelingAgentIP net.IP,
 	registerReconciledCheck func(name string, check healthz.Checker) error,
@@ -92,6 +95,7 @@ func Add(
 	versions kubermatic.Versions,
 	userSSHKeyAgent bool,
 	networkPolices bool,
+	apiPriorityAndFairness bool,
 	opaWebhookTimeout int,
 	caBundle resources.CABundle,
 	userClusterMLA UserClusterMLA,
@@ -112,6 +116,9 @@ func Add(
 		clusterIsPaused:           clusterIsPaused,
 		imageRewriter:             registry.GetImageRewriterFunc(overwriteRegistry),
 		openvpnServerPort:         openvpnServerPort,
+		openvpnTunnelMTU:          openvpnTunnelMTU,
+		openvpnCipher:             openvpnCipher,
+		openvpnCompression:        openvpnCompression,
 		kasSecurePort:             kasSecurePort,
 		tunnelingAgentIP:          tunnelingAgentIP,
 		log:                       log,
@@ -122,6 +129,7 @@ func Add(
 		opaWebhookTimeout:         opaWebhookTimeout,
 		userSSHKeyAgent:           userSSHKeyAgent,
 		networkPolices:            networkPolices,
+		apiPriorityAndFairness:    apiPriorityAndFairness,
 		versions:                  versions,
 		caBundle:                  caBundle,
 		userClusterMLA:            userClusterMLA,
@@ -271,6 +279,9 @@ type reconciler struct {
 	clusterIsPaused           userclustercontrollermanager.IsPausedChecker
 	imageRewriter             registry.ImageRewriter
 	openvpnServerPort         uint32
+	openvpnTunnelMTU          int
+	openvpnCipher             string
+	openvpnCompression        kubermaticv1.OpenVPNCompression
 	kasSecurePort             uint32
 	tunnelingAgentIP          net.IP
 	dnsClusterIP              string
@@ -280,6 +291,7 @@ type reconciler struct {
 	opaWebhookTimeout         int
 	userSSHKeyAgent           bool
 	networkPolices            bool
+	apiPriorityAndFairness    bool
 	versions                  kubermatic.Versions
 	caBundle                  resources.CABundle
 	userClusterMLA            UserClusterMLA
@@ -27,6 +27,7 @@ import (
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/cloudcontroller"
+	aggregatedroles "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/aggregated-roles"
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/applications"
 	cabundle "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/ca-bundle"
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/cloudinitsettings"
@@ -37,6 +38,7 @@ import (
 	csisnapshotter "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/csi-snapshotter"
 	dnatcontroller "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/dnat-controller"
 	envoyagent "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/envoy-agent"
+	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/flowcontrol"
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/gatekeeper"
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/konnectivity"
 	kubestatemetrics "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/kube-state-metrics"
@@ -54,6 +56,7 @@ import (
 	operatingsystemmanager "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/operating-system-manager"
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/prometheus"
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/scheduler"
+	serviceaccountissuer "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/service-account-issuer"
 	systembasicuser "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/system-basic-user"
 	userauth "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/user-auth"
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/usersshkeys"
@@ -67,9 +70,14 @@ import (
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -231,6 +239,16 @@ func (r *reconciler) reconcile(ctx context.Context) error {
 		}
 	}
 
+	if r.apiPriorityAndFairness {
+		if err := r.reconcileAPIPriorityAndFairness(ctx, data); err != nil {
+			return err
+		}
+	} else {
+		if err := r.ensureAPIPriorityAndFairnessIsRemoved(ctx); err != nil {
+			return err
+		}
+	}
+
 	// Try to delete OPA integration deployment if its present
 	if !r.opaIntegration {
 		if err := r.ensureOPAIntegrationIsRemoved(ctx); err != nil {
@@ -568,6 +586,22 @@ func (r *reconciler) reconcileClusterRoles(ctx context.Context, data reconcileDa
 	if err := reconciling.ReconcileClusterRoles(ctx, creators, "", r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile ClusterRoles: %w", err)
 	}
+
+	aggregatedCreators := aggregatedroles.ClusterRoleReconcilers()
+	if err := reconciling.ReconcileClusterRoles(ctx, aggregatedCreators, "", r.Client); err != nil {
+		return fmt.Errorf("failed to reconcile aggregated ClusterRoles: %w", err)
+	}
+
+	desired := sets.New[string]()
+	for _, factory := range aggregatedCreators {
+		name, _ := factory()
+		desired.Insert(name)
+	}
+
+	if err := r.pruneAggregatedRBAC(ctx, &rbacv1.ClusterRoleList{}, desired); err != nil {
+		return fmt.Errorf("failed to prune aggregated ClusterRoles: %w", err)
+	}
+
 	return nil
 }
 
@@ -586,6 +620,7 @@ func (r *reconciler) reconcileClusterRoleBindings(ctx context.Context, data reco
 		controllermanager.ClusterRoleBindingAuthDelegator(),
 		clusterautoscaler.ClusterRoleBindingReconciler(),
 		systembasicuser.ClusterRoleBinding,
+		serviceaccountissuer.DiscoveryClusterRoleBindingReconciler(),
 		cloudcontroller.ClusterRoleBindingReconciler(),
 		coredns.ClusterRoleBindingReconciler(),
 		operatingsystemmanager.ClusterRoleBindingReconciler(),
@@ -615,9 +650,44 @@ func (r *reconciler) reconcileClusterRoleBindings(ctx context.Context, data reco
 	if err := reconciling.ReconcileClusterRoleBindings(ctx, creators, "", r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile ClusterRoleBindings: %w", err)
 	}
+
+	aggregatedCreators := aggregatedroles.ClusterRoleBindingReconcilers()
+	if err := reconciling.ReconcileClusterRoleBindings(ctx, aggregatedCreators, "", r.Client); err != nil {
+		return fmt.Errorf("failed to reconcile aggregated ClusterRoleBindings: %w", err)
+	}
+
+	desired := sets.New[string]()
+	for _, factory := range aggregatedCreators {
+		name, _ := factory()
+		desired.Insert(name)
+	}
+
+	if err := r.pruneAggregatedRBAC(ctx, &rbacv1.ClusterRoleBindingList{}, desired); err != nil {
+		return fmt.Errorf("failed to prune aggregated ClusterRoleBindings: %w", err)
+	}
+
 	return nil
 }
 
+// pruneAggregatedRBAC deletes all ClusterRoles or ClusterRoleBindings managed by the
+// aggregatedroles package that are not part of the desired set (anymore).
+func (r *reconciler) pruneAggregatedRBAC(ctx context.Context, list ctrlruntimeclient.ObjectList, desired sets.Set[string]) error {
+	if err := r.List(ctx, list, ctrlruntimeclient.MatchingLabels{aggregatedroles.ManagedLabel: "true"}); err != nil {
+		return err
+	}
+
+	return meta.EachListItem(list, func(obj runtime.Object) error {
+		o := obj.(ctrlruntimeclient.Object)
+		if desired.Has(o.GetName()) {
+			return nil
+		}
+
+		r.log.Infow("Pruning aggregated RBAC object", "kind", fmt.Sprintf("%T", o), "name", o.GetName())
+
+		return ctrlruntimeclient.IgnoreNotFound(r.Delete(ctx, o))
+	})
+}
+
 func (r *reconciler) reconcileCRDs(ctx context.Context, data reconcileData) error {
 	c, err := crd.CRDForObject(&appskubermaticv1.ApplicationInstallation{
 		TypeMeta: metav1.TypeMeta{
@@ -799,14 +869,14 @@ func (r *reconciler) reconcileConfigMaps(ctx context.Context, data reconcileData
 			envoyagent.ConfigMapReconciler(envoyConfig),
 		}
 		if !r.isKonnectivityEnabled {
-			creators = append(creators, openvpn.ClientConfigConfigMapReconciler(r.tunnelingAgentIP.String(), r.openvpnServerPort))
+			creators = append(creators, openvpn.ClientConfigConfigMapReconciler(r.tunnelingAgentIP.String(), r.openvpnServerPort, r.openvpnTunnelMTU, r.openvpnCipher, r.openvpnCompression))
 		}
 	} else {
 		creators = []reconciling.NamedConfigMapReconcilerFactory{
 			cabundle.ConfigMapReconciler(r.caBundle),
 		}
 		if !r.isKonnectivityEnabled {
-			creators = append(creators, openvpn.ClientConfigConfigMapReconciler(r.clusterURL.Hostname(), r.openvpnServerPort))
+			creators = append(creators, openvpn.ClientConfigConfigMapReconciler(r.clusterURL.Hostname(), r.openvpnServerPort, r.openvpnTunnelMTU, r.openvpnCipher, r.openvpnCompression))
 		}
 	}
 
@@ -1095,6 +1165,45 @@ func (r *reconciler) reconcileNetworkPolicies(ctx context.Context, data reconcil
 	return nil
 }
 
+func (r *reconciler) reconcileAPIPriorityAndFairness(ctx context.Context, data reconcileData) error {
+	priorityLevelReconcilers := []kkpreconciling.NamedPriorityLevelConfigurationReconcilerFactory{
+		flowcontrol.PriorityLevelConfigurationReconciler(data.cluster.Spec.Tier),
+	}
+
+	if err := kkpreconciling.ReconcilePriorityLevelConfigurations(ctx, priorityLevelReconcilers, "", r.Client); err != nil {
+		return fmt.Errorf("failed to reconcile PriorityLevelConfigurations: %w", err)
+	}
+
+	flowSchemaReconcilers := []kkpreconciling.NamedFlowSchemaReconcilerFactory{
+		flowcontrol.FlowSchemaReconciler(),
+	}
+
+	if err := kkpreconciling.ReconcileFlowSchemas(ctx, flowSchemaReconcilers, "", r.Client); err != nil {
+		return fmt.Errorf("failed to reconcile FlowSchemas: %w", err)
+	}
+
+	return nil
+}
+
+func (r *reconciler) ensureAPIPriorityAndFairnessIsRemoved(ctx context.Context) error {
+	// remove the FlowSchema first, so no requests are classified into a missing priority level
+	if err := r.Client.Delete(ctx, &flowcontrolv1.FlowSchema{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: flowcontrol.WorkloadFlowSchemaName,
+		}}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to remove FlowSchema: %w", err)
+	}
+
+	if err := r.Client.Delete(ctx, &flowcontrolv1.PriorityLevelConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: flowcontrol.WorkloadPriorityLevelName,
+		}}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to remove PriorityLevelConfiguration: %w", err)
+	}
+
+	return nil
+}
+
 func (r *reconciler) reconcilePodDisruptionBudgets(ctx context.Context) error {
 	creators := []reconciling.NamedPodDisruptionBudgetReconcilerFactory{
 		coredns.PodDisruptionBudgetReconciler(),
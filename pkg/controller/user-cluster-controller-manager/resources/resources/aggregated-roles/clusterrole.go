@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregatedroles
+
+import (
+	"k8c.io/reconciler/pkg/reconciling"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ManagedLabel marks the ClusterRoles and ClusterRoleBindings created by this package, so
+	// that the ones which are no longer part of the reconciler lists can be pruned.
+	ManagedLabel = "rbac.kubermatic.k8c.io/managed"
+
+	// AggregationLabelPrefix is the prefix of the labels that select ClusterRoles to be
+	// aggregated into the Kubermatic ClusterRoles.
+	AggregationLabelPrefix = "rbac.kubermatic.k8c.io/aggregate-to-"
+
+	// DashboardViewClusterRoleName is the ClusterRole granting the Kubermatic dashboard read
+	// access to the user cluster via the viewer token.
+	DashboardViewClusterRoleName = "kubermatic:dashboard-view"
+
+	// viewerGroup is the group of the viewer token user, see apiserver.TokenUsersReconciler.
+	viewerGroup = "viewers"
+)
+
+type aggregatedRole struct {
+	name      string
+	shortName string
+	subjects  []rbacv1.Subject
+	baseRules []rbacv1.PolicyRule
+}
+
+var aggregatedRoles = []aggregatedRole{
+	{
+		name:      DashboardViewClusterRoleName,
+		shortName: "dashboard-view",
+		subjects: []rbacv1.Subject{
+			{
+				Kind:     rbacv1.GroupKind,
+				APIGroup: rbacv1.GroupName,
+				Name:     viewerGroup,
+			},
+		},
+		baseRules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"nodes", "namespaces", "pods", "services", "events"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"apps"},
+				Resources: []string{"deployments", "daemonsets", "statefulsets"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"cluster.k8s.io"},
+				Resources: []string{"machinedeployments", "machinesets", "machines"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+		},
+	},
+}
+
+// AggregationLabel returns the label that a ClusterRole must carry (with the value "true")
+// to have its rules aggregated into the Kubermatic ClusterRole with the given short name.
+func AggregationLabel(shortName string) string {
+	return AggregationLabelPrefix + shortName
+}
+
+func managedLabels(extra map[string]string) map[string]string {
+	labels := map[string]string{ManagedLabel: "true"}
+	for k, v := range extra {
+		labels[k] = v
+	}
+
+	return labels
+}
+
+// ClusterRoleReconcilers returns the aggregating ClusterRoles used by Kubermatic components
+// together with a base ClusterRole each, which holds the permissions the component needs.
+// Operators can extend the permissions by creating further ClusterRoles carrying the
+// aggregation label.
+func ClusterRoleReconcilers() []reconciling.NamedClusterRoleReconcilerFactory {
+	creators := []reconciling.NamedClusterRoleReconcilerFactory{}
+
+	for _, role := range aggregatedRoles {
+		creators = append(creators, aggregatingClusterRoleReconciler(role), baseClusterRoleReconciler(role))
+	}
+
+	return creators
+}
+
+func aggregatingClusterRoleReconciler(role aggregatedRole) reconciling.NamedClusterRoleReconcilerFactory {
+	return func() (string, reconciling.ClusterRoleReconciler) {
+		return role.name, func(cr *rbacv1.ClusterRole) (*rbacv1.ClusterRole, error) {
+			cr.Labels = managedLabels(cr.Labels)
+
+			// The rules are filled in by the clusterrole-aggregation controller
+			// of the kube-controller-manager and must not be touched here.
+			cr.AggregationRule = &rbacv1.AggregationRule{
+				ClusterRoleSelectors: []metav1.LabelSelector{
+					{
+						MatchLabels: map[string]string{
+							AggregationLabel(role.shortName): "true",
+						},
+					},
+				},
+			}
+
+			return cr, nil
+		}
+	}
+}
+
+func baseClusterRoleReconciler(role aggregatedRole) reconciling.NamedClusterRoleReconcilerFactory {
+	return func() (string, reconciling.ClusterRoleReconciler) {
+		return role.name + ":base", func(cr *rbacv1.ClusterRole) (*rbacv1.ClusterRole, error) {
+			cr.Labels = managedLabels(cr.Labels)
+			cr.Labels[AggregationLabel(role.shortName)] = "true"
+			cr.Rules = role.baseRules
+
+			return cr, nil
+		}
+	}
+}
+
+// ClusterRoleBindingReconcilers returns the ClusterRoleBindings granting the aggregating
+// ClusterRoles to the Kubermatic components.
+func ClusterRoleBindingReconcilers() []reconciling.NamedClusterRoleBindingReconcilerFactory {
+	creators := []reconciling.NamedClusterRoleBindingReconcilerFactory{}
+
+	for _, role := range aggregatedRoles {
+		creators = append(creators, clusterRoleBindingReconciler(role))
+	}
+
+	return creators
+}
+
+func clusterRoleBindingReconciler(role aggregatedRole) reconciling.NamedClusterRoleBindingReconcilerFactory {
+	return func() (string, reconciling.ClusterRoleBindingReconciler) {
+		return role.name, func(crb *rbacv1.ClusterRoleBinding) (*rbacv1.ClusterRoleBinding, error) {
+			crb.Labels = managedLabels(crb.Labels)
+			crb.RoleRef = rbacv1.RoleRef{
+				Name:     role.name,
+				Kind:     "ClusterRole",
+				APIGroup: rbacv1.GroupName,
+			}
+			crb.Subjects = role.subjects
+
+			return crb, nil
+		}
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregatedroles
+
+import (
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestClusterRoleReconcilers(t *testing.T) {
+	roles := map[string]*rbacv1.ClusterRole{}
+	for _, factory := range ClusterRoleReconcilers() {
+		name, reconciler := factory()
+
+		// pre-existing rules must be kept on aggregating roles, as they are
+		// managed by the clusterrole-aggregation controller
+		existing := &rbacv1.ClusterRole{
+			Rules: []rbacv1.PolicyRule{{APIGroups: []string{"example.com"}, Resources: []string{"widgets"}, Verbs: []string{"get"}}},
+		}
+
+		role, err := reconciler(existing)
+		if err != nil {
+			t.Fatalf("Failed to reconcile ClusterRole %q: %v", name, err)
+		}
+
+		if role.Labels[ManagedLabel] != "true" {
+			t.Errorf("Expected ClusterRole %q to carry the %s label", name, ManagedLabel)
+		}
+
+		roles[name] = role
+	}
+
+	for _, aggregated := range aggregatedRoles {
+		label := AggregationLabel(aggregated.shortName)
+
+		role, ok := roles[aggregated.name]
+		if !ok {
+			t.Fatalf("Expected aggregating ClusterRole %q to be reconciled", aggregated.name)
+		}
+
+		if role.AggregationRule == nil || len(role.AggregationRule.ClusterRoleSelectors) != 1 {
+			t.Fatalf("Expected ClusterRole %q to have a single aggregation selector, got %+v", aggregated.name, role.AggregationRule)
+		}
+
+		if value := role.AggregationRule.ClusterRoleSelectors[0].MatchLabels[label]; value != "true" {
+			t.Errorf("Expected ClusterRole %q to aggregate roles labelled %s=true, got %q", aggregated.name, label, value)
+		}
+
+		if len(role.Rules) != 1 || role.Rules[0].APIGroups[0] != "example.com" {
+			t.Errorf("Expected aggregated rules of ClusterRole %q to be untouched, got %+v", aggregated.name, role.Rules)
+		}
+
+		if _, ok := role.Labels[label]; ok {
+			t.Errorf("Expected aggregating ClusterRole %q not to aggregate into itself", aggregated.name)
+		}
+
+		base, ok := roles[aggregated.name+":base"]
+		if !ok {
+			t.Fatalf("Expected base ClusterRole for %q to be reconciled", aggregated.name)
+		}
+
+		if base.Labels[label] != "true" {
+			t.Errorf("Expected base ClusterRole %q to carry the aggregation label %s", base.Name, label)
+		}
+
+		if base.AggregationRule != nil {
+			t.Errorf("Expected base ClusterRole for %q not to aggregate other roles", aggregated.name)
+		}
+	}
+}
+
+func TestClusterRoleBindingReconcilers(t *testing.T) {
+	for _, factory := range ClusterRoleBindingReconcilers() {
+		name, reconciler := factory()
+
+		binding, err := reconciler(&rbacv1.ClusterRoleBinding{})
+		if err != nil {
+			t.Fatalf("Failed to reconcile ClusterRoleBinding %q: %v", name, err)
+		}
+
+		if binding.RoleRef.Name != name {
+			t.Errorf("Expected ClusterRoleBinding %q to reference the aggregating ClusterRole, got %q", name, binding.RoleRef.Name)
+		}
+
+		if binding.Labels[ManagedLabel] != "true" {
+			t.Errorf("Expected ClusterRoleBinding %q to carry the %s label", name, ManagedLabel)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coredns
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestServiceClusterIPMatchesNodeClusterDNS(t *testing.T) {
+	testCases := []struct {
+		name        string
+		serviceCIDR string
+		expectedIP  string
+	}{
+		{
+			name:        "default service CIDR",
+			serviceCIDR: "10.240.16.0/20",
+			expectedIP:  "10.240.16.10",
+		},
+		{
+			name:        "service CIDR with host bits",
+			serviceCIDR: "10.96.1.0/16",
+			expectedIP:  "10.96.0.10",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := resources.NewTemplateDataBuilder().
+				WithCluster(&kubermaticv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: kubermaticv1.ClusterSpec{
+						ClusterNetwork: kubermaticv1.ClusterNetworkingConfig{
+							Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{tc.serviceCIDR}},
+							NodeLocalDNSCacheEnabled: ptr.To(false),
+						},
+					},
+				}).
+				Build()
+
+			dnsClusterIP, err := data.ClusterDNSIP()
+			if err != nil {
+				t.Fatalf("Failed to get cluster DNS IP: %v", err)
+			}
+
+			nodeClusterDNSIP, err := data.NodeClusterDNSIP()
+			if err != nil {
+				t.Fatalf("Failed to get node cluster DNS IP: %v", err)
+			}
+
+			_, reconcile := ServiceReconciler(dnsClusterIP)()
+
+			service, err := reconcile(&corev1.Service{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile Service: %v", err)
+			}
+
+			if service.Spec.ClusterIP != tc.expectedIP {
+				t.Errorf("Expected ClusterIP %s, got %s", tc.expectedIP, service.Spec.ClusterIP)
+			}
+
+			if service.Spec.ClusterIP != nodeClusterDNSIP {
+				t.Errorf("Expected ClusterIP %s to match the cluster DNS handed to nodes %s", service.Spec.ClusterIP, nodeClusterDNSIP)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flowcontrol
+
+import (
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
+
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// WorkloadPriorityLevelName is the name of the PriorityLevelConfiguration that limits the
+	// requests of in-cluster workloads.
+	WorkloadPriorityLevelName = "kubermatic-workload"
+	// WorkloadFlowSchemaName is the name of the FlowSchema that classifies the requests of
+	// service accounts outside of kube-system into the workload priority level.
+	WorkloadFlowSchemaName = "kubermatic-workload"
+
+	// workloadFlowSchemaPrecedence places the FlowSchema after the built-in schemas for
+	// system components (which all have a precedence below 1000), but before the built-in
+	// "service-accounts" schema, which would otherwise catch these requests.
+	workloadFlowSchemaPrecedence = 8500
+)
+
+// Sizing describes how much of the apiserver's concurrency in-cluster workloads
+// may use and how their excess requests are queued.
+type Sizing struct {
+	NominalConcurrencyShares int32
+	LendablePercent          int32
+	Queues                   int32
+	HandSize                 int32
+	QueueLengthLimit         int32
+}
+
+// SizingForTier returns the API Priority and Fairness sizing for clusters in the given tier.
+// Production clusters are expected to run many clients, so requests are spread over more
+// queues; development clusters get a smaller share, so that a misbehaving client cannot
+// starve the system components of the smaller control plane.
+func SizingForTier(tier kubermaticv1.ClusterTier) Sizing {
+	switch tier {
+	case kubermaticv1.ClusterTierProduction:
+		return Sizing{
+			NominalConcurrencyShares: 100,
+			LendablePercent:          50,
+			Queues:                   128,
+			HandSize:                 6,
+			QueueLengthLimit:         50,
+		}
+
+	case kubermaticv1.ClusterTierDevelopment:
+		return Sizing{
+			NominalConcurrencyShares: 20,
+			LendablePercent:          0,
+			Queues:                   32,
+			HandSize:                 4,
+			QueueLengthLimit:         25,
+		}
+
+	default:
+		return Sizing{
+			NominalConcurrencyShares: 50,
+			LendablePercent:          25,
+			Queues:                   64,
+			HandSize:                 6,
+			QueueLengthLimit:         50,
+		}
+	}
+}
+
+// PriorityLevelConfigurationReconciler returns the function to create and update the
+// PriorityLevelConfiguration for in-cluster workloads.
+func PriorityLevelConfigurationReconciler(tier kubermaticv1.ClusterTier) reconciling.NamedPriorityLevelConfigurationReconcilerFactory {
+	return func() (string, reconciling.PriorityLevelConfigurationReconciler) {
+		return WorkloadPriorityLevelName, func(plc *flowcontrolv1.PriorityLevelConfiguration) (*flowcontrolv1.PriorityLevelConfiguration, error) {
+			sizing := SizingForTier(tier)
+
+			plc.Spec = flowcontrolv1.PriorityLevelConfigurationSpec{
+				Type: flowcontrolv1.PriorityLevelEnablementLimited,
+				Limited: &flowcontrolv1.LimitedPriorityLevelConfiguration{
+					NominalConcurrencyShares: ptr.To(sizing.NominalConcurrencyShares),
+					LendablePercent:          ptr.To(sizing.LendablePercent),
+					LimitResponse: flowcontrolv1.LimitResponse{
+						Type: flowcontrolv1.LimitResponseTypeQueue,
+						Queuing: &flowcontrolv1.QueuingConfiguration{
+							Queues:           sizing.Queues,
+							HandSize:         sizing.HandSize,
+							QueueLengthLimit: sizing.QueueLengthLimit,
+						},
+					},
+				},
+			}
+
+			return plc, nil
+		}
+	}
+}
+
+// FlowSchemaReconciler returns the function to create and update the FlowSchema that
+// assigns requests from service accounts to the workload priority level. Flows are
+// distinguished per user, so a single misbehaving client only fills its own queues.
+func FlowSchemaReconciler() reconciling.NamedFlowSchemaReconcilerFactory {
+	return func() (string, reconciling.FlowSchemaReconciler) {
+		return WorkloadFlowSchemaName, func(fs *flowcontrolv1.FlowSchema) (*flowcontrolv1.FlowSchema, error) {
+			fs.Spec = flowcontrolv1.FlowSchemaSpec{
+				PriorityLevelConfiguration: flowcontrolv1.PriorityLevelConfigurationReference{
+					Name: WorkloadPriorityLevelName,
+				},
+				MatchingPrecedence: workloadFlowSchemaPrecedence,
+				DistinguisherMethod: &flowcontrolv1.FlowDistinguisherMethod{
+					Type: flowcontrolv1.FlowDistinguisherMethodByUserType,
+				},
+				Rules: []flowcontrolv1.PolicyRulesWithSubjects{
+					{
+						Subjects: []flowcontrolv1.Subject{
+							{
+								Kind: flowcontrolv1.SubjectKindGroup,
+								Group: &flowcontrolv1.GroupSubject{
+									Name: "system:serviceaccounts",
+								},
+							},
+						},
+						ResourceRules: []flowcontrolv1.ResourcePolicyRule{
+							{
+								Verbs:        []string{flowcontrolv1.VerbAll},
+								APIGroups:    []string{flowcontrolv1.APIGroupAll},
+								Resources:    []string{flowcontrolv1.ResourceAll},
+								ClusterScope: true,
+								Namespaces:   []string{flowcontrolv1.NamespaceEvery},
+							},
+						},
+						NonResourceRules: []flowcontrolv1.NonResourcePolicyRule{
+							{
+								Verbs:           []string{flowcontrolv1.VerbAll},
+								NonResourceURLs: []string{flowcontrolv1.NonResourceAll},
+							},
+						},
+					},
+				},
+			}
+
+			return fs, nil
+		}
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flowcontrol
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+)
+
+func TestPriorityLevelConfigurationReconciler(t *testing.T) {
+	testCases := []struct {
+		name           string
+		tier           kubermaticv1.ClusterTier
+		expectedShares int32
+		expectedQueues int32
+	}{
+		{
+			name:           "untiered cluster",
+			expectedShares: 50,
+			expectedQueues: 64,
+		},
+		{
+			name:           "production cluster",
+			tier:           kubermaticv1.ClusterTierProduction,
+			expectedShares: 100,
+			expectedQueues: 128,
+		},
+		{
+			name:           "development cluster",
+			tier:           kubermaticv1.ClusterTierDevelopment,
+			expectedShares: 20,
+			expectedQueues: 32,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			name, reconciler := PriorityLevelConfigurationReconciler(tc.tier)()
+			if name != WorkloadPriorityLevelName {
+				t.Errorf("Expected PriorityLevelConfiguration to be named %q, got %q", WorkloadPriorityLevelName, name)
+			}
+
+			plc, err := reconciler(&flowcontrolv1.PriorityLevelConfiguration{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile PriorityLevelConfiguration: %v", err)
+			}
+
+			if plc.Spec.Type != flowcontrolv1.PriorityLevelEnablementLimited || plc.Spec.Limited == nil {
+				t.Fatalf("Expected a limited priority level, got %+v", plc.Spec)
+			}
+
+			if shares := *plc.Spec.Limited.NominalConcurrencyShares; shares != tc.expectedShares {
+				t.Errorf("Expected %d nominal concurrency shares, got %d", tc.expectedShares, shares)
+			}
+
+			queuing := plc.Spec.Limited.LimitResponse.Queuing
+			if plc.Spec.Limited.LimitResponse.Type != flowcontrolv1.LimitResponseTypeQueue || queuing == nil {
+				t.Fatalf("Expected excess requests to be queued, got %+v", plc.Spec.Limited.LimitResponse)
+			}
+
+			if queuing.Queues != tc.expectedQueues {
+				t.Errorf("Expected %d queues, got %d", tc.expectedQueues, queuing.Queues)
+			}
+
+			if queuing.HandSize > queuing.Queues {
+				t.Errorf("Expected hand size %d not to exceed the number of queues %d", queuing.HandSize, queuing.Queues)
+			}
+		})
+	}
+}
+
+func TestFlowSchemaReconciler(t *testing.T) {
+	name, reconciler := FlowSchemaReconciler()()
+	if name != WorkloadFlowSchemaName {
+		t.Errorf("Expected FlowSchema to be named %q, got %q", WorkloadFlowSchemaName, name)
+	}
+
+	fs, err := reconciler(&flowcontrolv1.FlowSchema{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile FlowSchema: %v", err)
+	}
+
+	if fs.Spec.PriorityLevelConfiguration.Name != WorkloadPriorityLevelName {
+		t.Errorf("Expected FlowSchema to reference %q, got %q", WorkloadPriorityLevelName, fs.Spec.PriorityLevelConfiguration.Name)
+	}
+
+	// must be evaluated after the built-in schemas for system components and
+	// before the built-in catch-all schemas
+	if fs.Spec.MatchingPrecedence <= 1000 || fs.Spec.MatchingPrecedence >= 9000 {
+		t.Errorf("Expected matching precedence between 1000 and 9000, got %d", fs.Spec.MatchingPrecedence)
+	}
+
+	if fs.Spec.DistinguisherMethod == nil || fs.Spec.DistinguisherMethod.Type != flowcontrolv1.FlowDistinguisherMethodByUserType {
+		t.Errorf("Expected flows to be distinguished by user, got %+v", fs.Spec.DistinguisherMethod)
+	}
+}
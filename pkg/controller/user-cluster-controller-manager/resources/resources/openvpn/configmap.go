@@ -18,7 +18,9 @@ package openvpn
 
 import (
 	"fmt"
+	"strings"
 
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/reconciler/pkg/reconciling"
 
@@ -30,7 +32,8 @@ const (
 )
 
 // ClientConfigConfigMapReconciler returns a ConfigMap containing the config for the OpenVPN client. It lives inside the user-cluster.
-func ClientConfigConfigMapReconciler(hostname string, serverPort uint32) reconciling.NamedConfigMapReconcilerFactory {
+// A tunnelMTU of 0 uses the OpenVPN default.
+func ClientConfigConfigMapReconciler(hostname string, serverPort uint32, tunnelMTU int, cipher string, compression kubermaticv1.OpenVPNCompression) reconciling.NamedConfigMapReconcilerFactory {
 	return func() (string, reconciling.ConfigMapReconciler) {
 		return resources.OpenVPNClientConfigConfigMapName, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
 			if cm.Data == nil {
@@ -38,6 +41,11 @@ func ClientConfigConfigMapReconciler(hostname string, serverPort uint32) reconci
 			}
 			cm.Labels = resources.BaseAppLabels(Name, nil)
 
+			var cryptoOptions string
+			for _, option := range resources.OpenVPNCryptoOptions(cipher, compression) {
+				cryptoOptions += strings.Join(option, " ") + "\n"
+			}
+
 			config := fmt.Sprintf(`client
 proto tcp
 dev kube
@@ -50,13 +58,14 @@ cert '/etc/openvpn/certs/client.crt'
 key '/etc/openvpn/certs/client.key'
 remote-cert-tls server
 script-security 2
-cipher AES-256-GCM
-auth SHA1
-keysize 256
-status /run/openvpn-status
+%sstatus /run/openvpn-status
 up '/bin/sh -c "/sbin/iptables -t nat -I POSTROUTING -s 10.20.0.0/24 -j MASQUERADE"'
 log /dev/stdout
-`, hostname, serverPort)
+`, hostname, serverPort, cryptoOptions)
+
+			if tunnelMTU > 0 {
+				config += fmt.Sprintf("tun-mtu %d\n", tunnelMTU)
+			}
 
 			cm.Data["config"] = config
 
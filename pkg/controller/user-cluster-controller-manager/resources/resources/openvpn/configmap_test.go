@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openvpn
+
+import (
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestClientConfigCryptoSettings(t *testing.T) {
+	tests := []struct {
+		name            string
+		cipher          string
+		compression     kubermaticv1.OpenVPNCompression
+		expectedLines   []string
+		unexpectedLines []string
+	}{
+		{
+			name:            "defaults",
+			cipher:          resources.DefaultOpenVPNCipher,
+			expectedLines:   []string{"cipher AES-256-GCM", "auth SHA1", "keysize 256"},
+			unexpectedLines: []string{"data-ciphers", "allow-compression"},
+		},
+		{
+			name:            "custom cipher",
+			cipher:          "AES-128-GCM",
+			expectedLines:   []string{"cipher AES-128-GCM", "data-ciphers AES-128-GCM"},
+			unexpectedLines: []string{"keysize"},
+		},
+		{
+			name:          "compression disabled",
+			cipher:        resources.DefaultOpenVPNCipher,
+			compression:   kubermaticv1.OpenVPNCompressionDisabled,
+			expectedLines: []string{"allow-compression no"},
+		},
+		{
+			name:          "compression enabled",
+			cipher:        resources.DefaultOpenVPNCipher,
+			compression:   kubermaticv1.OpenVPNCompressionLZ4v2,
+			expectedLines: []string{"allow-compression yes"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, reconciler := ClientConfigConfigMapReconciler("example.com", 1194, 0, test.cipher, test.compression)()
+
+			cm, err := reconciler(&corev1.ConfigMap{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile ConfigMap: %v", err)
+			}
+
+			lines := strings.Split(cm.Data["config"], "\n")
+			for _, expected := range test.expectedLines {
+				if !containsLine(lines, expected) {
+					t.Errorf("Expected client config to contain %q, got %q", expected, cm.Data["config"])
+				}
+			}
+			for _, unexpected := range test.unexpectedLines {
+				if strings.Contains(cm.Data["config"], unexpected) {
+					t.Errorf("Expected client config not to contain %q, got %q", unexpected, cm.Data["config"])
+				}
+			}
+		})
+	}
+}
+
+func containsLine(lines []string, line string) bool {
+	for _, l := range lines {
+		if l == line {
+			return true
+		}
+	}
+
+	return false
+}
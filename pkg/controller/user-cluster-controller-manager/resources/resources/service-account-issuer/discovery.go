@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccountissuer
+
+import (
+	"k8c.io/reconciler/pkg/reconciling"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+const (
+	// DiscoveryClusterRoleBindingName is the name of the ClusterRoleBinding that
+	// exposes the OIDC discovery document and JWKS of the service account issuer.
+	DiscoveryClusterRoleBindingName = "kubermatic:service-account-issuer-discovery"
+)
+
+// DiscoveryClusterRoleBindingReconciler returns a func to create/update the ClusterRoleBinding
+// which allows unauthenticated clients to fetch /.well-known/openid-configuration and
+// /openid/v1/jwks. This is required for external workload identity federation, where
+// third parties verify projected service account tokens issued by the cluster. It only
+// takes effect if the apiserver accepts anonymous requests, which KKP disables;
+// otherwise the JWKS has to be published by other means.
+func DiscoveryClusterRoleBindingReconciler() reconciling.NamedClusterRoleBindingReconcilerFactory {
+	return func() (string, reconciling.ClusterRoleBindingReconciler) {
+		return DiscoveryClusterRoleBindingName, func(crb *rbacv1.ClusterRoleBinding) (*rbacv1.ClusterRoleBinding, error) {
+			crb.RoleRef = rbacv1.RoleRef{
+				Name:     "system:service-account-issuer-discovery",
+				Kind:     "ClusterRole",
+				APIGroup: rbacv1.GroupName,
+			}
+			crb.Subjects = []rbacv1.Subject{
+				{
+					Kind:     rbacv1.GroupKind,
+					Name:     "system:unauthenticated",
+					APIGroup: rbacv1.GroupName,
+				},
+			}
+			return crb, nil
+		}
+	}
+}
@@ -187,10 +187,8 @@ func DatacenterForClusterSpec(spec *kubermaticv1.ClusterSpec, seed *kubermaticv1
 		return nil, field.Required(field.NewPath("spec", "cloud", "dc"), "no datacenter name specified")
 	}
 
-	for dcName, dc := range seed.Spec.Datacenters {
-		if dcName == datacenterName {
-			return &dc, nil
-		}
+	if dc, _, found := kubermaticv1helper.ResolveDatacenter(seed, datacenterName); found {
+		return dc, nil
 	}
 
 	return nil, field.Invalid(field.NewPath("spec", "cloud", "dc"), datacenterName, "invalid datacenter name")
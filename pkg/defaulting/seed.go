@@ -31,6 +31,16 @@ const (
 	// DefaultBackupInterval defines the default interval used to create backups.
 	DefaultBackupInterval = "20m"
 
+	// DefaultBackupCount defines the default number of backups to keep for every cluster.
+	DefaultBackupCount = 20
+
+	// DefaultEtcdDefragSchedule defines the default cron schedule for the etcd defragger.
+	DefaultEtcdDefragSchedule = "@every 3h"
+
+	// DefaultServiceAccountKeyRotationGracePeriod defines for how long tokens signed with a rotated
+	// service account key remain valid.
+	DefaultServiceAccountKeyRotationGracePeriod = "24h"
+
 	// DefaultMeteringStorageSize is the default size for the metering Prometheus PVC.
 	DefaultMeteringStorageSize = "100Gi"
 	// DefaultMeteringRetentionDays is the default number of days for which the metering Prometheus
@@ -29,6 +29,7 @@ import (
 	"fmt"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
 	kubelbresources "k8c.io/kubermatic/v2/pkg/ee/kubelb/resources"
 	kubelbmanagementresources "k8c.io/kubermatic/v2/pkg/ee/kubelb/resources/kubelb-cluster"
 	kubelbseedresources "k8c.io/kubermatic/v2/pkg/ee/kubelb/resources/seed-cluster"
@@ -70,13 +71,13 @@ func (r *reconciler) ensureKubeLBManagementClusterResourcesAreRemoved(ctx contex
 		return err
 	}
 
-	datacenter, found := seed.Spec.Datacenters[cluster.Spec.Cloud.DatacenterName]
+	datacenter, _, found := kubermaticv1helper.ResolveDatacenter(seed, cluster.Spec.Cloud.DatacenterName)
 	if !found {
 		return fmt.Errorf("couldn't find datacenter %q for cluster %q", cluster.Spec.Cloud.DatacenterName, cluster.Name)
 	}
 
 	// Get kubeLB management cluster client.
-	kubeLBManagementClient, _, err := r.getKubeLBManagementClusterClient(ctx, seed, datacenter)
+	kubeLBManagementClient, _, err := r.getKubeLBManagementClusterClient(ctx, seed, *datacenter)
 	if err != nil {
 		return err
 	}
@@ -173,13 +173,13 @@ func (r *reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluste
 		return nil, err
 	}
 
-	datacenter, found := seed.Spec.Datacenters[cluster.Spec.Cloud.DatacenterName]
+	datacenter, _, found := kubermaticv1helper.ResolveDatacenter(seed, cluster.Spec.Cloud.DatacenterName)
 	if !found {
 		return nil, fmt.Errorf("couldn't find datacenter %q for cluster %q", cluster.Spec.Cloud.DatacenterName, cluster.Name)
 	}
 
 	// Get kubeLB management cluster client.
-	kubeLBManagementClient, cfg, err := r.getKubeLBManagementClusterClient(ctx, seed, datacenter)
+	kubeLBManagementClient, cfg, err := r.getKubeLBManagementClusterClient(ctx, seed, *datacenter)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +204,7 @@ func (r *reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluste
 	}
 
 	// Create/update required resources in user cluster namespace in seed.
-	if err := r.createOrUpdateKubeLBSeedClusterResources(ctx, cluster, kubeLBManagementClient, cfg, datacenter); err != nil {
+	if err := r.createOrUpdateKubeLBSeedClusterResources(ctx, cluster, kubeLBManagementClient, cfg, *datacenter); err != nil {
 		return nil, err
 	}
 
@@ -46,6 +46,18 @@ const (
 	// unless it's explicitly disabled at the cluster level.
 	EtcdLauncher = "EtcdLauncher"
 
+	// ExternalDNSAnnotations if enabled annotates the apiserver Service of all user clusters with
+	// their external hostname, so that external-dns can manage the DNS records for them.
+	ExternalDNSAnnotations = "ExternalDNSAnnotations"
+
+	// ControlPlaneImageOverrides if enabled applies the image overrides configured in the spec of
+	// a user cluster to its control plane components, e.g. to canary a new apiserver image.
+	ControlPlaneImageOverrides = "ControlPlaneImageOverrides"
+
+	// APIServerExternalProbe if enabled deploys a probe next to each control plane that checks the
+	// apiserver through its external address and reports the result as a condition on the cluster.
+	APIServerExternalProbe = "APIServerExternalProbe"
+
 	// UserClusterMLA if enabled MonitoringLoggingAlerting stack will be deployed with corresponding controller.
 	UserClusterMLA = "UserClusterMLA"
 
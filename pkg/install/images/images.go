@@ -614,6 +614,8 @@ func getTemplateData(config *kubermaticv1.KubermaticConfiguration, clusterVersio
 		resources.ApiserverEtcdClientCertificateSecretName,
 		resources.ApiserverFrontProxyClientCertificateSecretName,
 		resources.EtcdTLSCertificateSecretName,
+		resources.EtcdPeerTLSCertificateSecretName,
+		resources.EtcdMetricsTLSCertificateSecretName,
 		resources.MachineControllerKubeconfigSecretName,
 		resources.ControllerManagerKubeconfigSecretName,
 		resources.SchedulerKubeconfigSecretName,
@@ -720,6 +722,7 @@ func getTemplateData(config *kubermaticv1.KubermaticConfiguration, clusterVersio
 		WithDnatControllerImage(defaulting.DefaultDNATControllerImage).
 		WithNetworkIntfMgrImage(defaulting.DefaultNetworkInterfaceManagerImage).
 		WithBackupPeriod(20 * time.Minute).
+		WithEtcdDefragSchedule(defaulting.DefaultEtcdDefragSchedule).
 		WithFailureDomainZoneAntiaffinity(false).
 		WithVersions(kubermaticVersions).
 		WithCABundle(caBundle).
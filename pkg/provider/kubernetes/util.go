@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	restclient "k8s.io/client-go/rest"
@@ -29,7 +30,7 @@ import (
 
 const (
 	// NamespacePrefix is the prefix for the cluster namespace.
-	NamespacePrefix = "cluster-"
+	NamespacePrefix = resources.ClusterNamespacePrefix
 )
 
 // ImpersonationClient gives runtime controller client that uses user impersonation.
@@ -41,8 +42,9 @@ func NamespaceName(clusterName string) string {
 }
 
 // ClusterFromNamespace filters all Cluster objects and returns the
-// one where status.namespaceName matches the given namespace. If no
-// such cluster exists, nil is returned (no error).
+// one where status.namespaceName (or the etcd namespace of a split
+// control plane) matches the given namespace. If no such cluster
+// exists, nil is returned (no error).
 func ClusterFromNamespace(ctx context.Context, client ctrlruntimeclient.Client, namespace string) (*kubermaticv1.Cluster, error) {
 	clusters := kubermaticv1.ClusterList{}
 	if err := client.List(ctx, &clusters); err != nil {
@@ -50,7 +52,8 @@ func ClusterFromNamespace(ctx context.Context, client ctrlruntimeclient.Client,
 	}
 
 	for i, c := range clusters.Items {
-		if c.Status.NamespaceName == namespace {
+		// clusters with a split control plane own a second namespace
+		if c.Status.NamespaceName == namespace || resources.EtcdNamespaceName(&c) == namespace {
 			return &clusters.Items[i], nil
 		}
 	}
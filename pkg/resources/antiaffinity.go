@@ -101,3 +101,25 @@ func podAffinityTerm(app string, topologyKey string) []corev1.PodAffinityTerm {
 		},
 	}
 }
+
+// FailureDomainZoneSpreadConstraints ensures that same-kind pods are evenly spread across availability zones.
+// The constraint is only enforced if antiAffinityType is "required", otherwise the scheduler treats it as a preference.
+func FailureDomainZoneSpreadConstraints(app string, antiAffinityType kubermaticv1.AntiAffinityType) []corev1.TopologySpreadConstraint {
+	whenUnsatisfiable := corev1.ScheduleAnyway
+	if antiAffinityType == kubermaticv1.AntiAffinityTypeRequired {
+		whenUnsatisfiable = corev1.DoNotSchedule
+	}
+
+	return []corev1.TopologySpreadConstraint{
+		{
+			MaxSkew:           1,
+			TopologyKey:       TopologyKeyZone,
+			WhenUnsatisfiable: whenUnsatisfiable,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					AppLabelKey: app,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+const (
+	auditWebhookMountPath = "/etc/kubernetes/audit/webhook"
+	auditWebhookName      = "audit-webhook"
+)
+
+// auditWebhookBackend returns the audit webhook backend configured for the cluster, or nil.
+func auditWebhookBackend(cluster *kubermaticv1.Cluster) *kubermaticv1.AuditWebhookBackendSettings {
+	if cluster.Spec.AuditLogging == nil {
+		return nil
+	}
+
+	return cluster.Spec.AuditLogging.WebhookBackend
+}
+
+// auditWebhookConfigSecretName returns the name of the secret mounted as audit webhook config file. It is
+// generated by KKP if an endpoint is configured, otherwise it is the secret referenced by the cluster.
+func auditWebhookConfigSecretName(webhook *kubermaticv1.AuditWebhookBackendSettings) string {
+	if webhook.Endpoint != "" || webhook.AuditWebhookConfig == nil {
+		return resources.AuditWebhookConfigSecretName
+	}
+
+	return webhook.AuditWebhookConfig.Name
+}
+
+// AuditWebhookConfigSecretReconciler returns a function to create the secret containing the audit webhook
+// config file that the kube-apiserver uses to send audit events to the configured endpoint.
+func AuditWebhookConfigSecretReconciler(data *resources.TemplateData) reconciling.NamedSecretReconcilerFactory {
+	return func() (string, reconciling.SecretReconciler) {
+		return resources.AuditWebhookConfigSecretName, func(secret *corev1.Secret) (*corev1.Secret, error) {
+			webhook := auditWebhookBackend(data.Cluster())
+			if webhook == nil || webhook.Endpoint == "" {
+				return secret, nil
+			}
+
+			config, err := auditWebhookConfig(webhook)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render audit webhook config: %w", err)
+			}
+
+			secret.Data = map[string][]byte{
+				resources.AuditWebhookConfigSecretKey: config,
+			}
+
+			return secret, nil
+		}
+	}
+}
+
+// auditWebhookConfig renders the kubeconfig-formatted file for the --audit-webhook-config-file flag.
+func auditWebhookConfig(webhook *kubermaticv1.AuditWebhookBackendSettings) ([]byte, error) {
+	u, err := url.Parse(webhook.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint: %w", err)
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return nil, errors.New("endpoint must be an absolute https URL")
+	}
+
+	cluster := &clientcmdapi.Cluster{
+		Server: webhook.Endpoint,
+	}
+
+	if webhook.CABundle != "" {
+		if _, err := certutil.ParseCertsPEM([]byte(webhook.CABundle)); err != nil {
+			return nil, fmt.Errorf("invalid CA bundle: %w", err)
+		}
+
+		cluster.CertificateAuthorityData = []byte(webhook.CABundle)
+	}
+
+	config := clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			auditWebhookName: cluster,
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			resources.ApiserverDeploymentName: {},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			auditWebhookName: {
+				Cluster:  auditWebhookName,
+				AuthInfo: resources.ApiserverDeploymentName,
+			},
+		},
+		CurrentContext: auditWebhookName,
+	}
+
+	return clientcmd.Write(config)
+}
+
+// getAuditWebhookFlags returns the kube-apiserver flags configuring the audit webhook backend.
+// The audit policy is shared with the log file backend and configured separately.
+func getAuditWebhookFlags(cluster *kubermaticv1.Cluster) []string {
+	webhook := auditWebhookBackend(cluster)
+	if webhook == nil {
+		return nil
+	}
+
+	flags := []string{
+		"--audit-webhook-config-file", filepath.Join(auditWebhookMountPath, resources.AuditWebhookConfigSecretKey),
+	}
+
+	if webhook.AuditWebhookInitialBackoff != "" {
+		flags = append(flags, "--audit-webhook-initial-backoff", webhook.AuditWebhookInitialBackoff)
+	}
+
+	if batch := webhook.Batch; batch != nil {
+		flags = append(flags, "--audit-webhook-mode", "batch")
+
+		if batch.MaxSize != nil {
+			flags = append(flags, "--audit-webhook-batch-max-size", fmt.Sprint(*batch.MaxSize))
+		}
+
+		if batch.MaxWait != nil {
+			flags = append(flags, "--audit-webhook-batch-max-wait", batch.MaxWait.Duration.String())
+		}
+
+		if batch.ThrottleQPS != nil {
+			flags = append(flags, "--audit-webhook-batch-throttle-enable=true", "--audit-webhook-batch-throttle-qps", fmt.Sprint(*batch.ThrottleQPS))
+		}
+
+		if batch.ThrottleBurst != nil {
+			flags = append(flags, "--audit-webhook-batch-throttle-burst", fmt.Sprint(*batch.ThrottleBurst))
+		}
+	}
+
+	return flags
+}
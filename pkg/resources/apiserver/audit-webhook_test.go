@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/ptr"
+)
+
+func TestAuditWebhookConfig(t *testing.T) {
+	ca, err := triple.NewCA("siem-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+	caBundle := string(triple.EncodeCertPEM(ca.Cert))
+
+	tests := []struct {
+		name      string
+		webhook   *kubermaticv1.AuditWebhookBackendSettings
+		expectErr bool
+	}{
+		{
+			name:    "https endpoint without CA bundle",
+			webhook: &kubermaticv1.AuditWebhookBackendSettings{Endpoint: "https://siem.example.com/audit"},
+		},
+		{
+			name:    "https endpoint with CA bundle",
+			webhook: &kubermaticv1.AuditWebhookBackendSettings{Endpoint: "https://siem.example.com/audit", CABundle: caBundle},
+		},
+		{
+			name:      "http endpoint",
+			webhook:   &kubermaticv1.AuditWebhookBackendSettings{Endpoint: "http://siem.example.com/audit"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid CA bundle",
+			webhook:   &kubermaticv1.AuditWebhookBackendSettings{Endpoint: "https://siem.example.com/audit", CABundle: "not-a-certificate"},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			raw, err := auditWebhookConfig(test.webhook)
+			if test.expectErr {
+				if err == nil {
+					t.Fatal("Expected an error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+
+			config, err := clientcmd.Load(raw)
+			if err != nil {
+				t.Fatalf("Failed to parse rendered config: %v", err)
+			}
+
+			context, ok := config.Contexts[config.CurrentContext]
+			if !ok {
+				t.Fatalf("Expected current context %q to exist", config.CurrentContext)
+			}
+
+			cluster, ok := config.Clusters[context.Cluster]
+			if !ok {
+				t.Fatalf("Expected cluster %q to exist", context.Cluster)
+			}
+
+			if cluster.Server != test.webhook.Endpoint {
+				t.Errorf("Expected server to be %q, got %q", test.webhook.Endpoint, cluster.Server)
+			}
+
+			if !bytes.Equal(cluster.CertificateAuthorityData, []byte(test.webhook.CABundle)) {
+				t.Errorf("Expected CA data to be %q, got %q", test.webhook.CABundle, cluster.CertificateAuthorityData)
+			}
+		})
+	}
+}
+
+func TestGetAuditWebhookFlags(t *testing.T) {
+	tests := []struct {
+		name         string
+		auditLogging *kubermaticv1.AuditLoggingSettings
+		expected     []string
+	}{
+		{
+			name: "no audit logging",
+		},
+		{
+			name:         "log file only",
+			auditLogging: &kubermaticv1.AuditLoggingSettings{Enabled: true},
+		},
+		{
+			name: "referenced webhook config",
+			auditLogging: &kubermaticv1.AuditLoggingSettings{
+				WebhookBackend: &kubermaticv1.AuditWebhookBackendSettings{
+					AuditWebhookConfig:         &corev1.SecretReference{Name: "my-webhook"},
+					AuditWebhookInitialBackoff: "10s",
+				},
+			},
+			expected: []string{
+				"--audit-webhook-config-file", "/etc/kubernetes/audit/webhook/webhook.yaml",
+				"--audit-webhook-initial-backoff", "10s",
+			},
+		},
+		{
+			name: "endpoint with batching",
+			auditLogging: &kubermaticv1.AuditLoggingSettings{
+				Enabled: true,
+				WebhookBackend: &kubermaticv1.AuditWebhookBackendSettings{
+					Endpoint: "https://siem.example.com/audit",
+					Batch: &kubermaticv1.AuditWebhookBatchSettings{
+						MaxSize:       ptr.To[int32](500),
+						MaxWait:       &metav1.Duration{Duration: 5 * time.Second},
+						ThrottleQPS:   ptr.To[int32](20),
+						ThrottleBurst: ptr.To[int32](30),
+					},
+				},
+			},
+			expected: []string{
+				"--audit-webhook-config-file", "/etc/kubernetes/audit/webhook/webhook.yaml",
+				"--audit-webhook-mode", "batch",
+				"--audit-webhook-batch-max-size", "500",
+				"--audit-webhook-batch-max-wait", "5s",
+				"--audit-webhook-batch-throttle-enable=true",
+				"--audit-webhook-batch-throttle-qps", "20",
+				"--audit-webhook-batch-throttle-burst", "30",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					AuditLogging: test.auditLogging,
+				},
+			}
+
+			flags := getAuditWebhookFlags(cluster)
+			if !reflect.DeepEqual(test.expected, flags) {
+				t.Errorf("Expected flags to match:\n%s", diff.ObjectDiff(test.expected, flags))
+			}
+		})
+	}
+}
+
+func TestAuditWebhookConfigSecretName(t *testing.T) {
+	tests := []struct {
+		name     string
+		webhook  *kubermaticv1.AuditWebhookBackendSettings
+		expected string
+	}{
+		{
+			name:     "referenced webhook config",
+			webhook:  &kubermaticv1.AuditWebhookBackendSettings{AuditWebhookConfig: &corev1.SecretReference{Name: "my-webhook"}},
+			expected: "my-webhook",
+		},
+		{
+			name:     "generated webhook config",
+			webhook:  &kubermaticv1.AuditWebhookBackendSettings{Endpoint: "https://siem.example.com/audit"},
+			expected: resources.AuditWebhookConfigSecretName,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if name := auditWebhookConfigSecretName(test.webhook); name != test.expected {
+				t.Errorf("Expected Secret %q, got %q", test.expected, name)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/semver"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+func TestAuditLogRotation(t *testing.T) {
+	tests := []struct {
+		name              string
+		rotation          *kubermaticv1.AuditLogRotationSettings
+		expectedMaxAge    string
+		expectedMaxBackup string
+		expectedMaxSize   string
+		expectedVolume    string
+	}{
+		{
+			name:              "defaults",
+			expectedMaxAge:    "30",
+			expectedMaxBackup: "3",
+			expectedMaxSize:   "100",
+			expectedVolume:    "500Mi",
+		},
+		{
+			name: "custom rotation",
+			rotation: &kubermaticv1.AuditLogRotationSettings{
+				MaxAge:    ptr.To[int32](7),
+				MaxBackup: ptr.To[int32](10),
+				MaxSize:   ptr.To[int32](50),
+			},
+			expectedMaxAge:    "7",
+			expectedMaxBackup: "10",
+			expectedMaxSize:   "50",
+			expectedVolume:    "600Mi",
+		},
+		{
+			name: "partial rotation",
+			rotation: &kubermaticv1.AuditLogRotationSettings{
+				MaxSize: ptr.To[int32](200),
+			},
+			expectedMaxAge:    "30",
+			expectedMaxBackup: "3",
+			expectedMaxSize:   "200",
+			expectedVolume:    "1000Mi",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					Version: *semver.NewSemverOrDie("1.31.0"),
+					AuditLogging: &kubermaticv1.AuditLoggingSettings{
+						Enabled:  true,
+						Rotation: test.rotation,
+					},
+				},
+			}
+			data := resources.NewTemplateDataBuilder().WithCluster(cluster).Build()
+
+			flags, err := getApiserverFlags(data, []string{"https://etcd-0:2379"}, false, true, false, false)
+			if err != nil {
+				t.Fatalf("Failed to get apiserver flags: %v", err)
+			}
+
+			if value := flagValue(flags, "--audit-log-maxage"); value != test.expectedMaxAge {
+				t.Errorf("Expected --audit-log-maxage to be %q, got %q", test.expectedMaxAge, value)
+			}
+
+			if value := flagValue(flags, "--audit-log-maxbackup"); value != test.expectedMaxBackup {
+				t.Errorf("Expected --audit-log-maxbackup to be %q, got %q", test.expectedMaxBackup, value)
+			}
+
+			if value := flagValue(flags, "--audit-log-maxsize"); value != test.expectedMaxSize {
+				t.Errorf("Expected --audit-log-maxsize to be %q, got %q", test.expectedMaxSize, value)
+			}
+
+			for _, volume := range getVolumes(data, false, true, false) {
+				if volume.Name != resources.AuditLogVolumeName {
+					continue
+				}
+
+				if volume.EmptyDir == nil || volume.EmptyDir.SizeLimit == nil {
+					t.Fatalf("Expected audit log volume to be a sized emptyDir, got %+v", volume.VolumeSource)
+				}
+
+				if expected := resource.MustParse(test.expectedVolume); volume.EmptyDir.SizeLimit.Cmp(expected) != 0 {
+					t.Errorf("Expected audit log volume to be limited to %s, got %s", test.expectedVolume, volume.EmptyDir.SizeLimit.String())
+				}
+			}
+		})
+	}
+}
+
+func TestAuditLogVolumeWithoutAuditLogging(t *testing.T) {
+	data := resources.NewTemplateDataBuilder().WithCluster(&kubermaticv1.Cluster{}).Build()
+
+	for _, volume := range getVolumes(data, false, false, false) {
+		if volume.Name == resources.AuditLogVolumeName && (volume.EmptyDir == nil || volume.EmptyDir.SizeLimit != nil) {
+			t.Errorf("Expected audit log volume to be an unlimited emptyDir, got %+v", volume.VolumeSource)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+const (
+	authorizationWebhookMountPath = "/etc/kubernetes/authorization-webhook"
+	authorizationWebhookName      = "webhook-authorizer"
+)
+
+// authorizationWebhook returns the webhook authorizer configured for the cluster, or nil.
+func authorizationWebhook(cluster *kubermaticv1.Cluster) *kubermaticv1.AuthorizationWebhookSettings {
+	if cluster.Spec.Authorization == nil {
+		return nil
+	}
+
+	return cluster.Spec.Authorization.Webhook
+}
+
+// AuthorizationWebhookSecretReconciler returns a function to create the secret containing the kubeconfig
+// that the kube-apiserver uses to send SubjectAccessReviews to the cluster's webhook authorizer.
+func AuthorizationWebhookSecretReconciler(data *resources.TemplateData) reconciling.NamedSecretReconcilerFactory {
+	return func() (string, reconciling.SecretReconciler) {
+		return resources.AuthorizationWebhookSecretName, func(secret *corev1.Secret) (*corev1.Secret, error) {
+			webhook := authorizationWebhook(data.Cluster())
+			if webhook == nil {
+				return secret, nil
+			}
+
+			kubeconfig, err := authorizationWebhookKubeconfig(webhook)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render webhook authorizer kubeconfig: %w", err)
+			}
+
+			secret.Data = map[string][]byte{
+				resources.AuthorizationWebhookKubeconfigSecretKey: kubeconfig,
+			}
+
+			return secret, nil
+		}
+	}
+}
+
+// authorizationWebhookKubeconfig renders the kubeconfig file for the --authorization-webhook-config-file flag.
+// The apiserver authenticates the webhook only via its serving certificate, so no credentials are included.
+func authorizationWebhookKubeconfig(webhook *kubermaticv1.AuthorizationWebhookSettings) ([]byte, error) {
+	u, err := url.Parse(webhook.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return nil, errors.New("URL must be an absolute https URL")
+	}
+
+	cluster := &clientcmdapi.Cluster{
+		Server: webhook.URL,
+	}
+
+	if webhook.CABundle != "" {
+		if _, err := certutil.ParseCertsPEM([]byte(webhook.CABundle)); err != nil {
+			return nil, fmt.Errorf("invalid CA bundle: %w", err)
+		}
+
+		cluster.CertificateAuthorityData = []byte(webhook.CABundle)
+	}
+
+	config := clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			authorizationWebhookName: cluster,
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			resources.ApiserverDeploymentName: {},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			authorizationWebhookName: {
+				Cluster:  authorizationWebhookName,
+				AuthInfo: resources.ApiserverDeploymentName,
+			},
+		},
+		CurrentContext: authorizationWebhookName,
+	}
+
+	return clientcmd.Write(config)
+}
+
+// getAuthorizationFlags returns the kube-apiserver flags configuring the authorizer chain.
+func getAuthorizationFlags(cluster *kubermaticv1.Cluster) []string {
+	webhook := authorizationWebhook(cluster)
+	if webhook == nil {
+		return []string{"--authorization-mode", "Node,RBAC"}
+	}
+
+	flags := []string{
+		"--authorization-mode", "Node,RBAC,Webhook",
+		"--authorization-webhook-config-file", filepath.Join(authorizationWebhookMountPath, resources.AuthorizationWebhookKubeconfigSecretKey),
+		"--authorization-webhook-version", "v1",
+	}
+
+	if webhook.AuthorizedTTL != nil {
+		flags = append(flags, "--authorization-webhook-cache-authorized-ttl", webhook.AuthorizedTTL.Duration.String())
+	}
+
+	if webhook.UnauthorizedTTL != nil {
+		flags = append(flags, "--authorization-webhook-cache-unauthorized-ttl", webhook.UnauthorizedTTL.Duration.String())
+	}
+
+	return flags
+}
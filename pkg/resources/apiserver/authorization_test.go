@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestAuthorizationWebhookKubeconfig(t *testing.T) {
+	ca, err := triple.NewCA("webhook-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+	caBundle := string(triple.EncodeCertPEM(ca.Cert))
+
+	tests := []struct {
+		name      string
+		webhook   *kubermaticv1.AuthorizationWebhookSettings
+		expectErr bool
+	}{
+		{
+			name:    "https webhook without CA bundle",
+			webhook: &kubermaticv1.AuthorizationWebhookSettings{URL: "https://authz.example.com/authorize"},
+		},
+		{
+			name:    "https webhook with CA bundle",
+			webhook: &kubermaticv1.AuthorizationWebhookSettings{URL: "https://authz.example.com/authorize", CABundle: caBundle},
+		},
+		{
+			name:      "http webhook",
+			webhook:   &kubermaticv1.AuthorizationWebhookSettings{URL: "http://authz.example.com/authorize"},
+			expectErr: true,
+		},
+		{
+			name:      "webhook without host",
+			webhook:   &kubermaticv1.AuthorizationWebhookSettings{URL: "https:///authorize"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid CA bundle",
+			webhook:   &kubermaticv1.AuthorizationWebhookSettings{URL: "https://authz.example.com/authorize", CABundle: "not-a-certificate"},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			raw, err := authorizationWebhookKubeconfig(test.webhook)
+			if test.expectErr {
+				if err == nil {
+					t.Fatal("Expected an error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+
+			config, err := clientcmd.Load(raw)
+			if err != nil {
+				t.Fatalf("Failed to parse rendered kubeconfig: %v", err)
+			}
+
+			context, ok := config.Contexts[config.CurrentContext]
+			if !ok {
+				t.Fatalf("Expected current context %q to exist", config.CurrentContext)
+			}
+
+			cluster, ok := config.Clusters[context.Cluster]
+			if !ok {
+				t.Fatalf("Expected cluster %q to exist", context.Cluster)
+			}
+
+			if cluster.Server != test.webhook.URL {
+				t.Errorf("Expected server to be %q, got %q", test.webhook.URL, cluster.Server)
+			}
+
+			if !bytes.Equal(cluster.CertificateAuthorityData, []byte(test.webhook.CABundle)) {
+				t.Errorf("Expected CA data to be %q, got %q", test.webhook.CABundle, cluster.CertificateAuthorityData)
+			}
+		})
+	}
+}
+
+func TestGetAuthorizationFlags(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization *kubermaticv1.AuthorizationSettings
+		expected      []string
+	}{
+		{
+			name:     "no authorization settings",
+			expected: []string{"--authorization-mode", "Node,RBAC"},
+		},
+		{
+			name:          "no webhook",
+			authorization: &kubermaticv1.AuthorizationSettings{},
+			expected:      []string{"--authorization-mode", "Node,RBAC"},
+		},
+		{
+			name: "webhook with default TTLs",
+			authorization: &kubermaticv1.AuthorizationSettings{
+				Webhook: &kubermaticv1.AuthorizationWebhookSettings{URL: "https://authz.example.com"},
+			},
+			expected: []string{
+				"--authorization-mode", "Node,RBAC,Webhook",
+				"--authorization-webhook-config-file", "/etc/kubernetes/authorization-webhook/webhook.kubeconfig",
+				"--authorization-webhook-version", "v1",
+			},
+		},
+		{
+			name: "webhook with custom TTLs",
+			authorization: &kubermaticv1.AuthorizationSettings{
+				Webhook: &kubermaticv1.AuthorizationWebhookSettings{
+					URL:             "https://authz.example.com",
+					AuthorizedTTL:   &metav1.Duration{Duration: 2 * time.Minute},
+					UnauthorizedTTL: &metav1.Duration{Duration: 10 * time.Second},
+				},
+			},
+			expected: []string{
+				"--authorization-mode", "Node,RBAC,Webhook",
+				"--authorization-webhook-config-file", "/etc/kubernetes/authorization-webhook/webhook.kubeconfig",
+				"--authorization-webhook-version", "v1",
+				"--authorization-webhook-cache-authorized-ttl", "2m0s",
+				"--authorization-webhook-cache-unauthorized-ttl", "10s",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					Authorization: test.authorization,
+				},
+			}
+
+			flags := getAuthorizationFlags(cluster)
+			if !reflect.DeepEqual(test.expected, flags) {
+				t.Errorf("Expected flags to match:\n%s", diff.ObjectDiff(test.expected, flags))
+			}
+		})
+	}
+}
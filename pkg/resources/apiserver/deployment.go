@@ -20,11 +20,13 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
 	"k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	encryptionresources "k8c.io/kubermatic/v2/pkg/resources/encryption"
 	"k8c.io/kubermatic/v2/pkg/resources/etcd"
 	"k8c.io/kubermatic/v2/pkg/resources/etcd/etcdrunning"
 	"k8c.io/kubermatic/v2/pkg/resources/konnectivity"
@@ -68,23 +70,49 @@ func DeploymentReconciler(data *resources.TemplateData, enableOIDCAuthentication
 			baseLabels := resources.BaseAppLabels(resources.ApiserverDeploymentName, nil)
 			kubernetes.EnsureLabels(dep, baseLabels)
 
-			dep.Spec.Replicas = resources.Int32(1)
-			if data.Cluster().Spec.ComponentsOverride.Apiserver.Replicas != nil {
-				dep.Spec.Replicas = data.Cluster().Spec.ComponentsOverride.Apiserver.Replicas
+			// the replicas of an autoscaled apiserver are managed by its HorizontalPodAutoscaler
+			if autoscaling := data.APIServerAutoscaling(); autoscaling != nil {
+				dep.Spec.Replicas = resources.Int32(autoscaledReplicas(dep, autoscaling))
+			} else {
+				dep.Spec.Replicas = resources.Int32(getReplicas(data.Cluster()))
 			}
 
 			dep.Spec.Selector = &metav1.LabelSelector{
 				MatchLabels: baseLabels,
 			}
+			// Never remove a ready apiserver before its replacement has become ready, so that
+			// the external Service always has at least one ready endpoint during rollouts.
+			dep.Spec.Strategy.Type = appsv1.RollingUpdateDeploymentStrategyType
+			dep.Spec.Strategy.RollingUpdate = &appsv1.RollingUpdateDeployment{
+				MaxSurge: &intstr.IntOrString{
+					Type:   intstr.Int,
+					IntVal: 1,
+				},
+				MaxUnavailable: &intstr.IntOrString{
+					Type:   intstr.Int,
+					IntVal: 0,
+				},
+			}
 			dep.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: resources.ImagePullSecretName}}
+			dep.Spec.Template.Spec.PriorityClassName = data.ControlPlanePriorityClassName()
 			dep.Spec.Template.Spec.ServiceAccountName = rbac.EtcdLauncherServiceAccountName
 			dep.Spec.Template.Spec.AutomountServiceAccountToken = ptr.To(true)
 
+			if gracePeriod := data.APIServerTerminationGracePeriod(); gracePeriod > 0 {
+				dep.Spec.Template.Spec.TerminationGracePeriodSeconds = ptr.To(int64(gracePeriod / time.Second))
+			}
+
 			auditLogEnabled := data.Cluster().Spec.AuditLogging != nil && data.Cluster().Spec.AuditLogging.Enabled
 			auditWebhookBackendEnabled := data.Cluster().Spec.AuditLogging != nil && data.Cluster().Spec.AuditLogging.WebhookBackend != nil
 
 			volumes := getVolumes(data, enableEncryptionConfiguration, auditLogEnabled, auditWebhookBackendEnabled)
-			volumeMounts := getVolumeMounts(data.IsKonnectivityEnabled(), enableEncryptionConfiguration, auditWebhookBackendEnabled)
+			volumeMounts := getVolumeMounts(data.IsKonnectivityEnabled(), enableEncryptionConfiguration, auditWebhookBackendEnabled, authorizationWebhook(data.Cluster()) != nil)
+
+			kms := kmsConfiguration(data.Cluster())
+			if kms != nil {
+				volumes = append(volumes, kmsSocketVolume())
+				volumeMounts = append(volumeMounts, kmsSocketVolumeMount())
+			}
 
 			version := data.Cluster().Status.Versions.Apiserver.Semver()
 
@@ -99,6 +127,9 @@ func DeploymentReconciler(data *resources.TemplateData, enableOIDCAuthentication
 
 			// these volumes should not block the autoscaler from evicting the pod
 			safeToEvictVolumes := []string{resources.AuditLogVolumeName, resources.KonnectivityUDS}
+			if kms != nil {
+				safeToEvictVolumes = append(safeToEvictVolumes, encryptionresources.KMSSocketVolumeName)
+			}
 
 			kubernetes.EnsureLabels(&dep.Spec.Template, podLabels)
 			kubernetes.EnsureAnnotations(&dep.Spec.Template, map[string]string{
@@ -109,7 +140,7 @@ func DeploymentReconciler(data *resources.TemplateData, enableOIDCAuthentication
 				resources.ClusterAutoscalerSafeToEvictVolumesAnnotation: strings.Join(safeToEvictVolumes, ","),
 			})
 
-			etcdEndpoints := etcd.GetClientEndpoints(data.Cluster().Status.NamespaceName)
+			etcdEndpoints := etcd.GetClientEndpoints(data.EtcdNamespace())
 
 			dep.Spec.Template.Spec.DNSPolicy, dep.Spec.Template.Spec.DNSConfig, err = resources.UserClusterDNSPolicyAndConfig(data)
 			if err != nil {
@@ -151,18 +182,21 @@ func DeploymentReconciler(data *resources.TemplateData, enableOIDCAuthentication
 				return nil, err
 			}
 
-			if auditWebhookBackendEnabled && data.Cluster().Spec.AuditLogging.WebhookBackend.AuditWebhookInitialBackoff != "" {
-				flags = append(flags, "--audit-webhook-initial-backoff", data.Cluster().Spec.AuditLogging.WebhookBackend.AuditWebhookInitialBackoff)
-			}
-
 			envVars, err := GetEnvVars(data)
 			if err != nil {
 				return nil, err
 			}
 
+			// anonymous requests are rejected, so the probes have to authenticate
+			healthCheckToken, err := data.GetApiserverHealthCheckToken()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get health check token: %w", err)
+			}
+			probeHeaders := []corev1.HTTPHeader{{Name: "Authorization", Value: "Bearer " + healthCheckToken}}
+
 			apiserverContainer := &corev1.Container{
 				Name:    resources.ApiserverDeploymentName,
-				Image:   registry.Must(data.RewriteImage(resources.RegistryK8S + "/kube-apiserver:v" + version.String())),
+				Image:   registry.Must(data.ComponentImage(resources.ApiserverDeploymentName, resources.RegistryK8S+"/kube-apiserver:v"+version.String())),
 				Command: []string{"/usr/local/bin/kube-apiserver"},
 				Env:     envVars,
 				Args:    flags,
@@ -172,12 +206,16 @@ func DeploymentReconciler(data *resources.TemplateData, enableOIDCAuthentication
 						Protocol:      corev1.ProtocolTCP,
 					},
 				},
+				// /readyz only reports ready once the apiserver can actually serve requests
+				// (e.g. informers are synced) and reports not ready as soon as it starts
+				// shutting down, so Service endpoints only ever contain usable apiservers.
 				ReadinessProbe: &corev1.Probe{
 					ProbeHandler: corev1.ProbeHandler{
 						HTTPGet: &corev1.HTTPGetAction{
-							Path:   "/healthz",
-							Port:   intstr.FromInt(int(address.Port)),
-							Scheme: "HTTPS",
+							Path:        "/readyz",
+							Port:        intstr.FromInt(int(address.Port)),
+							Scheme:      "HTTPS",
+							HTTPHeaders: probeHeaders,
 						},
 					},
 					FailureThreshold: 3,
@@ -188,9 +226,10 @@ func DeploymentReconciler(data *resources.TemplateData, enableOIDCAuthentication
 				LivenessProbe: &corev1.Probe{
 					ProbeHandler: corev1.ProbeHandler{
 						HTTPGet: &corev1.HTTPGetAction{
-							Path:   "/healthz",
-							Port:   intstr.FromInt(int(address.Port)),
-							Scheme: "HTTPS",
+							Path:        "/healthz",
+							Port:        intstr.FromInt(int(address.Port)),
+							Scheme:      "HTTPS",
+							HTTPHeaders: probeHeaders,
 						},
 					},
 					InitialDelaySeconds: 15,
@@ -226,6 +265,12 @@ func DeploymentReconciler(data *resources.TemplateData, enableOIDCAuthentication
 				}
 			}
 
+			if kms != nil {
+				kmsSidecar := kmsPluginSidecar(kms)
+				dep.Spec.Template.Spec.Containers = append(dep.Spec.Template.Spec.Containers, kmsSidecar)
+				defResourceRequirements[kmsSidecar.Name] = kmsSidecar.Resources.DeepCopy()
+			}
+
 			overrides := resources.GetOverrides(data.Cluster().Spec.ComponentsOverride)
 
 			if auditLogEnabled {
@@ -285,6 +330,7 @@ func getApiserverFlags(data *resources.TemplateData, etcdEndpoints []string, ena
 	}
 
 	cluster := data.Cluster()
+	auditLogRotation := data.AuditLogRotation()
 
 	admissionPlugins := sets.New(
 		"NamespaceLifecycle",
@@ -314,6 +360,8 @@ func getApiserverFlags(data *resources.TemplateData, etcdEndpoints []string, ena
 	address := data.Cluster().Status.Address
 
 	serviceAccountKeyFile := filepath.Join("/etc/kubernetes/service-account-key", resources.ServiceAccountKeySecretKey)
+	// contains the current and all recently rotated public keys, so existing tokens stay valid after a rotation
+	serviceAccountVerificationKeysFile := filepath.Join("/etc/kubernetes/service-account-key", resources.ServiceAccountVerificationKeysSecretKey)
 	flags := []string{
 		"--etcd-servers", strings.Join(etcdEndpoints, ","),
 		"--etcd-cafile", "/etc/etcd/pki/client/ca.crt",
@@ -322,27 +370,32 @@ func getApiserverFlags(data *resources.TemplateData, etcdEndpoints []string, ena
 		"--storage-backend", "etcd3",
 		"--enable-admission-plugins", strings.Join(sets.List(admissionPlugins), ","),
 		"--admission-control-config-file", "/etc/kubernetes/adm-control/admission-control.yaml",
-		"--authorization-mode", "Node,RBAC",
+	}
+
+	flags = append(flags, getAuthorizationFlags(cluster)...)
+
+	flags = append(flags,
 		"--external-hostname", address.ExternalName,
 		"--token-auth-file", "/etc/kubernetes/tokens/tokens.csv",
 		"--enable-bootstrap-token-auth",
-		"--service-account-key-file", serviceAccountKeyFile,
-		"--service-cluster-ip-range", strings.Join(cluster.Spec.ClusterNetwork.Services.CIDRBlocks, ","),
+		"--service-account-key-file", serviceAccountVerificationKeysFile,
+		"--service-cluster-ip-range", data.ServiceClusterIPRange(),
 		"--service-node-port-range", overrideFlags.NodePortRange,
 		"--allow-privileged",
-		"--audit-log-maxage", "30",
-		"--audit-log-maxbackup", "3",
-		"--audit-log-maxsize", "100",
+		"--audit-log-maxage", fmt.Sprint(auditLogRotation.MaxAge),
+		"--audit-log-maxbackup", fmt.Sprint(auditLogRotation.MaxBackup),
+		"--audit-log-maxsize", fmt.Sprint(auditLogRotation.MaxSize),
 		"--audit-log-path", "/var/log/kubernetes/audit/audit.log",
 		"--tls-cert-file", "/etc/kubernetes/tls/apiserver-tls.crt",
-		"--tls-cipher-suites", strings.Join(resources.GetAllowedTLSCipherSuites(), ","),
+		"--tls-cipher-suites", strings.Join(data.APIServerTLSCipherSuites(), ","),
+		"--tls-min-version", data.APIServerTLSMinVersion(),
 		"--tls-private-key-file", "/etc/kubernetes/tls/apiserver-tls.key",
-		"--proxy-client-cert-file", "/etc/kubernetes/pki/front-proxy/client/" + resources.ApiserverProxyClientCertificateCertSecretKey,
-		"--proxy-client-key-file", "/etc/kubernetes/pki/front-proxy/client/" + resources.ApiserverProxyClientCertificateKeySecretKey,
+		"--proxy-client-cert-file", filepath.Join("/etc/kubernetes/pki/front-proxy/client", resources.ApiserverProxyClientCertificateCertSecretKey),
+		"--proxy-client-key-file", filepath.Join("/etc/kubernetes/pki/front-proxy/client", resources.ApiserverProxyClientCertificateKeySecretKey),
 		"--client-ca-file", "/etc/kubernetes/pki/ca/ca.crt",
 		"--kubelet-client-certificate", "/etc/kubernetes/kubelet/kubelet-client.crt",
 		"--kubelet-client-key", "/etc/kubernetes/kubelet/kubelet-client.key",
-	}
+	)
 
 	// the "bring-your-own" provider does not support automatic TLS rotation in kubelets yet,
 	// and because of that certs might expire and kube-apiserver cannot validate the connection anymore.
@@ -350,15 +403,12 @@ func getApiserverFlags(data *resources.TemplateData, etcdEndpoints []string, ena
 		flags = append(flags, "--kubelet-certificate-authority", "/etc/kubernetes/pki/ca/ca.crt")
 	}
 
+	flags = append(flags, getRequestHeaderFlags(data.FrontProxyClientCertificateCommonName())...)
 	flags = append(flags,
-		"--requestheader-client-ca-file", "/etc/kubernetes/pki/front-proxy/ca/ca.crt",
-		"--requestheader-allowed-names", "apiserver-aggregator",
-		"--requestheader-extra-headers-prefix", "X-Remote-Extra-",
-		"--requestheader-group-headers", "X-Remote-Group",
-		"--requestheader-username-headers", "X-Remote-User",
 		"--endpoint-reconciler-type", "none",
 		// this can't be passed as two strings as the other parameters
 		"--profiling=false",
+		"--anonymous-auth=false",
 	)
 
 	// prepend to have advertise-address as first argument and avoid
@@ -378,21 +428,15 @@ func getApiserverFlags(data *resources.TemplateData, etcdEndpoints []string, ena
 	}
 
 	if auditWebhookEnabled {
-		flags = append(flags, "--audit-webhook-config-file", "/etc/kubernetes/audit/webhook/webhook.yaml")
+		flags = append(flags, getAuditWebhookFlags(cluster)...)
 	}
-	// enable service account signing key and issuer in Kubernetes 1.20 or when
-	// explicitly enabled in the cluster object
+	// configure service account token signing and a stable issuer, so that projected
+	// tokens can be verified by external parties using the published JWKS
 	var audiences []string
 
-	issuer := address.URL
-	if saConfig := cluster.Spec.ServiceAccount; saConfig != nil {
-		if saConfig.Issuer != "" {
-			issuer = saConfig.Issuer
-		}
-
-		if len(saConfig.APIAudiences) > 0 {
-			audiences = saConfig.APIAudiences
-		}
+	issuer := data.ServiceAccountIssuerURL()
+	if saConfig := cluster.Spec.ServiceAccount; saConfig != nil && len(saConfig.APIAudiences) > 0 {
+		audiences = saConfig.APIAudiences
 	}
 
 	if len(audiences) == 0 {
@@ -406,6 +450,7 @@ func getApiserverFlags(data *resources.TemplateData, etcdEndpoints []string, ena
 	flags = append(flags,
 		"--service-account-issuer", issuer,
 		"--service-account-signing-key-file", serviceAccountKeyFile,
+		"--service-account-jwks-uri", data.ServiceAccountJWKSURI(),
 		"--api-audiences", strings.Join(audiences, ","),
 	)
 
@@ -461,11 +506,40 @@ func getApiserverFlags(data *resources.TemplateData, etcdEndpoints []string, ena
 			"/etc/kubernetes/konnectivity/egress-selector-configuration.yaml")
 	}
 
+	// keep serving requests for a while after receiving SIGTERM, so that the pod is removed
+	// from the Service endpoints before the apiserver stops accepting new connections
+	if shutdownDelay := data.APIServerShutdownDelay(); shutdownDelay > 0 {
+		flags = append(flags, "--shutdown-delay-duration", shutdownDelay.String())
+	}
+
+	// pods are evicted from NotReady/unreachable nodes once their tolerations for
+	// the corresponding taints expire, so this is the pod eviction timeout
+	if timeout := cluster.Spec.ComponentsOverride.ControllerManager.PodEvictionTimeout; timeout != nil {
+		seconds := fmt.Sprintf("%d", int64(timeout.Duration.Seconds()))
+		flags = append(flags, "--default-not-ready-toleration-seconds", seconds)
+		flags = append(flags, "--default-unreachable-toleration-seconds", seconds)
+	}
+
 	if enableEncryption {
 		flags = append(flags, "--encryption-provider-config",
 			"/etc/kubernetes/encryption-configuration/encryption-configuration.yaml")
 	}
 
+	limits := data.APIServerRequestLimits()
+	flags = append(flags,
+		"--max-requests-inflight", fmt.Sprintf("%d", limits.MaxRequestsInflight),
+		"--max-mutating-requests-inflight", fmt.Sprintf("%d", limits.MaxMutatingRequestsInflight),
+	)
+
+	flags = append(flags, data.LogVerbosityFlags(resources.ApiserverDeploymentName)...)
+	flags = append(flags, data.LoggingFlags()...)
+
+	// extra args are validated by the cluster webhook; clusters which have been stored before
+	// must not weaken the authentication either, so their extra args are ignored
+	if extraArgs := cluster.Spec.ComponentsOverride.Apiserver.ExtraArgs; resources.ValidateAPIServerHardeningFlags(extraArgs) == nil {
+		flags = append(flags, extraArgs...)
+	}
+
 	return flags, nil
 }
 
@@ -485,7 +559,7 @@ func getApiserverOverrideFlags(data *resources.TemplateData) (kubermaticv1.APISe
 	return settings, nil
 }
 
-func getVolumeMounts(isKonnectivityEnabled, isEncryptionEnabled bool, isAuditWebhookEnabled bool) []corev1.VolumeMount {
+func getVolumeMounts(isKonnectivityEnabled, isEncryptionEnabled bool, isAuditWebhookEnabled bool, isAuthorizationWebhookEnabled bool) []corev1.VolumeMount {
 	vms := []corev1.VolumeMount{
 		{
 			MountPath: "/etc/kubernetes/tls",
@@ -579,7 +653,15 @@ func getVolumeMounts(isKonnectivityEnabled, isEncryptionEnabled bool, isAuditWeb
 	if isAuditWebhookEnabled {
 		vms = append(vms, corev1.VolumeMount{
 			Name:      resources.AuditWebhookVolumeName,
-			MountPath: "/etc/kubernetes/audit/webhook",
+			MountPath: auditWebhookMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	if isAuthorizationWebhookEnabled {
+		vms = append(vms, corev1.VolumeMount{
+			Name:      resources.AuthorizationWebhookSecretName,
+			MountPath: authorizationWebhookMountPath,
 			ReadOnly:  true,
 		})
 	}
@@ -587,6 +669,19 @@ func getVolumeMounts(isKonnectivityEnabled, isEncryptionEnabled bool, isAuditWeb
 	return vms
 }
 
+// auditLogVolumeSource returns the emptyDir holding the audit log file. When audit logging is
+// enabled, it is sized to fit the log file and all its rotated backups, so that the audit log
+// cannot exhaust the ephemeral storage of the node.
+func auditLogVolumeSource(data *resources.TemplateData, isAuditEnabled bool) *corev1.EmptyDirVolumeSource {
+	if !isAuditEnabled {
+		return &corev1.EmptyDirVolumeSource{}
+	}
+
+	size := data.AuditLogRotation().VolumeSize()
+
+	return &corev1.EmptyDirVolumeSource{SizeLimit: &size}
+}
+
 func getVolumes(data *resources.TemplateData, isEncryptionEnabled, isAuditEnabled bool, isAuditWebhookEnabled bool) []corev1.Volume {
 	vs := []corev1.Volume{
 		{
@@ -691,7 +786,7 @@ func getVolumes(data *resources.TemplateData, isEncryptionEnabled, isAuditEnable
 		{
 			Name: resources.AuditLogVolumeName,
 			VolumeSource: corev1.VolumeSource{
-				EmptyDir: &corev1.EmptyDirVolumeSource{},
+				EmptyDir: auditLogVolumeSource(data, isAuditEnabled),
 			},
 		},
 		{
@@ -792,7 +887,18 @@ func getVolumes(data *resources.TemplateData, isEncryptionEnabled, isAuditEnable
 			Name: resources.AuditWebhookVolumeName,
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
-					SecretName: data.Cluster().Spec.AuditLogging.WebhookBackend.AuditWebhookConfig.Name,
+					SecretName: auditWebhookConfigSecretName(data.Cluster().Spec.AuditLogging.WebhookBackend),
+				},
+			},
+		})
+	}
+
+	if authorizationWebhook(data.Cluster()) != nil {
+		vs = append(vs, corev1.Volume{
+			Name: resources.AuthorizationWebhookSecretName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: resources.AuthorizationWebhookSecretName,
 				},
 			},
 		})
@@ -835,9 +941,27 @@ func GetEnvVars(data kubeAPIServerEnvData) ([]corev1.EnvVar, error) {
 		vars = append(vars, corev1.EnvVar{Name: "AWS_ASSUME_ROLE_EXTERNAL_ID", Value: cluster.Spec.Cloud.AWS.AssumeRoleExternalID})
 	}
 
-	return append(vars, resources.GetHTTPProxyEnvVarsFromSeed(data.Seed(), data.Cluster().Status.Address.InternalName)...), nil
+	return append(vars, resources.GetHTTPProxyEnvVars(data.Seed(), data.Cluster())...), nil
 }
 
 func intPtr(n int32) *int32 {
 	return &n
 }
+
+// getReplicas returns the number of apiserver replicas for the given cluster.
+func getReplicas(cluster *kubermaticv1.Cluster) int32 {
+	return resources.ControlPlaneReplicas(cluster, cluster.Spec.ComponentsOverride.Apiserver.Replicas)
+}
+
+// getRequestHeaderFlags configures the aggregation layer to only accept requests
+// proxied with the front-proxy client certificate issued with the given CN.
+// As the CN is part of the flags, changing it rolls out the apiserver.
+func getRequestHeaderFlags(allowedName string) []string {
+	return []string{
+		"--requestheader-client-ca-file", "/etc/kubernetes/pki/front-proxy/ca/ca.crt",
+		"--requestheader-allowed-names", allowedName,
+		"--requestheader-extra-headers-prefix", "X-Remote-Extra-",
+		"--requestheader-group-headers", "X-Remote-Group",
+		"--requestheader-username-headers", "X-Remote-User",
+	}
+}
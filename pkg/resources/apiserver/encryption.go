@@ -21,6 +21,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
@@ -34,6 +35,9 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
+// kmsTimeout is the time kube-apiserver waits for the KMS plugin to respond.
+const kmsTimeout = 3 * time.Second
+
 type encryptionData interface {
 	Cluster() *kubermaticv1.Cluster
 	GetSecretKeyValue(ref *corev1.SecretKeySelector) ([]byte, error)
@@ -130,6 +134,17 @@ func EncryptionConfigurationSecretReconciler(data encryptionData) reconciling.Na
 					})
 				}
 
+				if kms := data.Cluster().Spec.EncryptionConfiguration.KMS; kms != nil {
+					providerList = append(providerList, apiserverconfigv1.ProviderConfiguration{
+						KMS: &apiserverconfigv1.KMSConfiguration{
+							APIVersion: "v2",
+							Name:       kms.Name,
+							Endpoint:   "unix://" + encryptionresources.KMSEndpoint(kms),
+							Timeout:    &metav1.Duration{Duration: kmsTimeout},
+						},
+					})
+				}
+
 				// always append the "unencrypted" provider.
 				providerList = append(providerList, apiserverconfigv1.ProviderConfiguration{
 					Identity: &apiserverconfigv1.IdentityConfiguration{},
@@ -192,6 +207,16 @@ func EncryptionConfigurationSecretReconciler(data encryptionData) reconciling.Na
 
 			secret.ObjectMeta.Labels[encryptionresources.ApiserverEncryptionHashLabelKey] = hex.EncodeToString(hash.Sum(nil))
 
+			// the EncryptionConfiguration does not know about the key a KMS plugin is using, so
+			// it is recorded on the secret to allow detecting key rotations.
+			if data.Cluster().IsEncryptionEnabled() && data.Cluster().Spec.EncryptionConfiguration.KMS != nil {
+				if secret.ObjectMeta.Annotations == nil {
+					secret.ObjectMeta.Annotations = map[string]string{}
+				}
+
+				secret.ObjectMeta.Annotations[encryptionresources.KMSKeyIDAnnotationKey] = data.Cluster().Spec.EncryptionConfiguration.KMS.KeyID
+			}
+
 			return secret, nil
 		}
 	}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/registry"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	externalProbeContainerName = "probe"
+	externalProbeKubeconfigDir = "/etc/kubernetes/kubeconfig"
+)
+
+var externalProbeResourceRequirements = corev1.ResourceRequirements{
+	Requests: corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("16Mi"),
+		corev1.ResourceCPU:    resource.MustParse("5m"),
+	},
+	Limits: corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("64Mi"),
+		corev1.ResourceCPU:    resource.MustParse("50m"),
+	},
+}
+
+type externalProbeData interface {
+	Cluster() *kubermaticv1.Cluster
+	RewriteImage(string) (string, error)
+	GetPodTemplateLabels(string, []corev1.Volume, map[string]string) (map[string]string, error)
+}
+
+// ExternalProbeDeploymentReconciler returns the function to create and update the Deployment that
+// checks the apiserver through its external address. The probe uses the admin kubeconfig, which
+// points to the external address and verifies the apiserver against the cluster CA. The Deployment
+// only becomes ready while the apiserver is reachable, which the cluster controller reflects in
+// the APIServerExternallyReachable condition.
+func ExternalProbeDeploymentReconciler(data externalProbeData) reconciling.NamedDeploymentReconcilerFactory {
+	return func() (string, reconciling.DeploymentReconciler) {
+		return resources.ApiserverExternalProbeDeploymentName, func(dep *appsv1.Deployment) (*appsv1.Deployment, error) {
+			baseLabels := resources.BaseAppLabels(resources.ApiserverExternalProbeDeploymentName, nil)
+			kubernetes.EnsureLabels(dep, baseLabels)
+
+			dep.Spec.Replicas = resources.Int32(1)
+			dep.Spec.Selector = &metav1.LabelSelector{
+				MatchLabels: baseLabels,
+			}
+
+			volumes := []corev1.Volume{
+				{
+					Name: resources.AdminKubeconfigSecretName,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: resources.AdminKubeconfigSecretName,
+						},
+					},
+				},
+			}
+
+			podLabels, err := data.GetPodTemplateLabels(resources.ApiserverExternalProbeDeploymentName, volumes, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			kubernetes.EnsureLabels(&dep.Spec.Template, podLabels)
+
+			kubeconfig := fmt.Sprintf("%s/%s", externalProbeKubeconfigDir, resources.KubeconfigSecretKey)
+
+			dep.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: resources.ImagePullSecretName}}
+			dep.Spec.Template.Spec.AutomountServiceAccountToken = ptr.To(false)
+			dep.Spec.Template.Spec.Volumes = volumes
+			dep.Spec.Template.Spec.Containers = []corev1.Container{
+				{
+					Name:    externalProbeContainerName,
+					Image:   registry.Must(data.RewriteImage(resources.RegistryQuay + "/kubermatic/util:2.5.0")),
+					Command: []string{"/bin/bash", "-c", "trap exit TERM; while true; do sleep 3600 & wait; done"},
+					ReadinessProbe: &corev1.Probe{
+						ProbeHandler: corev1.ProbeHandler{
+							Exec: &corev1.ExecAction{
+								Command: []string{"kubectl", "--kubeconfig", kubeconfig, "get", "--raw", "/readyz"},
+							},
+						},
+						FailureThreshold: 3,
+						PeriodSeconds:    10,
+						SuccessThreshold: 1,
+						TimeoutSeconds:   10,
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      resources.AdminKubeconfigSecretName,
+							MountPath: externalProbeKubeconfigDir,
+							ReadOnly:  true,
+						},
+					},
+					Resources: *externalProbeResourceRequirements.DeepCopy(),
+				},
+			}
+
+			return dep, nil
+		}
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type fakeExternalProbeData struct {
+	cluster *kubermaticv1.Cluster
+}
+
+func (f *fakeExternalProbeData) Cluster() *kubermaticv1.Cluster {
+	return f.cluster
+}
+
+func (f *fakeExternalProbeData) RewriteImage(image string) (string, error) {
+	return strings.Replace(image, resources.RegistryQuay, "registry.example.com", 1), nil
+}
+
+func (f *fakeExternalProbeData) GetPodTemplateLabels(appName string, _ []corev1.Volume, _ map[string]string) (map[string]string, error) {
+	return resources.BaseAppLabels(appName, nil), nil
+}
+
+func TestExternalProbeDeploymentReconciler(t *testing.T) {
+	name, reconciler := ExternalProbeDeploymentReconciler(&fakeExternalProbeData{cluster: &kubermaticv1.Cluster{}})()
+	if name != resources.ApiserverExternalProbeDeploymentName {
+		t.Fatalf("Expected Deployment name %q, got %q", resources.ApiserverExternalProbeDeploymentName, name)
+	}
+
+	dep, err := reconciler(&appsv1.Deployment{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile Deployment: %v", err)
+	}
+
+	if dep.Spec.Selector == nil || dep.Spec.Selector.MatchLabels[resources.AppLabelKey] != resources.ApiserverExternalProbeDeploymentName {
+		t.Errorf("Expected selector to match app label %q, got %v", resources.ApiserverExternalProbeDeploymentName, dep.Spec.Selector)
+	}
+
+	podSpec := dep.Spec.Template.Spec
+	if podSpec.AutomountServiceAccountToken == nil || *podSpec.AutomountServiceAccountToken {
+		t.Error("Expected service account token not to be mounted")
+	}
+
+	if len(podSpec.Volumes) != 1 || podSpec.Volumes[0].Secret == nil || podSpec.Volumes[0].Secret.SecretName != resources.AdminKubeconfigSecretName {
+		t.Fatalf("Expected a single volume for the %q secret, got %v", resources.AdminKubeconfigSecretName, podSpec.Volumes)
+	}
+
+	if len(podSpec.Containers) != 1 {
+		t.Fatalf("Expected a single container, got %d", len(podSpec.Containers))
+	}
+
+	container := podSpec.Containers[0]
+	if !strings.HasPrefix(container.Image, "registry.example.com/") {
+		t.Errorf("Expected image to be rewritten, got %q", container.Image)
+	}
+
+	if len(container.VolumeMounts) != 1 || container.VolumeMounts[0].Name != resources.AdminKubeconfigSecretName || container.VolumeMounts[0].MountPath != externalProbeKubeconfigDir {
+		t.Errorf("Expected the admin kubeconfig to be mounted at %q, got %v", externalProbeKubeconfigDir, container.VolumeMounts)
+	}
+
+	if container.ReadinessProbe == nil || container.ReadinessProbe.Exec == nil {
+		t.Fatal("Expected an exec readiness probe")
+	}
+
+	command := strings.Join(container.ReadinessProbe.Exec.Command, " ")
+	expected := "kubectl --kubeconfig " + externalProbeKubeconfigDir + "/" + resources.KubeconfigSecretKey + " get --raw /readyz"
+	if command != expected {
+		t.Errorf("Expected readiness probe command %q, got %q", expected, command)
+	}
+}
@@ -25,13 +25,14 @@ import (
 
 type frontProxyClientCertificateReconcilerData interface {
 	GetFrontProxyCA() (*triple.KeyPair, error)
+	FrontProxyClientCertificateCommonName() string
 }
 
 // FrontProxyClientCertificateReconciler returns a function to create/update the secret with the client certificate for authenticating against extension apiserver.
 func FrontProxyClientCertificateReconciler(data frontProxyClientCertificateReconcilerData) reconciling.NamedSecretReconcilerFactory {
 	return certificates.GetClientCertificateReconciler(
 		resources.ApiserverFrontProxyClientCertificateSecretName,
-		"apiserver-aggregator",
+		data.FrontProxyClientCertificateCommonName(),
 		nil,
 		resources.ApiserverProxyClientCertificateCertSecretKey,
 		resources.ApiserverProxyClientCertificateKeySecretKey,
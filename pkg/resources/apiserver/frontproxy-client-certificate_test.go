@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"testing"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+
+	corev1 "k8s.io/api/core/v1"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+type fakeFrontProxyData struct {
+	ca         *triple.KeyPair
+	commonName string
+}
+
+func (d *fakeFrontProxyData) GetFrontProxyCA() (*triple.KeyPair, error) {
+	return d.ca, nil
+}
+
+func (d *fakeFrontProxyData) FrontProxyClientCertificateCommonName() string {
+	return d.commonName
+}
+
+func TestRequestHeaderAllowedNamesMatchFrontProxyClientCertificate(t *testing.T) {
+	ca, err := triple.NewCA("front-proxy-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	testCases := []struct {
+		name       string
+		commonName string
+	}{
+		{
+			name:       "default common name",
+			commonName: resources.ApiserverFrontProxyClientCertificateCommonName,
+		},
+		{
+			name:       "custom common name",
+			commonName: "custom-aggregator",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			data := &fakeFrontProxyData{ca: ca, commonName: test.commonName}
+
+			_, reconciler := FrontProxyClientCertificateReconciler(data)()
+			se, err := reconciler(&corev1.Secret{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile front-proxy client certificate: %v", err)
+			}
+
+			certs, err := certutil.ParseCertsPEM(se.Data[resources.ApiserverProxyClientCertificateCertSecretKey])
+			if err != nil {
+				t.Fatalf("Failed to parse certificate: %v", err)
+			}
+
+			var allowedNames string
+			flags := getRequestHeaderFlags(data.FrontProxyClientCertificateCommonName())
+			for i := 0; i < len(flags)-1; i++ {
+				if flags[i] == "--requestheader-allowed-names" {
+					allowedNames = flags[i+1]
+				}
+			}
+
+			if allowedNames != certs[0].Subject.CommonName {
+				t.Errorf("Expected --requestheader-allowed-names to be %q, got %q", certs[0].Subject.CommonName, allowedNames)
+			}
+		})
+	}
+}
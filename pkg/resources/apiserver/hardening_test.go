@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"slices"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/semver"
+)
+
+func TestApiserverHardeningFlags(t *testing.T) {
+	tests := []struct {
+		name              string
+		extraArgs         []string
+		expectedExtraArgs bool
+	}{
+		{
+			name: "no extra args",
+		},
+		{
+			name:              "extra args are passed on",
+			extraArgs:         []string{"--watch-cache=false"},
+			expectedExtraArgs: true,
+		},
+		{
+			name:      "extra args enabling anonymous authentication are ignored",
+			extraArgs: []string{"--watch-cache=false", "--anonymous-auth", "true"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					Version: *semver.NewSemverOrDie("1.31.0"),
+					ComponentsOverride: kubermaticv1.ComponentSettings{
+						Apiserver: kubermaticv1.APIServerSettings{
+							ExtraArgs: test.extraArgs,
+						},
+					},
+				},
+			}
+			data := resources.NewTemplateDataBuilder().WithCluster(cluster).Build()
+
+			flags, err := getApiserverFlags(data, []string{"https://etcd-0:2379"}, false, false, false, false)
+			if err != nil {
+				t.Fatalf("Failed to get apiserver flags: %v", err)
+			}
+
+			if err := resources.ValidateAPIServerHardeningFlags(flags); err != nil {
+				t.Errorf("Expected flags not to weaken authentication: %v", err)
+			}
+
+			if !slices.Contains(flags, "--anonymous-auth=false") {
+				t.Errorf("Expected anonymous authentication to be disabled, got flags %v", flags)
+			}
+
+			if hasExtraArgs := slices.Contains(flags, "--watch-cache=false"); hasExtraArgs != test.expectedExtraArgs {
+				t.Errorf("Expected extra args to be passed = %v, got flags %v", test.expectedExtraArgs, flags)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"errors"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	kkpreconciling "k8c.io/kubermatic/v2/pkg/resources/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+type hpaData interface {
+	APIServerAutoscaling() *kubermaticv1.APIServerAutoscalingSettings
+}
+
+// HorizontalPodAutoscalerReconciler returns a func to create/update the apiserver
+// HorizontalPodAutoscaler, which scales the apiserver based on its CPU utilization.
+func HorizontalPodAutoscalerReconciler(data hpaData) kkpreconciling.NamedHorizontalPodAutoscalerReconcilerFactory {
+	return func() (string, kkpreconciling.HorizontalPodAutoscalerReconciler) {
+		return resources.ApiserverHorizontalPodAutoscalerName, func(hpa *autoscalingv2.HorizontalPodAutoscaler) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+			settings := data.APIServerAutoscaling()
+			if settings == nil {
+				return nil, errors.New("apiserver autoscaling is not enabled")
+			}
+
+			hpa.Spec.ScaleTargetRef = autoscalingv2.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       resources.ApiserverDeploymentName,
+			}
+			hpa.Spec.MinReplicas = ptr.To(*settings.MinReplicas)
+			hpa.Spec.MaxReplicas = *settings.MaxReplicas
+			hpa.Spec.Metrics = []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name: corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{
+						Type:               autoscalingv2.UtilizationMetricType,
+						AverageUtilization: ptr.To(*settings.TargetCPUUtilizationPercentage),
+					},
+				},
+			}}
+
+			return hpa, nil
+		}
+	}
+}
+
+// autoscaledReplicas returns the number of replicas of an autoscaled apiserver Deployment.
+// The replicas of an existing Deployment are managed by the HorizontalPodAutoscaler and
+// are only brought back into the configured bounds.
+func autoscaledReplicas(dep *appsv1.Deployment, settings *kubermaticv1.APIServerAutoscalingSettings) int32 {
+	if dep.ResourceVersion == "" || dep.Spec.Replicas == nil {
+		return *settings.MinReplicas
+	}
+
+	return min(max(*dep.Spec.Replicas, *settings.MinReplicas), *settings.MaxReplicas)
+}
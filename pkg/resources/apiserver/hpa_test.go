@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/utils/ptr"
+)
+
+type fakeHPAData struct {
+	settings *kubermaticv1.APIServerAutoscalingSettings
+}
+
+func (d *fakeHPAData) APIServerAutoscaling() *kubermaticv1.APIServerAutoscalingSettings {
+	return d.settings
+}
+
+func TestHorizontalPodAutoscalerReconciler(t *testing.T) {
+	data := &fakeHPAData{
+		settings: &kubermaticv1.APIServerAutoscalingSettings{
+			MinReplicas:                    ptr.To[int32](2),
+			MaxReplicas:                    ptr.To[int32](6),
+			TargetCPUUtilizationPercentage: ptr.To[int32](75),
+		},
+	}
+
+	_, reconcile := HorizontalPodAutoscalerReconciler(data)()
+
+	hpa, err := reconcile(&autoscalingv2.HorizontalPodAutoscaler{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile HorizontalPodAutoscaler: %v", err)
+	}
+
+	if hpa.Spec.ScaleTargetRef.Kind != "Deployment" || hpa.Spec.ScaleTargetRef.Name != name {
+		t.Errorf("Expected the apiserver Deployment to be scaled, got %+v", hpa.Spec.ScaleTargetRef)
+	}
+
+	if *hpa.Spec.MinReplicas != 2 || hpa.Spec.MaxReplicas != 6 {
+		t.Errorf("Expected 2 to 6 replicas, got %d to %d", *hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas)
+	}
+
+	if len(hpa.Spec.Metrics) != 1 || *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization != 75 {
+		t.Errorf("Expected a single CPU utilization target of 75%%, got %+v", hpa.Spec.Metrics)
+	}
+}
+
+func TestAutoscaledReplicas(t *testing.T) {
+	settings := &kubermaticv1.APIServerAutoscalingSettings{
+		MinReplicas: ptr.To[int32](2),
+		MaxReplicas: ptr.To[int32](6),
+	}
+
+	tests := []struct {
+		name     string
+		existing *appsv1.Deployment
+		expected int32
+	}{
+		{
+			name:     "new deployment starts with the minimum",
+			existing: &appsv1.Deployment{},
+			expected: 2,
+		},
+		{
+			name:     "replicas set by the autoscaler are kept",
+			existing: existingDeployment(4),
+			expected: 4,
+		},
+		{
+			name:     "replicas below the minimum are raised",
+			existing: existingDeployment(1),
+			expected: 2,
+		},
+		{
+			name:     "replicas above the maximum are lowered",
+			existing: existingDeployment(10),
+			expected: 6,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if replicas := autoscaledReplicas(test.existing, settings); replicas != test.expected {
+				t.Errorf("Expected %d replicas, got %d", test.expected, replicas)
+			}
+		})
+	}
+}
+
+func existingDeployment(replicas int32) *appsv1.Deployment {
+	dep := &appsv1.Deployment{}
+	dep.ResourceVersion = "1"
+	dep.Spec.Replicas = ptr.To(replicas)
+
+	return dep
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	encryptionresources "k8c.io/kubermatic/v2/pkg/resources/encryption"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	kmsPluginSidecarName = "kms-plugin"
+)
+
+var kmsPluginResourceRequirements = corev1.ResourceRequirements{
+	Requests: corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("32Mi"),
+		corev1.ResourceCPU:    resource.MustParse("10m"),
+	},
+	Limits: corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("128Mi"),
+		corev1.ResourceCPU:    resource.MustParse("100m"),
+	},
+}
+
+// kmsConfiguration returns the KMS plugin configuration if the cluster encrypts its
+// data through a KMS plugin. The plugin is also needed while encryption is being
+// disabled, as kube-apiserver still has to decrypt existing data with it.
+func kmsConfiguration(cluster *kubermaticv1.Cluster) *kubermaticv1.KMSEncryptionConfiguration {
+	if !cluster.IsEncryptionEnabled() && !cluster.IsEncryptionActive() {
+		return nil
+	}
+
+	if cluster.Spec.EncryptionConfiguration == nil {
+		return nil
+	}
+
+	return cluster.Spec.EncryptionConfiguration.KMS
+}
+
+// kmsPluginSidecar returns the container running the KMS plugin next to kube-apiserver.
+func kmsPluginSidecar(kms *kubermaticv1.KMSEncryptionConfiguration) corev1.Container {
+	return corev1.Container{
+		Name:  kmsPluginSidecarName,
+		Image: kms.Image,
+		Args:  kms.Args,
+		VolumeMounts: []corev1.VolumeMount{
+			kmsSocketVolumeMount(),
+		},
+		Resources: *kmsPluginResourceRequirements.DeepCopy(),
+	}
+}
+
+func kmsSocketVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: encryptionresources.KMSSocketVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+}
+
+func kmsSocketVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      encryptionresources.KMSSocketVolumeName,
+		MountPath: encryptionresources.KMSSocketDir,
+	}
+}
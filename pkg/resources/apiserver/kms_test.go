@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	encryptionresources "k8c.io/kubermatic/v2/pkg/resources/encryption"
+
+	corev1 "k8s.io/api/core/v1"
+	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"sigs.k8s.io/yaml"
+)
+
+type fakeEncryptionData struct {
+	cluster *kubermaticv1.Cluster
+}
+
+func (f *fakeEncryptionData) Cluster() *kubermaticv1.Cluster {
+	return f.cluster
+}
+
+func (f *fakeEncryptionData) GetSecretKeyValue(_ *corev1.SecretKeySelector) ([]byte, error) {
+	return nil, nil
+}
+
+func kmsCluster(enabled bool, endpoint string) *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		Spec: kubermaticv1.ClusterSpec{
+			Features: map[string]bool{
+				kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+			},
+			EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+				Enabled:   enabled,
+				Resources: []string{"secrets"},
+				KMS: &kubermaticv1.KMSEncryptionConfiguration{
+					Name:     "vault",
+					Image:    "registry.example.com/vault-kms-plugin:v1.0.0",
+					Args:     []string{"--listen=" + encryptionresources.KMSDefaultEndpoint},
+					Endpoint: endpoint,
+					KeyID:    "key-1",
+				},
+			},
+		},
+	}
+}
+
+func TestKMSPluginSidecar(t *testing.T) {
+	tests := []struct {
+		name            string
+		cluster         *kubermaticv1.Cluster
+		expectedSidecar bool
+	}{
+		{
+			name:            "KMS encryption enabled",
+			cluster:         kmsCluster(true, ""),
+			expectedSidecar: true,
+		},
+		{
+			name:            "KMS encryption disabled",
+			cluster:         kmsCluster(false, ""),
+			expectedSidecar: false,
+		},
+		{
+			name: "KMS encryption disabled but data still encrypted",
+			cluster: func() *kubermaticv1.Cluster {
+				c := kmsCluster(false, "")
+				c.Status.Conditions = map[kubermaticv1.ClusterConditionType]kubermaticv1.ClusterCondition{
+					kubermaticv1.ClusterConditionEncryptionInitialized: {Status: corev1.ConditionTrue},
+				}
+				return c
+			}(),
+			expectedSidecar: true,
+		},
+		{
+			name: "secretbox encryption enabled",
+			cluster: func() *kubermaticv1.Cluster {
+				c := kmsCluster(true, "")
+				c.Spec.EncryptionConfiguration.KMS = nil
+				c.Spec.EncryptionConfiguration.Secretbox = &kubermaticv1.SecretboxEncryptionConfiguration{}
+				return c
+			}(),
+			expectedSidecar: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kms := kmsConfiguration(test.cluster)
+			if (kms != nil) != test.expectedSidecar {
+				t.Fatalf("Expected KMS sidecar to be enabled: %v, got %v", test.expectedSidecar, kms != nil)
+			}
+
+			if kms == nil {
+				return
+			}
+
+			sidecar := kmsPluginSidecar(kms)
+			if sidecar.Image != kms.Image {
+				t.Errorf("Expected sidecar image %q, got %q", kms.Image, sidecar.Image)
+			}
+
+			if len(sidecar.Args) != len(kms.Args) {
+				t.Errorf("Expected sidecar args %v, got %v", kms.Args, sidecar.Args)
+			}
+
+			if len(sidecar.VolumeMounts) != 1 || sidecar.VolumeMounts[0] != kmsSocketVolumeMount() {
+				t.Errorf("Expected sidecar to mount the KMS socket volume, got %v", sidecar.VolumeMounts)
+			}
+
+			if volume := kmsSocketVolume(); volume.Name != sidecar.VolumeMounts[0].Name || volume.EmptyDir == nil {
+				t.Errorf("Expected KMS socket volume to be an emptyDir named %q, got %v", sidecar.VolumeMounts[0].Name, volume)
+			}
+		})
+	}
+}
+
+func TestEncryptionConfigurationWithKMS(t *testing.T) {
+	tests := []struct {
+		name             string
+		endpoint         string
+		expectedEndpoint string
+	}{
+		{
+			name:             "default endpoint",
+			expectedEndpoint: "unix://" + encryptionresources.KMSDefaultEndpoint,
+		},
+		{
+			name:             "custom endpoint",
+			endpoint:         encryptionresources.KMSSocketDir + "/vault.sock",
+			expectedEndpoint: "unix://" + encryptionresources.KMSSocketDir + "/vault.sock",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := &fakeEncryptionData{cluster: kmsCluster(true, test.endpoint)}
+
+			_, reconciler := EncryptionConfigurationSecretReconciler(data)()
+			secret, err := reconciler(&corev1.Secret{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile secret: %v", err)
+			}
+
+			var config apiserverconfigv1.EncryptionConfiguration
+			if err := yaml.Unmarshal(secret.Data[resources.EncryptionConfigurationKeyName], &config); err != nil {
+				t.Fatalf("Failed to parse EncryptionConfiguration: %v", err)
+			}
+
+			if len(config.Resources) != 1 || len(config.Resources[0].Providers) != 2 {
+				t.Fatalf("Expected one resource entry with two providers, got %v", config.Resources)
+			}
+
+			kms := config.Resources[0].Providers[0].KMS
+			if kms == nil {
+				t.Fatalf("Expected first provider to be KMS, got %v", config.Resources[0].Providers[0])
+			}
+
+			if kms.APIVersion != "v2" || kms.Name != "vault" {
+				t.Errorf("Expected KMS v2 provider named %q, got %q provider named %q", "vault", kms.APIVersion, kms.Name)
+			}
+
+			if kms.Endpoint != test.expectedEndpoint {
+				t.Errorf("Expected KMS endpoint %q, got %q", test.expectedEndpoint, kms.Endpoint)
+			}
+
+			if config.Resources[0].Providers[1].Identity == nil {
+				t.Errorf("Expected identity provider as fallback, got %v", config.Resources[0].Providers[1])
+			}
+
+			if keyID := secret.Annotations[encryptionresources.KMSKeyIDAnnotationKey]; keyID != "key-1" {
+				t.Errorf("Expected KMS key ID annotation %q, got %q", "key-1", keyID)
+			}
+		})
+	}
+}
@@ -57,6 +57,24 @@ func DenyAllPolicyReconciler() reconciling.NamedNetworkPolicyReconcilerFactory {
 func EctdAllowReconciler(c *kubermaticv1.Cluster) reconciling.NamedNetworkPolicyReconcilerFactory {
 	return func() (string, reconciling.NetworkPolicyReconciler) {
 		return resources.NetworkPolicyEtcdAllow, func(np *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+			etcdPeer := networkingv1.NetworkPolicyPeer{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						resources.AppLabelKey: "etcd",
+						"cluster":             c.ObjectMeta.Name,
+					},
+				},
+			}
+
+			// in a split control plane, etcd runs in its own namespace
+			if resources.IsSplitControlPlane(c) {
+				etcdPeer.NamespaceSelector = &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						corev1.LabelMetadataName: resources.EtcdNamespaceName(c),
+					},
+				}
+			}
+
 			np.Spec = networkingv1.NetworkPolicySpec{
 				PolicyTypes: []networkingv1.PolicyType{
 					networkingv1.PolicyTypeEgress,
@@ -68,16 +86,7 @@ func EctdAllowReconciler(c *kubermaticv1.Cluster) reconciling.NamedNetworkPolicy
 				},
 				Egress: []networkingv1.NetworkPolicyEgressRule{
 					{
-						To: []networkingv1.NetworkPolicyPeer{
-							{
-								PodSelector: &metav1.LabelSelector{
-									MatchLabels: map[string]string{
-										resources.AppLabelKey: "etcd",
-										"cluster":             c.ObjectMeta.Name,
-									},
-								},
-							},
-						},
+						To: []networkingv1.NetworkPolicyPeer{etcdPeer},
 					},
 				},
 			}
@@ -405,3 +414,25 @@ func ipListToPeers(ips []net.IP) []networkingv1.NetworkPolicyPeer {
 
 	return result
 }
+
+// IngressAllowReconciler returns a func to create/update the policy that allows ingress
+// traffic to the apiserver from anywhere, as it is exposed to the user cluster and its users.
+func IngressAllowReconciler() reconciling.NamedNetworkPolicyReconcilerFactory {
+	return func() (string, reconciling.NetworkPolicyReconciler) {
+		return resources.NetworkPolicyApiserverIngressAllow, func(np *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+			np.Spec = networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{
+					networkingv1.PolicyTypeIngress,
+				},
+				PodSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						resources.AppLabelKey: name,
+					},
+				},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{}},
+			}
+
+			return np, nil
+		}
+	}
+}
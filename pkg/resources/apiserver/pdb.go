@@ -17,6 +17,7 @@ limitations under the License.
 package apiserver
 
 import (
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/reconciler/pkg/reconciling"
 
@@ -25,20 +26,30 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+type pdbData interface {
+	Cluster() *kubermaticv1.Cluster
+}
+
 // PodDisruptionBudgetReconciler returns a func to create/update the apiserver PodDisruptionBudget.
-func PodDisruptionBudgetReconciler() reconciling.NamedPodDisruptionBudgetReconcilerFactory {
+// It allows only a single apiserver replica to be disrupted at a time. For clusters with a single
+// replica, this means that draining the seed node running the apiserver is blocked until the
+// apiserver has been scaled up.
+func PodDisruptionBudgetReconciler(data pdbData) reconciling.NamedPodDisruptionBudgetReconcilerFactory {
 	return func() (string, reconciling.PodDisruptionBudgetReconciler) {
 		return resources.ApiserverPodDisruptionBudgetName, func(pdb *policyv1.PodDisruptionBudget) (*policyv1.PodDisruptionBudget, error) {
-			maxUnavailable := intstr.FromInt(1)
+			minAvailable := intstr.FromInt(getMinAvailable(getReplicas(data.Cluster())))
 			pdb.Spec = policyv1.PodDisruptionBudgetSpec{
 				Selector: &metav1.LabelSelector{
 					MatchLabels: resources.BaseAppLabels(name, nil),
 				},
-				// we can only specify maxUnavailable as minAvailable would block in case we have replicas=1. See https://github.com/kubernetes/kubernetes/issues/66811
-				MaxUnavailable: &maxUnavailable,
+				MinAvailable: &minAvailable,
 			}
 
 			return pdb, nil
 		}
 	}
 }
+
+func getMinAvailable(replicas int32) int {
+	return max(int(replicas)-1, 1)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/utils/ptr"
+)
+
+type fakePDBData struct {
+	cluster *kubermaticv1.Cluster
+}
+
+func (f *fakePDBData) Cluster() *kubermaticv1.Cluster {
+	return f.cluster
+}
+
+func TestPodDisruptionBudgetReconciler(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		replicas             *int32
+		expectedMinAvailable int
+	}{
+		{
+			name:                 "default replicas",
+			expectedMinAvailable: 1,
+		},
+		{
+			name:                 "single replica blocks disruptions",
+			replicas:             ptr.To[int32](1),
+			expectedMinAvailable: 1,
+		},
+		{
+			name:                 "two replicas",
+			replicas:             ptr.To[int32](2),
+			expectedMinAvailable: 1,
+		},
+		{
+			name:                 "three replicas",
+			replicas:             ptr.To[int32](3),
+			expectedMinAvailable: 2,
+		},
+		{
+			name:                 "five replicas",
+			replicas:             ptr.To[int32](5),
+			expectedMinAvailable: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{}
+			cluster.Spec.ComponentsOverride.Apiserver.Replicas = tc.replicas
+
+			name, reconciler := PodDisruptionBudgetReconciler(&fakePDBData{cluster: cluster})()
+			if name != resources.ApiserverPodDisruptionBudgetName {
+				t.Errorf("Expected PodDisruptionBudget name %q, got %q", resources.ApiserverPodDisruptionBudgetName, name)
+			}
+
+			pdb, err := reconciler(&policyv1.PodDisruptionBudget{})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if pdb.Spec.MaxUnavailable != nil {
+				t.Errorf("Expected maxUnavailable to be unset, got %v", pdb.Spec.MaxUnavailable)
+			}
+			if pdb.Spec.MinAvailable == nil {
+				t.Fatal("Expected minAvailable to be set")
+			}
+			if minAvailable := pdb.Spec.MinAvailable.IntValue(); minAvailable != tc.expectedMinAvailable {
+				t.Errorf("Expected minAvailable %d, got %d", tc.expectedMinAvailable, minAvailable)
+			}
+		})
+	}
+}
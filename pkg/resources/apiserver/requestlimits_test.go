@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/semver"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestApiserverRequestLimitFlags(t *testing.T) {
+	tests := []struct {
+		name             string
+		tier             kubermaticv1.ClusterTier
+		settings         kubermaticv1.APIServerSettings
+		expectedTotal    string
+		expectedMutating string
+	}{
+		{
+			name:             "no tier",
+			expectedTotal:    "400",
+			expectedMutating: "200",
+		},
+		{
+			name:             "production tier",
+			tier:             kubermaticv1.ClusterTierProduction,
+			expectedTotal:    "800",
+			expectedMutating: "400",
+		},
+		{
+			name:             "development tier",
+			tier:             kubermaticv1.ClusterTierDevelopment,
+			expectedTotal:    "200",
+			expectedMutating: "100",
+		},
+		{
+			name: "overrides take precedence over the tier",
+			tier: kubermaticv1.ClusterTierDevelopment,
+			settings: kubermaticv1.APIServerSettings{
+				MaxRequestsInflight:         ptr.To[int32](1000),
+				MaxMutatingRequestsInflight: ptr.To[int32](300),
+			},
+			expectedTotal:    "1000",
+			expectedMutating: "300",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					Tier:    test.tier,
+					Version: *semver.NewSemverOrDie("1.31.0"),
+					ComponentsOverride: kubermaticv1.ComponentSettings{
+						Apiserver: test.settings,
+					},
+				},
+			}
+			data := resources.NewTemplateDataBuilder().WithCluster(cluster).Build()
+
+			flags, err := getApiserverFlags(data, []string{"https://etcd-0:2379"}, false, false, false, false)
+			if err != nil {
+				t.Fatalf("Failed to get apiserver flags: %v", err)
+			}
+
+			if value := flagValue(flags, "--max-requests-inflight"); value != test.expectedTotal {
+				t.Errorf("Expected --max-requests-inflight to be %q, got %q", test.expectedTotal, value)
+			}
+
+			if value := flagValue(flags, "--max-mutating-requests-inflight"); value != test.expectedMutating {
+				t.Errorf("Expected --max-mutating-requests-inflight to be %q, got %q", test.expectedMutating, value)
+			}
+		})
+	}
+}
+
+func flagValue(flags []string, name string) string {
+	for i := 0; i < len(flags)-1; i++ {
+		if flags[i] == name {
+			return flags[i+1]
+		}
+	}
+
+	return ""
+}
@@ -17,48 +17,131 @@ limitations under the License.
 package apiserver
 
 import (
+	"bytes"
 	cryptorand "crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/reconciler/pkg/reconciling"
 
 	corev1 "k8s.io/api/core/v1"
 )
 
+type serviceAccountKeyReconcilerData interface {
+	Cluster() *kubermaticv1.Cluster
+	ServiceAccountKeyRotationGracePeriod() time.Duration
+}
+
 // ServiceAccountKeyReconciler returns a function to create/update a secret with the ServiceAccount key.
-func ServiceAccountKeyReconciler() reconciling.NamedSecretReconcilerFactory {
+// The key is rotated whenever the ServiceAccountKeyRotationAnnotation on the cluster changes.
+func ServiceAccountKeyReconciler(data serviceAccountKeyReconcilerData) reconciling.NamedSecretReconcilerFactory {
 	return func() (string, reconciling.SecretReconciler) {
 		return resources.ServiceAccountKeySecretName, func(se *corev1.Secret) (*corev1.Secret, error) {
-			if _, exists := se.Data[resources.ServiceAccountKeySecretKey]; exists {
-				return se, nil
-			}
-			priv, err := rsa.GenerateKey(cryptorand.Reader, 2048)
-			if err != nil {
-				return nil, err
-			}
-			saKey := x509.MarshalPKCS1PrivateKey(priv)
-			privKeyBlock := pem.Block{
-				Type:  "RSA PRIVATE KEY",
-				Bytes: saKey,
-			}
-			publicKeyDer, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
-			if err != nil {
-				return nil, err
-			}
-			publicKeyBlock := pem.Block{
-				Type:    "PUBLIC KEY",
-				Headers: nil,
-				Bytes:   publicKeyDer,
-			}
-			if se.Data == nil {
-				se.Data = map[string][]byte{}
-			}
-			se.Data[resources.ServiceAccountKeySecretKey] = pem.EncodeToMemory(&privKeyBlock)
-			se.Data[resources.ServiceAccountKeyPublicKey] = pem.EncodeToMemory(&publicKeyBlock)
-			return se, nil
+			rotation := data.Cluster().Annotations[resources.ServiceAccountKeyRotationAnnotation]
+			return reconcileServiceAccountKey(se, rotation, data.ServiceAccountKeyRotationGracePeriod(), time.Now())
 		}
 	}
 }
+
+// reconcileServiceAccountKey ensures that the secret contains a signing key. If a new rotation
+// is requested, a new key is generated and the public key of the previous one is retired. Retired
+// keys are kept for verifying tokens until the grace period has passed.
+func reconcileServiceAccountKey(se *corev1.Secret, rotation string, gracePeriod time.Duration, now time.Time) (*corev1.Secret, error) {
+	if se.Data == nil {
+		se.Data = map[string][]byte{}
+	}
+	if se.Annotations == nil {
+		se.Annotations = map[string]string{}
+	}
+
+	privateKey, exists := se.Data[resources.ServiceAccountKeySecretKey]
+	rotate := exists && rotation != "" && se.Annotations[resources.ServiceAccountKeyRotationAnnotation] != rotation
+
+	if rotate {
+		publicKey, err := serviceAccountPublicKey(se)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get public key of the current key: %w", err)
+		}
+		se.Data[fmt.Sprintf("%s%d.pub", resources.ServiceAccountRetiredPublicKeyPrefix, now.Unix())] = publicKey
+	}
+
+	if !exists || rotate {
+		priv, err := rsa.GenerateKey(cryptorand.Reader, 2048)
+		if err != nil {
+			return nil, err
+		}
+		privateKey = pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(priv),
+		})
+		se.Data[resources.ServiceAccountKeySecretKey] = privateKey
+		delete(se.Data, resources.ServiceAccountKeyPublicKey)
+	}
+
+	if _, ok := se.Data[resources.ServiceAccountKeyPublicKey]; !ok {
+		publicKey, err := serviceAccountPublicKey(se)
+		if err != nil {
+			return nil, err
+		}
+		se.Data[resources.ServiceAccountKeyPublicKey] = publicKey
+	}
+
+	if rotation != "" {
+		se.Annotations[resources.ServiceAccountKeyRotationAnnotation] = rotation
+	}
+
+	// the retired keys have to be sorted, as the order must be stable to not needlessly roll the apiserver
+	var retiredKeys []string
+	for key := range se.Data {
+		if strings.HasPrefix(key, resources.ServiceAccountRetiredPublicKeyPrefix) {
+			retiredKeys = append(retiredKeys, key)
+		}
+	}
+	sort.Strings(retiredKeys)
+
+	verificationKeys := [][]byte{se.Data[resources.ServiceAccountKeyPublicKey]}
+	for _, key := range retiredKeys {
+		timestamp := strings.TrimSuffix(strings.TrimPrefix(key, resources.ServiceAccountRetiredPublicKeyPrefix), ".pub")
+		retiredAt, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil || now.Sub(time.Unix(retiredAt, 0)) > gracePeriod {
+			delete(se.Data, key)
+			continue
+		}
+		verificationKeys = append(verificationKeys, se.Data[key])
+	}
+	se.Data[resources.ServiceAccountVerificationKeysSecretKey] = bytes.Join(verificationKeys, nil)
+
+	return se, nil
+}
+
+// serviceAccountPublicKey returns the PEM-encoded public key for the private key in the secret.
+func serviceAccountPublicKey(se *corev1.Secret) ([]byte, error) {
+	block, _ := pem.Decode(se.Data[resources.ServiceAccountKeySecretKey])
+	if block == nil {
+		return nil, errors.New("service account key is not valid PEM")
+	}
+
+	priv, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse service account key: %w", err)
+	}
+
+	publicKeyDer, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: publicKeyDer,
+	}), nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/keyutil"
+)
+
+func TestServiceAccountKeyRotation(t *testing.T) {
+	const gracePeriod = 24 * time.Hour
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	reconcile := func(se *corev1.Secret, rotation string, now time.Time) *corev1.Secret {
+		se, err := reconcileServiceAccountKey(se, rotation, gracePeriod, now)
+		if err != nil {
+			t.Fatalf("Failed to reconcile service account key: %v", err)
+		}
+		return se
+	}
+
+	verificationKeys := func(se *corev1.Secret) int {
+		keys, err := keyutil.ParsePublicKeysPEM(se.Data[resources.ServiceAccountVerificationKeysSecretKey])
+		if err != nil {
+			t.Fatalf("Failed to parse verification keys: %v", err)
+		}
+		return len(keys)
+	}
+
+	se := reconcile(&corev1.Secret{}, "", now)
+	initialKey := se.Data[resources.ServiceAccountKeySecretKey]
+	initialPublicKey := se.Data[resources.ServiceAccountKeyPublicKey]
+	if len(initialKey) == 0 || len(initialPublicKey) == 0 {
+		t.Fatal("Expected a new key to be generated")
+	}
+	if n := verificationKeys(se); n != 1 {
+		t.Fatalf("Expected 1 verification key, got %d", n)
+	}
+
+	// requesting a rotation generates a new signing key, but keeps the old one for verification
+	se = reconcile(se, "1", now)
+	rotatedKey := se.Data[resources.ServiceAccountKeySecretKey]
+	if bytes.Equal(initialKey, rotatedKey) {
+		t.Fatal("Expected the key to be rotated")
+	}
+	if n := verificationKeys(se); n != 2 {
+		t.Fatalf("Expected 2 verification keys during the grace period, got %d", n)
+	}
+	if !bytes.Contains(se.Data[resources.ServiceAccountVerificationKeysSecretKey], initialPublicKey) {
+		t.Error("Expected the retired public key to still be accepted for verification")
+	}
+
+	// reconciling again within the grace period must not change anything
+	verification := se.Data[resources.ServiceAccountVerificationKeysSecretKey]
+	se = reconcile(se, "1", now.Add(time.Hour))
+	if !bytes.Equal(rotatedKey, se.Data[resources.ServiceAccountKeySecretKey]) {
+		t.Error("Expected the key to not be rotated again")
+	}
+	if !bytes.Equal(verification, se.Data[resources.ServiceAccountVerificationKeysSecretKey]) {
+		t.Error("Expected the verification keys to be stable")
+	}
+
+	// after the grace period, the retired key is pruned
+	se = reconcile(se, "1", now.Add(gracePeriod+time.Second))
+	if n := verificationKeys(se); n != 1 {
+		t.Fatalf("Expected 1 verification key after the grace period, got %d", n)
+	}
+	if bytes.Contains(se.Data[resources.ServiceAccountVerificationKeysSecretKey], initialPublicKey) {
+		t.Error("Expected the retired public key to be pruned")
+	}
+	for key := range se.Data {
+		if key != resources.ServiceAccountKeySecretKey && key != resources.ServiceAccountKeyPublicKey && key != resources.ServiceAccountVerificationKeysSecretKey {
+			t.Errorf("Expected retired key %q to be removed", key)
+		}
+	}
+}
@@ -29,8 +29,21 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+const (
+	// ExternalDNSHostnameAnnotationKey is the annotation external-dns uses to determine the
+	// hostname to create a DNS record for.
+	ExternalDNSHostnameAnnotationKey = "external-dns.alpha.kubernetes.io/hostname"
+	// ExternalDNSTTLAnnotationKey is the annotation external-dns uses to determine the TTL
+	// of the DNS record.
+	ExternalDNSTTLAnnotationKey = "external-dns.alpha.kubernetes.io/ttl"
+
+	externalDNSTTL = "300"
+)
+
 // ServiceReconciler returns the function to reconcile the external API server service.
-func ServiceReconciler(exposeStrategy kubermaticv1.ExposeStrategy, externalURL string, apiServerServiceType *corev1.ServiceType) reconciling.NamedServiceReconcilerFactory {
+// If externalDNSHostname is not empty, the service is annotated for external-dns to
+// create a DNS record for that hostname.
+func ServiceReconciler(exposeStrategy kubermaticv1.ExposeStrategy, externalURL string, apiServerServiceType *corev1.ServiceType, externalDNSHostname string) reconciling.NamedServiceReconcilerFactory {
 	return func() (string, reconciling.ServiceReconciler) {
 		return resources.ApiserverServiceName, func(se *corev1.Service) (*corev1.Service, error) {
 			if se.Annotations == nil {
@@ -67,7 +80,17 @@ func ServiceReconciler(exposeStrategy kubermaticv1.ExposeStrategy, externalURL s
 				se.Spec.Type = *apiServerServiceType
 			}
 
+			if externalDNSHostname != "" {
+				se.Annotations[ExternalDNSHostnameAnnotationKey] = externalDNSHostname
+				se.Annotations[ExternalDNSTTLAnnotationKey] = externalDNSTTL
+			} else {
+				delete(se.Annotations, ExternalDNSHostnameAnnotationKey)
+				delete(se.Annotations, ExternalDNSTTLAnnotationKey)
+			}
+
 			se.Spec.Selector = resources.BaseAppLabels(name, nil)
+			// only route traffic to apiservers that passed their readiness probe
+			se.Spec.PublishNotReadyAddresses = false
 
 			if len(se.Spec.Ports) == 0 {
 				se.Spec.Ports = []corev1.ServicePort{
@@ -22,6 +22,7 @@ import (
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -48,7 +49,7 @@ func TestServiceReconcilerRequiresExposeStrategy(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, creator := ServiceReconciler(tc.exposeStrategy, tc.internalService, nil)()
+			_, creator := ServiceReconciler(tc.exposeStrategy, tc.internalService, nil, "")()
 			_, err := creator(&corev1.Service{})
 			if (err != nil) != tc.errExpected {
 				t.Errorf("Expected err: %t, but got err %v", tc.errExpected, err)
@@ -147,7 +148,7 @@ func TestServiceReconcilerSetsPort(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, creator := ServiceReconciler(tc.exposeStrategy, tc.internalService, tc.expectedServiceType)()
+			_, creator := ServiceReconciler(tc.exposeStrategy, tc.internalService, tc.expectedServiceType, "")()
 			svc, err := creator(tc.inService)
 			if err != nil {
 				t.Fatalf("Unexpected error: %v", err)
@@ -176,3 +177,92 @@ func TestServiceReconcilerSetsPort(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceReconcilerSetsExternalDNSAnnotations(t *testing.T) {
+	testCases := []struct {
+		name                string
+		externalDNSHostname string
+		inService           *corev1.Service
+		expectedAnnotations map[string]string
+	}{
+		{
+			name:                "Annotations are set to the configured hostname",
+			externalDNSHostname: "abcd1234.europe-west3-c.dev.kubermatic.io",
+			inService:           &corev1.Service{},
+			expectedAnnotations: map[string]string{
+				ExternalDNSHostnameAnnotationKey: "abcd1234.europe-west3-c.dev.kubermatic.io",
+				ExternalDNSTTLAnnotationKey:      externalDNSTTL,
+			},
+		},
+		{
+			name:                "Hostname change updates the annotation",
+			externalDNSHostname: "abcd1234.europe-west3-c.dev.kubermatic.io",
+			inService: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ExternalDNSHostnameAnnotationKey: "abcd1234.old.dev.kubermatic.io",
+						ExternalDNSTTLAnnotationKey:      externalDNSTTL,
+					},
+				},
+			},
+			expectedAnnotations: map[string]string{
+				ExternalDNSHostnameAnnotationKey: "abcd1234.europe-west3-c.dev.kubermatic.io",
+				ExternalDNSTTLAnnotationKey:      externalDNSTTL,
+			},
+		},
+		{
+			name: "Annotations are removed when disabled",
+			inService: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						ExternalDNSHostnameAnnotationKey: "abcd1234.europe-west3-c.dev.kubermatic.io",
+						ExternalDNSTTLAnnotationKey:      externalDNSTTL,
+					},
+				},
+			},
+			expectedAnnotations: map[string]string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, creator := ServiceReconciler(kubermaticv1.ExposeStrategyNodePort, "", nil, tc.externalDNSHostname)()
+			svc, err := creator(tc.inService)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			for _, key := range []string{ExternalDNSHostnameAnnotationKey, ExternalDNSTTLAnnotationKey} {
+				expected, expectedExists := tc.expectedAnnotations[key]
+				value, exists := svc.Annotations[key]
+				if exists != expectedExists || value != expected {
+					t.Errorf("Expected annotation %q to be %q (exists: %t), but got %q (exists: %t)", key, expected, expectedExists, value, exists)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceReconcilerDoesNotPublishNotReadyAddresses(t *testing.T) {
+	for _, exposeStrategy := range []kubermaticv1.ExposeStrategy{
+		kubermaticv1.ExposeStrategyNodePort,
+		kubermaticv1.ExposeStrategyLoadBalancer,
+		kubermaticv1.ExposeStrategyTunneling,
+	} {
+		t.Run(string(exposeStrategy), func(t *testing.T) {
+			_, creator := ServiceReconciler(exposeStrategy, "", nil, "")()
+			svc, err := creator(&corev1.Service{
+				Spec: corev1.ServiceSpec{
+					PublishNotReadyAddresses: true,
+				},
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if svc.Spec.PublishNotReadyAddresses {
+				t.Error("Expected publishNotReadyAddresses to be false, but it is true")
+			}
+		})
+	}
+}
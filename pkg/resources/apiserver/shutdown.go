@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// defaultTerminationGracePeriod is the termination grace period Kubernetes uses if a
+	// pod does not specify one.
+	defaultTerminationGracePeriod = corev1.DefaultTerminationGracePeriodSeconds * time.Second
+
+	// minimumShutdownMargin is the time the apiserver needs after the shutdown delay has
+	// passed to finish in-flight requests and stop its servers.
+	minimumShutdownMargin = 15 * time.Second
+)
+
+// ValidateGracefulTermination ensures that the apiserver is given enough time to finish
+// in-flight requests after its shutdown delay has passed, before it gets killed. A zero
+// terminationGracePeriod means the Kubernetes default is used.
+func ValidateGracefulTermination(shutdownDelay, terminationGracePeriod time.Duration) error {
+	if shutdownDelay < 0 {
+		return fmt.Errorf("shutdown delay must not be negative, got %v", shutdownDelay)
+	}
+
+	if terminationGracePeriod < 0 {
+		return fmt.Errorf("termination grace period must not be negative, got %v", terminationGracePeriod)
+	}
+
+	if terminationGracePeriod%time.Second != 0 {
+		return fmt.Errorf("termination grace period must be a whole number of seconds, got %v", terminationGracePeriod)
+	}
+
+	gracePeriod := terminationGracePeriod
+	if gracePeriod == 0 {
+		gracePeriod = defaultTerminationGracePeriod
+	}
+
+	if shutdownDelay > 0 && gracePeriod < shutdownDelay+minimumShutdownMargin {
+		return fmt.Errorf("termination grace period (%v) must exceed the shutdown delay (%v) by at least %v", gracePeriod, shutdownDelay, minimumShutdownMargin)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateGracefulTermination(t *testing.T) {
+	tests := []struct {
+		name                   string
+		shutdownDelay          time.Duration
+		terminationGracePeriod time.Duration
+		valid                  bool
+	}{
+		{
+			name:  "defaults",
+			valid: true,
+		},
+		{
+			name:          "shutdown delay within default grace period",
+			shutdownDelay: 10 * time.Second,
+			valid:         true,
+		},
+		{
+			name:          "shutdown delay exceeding default grace period",
+			shutdownDelay: 20 * time.Second,
+			valid:         false,
+		},
+		{
+			name:                   "grace period comfortably exceeding shutdown delay",
+			shutdownDelay:          30 * time.Second,
+			terminationGracePeriod: 60 * time.Second,
+			valid:                  true,
+		},
+		{
+			name:                   "grace period barely exceeding shutdown delay",
+			shutdownDelay:          30 * time.Second,
+			terminationGracePeriod: 35 * time.Second,
+			valid:                  false,
+		},
+		{
+			name:                   "grace period shorter than shutdown delay",
+			shutdownDelay:          60 * time.Second,
+			terminationGracePeriod: 30 * time.Second,
+			valid:                  false,
+		},
+		{
+			name:                   "fractional grace period",
+			terminationGracePeriod: 1500 * time.Millisecond,
+			valid:                  false,
+		},
+		{
+			name:          "negative shutdown delay",
+			shutdownDelay: -time.Second,
+			valid:         false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateGracefulTermination(test.shutdownDelay, test.terminationGracePeriod)
+
+			if (err == nil) != test.valid {
+				t.Errorf("Expected valid to be %v, got error %v", test.valid, err)
+			}
+		})
+	}
+}
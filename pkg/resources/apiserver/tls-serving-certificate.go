@@ -17,6 +17,7 @@ limitations under the License.
 package apiserver
 
 import (
+	"crypto/rsa"
 	"crypto/x509"
 	"errors"
 	"fmt"
@@ -135,9 +136,14 @@ func TLSServingCertificateReconciler(data tlsServingCertReconcilerData) reconcil
 				}
 			}
 
-			key, err := triple.NewPrivateKey()
-			if err != nil {
-				return nil, fmt.Errorf("unable to create a server private key: %w", err)
+			// When only the SANs changed, re-sign the certificate with the existing
+			// key to not disrupt long-lived connections with a key rotation.
+			key := reusableServingKey(se, ca)
+			if key == nil {
+				key, err = triple.NewPrivateKey()
+				if err != nil {
+					return nil, fmt.Errorf("unable to create a server private key: %w", err)
+				}
 			}
 
 			config := certutil.Config{
@@ -158,3 +164,29 @@ func TLSServingCertificateReconciler(data tlsServingCertReconcilerData) reconcil
 		}
 	}
 }
+
+// reusableServingKey returns the private key of the existing serving certificate
+// if it is still signed by the given CA and not about to expire, so that it can
+// be reused for a certificate with a different set of SANs. If the CA has been
+// rotated or the secret cannot be parsed, nil is returned and a new key must
+// be generated.
+func reusableServingKey(se *corev1.Secret, ca *triple.KeyPair) *rsa.PrivateKey {
+	keyPair, err := triple.ParseRSAKeyPair(se.Data[resources.ApiserverTLSCertSecretKey], se.Data[resources.ApiserverTLSKeySecretKey])
+	if err != nil {
+		return nil
+	}
+
+	if resources.CertWillExpireSoon(keyPair.Cert) {
+		return nil
+	}
+
+	if err := keyPair.Cert.CheckSignatureFrom(ca.Cert); err != nil {
+		return nil
+	}
+
+	if !keyPair.Key.PublicKey.Equal(keyPair.Cert.PublicKey) {
+		return nil
+	}
+
+	return keyPair.Key
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+
+	corev1 "k8s.io/api/core/v1"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+type fakeTLSServingCertData struct {
+	cluster  *kubermaticv1.Cluster
+	ca       *triple.KeyPair
+	altNames certutil.AltNames
+}
+
+func (d *fakeTLSServingCertData) Cluster() *kubermaticv1.Cluster {
+	return d.cluster
+}
+
+func (d *fakeTLSServingCertData) GetRootCA() (*triple.KeyPair, error) {
+	return d.ca, nil
+}
+
+func (d *fakeTLSServingCertData) GetTunnelingAgentIP() string {
+	return "100.64.30.10"
+}
+
+func (d *fakeTLSServingCertData) GetAPIServerAlternateNames() (*certutil.AltNames, error) {
+	return &d.altNames, nil
+}
+
+func TestTLSServingCertificateKeyReuse(t *testing.T) {
+	ca, err := triple.NewCA("test-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	data := &fakeTLSServingCertData{
+		cluster: &kubermaticv1.Cluster{
+			Spec: kubermaticv1.ClusterSpec{
+				ExposeStrategy: kubermaticv1.ExposeStrategyNodePort,
+				ClusterNetwork: kubermaticv1.ClusterNetworkingConfig{
+					Services:  kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.16.0/20"}},
+					DNSDomain: "cluster.local",
+				},
+			},
+			Status: kubermaticv1.ClusterStatus{
+				NamespaceName: "cluster-test",
+				Address: kubermaticv1.ClusterAddress{
+					ExternalName: "test.example.com",
+					IP:           "192.0.2.10",
+				},
+			},
+		},
+		ca: ca,
+	}
+
+	reconcile := func(se *corev1.Secret) *corev1.Secret {
+		_, reconciler := TLSServingCertificateReconciler(data)()
+		se, err := reconciler(se)
+		if err != nil {
+			t.Fatalf("Failed to reconcile serving certificate: %v", err)
+		}
+		return se
+	}
+
+	parseCert := func(se *corev1.Secret) []string {
+		certs, err := certutil.ParseCertsPEM(se.Data[resources.ApiserverTLSCertSecretKey])
+		if err != nil {
+			t.Fatalf("Failed to parse certificate: %v", err)
+		}
+		return certs[0].DNSNames
+	}
+
+	se := reconcile(&corev1.Secret{})
+	initialKey := bytes.Clone(se.Data[resources.ApiserverTLSKeySecretKey])
+	initialCert := bytes.Clone(se.Data[resources.ApiserverTLSCertSecretKey])
+
+	// reconciling without changes must not touch the secret
+	se = reconcile(se)
+	if !bytes.Equal(initialCert, se.Data[resources.ApiserverTLSCertSecretKey]) {
+		t.Error("Expected the certificate to be unchanged")
+	}
+
+	// adding a SAN re-signs the certificate with the existing key
+	data.altNames = certutil.AltNames{DNSNames: []string{"new.example.com"}, IPs: []net.IP{net.ParseIP("192.0.2.20")}}
+	se = reconcile(se)
+	if bytes.Equal(initialCert, se.Data[resources.ApiserverTLSCertSecretKey]) {
+		t.Error("Expected the certificate to be re-signed")
+	}
+	if !bytes.Equal(initialKey, se.Data[resources.ApiserverTLSKeySecretKey]) {
+		t.Error("Expected the private key to be preserved on a SAN-only change")
+	}
+	found := false
+	for _, name := range parseCert(se) {
+		if name == "new.example.com" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected the certificate to contain the new SAN")
+	}
+
+	// rotating the CA must also rotate the key
+	data.ca, err = triple.NewCA("new-test-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+	se = reconcile(se)
+	if bytes.Equal(initialKey, se.Data[resources.ApiserverTLSKeySecretKey]) {
+		t.Error("Expected the private key to be rotated on a CA change")
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/semver"
+)
+
+func TestApiserverTLSFlags(t *testing.T) {
+	tests := []struct {
+		name                 string
+		settings             kubermaticv1.APIServerSettings
+		expectedMinVersion   string
+		expectedCipherSuites string
+	}{
+		{
+			name:                 "defaults",
+			expectedMinVersion:   "VersionTLS12",
+			expectedCipherSuites: strings.Join(resources.GetAllowedTLSCipherSuites(), ","),
+		},
+		{
+			name: "overrides",
+			settings: kubermaticv1.APIServerSettings{
+				TLSMinVersion:   "VersionTLS13",
+				TLSCipherSuites: []string{"TLS_AES_128_GCM_SHA256", "TLS_AES_256_GCM_SHA384"},
+			},
+			expectedMinVersion:   "VersionTLS13",
+			expectedCipherSuites: "TLS_AES_128_GCM_SHA256,TLS_AES_256_GCM_SHA384",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					Version: *semver.NewSemverOrDie("1.31.0"),
+					ComponentsOverride: kubermaticv1.ComponentSettings{
+						Apiserver: test.settings,
+					},
+				},
+			}
+			data := resources.NewTemplateDataBuilder().WithCluster(cluster).Build()
+
+			flags, err := getApiserverFlags(data, []string{"https://etcd-0:2379"}, false, false, false, false)
+			if err != nil {
+				t.Fatalf("Failed to get apiserver flags: %v", err)
+			}
+
+			if value := flagValue(flags, "--tls-min-version"); value != test.expectedMinVersion {
+				t.Errorf("Expected --tls-min-version to be %q, got %q", test.expectedMinVersion, value)
+			}
+
+			if value := flagValue(flags, "--tls-cipher-suites"); value != test.expectedCipherSuites {
+				t.Errorf("Expected --tls-cipher-suites to be %q, got %q", test.expectedCipherSuites, value)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package apiserver
 import (
 	"bytes"
 	"encoding/csv"
+	"strings"
 
 	"k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/resources"
@@ -27,6 +28,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
+// healthCheckUsername is the user of the token the apiserver's health probes authenticate with. It
+// is not a member of any group besides system:authenticated, which may access the health endpoints.
+const healthCheckUsername = "kubermatic:apiserver-health-check"
+
 // TokenUsers returns a secret containing the tokens csv.
 func TokenUsersReconciler(data *resources.TemplateData) reconciling.NamedSecretReconcilerFactory {
 	return func() (string, reconciling.SecretReconciler) {
@@ -47,17 +52,43 @@ func TokenUsersReconciler(data *resources.TemplateData) reconciling.NamedSecretR
 			if err := writer.Write([]string{viewerToken, "viewer", "10001", "viewers"}); err != nil {
 				return nil, err
 			}
+			healthCheckToken, err := data.GetApiserverHealthCheckToken()
+			if err != nil {
+				return nil, err
+			}
+			if err := writer.Write([]string{healthCheckToken, healthCheckUsername, "10002"}); err != nil {
+				return nil, err
+			}
+
 			writer.Flush()
 			if err := writer.Error(); err != nil {
 				return nil, err
 			}
 
 			se.Data[resources.TokensSecretKey] = buffer.Bytes()
+			removeLegacyNodeBootstrapTokens(se)
+
 			return se, nil
 		}
 	}
 }
 
+// removeLegacyNodeBootstrapTokens removes the node bootstrap tokens which older versions stored
+// in the tokens Secret. Node bootstrap tokens are bootstrap token Secrets in the user cluster now.
+func removeLegacyNodeBootstrapTokens(se *corev1.Secret) {
+	delete(se.Data, resources.NodeBootstrapTokenSecretKey)
+	for key := range se.Data {
+		if strings.HasPrefix(key, resources.NodeBootstrapTokenSecretKeyPrefix) {
+			delete(se.Data, key)
+		}
+	}
+	for annotation := range se.Annotations {
+		if strings.HasPrefix(annotation, resources.NodeBootstrapTokenAnnotationPrefix) {
+			delete(se.Annotations, annotation)
+		}
+	}
+}
+
 // TokenViewerReconciler returns a secret containing the viewer token.
 func TokenViewerReconciler() reconciling.NamedSecretReconcilerFactory {
 	return func() (string, reconciling.SecretReconciler) {
@@ -74,3 +105,21 @@ func TokenViewerReconciler() reconciling.NamedSecretReconcilerFactory {
 		}
 	}
 }
+
+// HealthCheckTokenReconciler returns a secret containing the token the apiserver's health probes
+// authenticate with, as anonymous requests are rejected.
+func HealthCheckTokenReconciler() reconciling.NamedSecretReconcilerFactory {
+	return func() (string, reconciling.SecretReconciler) {
+		return resources.ApiserverHealthCheckTokenSecretName, func(se *corev1.Secret) (*corev1.Secret, error) {
+			if se.Data == nil {
+				se.Data = map[string][]byte{}
+			}
+
+			if _, ok := se.Data[resources.ApiserverHealthCheckTokenSecretKey]; !ok {
+				se.Data[resources.ApiserverHealthCheckTokenSecretKey] = []byte(kubernetes.GenerateToken())
+			}
+
+			return se, nil
+		}
+	}
+}
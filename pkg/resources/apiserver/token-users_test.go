@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"testing"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLegacyNodeBootstrapTokensAreRemoved(t *testing.T) {
+	se := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				resources.NodeBootstrapTokenAnnotationPrefix + "abcdef": "2026-01-01T00:00:00Z",
+				"unrelated": "true",
+			},
+		},
+		Data: map[string][]byte{
+			resources.TokensSecretKey:                              []byte("token,admin,10000,system:masters"),
+			resources.NodeBootstrapTokenSecretKey:                  []byte("abcdef.0123456789abcdef"),
+			resources.NodeBootstrapTokenSecretKeyPrefix + "abcdef": []byte("abcdef.0123456789abcdef"),
+		},
+	}
+
+	removeLegacyNodeBootstrapTokens(se)
+
+	if len(se.Data) != 1 || se.Data[resources.TokensSecretKey] == nil {
+		t.Errorf("Expected only the tokens file to remain, got keys %v", se.Data)
+	}
+	if len(se.Annotations) != 1 || se.Annotations["unrelated"] != "true" {
+		t.Errorf("Expected only unrelated annotations to remain, got %v", se.Annotations)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/utils/ptr"
+)
+
+// DefaultAPIServerTargetCPUUtilization is the average CPU utilization of the apiserver
+// pods the HorizontalPodAutoscaler aims for, unless configured otherwise.
+const DefaultAPIServerTargetCPUUtilization = 80
+
+// GetAPIServerAutoscaling returns the HorizontalPodAutoscaler settings for the apiserver of
+// the given cluster, i.e. the datacenter's defaults with the cluster's settings applied.
+// It returns nil if the apiserver is not autoscaled.
+func GetAPIServerAutoscaling(cluster *kubermaticv1.Cluster, dc *kubermaticv1.Datacenter) *kubermaticv1.APIServerAutoscalingSettings {
+	settings := &kubermaticv1.APIServerAutoscalingSettings{}
+	if dc != nil && dc.Spec.APIServerAutoscaling != nil {
+		settings = dc.Spec.APIServerAutoscaling.DeepCopy()
+	}
+
+	if overrides := cluster.Spec.ComponentsOverride.Apiserver.Autoscaling; overrides != nil {
+		if overrides.MinReplicas != nil {
+			settings.MinReplicas = overrides.MinReplicas
+		}
+		if overrides.MaxReplicas != nil {
+			settings.MaxReplicas = overrides.MaxReplicas
+		}
+		if overrides.TargetCPUUtilizationPercentage != nil {
+			settings.TargetCPUUtilizationPercentage = overrides.TargetCPUUtilizationPercentage
+		}
+	}
+
+	if settings.MaxReplicas == nil {
+		return nil
+	}
+
+	if settings.MinReplicas == nil {
+		settings.MinReplicas = ptr.To(ControlPlaneReplicas(cluster, cluster.Spec.ComponentsOverride.Apiserver.Replicas))
+	}
+
+	// a datacenter default must not push the minimum beyond a cluster's maximum
+	if *settings.MinReplicas > *settings.MaxReplicas {
+		settings.MinReplicas = ptr.To(*settings.MaxReplicas)
+	}
+
+	if settings.TargetCPUUtilizationPercentage == nil {
+		settings.TargetCPUUtilizationPercentage = ptr.To[int32](DefaultAPIServerTargetCPUUtilization)
+	}
+
+	return settings
+}
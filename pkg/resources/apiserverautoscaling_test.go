@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetAPIServerAutoscaling(t *testing.T) {
+	tests := []struct {
+		name       string
+		haTier     bool
+		datacenter *kubermaticv1.APIServerAutoscalingSettings
+		cluster    *kubermaticv1.APIServerAutoscalingSettings
+		expected   *kubermaticv1.APIServerAutoscalingSettings
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name: "no maximum",
+			cluster: &kubermaticv1.APIServerAutoscalingSettings{
+				MinReplicas: ptr.To[int32](2),
+			},
+		},
+		{
+			name: "datacenter defaults",
+			datacenter: &kubermaticv1.APIServerAutoscalingSettings{
+				MaxReplicas: ptr.To[int32](5),
+			},
+			expected: &kubermaticv1.APIServerAutoscalingSettings{
+				MinReplicas:                    ptr.To[int32](1),
+				MaxReplicas:                    ptr.To[int32](5),
+				TargetCPUUtilizationPercentage: ptr.To[int32](DefaultAPIServerTargetCPUUtilization),
+			},
+		},
+		{
+			name:   "minimum defaults to the HA replicas",
+			haTier: true,
+			cluster: &kubermaticv1.APIServerAutoscalingSettings{
+				MaxReplicas: ptr.To[int32](5),
+			},
+			expected: &kubermaticv1.APIServerAutoscalingSettings{
+				MinReplicas:                    ptr.To[int32](ControlPlaneHAReplicas),
+				MaxReplicas:                    ptr.To[int32](5),
+				TargetCPUUtilizationPercentage: ptr.To[int32](DefaultAPIServerTargetCPUUtilization),
+			},
+		},
+		{
+			name: "cluster settings take precedence over the datacenter",
+			datacenter: &kubermaticv1.APIServerAutoscalingSettings{
+				MinReplicas:                    ptr.To[int32](2),
+				MaxReplicas:                    ptr.To[int32](5),
+				TargetCPUUtilizationPercentage: ptr.To[int32](70),
+			},
+			cluster: &kubermaticv1.APIServerAutoscalingSettings{
+				MaxReplicas:                    ptr.To[int32](10),
+				TargetCPUUtilizationPercentage: ptr.To[int32](60),
+			},
+			expected: &kubermaticv1.APIServerAutoscalingSettings{
+				MinReplicas:                    ptr.To[int32](2),
+				MaxReplicas:                    ptr.To[int32](10),
+				TargetCPUUtilizationPercentage: ptr.To[int32](60),
+			},
+		},
+		{
+			name: "minimum is capped at the maximum",
+			datacenter: &kubermaticv1.APIServerAutoscalingSettings{
+				MinReplicas: ptr.To[int32](4),
+			},
+			cluster: &kubermaticv1.APIServerAutoscalingSettings{
+				MaxReplicas: ptr.To[int32](3),
+			},
+			expected: &kubermaticv1.APIServerAutoscalingSettings{
+				MinReplicas:                    ptr.To[int32](3),
+				MaxReplicas:                    ptr.To[int32](3),
+				TargetCPUUtilizationPercentage: ptr.To[int32](DefaultAPIServerTargetCPUUtilization),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{}
+			cluster.Spec.ComponentsOverride.Apiserver.Autoscaling = test.cluster
+			if test.haTier {
+				cluster.Spec.ControlPlaneHATier = kubermaticv1.ControlPlaneHATierHA
+			}
+
+			dc := &kubermaticv1.Datacenter{}
+			dc.Spec.APIServerAutoscaling = test.datacenter
+
+			settings := GetAPIServerAutoscaling(cluster, dc)
+			if !equality.Semantic.DeepEqual(settings, test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, settings)
+			}
+		})
+	}
+}
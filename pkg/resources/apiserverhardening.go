@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ValidateAPIServerHardeningFlags rejects apiserver flags that would weaken the authentication
+// of a user cluster apiserver, namely enabling anonymous authentication or opening an insecure
+// (unauthenticated, unencrypted) port. Flags can be given either as "--flag=value" or as
+// "--flag value".
+func ValidateAPIServerHardeningFlags(flags []string) error {
+	for i := 0; i < len(flags); i++ {
+		name, value, hasValue := strings.Cut(flags[i], "=")
+
+		switch name {
+		case "--anonymous-auth":
+			if !hasValue && i+1 < len(flags) && !strings.HasPrefix(flags[i+1], "-") {
+				i++
+				value = flags[i]
+				hasValue = true
+			}
+
+			// a boolean flag without a value enables it
+			enabled := true
+			if hasValue {
+				parsed, err := strconv.ParseBool(value)
+				if err != nil {
+					return fmt.Errorf("invalid value %q for --anonymous-auth: %w", value, err)
+				}
+				enabled = parsed
+			}
+
+			if enabled {
+				return fmt.Errorf("anonymous authentication must not be enabled")
+			}
+
+		case "--insecure-port", "--insecure-bind-address":
+			if !hasValue {
+				if i+1 >= len(flags) {
+					return fmt.Errorf("missing value for %s", name)
+				}
+				i++
+				value = flags[i]
+			}
+
+			if name == "--insecure-bind-address" || value != "0" {
+				return fmt.Errorf("the insecure port must not be enabled, but %s is set to %q", name, value)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+)
+
+func TestValidateAPIServerHardeningFlags(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags []string
+		valid bool
+	}{
+		{
+			name:  "no hardening flags",
+			flags: []string{"--secure-port", "6443", "--profiling=false"},
+			valid: true,
+		},
+		{
+			name:  "anonymous auth disabled",
+			flags: []string{"--anonymous-auth=false"},
+			valid: true,
+		},
+		{
+			name:  "anonymous auth enabled",
+			flags: []string{"--anonymous-auth=true"},
+			valid: false,
+		},
+		{
+			name:  "anonymous auth enabled without value",
+			flags: []string{"--anonymous-auth", "--profiling=false"},
+			valid: false,
+		},
+		{
+			name:  "anonymous auth with invalid value",
+			flags: []string{"--anonymous-auth=maybe"},
+			valid: false,
+		},
+		{
+			name:  "anonymous auth disabled as separate argument",
+			flags: []string{"--anonymous-auth", "false"},
+			valid: true,
+		},
+		{
+			name:  "anonymous auth enabled as separate argument",
+			flags: []string{"--anonymous-auth", "true", "--profiling=false"},
+			valid: false,
+		},
+		{
+			name:  "insecure port disabled",
+			flags: []string{"--insecure-port=0"},
+			valid: true,
+		},
+		{
+			name:  "insecure port enabled",
+			flags: []string{"--insecure-port=8080"},
+			valid: false,
+		},
+		{
+			name:  "insecure port enabled as separate argument",
+			flags: []string{"--insecure-port", "8080"},
+			valid: false,
+		},
+		{
+			name:  "insecure bind address",
+			flags: []string{"--insecure-bind-address", "0.0.0.0"},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateAPIServerHardeningFlags(test.flags)
+
+			if (err == nil) != test.valid {
+				t.Errorf("Expected valid to be %v, got error %v", test.valid, err)
+			}
+		})
+	}
+}
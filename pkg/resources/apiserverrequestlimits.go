@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+)
+
+// APIServerRequestLimitsForTier returns the in-flight request ceilings of the apiserver for
+// clusters in the given tier. Clusters without a tier get the apiserver's own defaults.
+func APIServerRequestLimitsForTier(tier kubermaticv1.ClusterTier) kubermaticv1.APIServerRequestLimits {
+	switch tier {
+	case kubermaticv1.ClusterTierProduction:
+		return kubermaticv1.APIServerRequestLimits{
+			MaxRequestsInflight:         800,
+			MaxMutatingRequestsInflight: 400,
+		}
+
+	case kubermaticv1.ClusterTierDevelopment:
+		return kubermaticv1.APIServerRequestLimits{
+			MaxRequestsInflight:         200,
+			MaxMutatingRequestsInflight: 100,
+		}
+
+	default:
+		return kubermaticv1.APIServerRequestLimits{
+			MaxRequestsInflight:         400,
+			MaxMutatingRequestsInflight: 200,
+		}
+	}
+}
+
+// GetAPIServerRequestLimits returns the in-flight request ceilings for the apiserver of the
+// given cluster, i.e. the tier's defaults with the cluster's overrides applied.
+func GetAPIServerRequestLimits(cluster *kubermaticv1.Cluster) kubermaticv1.APIServerRequestLimits {
+	limits := APIServerRequestLimitsForTier(cluster.Spec.Tier)
+
+	settings := cluster.Spec.ComponentsOverride.Apiserver
+	if settings.MaxRequestsInflight != nil {
+		limits.MaxRequestsInflight = *settings.MaxRequestsInflight
+	}
+	if settings.MaxMutatingRequestsInflight != nil {
+		limits.MaxMutatingRequestsInflight = *settings.MaxMutatingRequestsInflight
+	}
+
+	return limits
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// DefaultAPIServerTLSMinVersion is the minimum TLS version the apiserver accepts unless
+	// configured otherwise.
+	DefaultAPIServerTLSMinVersion = "VersionTLS12"
+)
+
+// apiserverTLSVersions are the values of the apiserver's --tls-min-version flag that are
+// considered secure, mapped to their Go constants.
+var apiserverTLSVersions = map[string]uint16{
+	"VersionTLS12": tls.VersionTLS12,
+	"VersionTLS13": tls.VersionTLS13,
+}
+
+// ValidateAPIServerTLSSettings ensures that the minimum TLS version is one the apiserver
+// accepts and that is still considered secure, and that all cipher suites are secure cipher
+// suites known to Go. As the apiserver serves an RSA certificate, at least one TLS 1.2
+// ECDHE_RSA cipher suite is required unless TLS 1.3 is enforced. Empty values are valid and
+// result in the defaults.
+func ValidateAPIServerTLSSettings(minVersion string, cipherSuites []string) error {
+	if minVersion == "" {
+		minVersion = DefaultAPIServerTLSMinVersion
+	}
+
+	version, ok := apiserverTLSVersions[minVersion]
+	if !ok {
+		return fmt.Errorf("unsupported TLS version %q, must be one of VersionTLS12, VersionTLS13", minVersion)
+	}
+
+	if len(cipherSuites) == 0 {
+		return nil
+	}
+
+	secure := map[string]*tls.CipherSuite{}
+	for _, suite := range tls.CipherSuites() {
+		secure[suite.Name] = suite
+	}
+
+	insecure := map[string]struct{}{}
+	for _, suite := range tls.InsecureCipherSuites() {
+		insecure[suite.Name] = struct{}{}
+	}
+
+	hasECDHERSA := false
+	for _, name := range cipherSuites {
+		if _, ok := insecure[name]; ok {
+			return fmt.Errorf("cipher suite %q is insecure", name)
+		}
+
+		suite, ok := secure[name]
+		if !ok {
+			return fmt.Errorf("unknown cipher suite %q", name)
+		}
+
+		if strings.HasPrefix(name, "TLS_ECDHE_RSA_") && slices.Contains(suite.SupportedVersions, tls.VersionTLS12) {
+			hasECDHERSA = true
+		}
+	}
+
+	if version < tls.VersionTLS13 && !hasECDHERSA {
+		return errors.New("at least one TLS_ECDHE_RSA cipher suite is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+)
+
+func TestValidateAPIServerTLSSettings(t *testing.T) {
+	tests := []struct {
+		name         string
+		minVersion   string
+		cipherSuites []string
+		valid        bool
+	}{
+		{
+			name:  "defaults",
+			valid: true,
+		},
+		{
+			name:         "default cipher suites",
+			minVersion:   "VersionTLS12",
+			cipherSuites: GetAllowedTLSCipherSuites(),
+			valid:        true,
+		},
+		{
+			name:         "TLS 1.3 only",
+			minVersion:   "VersionTLS13",
+			cipherSuites: []string{"TLS_AES_128_GCM_SHA256"},
+			valid:        true,
+		},
+		{
+			name:       "TLS 1.1",
+			minVersion: "VersionTLS11",
+			valid:      false,
+		},
+		{
+			name:       "unknown version",
+			minVersion: "TLSv1.2",
+			valid:      false,
+		},
+		{
+			name:         "insecure cipher suite",
+			cipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_RSA_WITH_RC4_128_SHA"},
+			valid:        false,
+		},
+		{
+			name:         "unknown cipher suite",
+			cipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_FOO"},
+			valid:        false,
+		},
+		{
+			name:         "no ECDHE_RSA cipher suite for TLS 1.2",
+			cipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+			valid:        false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateAPIServerTLSSettings(test.minVersion, test.cipherSuites)
+
+			if (err == nil) != test.valid {
+				t.Errorf("Expected valid to be %v, got error %v", test.valid, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"fmt"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+type clusterCABundleReconcilerData interface {
+	GetRootCA() (*triple.KeyPair, error)
+	GetFrontProxyCA() (*triple.KeyPair, error)
+}
+
+// ClusterCABundleSecretReconciler returns a function to create a secret containing both the root CA
+// and the front proxy CA, so that clients can verify all control plane certificates using a single
+// bundle. The bundle is regenerated whenever one of the CAs changes; workloads mounting it are
+// rolled via their volume revision labels.
+func ClusterCABundleSecretReconciler(data clusterCABundleReconcilerData) reconciling.NamedSecretReconcilerFactory {
+	return func() (string, reconciling.SecretReconciler) {
+		return resources.ClusterCABundleSecretName, func(se *corev1.Secret) (*corev1.Secret, error) {
+			rootCA, err := data.GetRootCA()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get root CA: %w", err)
+			}
+
+			frontProxyCA, err := data.GetFrontProxyCA()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get front proxy CA: %w", err)
+			}
+
+			se.Data = map[string][]byte{
+				resources.ClusterCABundleSecretKey: bytes.Join([][]byte{
+					triple.EncodeCertPEM(rootCA.Cert),
+					triple.EncodeCertPEM(frontProxyCA.Cert),
+				}, nil),
+			}
+
+			return se, nil
+		}
+	}
+}
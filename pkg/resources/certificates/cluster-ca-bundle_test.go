@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"testing"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+
+	corev1 "k8s.io/api/core/v1"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+type fakeClusterCABundleData struct {
+	rootCA       *triple.KeyPair
+	frontProxyCA *triple.KeyPair
+}
+
+func (d *fakeClusterCABundleData) GetRootCA() (*triple.KeyPair, error) {
+	return d.rootCA, nil
+}
+
+func (d *fakeClusterCABundleData) GetFrontProxyCA() (*triple.KeyPair, error) {
+	return d.frontProxyCA, nil
+}
+
+func TestClusterCABundleSecretReconciler(t *testing.T) {
+	newCA := func(name string) *triple.KeyPair {
+		ca, err := triple.NewCA(name)
+		if err != nil {
+			t.Fatalf("Failed to create CA: %v", err)
+		}
+		return ca
+	}
+
+	data := &fakeClusterCABundleData{
+		rootCA:       newCA("root-ca"),
+		frontProxyCA: newCA("front-proxy-ca"),
+	}
+
+	reconcile := func() []byte {
+		_, reconciler := ClusterCABundleSecretReconciler(data)()
+		secret, err := reconciler(&corev1.Secret{})
+		if err != nil {
+			t.Fatalf("Failed to reconcile secret: %v", err)
+		}
+
+		bundle := secret.Data[resources.ClusterCABundleSecretKey]
+		certs, err := certutil.ParseCertsPEM(bundle)
+		if err != nil {
+			t.Fatalf("Bundle is not valid PEM: %v", err)
+		}
+		if len(certs) != 2 {
+			t.Fatalf("Expected bundle to contain 2 certificates, got %d", len(certs))
+		}
+		if !certs[0].Equal(data.rootCA.Cert) {
+			t.Error("Expected first certificate to be the root CA")
+		}
+		if !certs[1].Equal(data.frontProxyCA.Cert) {
+			t.Error("Expected second certificate to be the front proxy CA")
+		}
+
+		return bundle
+	}
+
+	initial := reconcile()
+	if unchanged := reconcile(); !bytes.Equal(initial, unchanged) {
+		t.Error("Expected bundle to be stable if no CA changed")
+	}
+
+	data.frontProxyCA = newCA("front-proxy-ca")
+	if rotated := reconcile(); bytes.Equal(initial, rotated) {
+		t.Error("Expected bundle to change after rotating the front proxy CA")
+	}
+}
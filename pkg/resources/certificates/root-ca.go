@@ -74,6 +74,15 @@ func RootCAReconciler(data caReconcilerData) reconciling.NamedSecretReconcilerFa
 	}
 }
 
+// EtcdMetricsCAReconciler returns a function to create a secret with the etcd metrics ca.
+// It is separate from the root ca, so that certificates issued for scraping the etcd
+// metrics are not accepted by the etcd client endpoint.
+func EtcdMetricsCAReconciler() reconciling.NamedSecretReconcilerFactory {
+	return func() (string, reconciling.SecretReconciler) {
+		return resources.EtcdMetricsCASecretName, GetCAReconciler("etcd-metrics-ca")
+	}
+}
+
 // FrontProxyCAReconciler returns a function to create a secret with front proxy ca.
 func FrontProxyCAReconciler() reconciling.NamedSecretReconcilerFactory {
 	return func() (string, reconciling.SecretReconciler) {
@@ -107,6 +107,12 @@ func DeploymentReconciler(data *resources.TemplateData) reconciling.NamedDeploym
 					MatchLabels: baseLabels,
 				}
 
+				for i, container := range modified.Spec.Template.Spec.Containers {
+					if container.Name == ccmContainerName {
+						modified.Spec.Template.Spec.Containers[i].Env = append(container.Env, resources.GetHTTPProxyEnvVars(data.Seed(), data.Cluster())...)
+					}
+				}
+
 				containerNames := sets.New(ccmContainerName)
 
 				if !data.IsKonnectivityEnabled() {
@@ -71,15 +71,13 @@ func DeploymentReconciler(data *resources.TemplateData) reconciling.NamedDeploym
 				return nil, err
 			}
 
-			dep.Spec.Replicas = resources.Int32(1)
-			if data.Cluster().Spec.ComponentsOverride.ControllerManager.Replicas != nil {
-				dep.Spec.Replicas = data.Cluster().Spec.ComponentsOverride.ControllerManager.Replicas
-			}
+			dep.Spec.Replicas = resources.Int32(getReplicas(data.Cluster()))
 
 			dep.Spec.Selector = &metav1.LabelSelector{
 				MatchLabels: baseLabels,
 			}
 			dep.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: resources.ImagePullSecretName}}
+			dep.Spec.Template.Spec.PriorityClassName = data.ControlPlanePriorityClassName()
 
 			volumes := getVolumes(data.IsKonnectivityEnabled())
 			volumeMounts := getVolumeMounts()
@@ -152,7 +150,7 @@ func DeploymentReconciler(data *resources.TemplateData) reconciling.NamedDeploym
 			dep.Spec.Template.Spec.Containers = []corev1.Container{
 				{
 					Name:    resources.ControllerManagerDeploymentName,
-					Image:   registry.Must(data.RewriteImage(resources.RegistryK8S + "/kube-controller-manager:v" + version.String())),
+					Image:   registry.Must(data.ComponentImage(resources.ControllerManagerDeploymentName, resources.RegistryK8S+"/kube-controller-manager:v"+version.String())),
 					Command: []string{"/usr/local/bin/kube-controller-manager"},
 					Args:    flags,
 					Env:     envVars,
@@ -236,7 +234,7 @@ func getFlags(data *resources.TemplateData, version *semverlib.Version) ([]strin
 	if cluster.Spec.CNIPlugin.Type != kubermaticv1.CNIPluginTypeCilium {
 		flags = append(flags, "--allocate-node-cidrs")
 		flags = append(flags, "--cluster-cidr", strings.Join(cluster.Spec.ClusterNetwork.Pods.CIDRBlocks, ","))
-		flags = append(flags, "--service-cluster-ip-range", strings.Join(cluster.Spec.ClusterNetwork.Services.CIDRBlocks, ","))
+		flags = append(flags, "--service-cluster-ip-range", data.ServiceClusterIPRange())
 		if cluster.IsDualStack() {
 			if cluster.Spec.ClusterNetwork.NodeCIDRMaskSizeIPv4 != nil {
 				flags = append(flags, fmt.Sprintf("--node-cidr-mask-size-ipv4=%d", *cluster.Spec.ClusterNetwork.NodeCIDRMaskSizeIPv4))
@@ -301,6 +299,14 @@ func getFlags(data *resources.TemplateData, version *semverlib.Version) ([]strin
 		flags = append(flags, "--leader-elect-retry-period", fmt.Sprintf("%ds", *rps))
 	}
 
+	if gp := cluster.Spec.ComponentsOverride.ControllerManager.NodeMonitorGracePeriod; gp != nil {
+		flags = append(flags, "--node-monitor-grace-period", gp.Duration.String())
+	}
+
+	flags = append(flags, resources.ControllerManagerConcurrentSyncsFlags(data.ControllerManagerConcurrentSyncs())...)
+	flags = append(flags, data.LogVerbosityFlags(resources.ControllerManagerDeploymentName)...)
+	flags = append(flags, data.LoggingFlags()...)
+
 	return flags, nil
 }
 
@@ -430,7 +436,7 @@ func GetEnvVars(data kubeControllerManagerEnvData) ([]corev1.EnvVar, error) {
 		vars = append(vars, corev1.EnvVar{Name: "GOOGLE_APPLICATION_CREDENTIALS", Value: "/etc/gcp/serviceAccount"})
 	}
 
-	vars = append(vars, resources.GetHTTPProxyEnvVarsFromSeed(data.Seed(), data.Cluster().Status.Address.InternalName)...)
+	vars = append(vars, resources.GetHTTPProxyEnvVars(data.Seed(), data.Cluster())...)
 	return vars, nil
 }
 
@@ -452,3 +458,8 @@ func CloudRoutesFlagVal(cloudSpec kubermaticv1.CloudSpec) *bool {
 	}
 	return nil
 }
+
+// getReplicas returns the number of controller-manager replicas for the given cluster.
+func getReplicas(cluster *kubermaticv1.Cluster) int32 {
+	return resources.ControlPlaneReplicas(cluster, cluster.Spec.ComponentsOverride.ControllerManager.Replicas)
+}
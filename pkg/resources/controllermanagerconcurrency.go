@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/utils/ptr"
+)
+
+// controllerManagerConcurrentSyncsFlags maps the kube-controller-manager flags to the
+// concurrencies they configure, in the order the flags are passed.
+var controllerManagerConcurrentSyncsFlags = []struct {
+	flag  string
+	value func(*kubermaticv1.ControllerManagerConcurrentSyncs) **int32
+}{
+	{"--concurrent-deployment-syncs", func(s *kubermaticv1.ControllerManagerConcurrentSyncs) **int32 { return &s.Deployment }},
+	{"--concurrent-replicaset-syncs", func(s *kubermaticv1.ControllerManagerConcurrentSyncs) **int32 { return &s.ReplicaSet }},
+	{"--concurrent-statefulset-syncs", func(s *kubermaticv1.ControllerManagerConcurrentSyncs) **int32 { return &s.StatefulSet }},
+	{"--concurrent-daemonset-syncs", func(s *kubermaticv1.ControllerManagerConcurrentSyncs) **int32 { return &s.DaemonSet }},
+	{"--concurrent-endpoint-syncs", func(s *kubermaticv1.ControllerManagerConcurrentSyncs) **int32 { return &s.Endpoint }},
+	{"--concurrent-namespace-syncs", func(s *kubermaticv1.ControllerManagerConcurrentSyncs) **int32 { return &s.Namespace }},
+	{"--concurrent-gc-syncs", func(s *kubermaticv1.ControllerManagerConcurrentSyncs) **int32 { return &s.GarbageCollector }},
+}
+
+// ControllerManagerConcurrentSyncsForTier returns how many objects the controllers of
+// kube-controller-manager sync concurrently for clusters in the given tier. Only production
+// clusters, which tend to run many workloads, get a higher concurrency; all other clusters
+// use the controller-manager's own defaults.
+func ControllerManagerConcurrentSyncsForTier(tier kubermaticv1.ClusterTier) kubermaticv1.ControllerManagerConcurrentSyncs {
+	if tier != kubermaticv1.ClusterTierProduction {
+		return kubermaticv1.ControllerManagerConcurrentSyncs{}
+	}
+
+	return kubermaticv1.ControllerManagerConcurrentSyncs{
+		Deployment:       ptr.To[int32](10),
+		ReplicaSet:       ptr.To[int32](10),
+		StatefulSet:      ptr.To[int32](10),
+		DaemonSet:        ptr.To[int32](4),
+		Endpoint:         ptr.To[int32](10),
+		Namespace:        ptr.To[int32](20),
+		GarbageCollector: ptr.To[int32](40),
+	}
+}
+
+// GetControllerManagerConcurrentSyncs returns the concurrency of the controllers of the given
+// cluster's controller-manager, i.e. the tier's defaults with the cluster's overrides applied.
+func GetControllerManagerConcurrentSyncs(cluster *kubermaticv1.Cluster) kubermaticv1.ControllerManagerConcurrentSyncs {
+	syncs := ControllerManagerConcurrentSyncsForTier(cluster.Spec.Tier)
+
+	if overrides := cluster.Spec.ComponentsOverride.ControllerManager.ConcurrentSyncs; overrides != nil {
+		for _, field := range controllerManagerConcurrentSyncsFlags {
+			if override := *field.value(overrides); override != nil {
+				*field.value(&syncs) = ptr.To(*override)
+			}
+		}
+	}
+
+	return syncs
+}
+
+// ControllerManagerConcurrentSyncsFlags returns the kube-controller-manager flags for all
+// configured concurrencies.
+func ControllerManagerConcurrentSyncsFlags(syncs kubermaticv1.ControllerManagerConcurrentSyncs) []string {
+	var flags []string
+
+	for _, field := range controllerManagerConcurrentSyncsFlags {
+		if value := *field.value(&syncs); value != nil {
+			flags = append(flags, field.flag, fmt.Sprintf("%d", *value))
+		}
+	}
+
+	return flags
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestControllerManagerConcurrentSyncsFlags(t *testing.T) {
+	tests := []struct {
+		name      string
+		tier      kubermaticv1.ClusterTier
+		overrides *kubermaticv1.ControllerManagerConcurrentSyncs
+		expected  string
+	}{
+		{
+			name: "no tier",
+		},
+		{
+			name: "development tier",
+			tier: kubermaticv1.ClusterTierDevelopment,
+		},
+		{
+			name:     "production tier",
+			tier:     kubermaticv1.ClusterTierProduction,
+			expected: "--concurrent-deployment-syncs 10 --concurrent-replicaset-syncs 10 --concurrent-statefulset-syncs 10 --concurrent-daemonset-syncs 4 --concurrent-endpoint-syncs 10 --concurrent-namespace-syncs 20 --concurrent-gc-syncs 40",
+		},
+		{
+			name: "overrides without tier",
+			overrides: &kubermaticv1.ControllerManagerConcurrentSyncs{
+				Deployment: ptr.To[int32](15),
+			},
+			expected: "--concurrent-deployment-syncs 15",
+		},
+		{
+			name: "overrides take precedence over the tier",
+			tier: kubermaticv1.ClusterTierProduction,
+			overrides: &kubermaticv1.ControllerManagerConcurrentSyncs{
+				Deployment:       ptr.To[int32](25),
+				GarbageCollector: ptr.To[int32](50),
+			},
+			expected: "--concurrent-deployment-syncs 25 --concurrent-replicaset-syncs 10 --concurrent-statefulset-syncs 10 --concurrent-daemonset-syncs 4 --concurrent-endpoint-syncs 10 --concurrent-namespace-syncs 20 --concurrent-gc-syncs 50",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{}
+			cluster.Spec.Tier = test.tier
+			cluster.Spec.ComponentsOverride.ControllerManager.ConcurrentSyncs = test.overrides
+
+			flags := strings.Join(ControllerManagerConcurrentSyncsFlags(GetControllerManagerConcurrentSyncs(cluster)), " ")
+			if flags != test.expected {
+				t.Errorf("Expected flags %q, got %q", test.expected, flags)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"k8c.io/reconciler/pkg/reconciling"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ControlPlaneDNSAnnotation marks pod templates whose DNS settings have been applied by the
+// ControlPlaneDNSModifier, so that these can be reset when the settings change.
+const ControlPlaneDNSAnnotation = "kubermatic.k8c.io/control-plane-dns"
+
+// ControlPlaneDNSModifier returns an ObjectModifier that applies the given DNS settings to the pod
+// template of the reconciled Deployment or StatefulSet. Pods with the "None" DNS policy already
+// resolve through the user cluster DNS and are left untouched. As the DNS settings are part of the
+// pod template, changing them rolls the affected component.
+func ControlPlaneDNSModifier(settings *kubermaticv1.ControlPlaneDNSSettings) reconciling.ObjectModifier {
+	return func(create reconciling.ObjectReconciler) reconciling.ObjectReconciler {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			// Reconcilers that do not set the DNS settings themselves keep the ones applied by
+			// a previous reconciliation, so these have to be reset first.
+			if template := podTemplate(existing); template != nil {
+				if _, ok := template.Annotations[ControlPlaneDNSAnnotation]; ok {
+					delete(template.Annotations, ControlPlaneDNSAnnotation)
+					template.Spec.DNSPolicy = corev1.DNSClusterFirst
+					template.Spec.DNSConfig = nil
+				}
+			}
+
+			obj, err := create(existing)
+			if err != nil {
+				return obj, err
+			}
+
+			if settings == nil {
+				return obj, nil
+			}
+
+			template := podTemplate(obj)
+			if template == nil || template.Spec.DNSPolicy == corev1.DNSNone {
+				return obj, nil
+			}
+
+			if settings.Policy != "" {
+				template.Spec.DNSPolicy = settings.Policy
+			}
+			template.Spec.DNSConfig = settings.Config.DeepCopy()
+
+			if template.Annotations == nil {
+				template.Annotations = map[string]string{}
+			}
+			template.Annotations[ControlPlaneDNSAnnotation] = ""
+
+			return obj, nil
+		}
+	}
+}
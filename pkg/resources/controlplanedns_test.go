@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// apiserverDNSTestDeployment renders the DNS settings of the apiserver, which uses the user cluster
+// DNS unless Konnectivity is enabled.
+func apiserverDNSTestDeployment(konnectivity bool) reconciling.ObjectReconciler {
+	return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		dep := existing.(*appsv1.Deployment)
+		dep.Name = ApiserverDeploymentName
+
+		if konnectivity {
+			dep.Spec.Template.Spec.DNSPolicy = corev1.DNSClusterFirst
+			dep.Spec.Template.Spec.DNSConfig = nil
+		} else {
+			dep.Spec.Template.Spec.DNSPolicy = corev1.DNSNone
+			dep.Spec.Template.Spec.DNSConfig = &corev1.PodDNSConfig{
+				Nameservers: []string{"10.240.16.10"},
+			}
+		}
+
+		return dep, nil
+	}
+}
+
+func TestControlPlaneDNSModifier(t *testing.T) {
+	settings := &kubermaticv1.ControlPlaneDNSSettings{
+		Policy: corev1.DNSNone,
+		Config: &corev1.PodDNSConfig{
+			Nameservers: []string{"192.0.2.53", "2001:db8::53"},
+			Searches:    []string{"example.com"},
+			Options: []corev1.PodDNSConfigOption{
+				{Name: "ndots", Value: ptr.To("2")},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		settings       *kubermaticv1.ControlPlaneDNSSettings
+		konnectivity   bool
+		expectedPolicy corev1.DNSPolicy
+		expectedConfig *corev1.PodDNSConfig
+	}{
+		{
+			name:           "no settings",
+			konnectivity:   true,
+			expectedPolicy: corev1.DNSClusterFirst,
+		},
+		{
+			name:           "settings are applied",
+			settings:       settings,
+			konnectivity:   true,
+			expectedPolicy: corev1.DNSNone,
+			expectedConfig: settings.Config,
+		},
+		{
+			name: "config is merged into the default policy",
+			settings: &kubermaticv1.ControlPlaneDNSSettings{
+				Config: &corev1.PodDNSConfig{Searches: []string{"example.com"}},
+			},
+			konnectivity:   true,
+			expectedPolicy: corev1.DNSClusterFirst,
+			expectedConfig: &corev1.PodDNSConfig{Searches: []string{"example.com"}},
+		},
+		{
+			name:           "user cluster DNS is kept",
+			settings:       settings,
+			konnectivity:   false,
+			expectedPolicy: corev1.DNSNone,
+			expectedConfig: &corev1.PodDNSConfig{Nameservers: []string{"10.240.16.10"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reconciler := ControlPlaneDNSModifier(tc.settings)(apiserverDNSTestDeployment(tc.konnectivity))
+
+			obj, err := reconciler(&appsv1.Deployment{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile Deployment: %v", err)
+			}
+
+			podSpec := obj.(*appsv1.Deployment).Spec.Template.Spec
+			if podSpec.DNSPolicy != tc.expectedPolicy {
+				t.Errorf("Expected DNS policy %q, got %q", tc.expectedPolicy, podSpec.DNSPolicy)
+			}
+
+			if !equality.Semantic.DeepEqual(podSpec.DNSConfig, tc.expectedConfig) {
+				t.Errorf("Expected DNS config %+v, got %+v", tc.expectedConfig, podSpec.DNSConfig)
+			}
+		})
+	}
+}
+
+func TestControlPlaneDNSModifierReset(t *testing.T) {
+	// a reconciler that does not set the DNS settings itself
+	reconciler := func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		return existing, nil
+	}
+
+	settings := &kubermaticv1.ControlPlaneDNSSettings{
+		Policy: corev1.DNSNone,
+		Config: &corev1.PodDNSConfig{Nameservers: []string{"192.0.2.53"}},
+	}
+
+	obj, err := ControlPlaneDNSModifier(settings)(reconciler)(&appsv1.Deployment{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile Deployment: %v", err)
+	}
+
+	settings.Config.Nameservers = []string{"192.0.2.54"}
+
+	obj, err = ControlPlaneDNSModifier(settings)(reconciler)(obj)
+	if err != nil {
+		t.Fatalf("Failed to reconcile Deployment: %v", err)
+	}
+
+	if nameservers := obj.(*appsv1.Deployment).Spec.Template.Spec.DNSConfig.Nameservers; len(nameservers) != 1 || nameservers[0] != "192.0.2.54" {
+		t.Errorf("Expected changed nameservers to be applied, got %v", nameservers)
+	}
+
+	obj, err = ControlPlaneDNSModifier(nil)(reconciler)(obj)
+	if err != nil {
+		t.Fatalf("Failed to reconcile Deployment: %v", err)
+	}
+
+	podSpec := obj.(*appsv1.Deployment).Spec.Template.Spec
+	if podSpec.DNSPolicy != corev1.DNSClusterFirst || podSpec.DNSConfig != nil {
+		t.Errorf("Expected DNS settings to be reset, got policy %q and config %+v", podSpec.DNSPolicy, podSpec.DNSConfig)
+	}
+}
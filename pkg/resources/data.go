@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"strconv"
@@ -56,6 +57,31 @@ const (
 	CloudProviderExternalFlag = "external"
 )
 
+const (
+	// DefaultAuditLogMaxAge is the default number of days rotated audit log files are retained.
+	DefaultAuditLogMaxAge = 30
+	// DefaultAuditLogMaxBackup is the default number of rotated audit log files that are retained.
+	DefaultAuditLogMaxBackup = 3
+	// DefaultAuditLogMaxSize is the default size in megabytes at which the audit log file is rotated.
+	DefaultAuditLogMaxSize = 100
+)
+
+// AuditLogRotation configures the rotation of the audit log file written by the kube-apiserver.
+type AuditLogRotation struct {
+	// MaxAge is the number of days rotated files are retained.
+	MaxAge int32
+	// MaxBackup is the number of rotated files that are retained.
+	MaxBackup int32
+	// MaxSize is the size in megabytes at which the file is rotated.
+	MaxSize int32
+}
+
+// VolumeSize returns the size of a volume that can hold the audit log file, all rotated
+// files and one more file that is currently being rotated.
+func (r AuditLogRotation) VolumeSize() resource.Quantity {
+	return *resource.NewQuantity(int64(r.MaxBackup+2)*int64(r.MaxSize)*1024*1024, resource.BinarySI)
+}
+
 type CABundle interface {
 	CertPool() *x509.CertPool
 	String() string
@@ -81,10 +107,22 @@ type TemplateData struct {
 	machineControllerImageTag        string
 	machineControllerImageRepository string
 	backupSchedule                   time.Duration
+	backupCount                      int
+	etcdDefragSchedule               string
+	saKeyRotationGracePeriod         time.Duration
+	prometheusTokenExpiration        time.Duration
+	apiserverShutdownDelay           time.Duration
+	apiserverTerminationGracePeriod  time.Duration
+	sidecarInjections                []SidecarInjection
+	resourceOverrides                ResourceOverrides
+	eventExporterSink                string
+	externalDNSAnnotations           bool
+	imageOverrides                   map[string]string
 	versions                         kubermatic.Versions
 	caBundle                         CABundle
 
 	supportsFailureDomainZoneAntiAffinity bool
+	failureDomainZones                    int
 
 	userClusterMLAEnabled bool
 	isKonnectivityEnabled bool
@@ -140,6 +178,13 @@ func (td *TemplateDataBuilder) WithOverwriteRegistry(overwriteRegistry string) *
 	return td
 }
 
+// WithImageOverrides sets the images to use for individual control plane components,
+// keyed by the component name.
+func (td *TemplateDataBuilder) WithImageOverrides(overrides map[string]string) *TemplateDataBuilder {
+	td.data.imageOverrides = overrides
+	return td
+}
+
 func (td *TemplateDataBuilder) WithNodePortRange(npRange string) *TemplateDataBuilder {
 	td.data.nodePortRange = npRange
 	return td
@@ -225,11 +270,78 @@ func (td *TemplateDataBuilder) WithFailureDomainZoneAntiaffinity(enabled bool) *
 	return td
 }
 
+func (td *TemplateDataBuilder) WithFailureDomainZones(zones int) *TemplateDataBuilder {
+	td.data.failureDomainZones = zones
+	return td
+}
+
 func (td *TemplateDataBuilder) WithBackupPeriod(backupPeriod time.Duration) *TemplateDataBuilder {
 	td.data.backupSchedule = backupPeriod
 	return td
 }
 
+// WithBackupCount sets the number of etcd backups to keep for the cluster.
+func (td *TemplateDataBuilder) WithBackupCount(count int) *TemplateDataBuilder {
+	td.data.backupCount = count
+	return td
+}
+
+// WithEtcdDefragSchedule sets the cron schedule of the etcd defragger. An empty
+// schedule disables the defragger.
+func (td *TemplateDataBuilder) WithEtcdDefragSchedule(schedule string) *TemplateDataBuilder {
+	td.data.etcdDefragSchedule = schedule
+	return td
+}
+
+// WithServiceAccountKeyRotationGracePeriod sets for how long tokens signed with a
+// rotated service account key remain valid.
+func (td *TemplateDataBuilder) WithServiceAccountKeyRotationGracePeriod(gracePeriod time.Duration) *TemplateDataBuilder {
+	td.data.saKeyRotationGracePeriod = gracePeriod
+	return td
+}
+
+// WithPrometheusTokenExpiration sets the lifetime of the ServiceAccount token projected
+// into Prometheus. 0 uses the Kubernetes default.
+func (td *TemplateDataBuilder) WithPrometheusTokenExpiration(expiration time.Duration) *TemplateDataBuilder {
+	td.data.prometheusTokenExpiration = expiration
+	return td
+}
+
+// WithAPIServerGracefulTermination sets for how long the apiserver keeps serving requests
+// after it has been asked to shut down, and how long Kubernetes waits for it to exit
+// before killing it. Zero values keep the defaults.
+func (td *TemplateDataBuilder) WithAPIServerGracefulTermination(shutdownDelay, terminationGracePeriod time.Duration) *TemplateDataBuilder {
+	td.data.apiserverShutdownDelay = shutdownDelay
+	td.data.apiserverTerminationGracePeriod = terminationGracePeriod
+	return td
+}
+
+// WithSidecarInjections sets the sidecar containers to inject into control plane pods.
+func (td *TemplateDataBuilder) WithSidecarInjections(injections []SidecarInjection) *TemplateDataBuilder {
+	td.data.sidecarInjections = injections
+	return td
+}
+
+// WithResourceOverrides sets the container resource overrides read from the cluster's
+// resource override ConfigMap.
+func (td *TemplateDataBuilder) WithResourceOverrides(overrides ResourceOverrides) *TemplateDataBuilder {
+	td.data.resourceOverrides = overrides
+	return td
+}
+
+// WithEventExporterSink sets the endpoint the events of the user cluster are forwarded to.
+// An empty sink disables the event-exporter.
+func (td *TemplateDataBuilder) WithEventExporterSink(sink string) *TemplateDataBuilder {
+	td.data.eventExporterSink = sink
+	return td
+}
+
+// WithExternalDNSAnnotations enables annotating the apiserver Service for external-dns.
+func (td *TemplateDataBuilder) WithExternalDNSAnnotations(enabled bool) *TemplateDataBuilder {
+	td.data.externalDNSAnnotations = enabled
+	return td
+}
+
 func (td *TemplateDataBuilder) WithMachineControllerImageTag(tag string) *TemplateDataBuilder {
 	td.data.machineControllerImageTag = tag
 	return td
@@ -259,6 +371,15 @@ func (d *TemplateData) GetViewerToken() (string, error) {
 	return string(viewerTokenSecret.Data[ViewerTokenSecretKey]), nil
 }
 
+// GetApiserverHealthCheckToken returns the token the apiserver's health probes authenticate with.
+func (d *TemplateData) GetApiserverHealthCheckToken() (string, error) {
+	secret := &corev1.Secret{}
+	if err := d.client.Get(d.ctx, ctrlruntimeclient.ObjectKey{Name: ApiserverHealthCheckTokenSecretName, Namespace: d.cluster.Status.NamespaceName}, secret); err != nil {
+		return "", err
+	}
+	return string(secret.Data[ApiserverHealthCheckTokenSecretKey]), nil
+}
+
 // CABundle returns the set of CA certificates that should be used
 // for all outgoing communication.
 func (d *TemplateData) CABundle() CABundle {
@@ -275,6 +396,28 @@ func (d *TemplateData) OIDCIssuerClientID() string {
 	return d.oidcIssuerClientID
 }
 
+// ServiceAccountIssuerURL returns the issuer used for service account tokens. It
+// defaults to the external apiserver URL unless the cluster overrides it.
+func (d *TemplateData) ServiceAccountIssuerURL() string {
+	if sa := d.cluster.Spec.ServiceAccount; sa != nil && sa.Issuer != "" {
+		return sa.Issuer
+	}
+
+	return d.cluster.Status.Address.URL
+}
+
+// ServiceAccountJWKSURI returns the URI under which the public keys used to
+// verify service account tokens are published.
+func (d *TemplateData) ServiceAccountJWKSURI() string {
+	return strings.TrimSuffix(d.ServiceAccountIssuerURL(), "/") + "/openid/v1/jwks"
+}
+
+// EtcdNamespace returns the namespace etcd is placed into. This is the cluster
+// namespace, unless the cluster uses a split control plane.
+func (d *TemplateData) EtcdNamespace() string {
+	return EtcdNamespaceName(d.cluster)
+}
+
 // Cluster returns the cluster.
 func (d *TemplateData) Cluster() *kubermaticv1.Cluster {
 	return d.cluster
@@ -285,11 +428,64 @@ func (d *TemplateData) DC() *kubermaticv1.Datacenter {
 	return d.dc
 }
 
-// EtcdDiskSize returns the etcd disk size.
+// EtcdDiskSize returns the size of the etcd volumes, which can be overridden per
+// cluster and otherwise defaults to the controller-wide disk size.
 func (d *TemplateData) EtcdDiskSize() resource.Quantity {
+	if size := d.cluster.Spec.ComponentsOverride.Etcd.DiskSize; size != nil {
+		return *size
+	}
+
 	return d.etcdDiskSize
 }
 
+// EtcdQuotaBackendBytes returns the etcd database size limit configured for the
+// cluster, or 0 if etcd's default should be used.
+func (d *TemplateData) EtcdQuotaBackendBytes() int64 {
+	if quota := d.cluster.Spec.ComponentsOverride.Etcd.QuotaBackendBytes; quota != nil {
+		return quota.Value()
+	}
+	return 0
+}
+
+// EtcdAutoCompactionMode returns the etcd auto-compaction mode configured for the
+// cluster, or an empty string if etcd's default should be used.
+func (d *TemplateData) EtcdAutoCompactionMode() string {
+	return string(d.cluster.Spec.ComponentsOverride.Etcd.AutoCompactionMode)
+}
+
+// EtcdAutoCompactionRetention returns the etcd auto-compaction retention configured
+// for the cluster, or an empty string if the default retention should be used.
+func (d *TemplateData) EtcdAutoCompactionRetention() string {
+	return d.cluster.Spec.ComponentsOverride.Etcd.AutoCompactionRetention
+}
+
+// APIServerTLSMinVersion returns the minimum TLS version the apiserver accepts.
+func (d *TemplateData) APIServerTLSMinVersion() string {
+	if version := d.cluster.Spec.ComponentsOverride.Apiserver.TLSMinVersion; version != "" {
+		return version
+	}
+
+	return DefaultAPIServerTLSMinVersion
+}
+
+// APIServerTLSCipherSuites returns the TLS cipher suites the apiserver accepts.
+func (d *TemplateData) APIServerTLSCipherSuites() []string {
+	if suites := d.cluster.Spec.ComponentsOverride.Apiserver.TLSCipherSuites; len(suites) > 0 {
+		return suites
+	}
+
+	return GetAllowedTLSCipherSuites()
+}
+
+// EtcdCipherSuites returns the TLS cipher suites etcd accepts from clients and peers.
+func (d *TemplateData) EtcdCipherSuites() []string {
+	if suites := d.cluster.Spec.ComponentsOverride.Etcd.CipherSuites; len(suites) > 0 {
+		return suites
+	}
+
+	return GetDefaultEtcdCipherSuites()
+}
+
 func (d *TemplateData) EtcdLauncherImage() string {
 	return registry.Must(d.RewriteImage(d.etcdLauncherImage))
 }
@@ -306,6 +502,22 @@ func (d *TemplateData) EtcdBackupDestination() *kubermaticv1.BackupDestination {
 	return d.etcdBackupDestination
 }
 
+// EtcdBackupStorageCredentials returns the data of the shared Secret holding the
+// object-storage credentials of the configured etcd backup destination.
+func (d *TemplateData) EtcdBackupStorageCredentials() (map[string][]byte, error) {
+	key, ok := EtcdBackupStorageCredentialsKey(d.etcdBackupDestination)
+	if !ok {
+		return nil, errors.New("no credentials configured for the etcd backup destination")
+	}
+
+	secret := corev1.Secret{}
+	if err := d.client.Get(d.ctx, key, &secret); err != nil {
+		return nil, fmt.Errorf("failed to get etcd backup storage credentials %s: %w", key, err)
+	}
+
+	return secret.Data, nil
+}
+
 func (d *TemplateData) EtcdLauncherTag() string {
 	return d.versions.Kubermatic
 }
@@ -429,6 +641,80 @@ func (d *TemplateData) RewriteImage(image string) (string, error) {
 	return d.ImageRewriter()(image)
 }
 
+// ComponentImage returns the image to use for the given control plane component. An image
+// override configured for the component takes precedence and is used as-is, otherwise the
+// default image is rewritten to apply a custom registry if specified.
+func (d *TemplateData) ComponentImage(component, defaultImage string) (string, error) {
+	if override, ok := d.imageOverrides[component]; ok {
+		return override, nil
+	}
+
+	return d.RewriteImage(defaultImage)
+}
+
+// ControlPlanePriorityClassName returns the PriorityClass for the control plane pods, as
+// selected by the cluster's tier.
+func (d *TemplateData) ControlPlanePriorityClassName() string {
+	return ControlPlanePriorityClassName(d.cluster.Spec.Tier)
+}
+
+// NodeRuntimeConfig returns the custom container runtime configuration for the
+// nodes of the cluster, or an empty string if none is configured.
+func (d *TemplateData) NodeRuntimeConfig() string {
+	return d.cluster.Spec.NodeRuntimeConfig
+}
+
+// LogVerbosityFlags returns the flags setting the log verbosity configured for the given control
+// plane component, or nil if the component should use its default verbosity.
+func (d *TemplateData) LogVerbosityFlags(component string) []string {
+	verbosity, ok := d.cluster.Spec.LogVerbosity[component]
+	if !ok {
+		return nil
+	}
+
+	return []string{"-v", fmt.Sprint(verbosity)}
+}
+
+// LoggingFlags returns the flags setting the log format and flush frequency configured for the
+// apiserver, controller-manager and scheduler, or nil if their defaults should be used.
+func (d *TemplateData) LoggingFlags() []string {
+	var flags []string
+
+	if format := d.cluster.Spec.LogFormat; format != "" {
+		flags = append(flags, "--logging-format", string(format))
+	}
+
+	if frequency := d.cluster.Spec.LogFlushFrequency; frequency != nil {
+		flags = append(flags, "--log-flush-frequency", frequency.Duration.String())
+	}
+
+	return flags
+}
+
+// PrometheusSizing returns the resources, storage size and retention of the
+// Prometheus in the cluster namespace, as selected by the cluster's tier.
+func (d *TemplateData) PrometheusSizing() PrometheusSizing {
+	return PrometheusSizingForTier(d.cluster.Spec.Tier)
+}
+
+// APIServerRequestLimits returns the in-flight request ceilings of the apiserver, as
+// selected by the cluster's tier and components override.
+func (d *TemplateData) APIServerRequestLimits() kubermaticv1.APIServerRequestLimits {
+	return GetAPIServerRequestLimits(d.cluster)
+}
+
+// APIServerAutoscaling returns the HorizontalPodAutoscaler settings of the apiserver, or
+// nil if the apiserver is not autoscaled.
+func (d *TemplateData) APIServerAutoscaling() *kubermaticv1.APIServerAutoscalingSettings {
+	return GetAPIServerAutoscaling(d.cluster, d.dc)
+}
+
+// ControllerManagerConcurrentSyncs returns how many objects the controllers of the
+// controller-manager sync concurrently, as selected by the cluster's tier and components override.
+func (d *TemplateData) ControllerManagerConcurrentSyncs() kubermaticv1.ControllerManagerConcurrentSyncs {
+	return GetControllerManagerConcurrentSyncs(d.cluster)
+}
+
 // GetRootCA returns the root CA of the cluster.
 func (d *TemplateData) GetRootCA() (*triple.KeyPair, error) {
 	return GetClusterRootCA(d.ctx, d.cluster.Status.NamespaceName, d.client)
@@ -439,6 +725,17 @@ func (d *TemplateData) GetFrontProxyCA() (*triple.KeyPair, error) {
 	return GetClusterFrontProxyCA(d.ctx, d.cluster.Status.NamespaceName, d.client)
 }
 
+// GetEtcdMetricsCA returns the CA for the etcd metrics endpoint.
+func (d *TemplateData) GetEtcdMetricsCA() (*triple.KeyPair, error) {
+	return GetClusterEtcdMetricsCA(d.ctx, d.cluster.Status.NamespaceName, d.client)
+}
+
+// FrontProxyClientCertificateCommonName returns the common name of the client certificate
+// the apiserver uses to authenticate against aggregated apiservers.
+func (d *TemplateData) FrontProxyClientCertificateCommonName() string {
+	return ApiserverFrontProxyClientCertificateCommonName
+}
+
 // GetOpenVPNCA returns the root ca for the OpenVPN.
 func (d *TemplateData) GetOpenVPNCA() (*ECDSAKeyPair, error) {
 	return GetOpenVPNCA(d.ctx, d.cluster.Status.NamespaceName, d.client)
@@ -455,6 +752,26 @@ func (d *TemplateData) GetPodTemplateLabels(appName string, volumes []corev1.Vol
 	return GetPodTemplateLabels(d.ctx, d.client, appName, d.cluster.Name, d.cluster.Status.NamespaceName, volumes, additionalLabels)
 }
 
+// OpenVPNCipher returns the data channel cipher of the OpenVPN tunnel.
+func (d *TemplateData) OpenVPNCipher() string {
+	return GetOpenVPNCipher(d.cluster)
+}
+
+// OpenVPNCompression returns the compression of the OpenVPN tunnel, or an empty string if
+// the OpenVPN default should be used.
+func (d *TemplateData) OpenVPNCompression() kubermaticv1.OpenVPNCompression {
+	return d.cluster.Spec.ClusterNetwork.TunnelCompression
+}
+
+// OpenVPNTunnelMTU returns the MTU configured for the OpenVPN tunnel, or 0 if the
+// OpenVPN default should be used.
+func (d *TemplateData) OpenVPNTunnelMTU() int32 {
+	if mtu := d.cluster.Spec.ClusterNetwork.TunnelMTU; mtu != nil {
+		return *mtu
+	}
+	return 0
+}
+
 // GetOpenVPNServerPort returns the nodeport of the external apiserver service.
 func (d *TemplateData) GetOpenVPNServerPort() (int32, error) {
 	// When using tunneling expose strategy the port is fixed
@@ -569,6 +886,39 @@ func (d *TemplateData) NodeLocalDNSCacheEnabled() bool {
 	return d.Cluster().Spec.ClusterNetwork.NodeLocalDNSCacheEnabled == nil || *d.Cluster().Spec.ClusterNetwork.NodeLocalDNSCacheEnabled
 }
 
+// ServiceCIDRs returns the service CIDRs of the cluster. All components which need to
+// agree on the service network must be configured from this value.
+func (d *TemplateData) ServiceCIDRs() []string {
+	return d.cluster.Spec.ClusterNetwork.Services.CIDRBlocks
+}
+
+// ServiceClusterIPRange returns the service CIDRs in the format expected by the
+// --service-cluster-ip-range flag of the apiserver and controller-manager.
+func (d *TemplateData) ServiceClusterIPRange() string {
+	return strings.Join(d.ServiceCIDRs(), ",")
+}
+
+// ClusterDNSIP returns the ClusterIP of the user cluster DNS Service. It is derived
+// from the service CIDR, so that it matches the cluster DNS configured on the nodes.
+func (d *TemplateData) ClusterDNSIP() (string, error) {
+	cidrs := d.ServiceCIDRs()
+	if len(cidrs) == 0 {
+		return "", fmt.Errorf("failed to get cluster dns ip for cluster `%s`: empty CIDRBlocks", d.cluster.Name)
+	}
+
+	return ClusterDNSIPFromServiceCIDR(cidrs[0])
+}
+
+// NodeClusterDNSIP returns the DNS server IP the kubelets on the nodes are configured with.
+// This is the node-local DNS cache, if enabled, and the user cluster DNS Service otherwise.
+func (d *TemplateData) NodeClusterDNSIP() (string, error) {
+	if d.NodeLocalDNSCacheEnabled() {
+		return NodeLocalDNSCacheAddress, nil
+	}
+
+	return d.ClusterDNSIP()
+}
+
 func (d *TemplateData) KubermaticAPIImage() string {
 	return registry.Must(d.RewriteImage(d.kubermaticImage))
 }
@@ -589,6 +939,111 @@ func (d *TemplateData) BackupSchedule() time.Duration {
 	return d.backupSchedule
 }
 
+// BackupCount returns the number of etcd backups to keep for the cluster.
+func (d *TemplateData) BackupCount() int {
+	return d.backupCount
+}
+
+// EtcdDefragSchedule returns the cron schedule of the etcd defragger.
+func (d *TemplateData) EtcdDefragSchedule() string {
+	return d.etcdDefragSchedule
+}
+
+// IsEtcdDefragEnabled returns whether the etcd defragger CronJob should be deployed.
+func (d *TemplateData) IsEtcdDefragEnabled() bool {
+	return d.etcdDefragSchedule != ""
+}
+
+// ServiceAccountKeyRotationGracePeriod returns for how long tokens signed with a rotated
+// service account key remain valid.
+func (d *TemplateData) ServiceAccountKeyRotationGracePeriod() time.Duration {
+	return d.saKeyRotationGracePeriod
+}
+
+// PrometheusTokenExpiration returns the lifetime of the ServiceAccount token projected
+// into Prometheus, or 0 if the automounted token should be used.
+func (d *TemplateData) PrometheusTokenExpiration() time.Duration {
+	return d.prometheusTokenExpiration
+}
+
+// APIServerShutdownDelay returns the value for the apiserver's --shutdown-delay-duration flag.
+func (d *TemplateData) APIServerShutdownDelay() time.Duration {
+	return d.apiserverShutdownDelay
+}
+
+// APIServerTerminationGracePeriod returns the termination grace period for apiserver pods.
+func (d *TemplateData) APIServerTerminationGracePeriod() time.Duration {
+	return d.apiserverTerminationGracePeriod
+}
+
+// SidecarInjections returns the sidecar containers to inject into control plane pods.
+func (d *TemplateData) SidecarInjections() []SidecarInjection {
+	return d.sidecarInjections
+}
+
+// ResourceOverrides returns the container resource overrides of the control plane components.
+func (d *TemplateData) ResourceOverrides() ResourceOverrides {
+	return d.resourceOverrides
+}
+
+// EventExporterSink returns the endpoint the events of the user cluster are forwarded to,
+// or an empty string if the event-exporter is disabled.
+func (d *TemplateData) EventExporterSink() string {
+	return d.eventExporterSink
+}
+
+// ResourceLimitPolicy returns the policy shaping the resource requirements of the control plane
+// containers, defaulting to keeping both requests and limits.
+func (d *TemplateData) ResourceLimitPolicy() kubermaticv1.ControlPlaneResourceLimitPolicy {
+	if policy := d.cluster.Spec.ControlPlaneResourceLimitPolicy; policy != "" {
+		return policy
+	}
+
+	return kubermaticv1.ControlPlaneResourceLimitPolicyRequestsAndLimits
+}
+
+// AuditLogRotation returns the rotation of the apiserver audit log file, falling back to
+// the defaults for settings that are not configured for the cluster.
+func (d *TemplateData) AuditLogRotation() AuditLogRotation {
+	rotation := AuditLogRotation{
+		MaxAge:    DefaultAuditLogMaxAge,
+		MaxBackup: DefaultAuditLogMaxBackup,
+		MaxSize:   DefaultAuditLogMaxSize,
+	}
+
+	if d.cluster.Spec.AuditLogging == nil || d.cluster.Spec.AuditLogging.Rotation == nil {
+		return rotation
+	}
+
+	settings := d.cluster.Spec.AuditLogging.Rotation
+	if settings.MaxAge != nil {
+		rotation.MaxAge = *settings.MaxAge
+	}
+	if settings.MaxBackup != nil {
+		rotation.MaxBackup = *settings.MaxBackup
+	}
+	if settings.MaxSize != nil {
+		rotation.MaxSize = *settings.MaxSize
+	}
+
+	return rotation
+}
+
+// ControlPlaneDNS returns the DNS settings for the control plane pods. It is nil if the
+// pods use the default DNS policy.
+func (d *TemplateData) ControlPlaneDNS() *kubermaticv1.ControlPlaneDNSSettings {
+	return d.cluster.Spec.ControlPlaneDNS
+}
+
+// ExternalDNSHostname returns the hostname external-dns should create a DNS record for
+// the apiserver for. It is empty if external-dns annotations are disabled.
+func (d *TemplateData) ExternalDNSHostname() string {
+	if !d.externalDNSAnnotations {
+		return ""
+	}
+	return d.cluster.Status.Address.ExternalName
+}
+
 func (d *TemplateData) DNATControllerTag() string {
 	return d.versions.Kubermatic
 }
@@ -597,6 +1052,11 @@ func (d *TemplateData) SupportsFailureDomainZoneAntiAffinity() bool {
 	return d.supportsFailureDomainZoneAntiAffinity
 }
 
+// FailureDomainZones returns the number of availability zones the seed's nodes are spread across.
+func (d *TemplateData) FailureDomainZones() int {
+	return d.failureDomainZones
+}
+
 func (d *TemplateData) GetGlobalSecretKeySelectorValue(configVar *providerconfig.GlobalSecretKeySelector, key string) (string, error) {
 	return provider.SecretKeySelectorValueFuncFactory(d.ctx, d.client)(configVar, key)
 }
@@ -878,7 +1338,7 @@ func (data *TemplateData) GetEnvVars() ([]corev1.EnvVar, error) {
 			vars = append(vars, corev1.EnvVar{Name: "VCD_ALLOW_UNVERIFIED_SSL", Value: "true"})
 		}
 	}
-	vars = append(vars, GetHTTPProxyEnvVarsFromSeed(data.Seed(), cluster.Status.Address.InternalName)...)
+	vars = append(vars, GetHTTPProxyEnvVars(data.Seed(), cluster)...)
 
 	vars = SanitizeEnvVars(vars)
 	if cluster.Spec.Cloud.Kubevirt != nil && dc.Spec.Kubevirt != nil && dc.Spec.Kubevirt.NamespacedMode != nil && dc.Spec.Kubevirt.NamespacedMode.Enabled {
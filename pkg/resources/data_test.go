@@ -17,7 +17,9 @@ limitations under the License.
 package resources
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/semver"
@@ -25,6 +27,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 )
 
 func TestGetCSIMigrationFeatureGates(t *testing.T) {
@@ -372,3 +375,216 @@ func TestNetworkInterfaceManagerImage(t *testing.T) {
 		})
 	}
 }
+
+func TestComponentImage(t *testing.T) {
+	const defaultImage = "registry.k8s.io/kube-apiserver:v1.30.0"
+
+	testCases := []struct {
+		name         string
+		templateData *TemplateData
+		wantImage    string
+	}{
+		{
+			name:         "default image",
+			templateData: &TemplateData{},
+			wantImage:    defaultImage,
+		},
+		{
+			name: "default image with overwrite registry",
+			templateData: &TemplateData{
+				OverwriteRegistry: "custom-registry.kubermatic.io",
+			},
+			wantImage: "custom-registry.kubermatic.io/kube-apiserver:v1.30.0",
+		},
+		{
+			name: "override for another component",
+			templateData: &TemplateData{
+				OverwriteRegistry: "custom-registry.kubermatic.io",
+				imageOverrides: map[string]string{
+					SchedulerDeploymentName: "registry.example.com/kube-scheduler:canary",
+				},
+			},
+			wantImage: "custom-registry.kubermatic.io/kube-apiserver:v1.30.0",
+		},
+		{
+			name: "override",
+			templateData: &TemplateData{
+				imageOverrides: map[string]string{
+					ApiserverDeploymentName: "registry.example.com/kube-apiserver:canary",
+				},
+			},
+			wantImage: "registry.example.com/kube-apiserver:canary",
+		},
+		{
+			name: "override wins over overwrite registry",
+			templateData: &TemplateData{
+				OverwriteRegistry: "custom-registry.kubermatic.io",
+				imageOverrides: map[string]string{
+					ApiserverDeploymentName: "registry.example.com/kube-apiserver:canary",
+				},
+			},
+			wantImage: "registry.example.com/kube-apiserver:canary",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			img, err := tc.templateData.ComponentImage(ApiserverDeploymentName, defaultImage)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if img != tc.wantImage {
+				t.Errorf("want image %q, but got %q", tc.wantImage, img)
+			}
+		})
+	}
+}
+
+func TestLogVerbosityFlags(t *testing.T) {
+	logVerbosity := map[string]int32{
+		ApiserverDeploymentName: 4,
+		SchedulerDeploymentName: 0,
+	}
+
+	testCases := []struct {
+		name          string
+		component     string
+		logVerbosity  map[string]int32
+		expectedFlags []string
+	}{
+		{
+			name:          "configured verbosity",
+			component:     ApiserverDeploymentName,
+			logVerbosity:  logVerbosity,
+			expectedFlags: []string{"-v", "4"},
+		},
+		{
+			name:          "configured zero verbosity",
+			component:     SchedulerDeploymentName,
+			logVerbosity:  logVerbosity,
+			expectedFlags: []string{"-v", "0"},
+		},
+		{
+			name:         "unset component",
+			component:    ControllerManagerDeploymentName,
+			logVerbosity: logVerbosity,
+		},
+		{
+			name:      "no verbosity configured",
+			component: ApiserverDeploymentName,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := NewTemplateDataBuilder().
+				WithCluster(&kubermaticv1.Cluster{
+					Spec: kubermaticv1.ClusterSpec{
+						LogVerbosity: tc.logVerbosity,
+					},
+				}).
+				Build()
+
+			if flags := td.LogVerbosityFlags(tc.component); !reflect.DeepEqual(flags, tc.expectedFlags) {
+				t.Errorf("Expected flags %v for %q, got %v", tc.expectedFlags, tc.component, flags)
+			}
+		})
+	}
+}
+
+func TestLoggingFlags(t *testing.T) {
+	testCases := []struct {
+		name              string
+		logFormat         kubermaticv1.ControlPlaneLogFormat
+		logFlushFrequency *metav1.Duration
+		expectedFlags     []string
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name:          "json format",
+			logFormat:     kubermaticv1.ControlPlaneLogFormatJSON,
+			expectedFlags: []string{"--logging-format", "json"},
+		},
+		{
+			name:          "explicit text format",
+			logFormat:     kubermaticv1.ControlPlaneLogFormatText,
+			expectedFlags: []string{"--logging-format", "text"},
+		},
+		{
+			name:              "flush frequency",
+			logFlushFrequency: &metav1.Duration{Duration: 10 * time.Second},
+			expectedFlags:     []string{"--log-flush-frequency", "10s"},
+		},
+		{
+			name:              "json format and flush frequency",
+			logFormat:         kubermaticv1.ControlPlaneLogFormatJSON,
+			logFlushFrequency: &metav1.Duration{Duration: time.Second},
+			expectedFlags:     []string{"--logging-format", "json", "--log-flush-frequency", "1s"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := NewTemplateDataBuilder().
+				WithCluster(&kubermaticv1.Cluster{
+					Spec: kubermaticv1.ClusterSpec{
+						LogFormat:         tc.logFormat,
+						LogFlushFrequency: tc.logFlushFrequency,
+					},
+				}).
+				Build()
+
+			if flags := td.LoggingFlags(); !reflect.DeepEqual(flags, tc.expectedFlags) {
+				t.Errorf("Expected flags %v, got %v", tc.expectedFlags, flags)
+			}
+		})
+	}
+}
+
+func TestNodeClusterDNSIP(t *testing.T) {
+	testCases := []struct {
+		name                     string
+		nodeLocalDNSCacheEnabled *bool
+		expectedIP               string
+	}{
+		{
+			name:       "node-local DNS cache enabled by default",
+			expectedIP: NodeLocalDNSCacheAddress,
+		},
+		{
+			name:                     "node-local DNS cache enabled",
+			nodeLocalDNSCacheEnabled: ptr.To(true),
+			expectedIP:               NodeLocalDNSCacheAddress,
+		},
+		{
+			name:                     "node-local DNS cache disabled",
+			nodeLocalDNSCacheEnabled: ptr.To(false),
+			expectedIP:               "10.240.16.10",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			td := NewTemplateDataBuilder().
+				WithCluster(&kubermaticv1.Cluster{
+					Spec: kubermaticv1.ClusterSpec{
+						ClusterNetwork: kubermaticv1.ClusterNetworkingConfig{
+							Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.16.0/20"}},
+							NodeLocalDNSCacheEnabled: tc.nodeLocalDNSCacheEnabled,
+						},
+					},
+				}).
+				Build()
+
+			ip, err := td.NodeClusterDNSIP()
+			if err != nil {
+				t.Fatalf("Failed to get node cluster DNS IP: %v", err)
+			}
+
+			if ip != tc.expectedIP {
+				t.Errorf("Expected node cluster DNS IP %s, got %s", tc.expectedIP, ip)
+			}
+		})
+	}
+}
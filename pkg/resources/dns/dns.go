@@ -218,13 +218,14 @@ func getVolumes(isKonnectivityEnabled bool) []corev1.Volume {
 
 type configMapReconcilerData interface {
 	Cluster() *kubermaticv1.Cluster
+	ClusterDNSIP() (string, error)
 }
 
 // ConfigMapReconciler returns a ConfigMap containing the cloud-config for the supplied data.
 func ConfigMapReconciler(data configMapReconcilerData) reconciling.NamedConfigMapReconcilerFactory {
 	return func() (string, reconciling.ConfigMapReconciler) {
 		return resources.DNSResolverConfigMapName, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
-			dnsIP, err := resources.UserClusterDNSResolverIP(data.Cluster())
+			dnsIP, err := data.ClusterDNSIP()
 			if err != nil {
 				return nil, err
 			}
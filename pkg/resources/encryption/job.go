@@ -31,10 +31,11 @@ import (
 )
 
 const (
-	EncryptionJobPrefix    = "data-encryption"
-	ClusterLabelKey        = "kubermatic.k8c.io/cluster"
-	SecretRevisionLabelKey = "kubermatic.k8c.io/secret-revision"
-	AppLabelValue          = "encryption-runner"
+	EncryptionJobPrefix       = "data-encryption"
+	StorageMigrationJobPrefix = "storage-migration"
+	ClusterLabelKey           = "kubermatic.k8c.io/cluster"
+	SecretRevisionLabelKey    = "kubermatic.k8c.io/secret-revision"
+	AppLabelValue             = "encryption-runner"
 
 	encryptionJobScript = `
 resources=$(kubectl get %s --all-namespaces --output json | jq -r '.items[] | "\(.metadata.namespace // "default"):\(.kind):\(.metadata.name)"');
@@ -52,11 +53,22 @@ type encryptionData interface {
 }
 
 func EncryptionJobCreator(data encryptionData, cluster *kubermaticv1.Cluster, secret *corev1.Secret, res []string, key string) batchv1.Job {
+	return rewriteJob(data, cluster, secret, EncryptionJobPrefix, res)
+}
+
+// StorageMigrationJobCreator returns a Job that rewrites all given resources after the key of a KMS
+// provider has been rotated. The KMS plugin keeps decrypting data with previous keys, but only
+// rewriting the resources makes sure that they are stored encrypted with the new key.
+func StorageMigrationJobCreator(data encryptionData, cluster *kubermaticv1.Cluster, secret *corev1.Secret, res []string) batchv1.Job {
+	return rewriteJob(data, cluster, secret, StorageMigrationJobPrefix, res)
+}
+
+func rewriteJob(data encryptionData, cluster *kubermaticv1.Cluster, secret *corev1.Secret, prefix string, res []string) batchv1.Job {
 	resourceList := strings.Join(res, ",")
 
 	return batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: fmt.Sprintf("%s-%s-", EncryptionJobPrefix, cluster.Name),
+			GenerateName: fmt.Sprintf("%s-%s-", prefix, cluster.Name),
 			Namespace:    cluster.Status.NamespaceName,
 			Labels: map[string]string{
 				resources.AppLabelKey:  AppLabelValue,
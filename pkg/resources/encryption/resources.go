@@ -16,10 +16,38 @@ limitations under the License.
 
 package encryption
 
+import (
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+)
+
 const (
 	ApiserverEncryptionRevisionLabelKey = "apiserver-encryption-configuration-secret-revision"
 	ApiserverEncryptionHashLabelKey     = "kubermatic.k8c.io/encryption-spec-hash"
+	KMSKeyIDAnnotationKey               = "kubermatic.k8c.io/kms-key-id"
 
 	SecretboxPrefix = "secretbox"
+	KMSPrefix       = "kms"
 	IdentityKey     = "identity"
+
+	// KMSSocketVolumeName is the name of the volume shared between kube-apiserver and
+	// the KMS plugin sidecar, which holds the plugin's unix socket.
+	KMSSocketVolumeName = "kms-socket"
+	KMSSocketDir        = "/var/run/kmsplugin"
+	KMSDefaultEndpoint  = KMSSocketDir + "/socket.sock"
 )
+
+// KMSEndpoint returns the socket path the KMS plugin is listening on.
+func KMSEndpoint(kms *kubermaticv1.KMSEncryptionConfiguration) string {
+	if kms.Endpoint != "" {
+		return kms.Endpoint
+	}
+
+	return KMSDefaultEndpoint
+}
+
+// KMSKeyHint returns the key "hint" for a KMS provider and the key it is currently using.
+func KMSKeyHint(name, keyID string) string {
+	return fmt.Sprintf("%s/%s/%s", KMSPrefix, name, keyID)
+}
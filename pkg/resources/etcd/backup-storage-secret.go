@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"fmt"
+	"maps"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+type backupStorageSecretReconcilerData interface {
+	EtcdBackupStorageCredentials() (map[string][]byte, error)
+}
+
+// BackupStorageSecretReconciler returns a function to create/update the Secret holding the
+// object-storage credentials for etcd backups. The credentials are shared by all clusters
+// and copied from the seed's backup destination, so that rotating them there rolls them
+// out into every cluster namespace.
+func BackupStorageSecretReconciler(data backupStorageSecretReconcilerData) reconciling.NamedSecretReconcilerFactory {
+	return func() (string, reconciling.SecretReconciler) {
+		return resources.EtcdBackupStorageSecretName, func(se *corev1.Secret) (*corev1.Secret, error) {
+			credentials, err := data.EtcdBackupStorageCredentials()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get etcd backup storage credentials: %w", err)
+			}
+
+			// replace the data entirely, so keys removed from the shared credentials
+			// do not linger in the cluster namespace
+			se.Data = maps.Clone(credentials)
+
+			return se, nil
+		}
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestBackupStorageSecretReconciler(t *testing.T) {
+	ctx := context.Background()
+
+	sharedCredentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backup-credentials",
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string][]byte{
+			"ACCESS_KEY_ID":     []byte("old-id"),
+			"SECRET_ACCESS_KEY": []byte("old-secret"),
+		},
+	}
+
+	client := fakectrlruntimeclient.NewClientBuilder().WithObjects(sharedCredentials).Build()
+
+	data := resources.NewTemplateDataBuilder().
+		WithContext(ctx).
+		WithClient(client).
+		WithEtcdBackupDestination(&kubermaticv1.BackupDestination{
+			Credentials: &corev1.SecretReference{Name: sharedCredentials.Name},
+		}).
+		Build()
+
+	clusterNamespaces := []string{"cluster-a", "cluster-b"}
+
+	reconcile := func() {
+		for _, namespace := range clusterNamespaces {
+			reconcilers := []reconciling.NamedSecretReconcilerFactory{BackupStorageSecretReconciler(data)}
+			if err := reconciling.ReconcileSecrets(ctx, reconcilers, namespace, client, resources.SecretRotationModifier()); err != nil {
+				t.Fatalf("Failed to reconcile Secret in %s: %v", namespace, err)
+			}
+		}
+	}
+
+	assertCredentials := func(expected map[string][]byte) {
+		for _, namespace := range clusterNamespaces {
+			secret := &corev1.Secret{}
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: namespace, Name: resources.EtcdBackupStorageSecretName}, secret); err != nil {
+				t.Fatalf("Failed to get Secret in %s: %v", namespace, err)
+			}
+
+			if len(secret.Data) != len(expected) {
+				t.Errorf("Expected %d keys in %s, got %v", len(expected), namespace, secret.Data)
+			}
+			for key, value := range expected {
+				if string(secret.Data[key]) != string(value) {
+					t.Errorf("Expected %s in %s to be %q, got %q", key, namespace, value, secret.Data[key])
+				}
+			}
+			if secret.Annotations[resources.SecretRotatedAtAnnotation] == "" {
+				t.Errorf("Expected Secret in %s to carry the %s annotation", namespace, resources.SecretRotatedAtAnnotation)
+			}
+		}
+	}
+
+	// initial propagation into all cluster namespaces
+	reconcile()
+	assertCredentials(sharedCredentials.Data)
+
+	// rotating the shared credentials rolls them out and drops stale keys
+	rotated := map[string][]byte{
+		"ACCESS_KEY_ID": []byte("new-id"),
+	}
+	sharedCredentials.Data = rotated
+	if err := client.Update(ctx, sharedCredentials); err != nil {
+		t.Fatalf("Failed to rotate shared credentials: %v", err)
+	}
+
+	reconcile()
+	assertCredentials(rotated)
+}
+
+func TestBackupStorageSecretReconcilerWithoutCredentials(t *testing.T) {
+	data := resources.NewTemplateDataBuilder().
+		WithContext(context.Background()).
+		WithClient(fakectrlruntimeclient.NewClientBuilder().Build()).
+		Build()
+
+	_, reconciler := BackupStorageSecretReconciler(data)()
+	if _, err := reconciler(&corev1.Secret{}); err == nil {
+		t.Error("Expected an error when no backup destination credentials are configured")
+	}
+}
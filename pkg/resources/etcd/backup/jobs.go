@@ -44,7 +44,14 @@ const (
 	// bucketNameEnvVarKey defines the environment variable key for the backup bucket name.
 
 	// BackupJobLabel defines the label we use on all backup jobs.
-	BackupJobLabel   = "kubermatic-etcd-backup"
+	BackupJobLabel = "kubermatic-etcd-backup"
+	// BackupJobTypeLabelKey is the label key used to tell jobs creating backups apart from jobs deleting them.
+	BackupJobTypeLabelKey = "backupJobType"
+	// BackupJobTypeCreate marks jobs that take a snapshot of etcd.
+	BackupJobTypeCreate = "create"
+	// BackupJobTypeDelete marks jobs that delete a backup from the backup destination.
+	BackupJobTypeDelete = "delete"
+
 	clusterEnvVarKey = "CLUSTER"
 	// BackupToCreateEnvVarKey defines the environment variable key for the name of the backup to create.
 	BackupToCreateEnvVarKey = "BACKUP_TO_CREATE"
@@ -131,7 +138,7 @@ func BackupJob(data etcdBackupData, config *kubermaticv1.EtcdBackupConfig, statu
 		ReadOnly:  true,
 	})
 
-	job := jobBase(config, data.Cluster(), status.JobName)
+	job := jobBase(config, data.Cluster(), status.JobName, BackupJobTypeCreate)
 
 	job.Spec.Template.Spec.ServiceAccountName = fmt.Sprintf("%s-%s", rbac.EtcdLauncherServiceAccountName, data.Cluster().Name)
 	job.Spec.Template.Spec.Containers = []corev1.Container{*storeContainer}
@@ -268,7 +275,7 @@ func BackupDeleteJob(data etcdBackupData, config *kubermaticv1.EtcdBackupConfig,
 		ReadOnly:  true,
 	})
 
-	job := jobBase(config, data.Cluster(), status.DeleteJobName)
+	job := jobBase(config, data.Cluster(), status.DeleteJobName, BackupJobTypeDelete)
 	job.Spec.Template.Spec.Containers = []corev1.Container{*deleteContainer}
 	job.Spec.ActiveDeadlineSeconds = resources.Int64(4 * 60)
 	job.Spec.Template.Spec.Volumes = []corev1.Volume{
@@ -286,14 +293,16 @@ func BackupDeleteJob(data etcdBackupData, config *kubermaticv1.EtcdBackupConfig,
 	return job
 }
 
-func jobBase(backupConfig *kubermaticv1.EtcdBackupConfig, cluster *kubermaticv1.Cluster, jobName string) *batchv1.Job {
+func jobBase(backupConfig *kubermaticv1.EtcdBackupConfig, cluster *kubermaticv1.Cluster, jobName, jobType string) *batchv1.Job {
 	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      jobName,
 			Namespace: metav1.NamespaceSystem,
 			Labels: map[string]string{
-				resources.AppLabelKey:    BackupJobLabel,
-				BackupConfigNameLabelKey: backupConfig.Name,
+				resources.AppLabelKey:     BackupJobLabel,
+				resources.ClusterLabelKey: cluster.Name,
+				BackupConfigNameLabelKey:  backupConfig.Name,
+				BackupJobTypeLabelKey:     jobType,
 			},
 			OwnerReferences: []metav1.OwnerReference{
 				resources.GetClusterRef(cluster),
@@ -32,6 +32,7 @@ import (
 type etcdBackupConfigReconcilerData interface {
 	Cluster() *kubermaticv1.Cluster
 	BackupSchedule() time.Duration
+	BackupCount() int
 }
 
 // BackupConfigReconciler returns the function to reconcile the EtcdBackupConfigs.
@@ -51,7 +52,7 @@ func BackupConfigReconciler(data etcdBackupConfigReconcilerData, seed *kubermati
 			}
 			config.Spec.Name = resources.EtcdDefaultBackupConfigName
 			config.Spec.Schedule = backupScheduleString
-			keep := 20
+			keep := data.BackupCount()
 			config.Spec.Keep = &keep
 			config.Spec.Cluster = corev1.ObjectReference{
 				Kind:       kubermaticv1.ClusterKindName,
@@ -69,6 +70,34 @@ func BackupConfigReconciler(data etcdBackupConfigReconcilerData, seed *kubermati
 	}
 }
 
+// OneShotBackupConfigReconciler returns the function to reconcile a one-shot EtcdBackupConfig
+// writing to the seed's default backup destination, e.g. to back up etcd before the control
+// plane of the cluster is hibernated or when a snapshot was requested on demand.
+func OneShotBackupConfigReconciler(cluster *kubermaticv1.Cluster, seed *kubermaticv1.Seed, name string) reconciling.NamedEtcdBackupConfigReconcilerFactory {
+	return func() (string, reconciling.EtcdBackupConfigReconciler) {
+		return name, func(config *kubermaticv1.EtcdBackupConfig) (*kubermaticv1.EtcdBackupConfig, error) {
+			if config.Labels == nil {
+				config.Labels = make(map[string]string)
+			}
+			if cluster.Labels != nil {
+				config.Labels[kubermaticv1.ProjectIDLabelKey] = cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+			}
+
+			config.Spec.Name = name
+			config.Spec.Schedule = ""
+			config.Spec.Cluster = corev1.ObjectReference{
+				Kind:       kubermaticv1.ClusterKindName,
+				Name:       cluster.Name,
+				UID:        cluster.UID,
+				APIVersion: "kubermatic.k8c.io/v1",
+			}
+			config.Spec.Destination = seed.Spec.EtcdBackupRestore.DefaultDestination
+
+			return config, nil
+		}
+	}
+}
+
 func parseDuration(interval time.Duration) (string, error) {
 	scheduleString := fmt.Sprintf("@every %vm", interval.Round(time.Minute).Minutes())
 	// We verify the validity of the scheduleString here, because the etcd_backup_controller
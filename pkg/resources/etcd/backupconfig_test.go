@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeBackupConfigReconcilerData struct {
+	cluster *kubermaticv1.Cluster
+	count   int
+}
+
+func (f *fakeBackupConfigReconcilerData) Cluster() *kubermaticv1.Cluster {
+	return f.cluster
+}
+
+func (f *fakeBackupConfigReconcilerData) BackupSchedule() time.Duration {
+	return 20 * time.Minute
+}
+
+func (f *fakeBackupConfigReconcilerData) BackupCount() int {
+	return f.count
+}
+
+func TestBackupConfigReconciler(t *testing.T) {
+	data := &fakeBackupConfigReconcilerData{
+		cluster: &kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "62m9k9tqlm",
+			},
+		},
+		count: 7,
+	}
+
+	name, reconciler := BackupConfigReconciler(data, &kubermaticv1.Seed{})()
+	if name != resources.EtcdDefaultBackupConfigName {
+		t.Fatalf("Expected EtcdBackupConfig to be named %q, got %q", resources.EtcdDefaultBackupConfigName, name)
+	}
+
+	config, err := reconciler(&kubermaticv1.EtcdBackupConfig{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile EtcdBackupConfig: %v", err)
+	}
+
+	if config.Spec.Keep == nil || *config.Spec.Keep != data.count {
+		t.Errorf("Expected %d backups to be kept, got %v", data.count, config.Spec.Keep)
+	}
+
+	if expected := "@every 20m"; config.Spec.Schedule != expected {
+		t.Errorf("Expected schedule %q, got %q", expected, config.Spec.Schedule)
+	}
+}
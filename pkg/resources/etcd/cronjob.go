@@ -37,16 +37,19 @@ type cronJobReconcilerData interface {
 	GetClusterRef() metav1.OwnerReference
 	EtcdLauncherImage() string
 	EtcdLauncherTag() string
+	EtcdDefragSchedule() string
 }
 
 // CronJobReconciler returns the func to create/update the etcd defragger cronjob.
+// The defragger never runs concurrently and defragments the etcd members one after
+// another, so that the etcd cluster keeps its quorum at all times.
 func CronJobReconciler(data cronJobReconcilerData) reconciling.NamedCronJobReconcilerFactory {
 	return func() (string, reconciling.CronJobReconciler) {
 		return resources.EtcdDefragCronJobName, func(job *batchv1.CronJob) (*batchv1.CronJob, error) {
 			job.Name = resources.EtcdDefragCronJobName
 			job.Spec.ConcurrencyPolicy = batchv1.ForbidConcurrent
 			job.Spec.SuccessfulJobsHistoryLimit = ptr.To[int32](1)
-			job.Spec.Schedule = "@every 3h"
+			job.Spec.Schedule = data.EtcdDefragSchedule()
 
 			job.Spec.JobTemplate.Spec.Template.Spec.ServiceAccountName = rbac.EtcdLauncherServiceAccountName
 			job.Spec.JobTemplate.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeCronJobReconcilerData struct {
+	cluster  *kubermaticv1.Cluster
+	schedule string
+}
+
+func (f *fakeCronJobReconcilerData) Cluster() *kubermaticv1.Cluster {
+	return f.cluster
+}
+
+func (f *fakeCronJobReconcilerData) RewriteImage(image string) (string, error) {
+	return image, nil
+}
+
+func (f *fakeCronJobReconcilerData) GetClusterRef() metav1.OwnerReference {
+	return metav1.OwnerReference{}
+}
+
+func (f *fakeCronJobReconcilerData) EtcdLauncherImage() string {
+	return "quay.io/kubermatic/etcd-launcher"
+}
+
+func (f *fakeCronJobReconcilerData) EtcdLauncherTag() string {
+	return "v0.0.0"
+}
+
+func (f *fakeCronJobReconcilerData) EtcdDefragSchedule() string {
+	return f.schedule
+}
+
+func TestDefraggerCronJob(t *testing.T) {
+	data := &fakeCronJobReconcilerData{
+		cluster: &kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "62m9k9tqlm",
+			},
+		},
+		schedule: "0 3 * * *",
+	}
+
+	name, reconciler := CronJobReconciler(data)()
+	if name != resources.EtcdDefragCronJobName {
+		t.Fatalf("Expected CronJob to be named %q, got %q", resources.EtcdDefragCronJobName, name)
+	}
+
+	job, err := reconciler(&batchv1.CronJob{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile CronJob: %v", err)
+	}
+
+	if job.Spec.Schedule != data.schedule {
+		t.Errorf("Expected schedule %q, got %q", data.schedule, job.Spec.Schedule)
+	}
+
+	// a new Job must never be started while the previous one is still defragmenting
+	if job.Spec.ConcurrencyPolicy != batchv1.ForbidConcurrent {
+		t.Errorf("Expected concurrency policy %q, got %q", batchv1.ForbidConcurrent, job.Spec.ConcurrencyPolicy)
+	}
+
+	// a single container must handle all members, as etcd-launcher defragments them one after another;
+	// multiple containers would defragment members in parallel and risk the quorum
+	containers := job.Spec.JobTemplate.Spec.Template.Spec.Containers
+	if len(containers) != 1 {
+		t.Fatalf("Expected exactly one container, got %d", len(containers))
+	}
+
+	command := containers[0].Command
+	if len(command) < 2 || command[0] != "/etcd-launcher" || command[1] != "defrag" {
+		t.Fatalf("Expected command to run etcd-launcher defrag, got %v", command)
+	}
+
+	clusterFlag := "--cluster=" + data.cluster.Name
+	found := false
+	for _, arg := range command {
+		if arg == clusterFlag {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("Expected command to target all members of the cluster via %q, got %v", clusterFlag, command)
+	}
+}
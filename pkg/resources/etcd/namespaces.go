@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// objectNames are the names of all control plane objects that belong to etcd alone.
+// Note that the StatefulSet, its Service, PodDisruptionBudget and VerticalPodAutoscaler
+// all share the same name.
+var objectNames = sets.New(
+	resources.EtcdStatefulSetName,
+	resources.EtcdTLSCertificateSecretName,
+	resources.EtcdPeerTLSCertificateSecretName,
+	resources.EtcdMetricsTLSCertificateSecretName,
+	resources.EtcdDefragCronJobName,
+)
+
+// ObjectNamespace returns the namespace the control plane object with the given name
+// has to be reconciled into. Objects that belong to etcd are placed into the etcd
+// namespace, all other objects into the cluster namespace.
+func ObjectNamespace(cluster *kubermaticv1.Cluster, name string) string {
+	if objectNames.Has(name) {
+		return resources.EtcdNamespaceName(cluster)
+	}
+
+	return cluster.Status.NamespaceName
+}
+
+type rootCACertificateReconcilerData interface {
+	GetRootCA() (*triple.KeyPair, error)
+}
+
+// RootCACertificateReconciler returns a function to create/update a copy of the cluster's
+// root CA certificate. It is used to provide etcd in a split control plane with the CA,
+// without also copying the CA's private key into the etcd namespace.
+func RootCACertificateReconciler(data rootCACertificateReconcilerData) reconciling.NamedSecretReconcilerFactory {
+	return func() (string, reconciling.SecretReconciler) {
+		return resources.CASecretName, func(se *corev1.Secret) (*corev1.Secret, error) {
+			ca, err := data.GetRootCA()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get cluster ca: %w", err)
+			}
+
+			se.Data = map[string][]byte{
+				resources.CACertSecretKey: triple.EncodeCertPEM(ca.Cert),
+			}
+
+			return se, nil
+		}
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+)
+
+func TestObjectNamespace(t *testing.T) {
+	tests := []struct {
+		name       string
+		split      bool
+		objectName string
+		expected   string
+	}{
+		{
+			name:       "etcd in single namespace",
+			objectName: resources.EtcdStatefulSetName,
+			expected:   "cluster-62m9k9tqlm",
+		},
+		{
+			name:       "etcd in split control plane",
+			split:      true,
+			objectName: resources.EtcdStatefulSetName,
+			expected:   "cluster-62m9k9tqlm-etcd",
+		},
+		{
+			name:       "etcd certificate in split control plane",
+			split:      true,
+			objectName: resources.EtcdTLSCertificateSecretName,
+			expected:   "cluster-62m9k9tqlm-etcd",
+		},
+		{
+			name:       "apiserver in split control plane",
+			split:      true,
+			objectName: resources.ApiserverDeploymentName,
+			expected:   "cluster-62m9k9tqlm",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{}
+			cluster.Spec.Features = map[string]bool{kubermaticv1.ClusterFeatureSplitEtcdNamespace: test.split}
+			cluster.Status.NamespaceName = "cluster-62m9k9tqlm"
+
+			if namespace := ObjectNamespace(cluster, test.objectName); namespace != test.expected {
+				t.Errorf("Expected %q to be placed into %q, got %q.", test.objectName, test.expected, namespace)
+			}
+		})
+	}
+}
@@ -34,12 +34,23 @@ type pdbData interface {
 func PodDisruptionBudgetReconciler(data pdbData) reconciling.NamedPodDisruptionBudgetReconcilerFactory {
 	return func() (string, reconciling.PodDisruptionBudgetReconciler) {
 		return resources.EtcdPodDisruptionBudgetName, func(pdb *policyv1.PodDisruptionBudget) (*policyv1.PodDisruptionBudget, error) {
-			minAvailable := intstr.FromInt((int(getClusterSize(data.Cluster().Spec.ComponentsOverride.Etcd)) / 2) + 1)
+			settings := data.Cluster().Spec.ComponentsOverride.Etcd
+
 			pdb.Spec = policyv1.PodDisruptionBudgetSpec{
 				Selector: &metav1.LabelSelector{
 					MatchLabels: GetBasePodLabels(data.Cluster()),
 				},
-				MinAvailable: &minAvailable,
+			}
+
+			// If the autoscaler is allowed to evict etcd pods, only ever allow a single member
+			// to be disrupted, so that draining seed nodes never evicts members in parallel.
+			// Otherwise it is sufficient to protect the quorum against voluntary disruptions.
+			if isSafeToEvict(settings) {
+				maxUnavailable := intstr.FromInt(1)
+				pdb.Spec.MaxUnavailable = &maxUnavailable
+			} else {
+				minAvailable := intstr.FromInt((int(getClusterSize(settings)) / 2) + 1)
+				pdb.Spec.MinAvailable = &minAvailable
 			}
 
 			return pdb, nil
@@ -17,9 +17,12 @@ limitations under the License.
 package etcd
 
 import (
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	semverlib "github.com/Masterminds/semver/v3"
 
@@ -42,6 +45,10 @@ const (
 	dataDir = "/var/run/etcd/pod_$(POD_NAME)/"
 
 	memberListPattern = "etcd-%d=http://etcd-%d.%s.%s.svc.cluster.local:2380"
+
+	// defaultAutoCompactionRetention is the number of hours of history etcd keeps
+	// in periodic auto-compaction mode.
+	defaultAutoCompactionRetention = "8"
 )
 
 var (
@@ -63,11 +70,18 @@ type etcdStatefulSetReconcilerData interface {
 	Cluster() *kubermaticv1.Cluster
 	GetPodTemplateLabels(string, []corev1.Volume, map[string]string) (map[string]string, error)
 	RewriteImage(string) (string, error)
+	ComponentImage(string, string) (string, error)
+	ControlPlanePriorityClassName() string
 	EtcdDiskSize() resource.Quantity
+	EtcdQuotaBackendBytes() int64
+	EtcdAutoCompactionMode() string
+	EtcdAutoCompactionRetention() string
+	EtcdCipherSuites() []string
 	EtcdLauncherImage() string
 	EtcdLauncherTag() string
 	GetClusterRef() metav1.OwnerReference
 	SupportsFailureDomainZoneAntiAffinity() bool
+	FailureDomainZones() int
 }
 
 // StatefulSetReconciler returns the function to reconcile the etcd StatefulSet.
@@ -99,6 +113,7 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 			set.Spec.PodManagementPolicy = appsv1.ParallelPodManagement
 			set.Spec.ServiceName = resources.EtcdServiceName
 			set.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: resources.ImagePullSecretName}}
+			set.Spec.Template.Spec.PriorityClassName = data.ControlPlanePriorityClassName()
 
 			baseLabels := GetBasePodLabels(data.Cluster())
 			set.Spec.Selector = &metav1.LabelSelector{
@@ -122,6 +137,10 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 
 				// these volumes should not block the autoscaler from evicting the pod
 				resources.ClusterAutoscalerSafeToEvictVolumesAnnotation: "launcher",
+
+				// unless explicitly allowed, etcd pods must not be evicted when the autoscaler
+				// scales down seed nodes, as this could cost the etcd cluster its quorum
+				resources.ClusterAutoscalerSafeToEvictAnnotation: strconv.FormatBool(isSafeToEvict(data.Cluster().Spec.ComponentsOverride.Etcd)),
 			})
 
 			etcdEnv := []corev1.EnvVar{
@@ -214,6 +233,12 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 					Name:          "peer-tls",
 				})
 
+				etcdPorts = append(etcdPorts, corev1.ContainerPort{
+					ContainerPort: resources.EtcdMetricsTLSPort,
+					Protocol:      corev1.ProtocolTCP,
+					Name:          "metrics-tls",
+				})
+
 				kubernetes.EnsureAnnotations(&set.Spec.Template, map[string]string{
 					resources.EtcdTLSEnabledAnnotation: "",
 				})
@@ -225,10 +250,10 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 				endpoints := []string{}
 
 				for i := range 3 {
-					endpoints = append(endpoints, fmt.Sprintf(memberListPattern, i, i, resources.EtcdServiceName, data.Cluster().Status.NamespaceName))
+					endpoints = append(endpoints, fmt.Sprintf(memberListPattern, i, i, resources.EtcdServiceName, resources.EtcdNamespaceName(data.Cluster())))
 				}
 
-				etcdEnv = append(etcdEnv, corev1.EnvVar{Name: "MASTER_ENDPOINT", Value: fmt.Sprintf("https://etcd-0.%s.%s.svc.cluster.local:2379", resources.EtcdServiceName, data.Cluster().Status.NamespaceName)})
+				etcdEnv = append(etcdEnv, corev1.EnvVar{Name: "MASTER_ENDPOINT", Value: fmt.Sprintf("https://etcd-0.%s.%s.svc.cluster.local:2379", resources.EtcdServiceName, resources.EtcdNamespaceName(data.Cluster()))})
 				etcdEnv = append(etcdEnv, corev1.EnvVar{Name: "INITIAL_CLUSTER", Value: strings.Join(endpoints, ",")})
 			}
 
@@ -236,9 +261,9 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 				{
 					Name: resources.EtcdStatefulSetName,
 
-					Image:           registry.Must(data.RewriteImage(resources.RegistryGCR + "/etcd-development/etcd:" + imageTag)),
+					Image:           registry.Must(data.ComponentImage(resources.EtcdStatefulSetName, resources.RegistryGCR+"/etcd-development/etcd:"+imageTag)),
 					ImagePullPolicy: corev1.PullIfNotPresent,
-					Command:         getEtcdCommand(data.Cluster(), enableDataCorruptionChecks, launcherEnabled),
+					Command:         getEtcdCommand(data.Cluster(), enableDataCorruptionChecks, launcherEnabled, data.EtcdQuotaBackendBytes(), data.EtcdAutoCompactionMode(), data.EtcdAutoCompactionRetention(), data.EtcdCipherSuites()),
 					Env:             etcdEnv,
 					Ports:           etcdPorts,
 					ReadinessProbe: &corev1.Probe{
@@ -296,6 +321,10 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 							Name:      resources.EtcdTLSCertificateSecretName,
 							MountPath: "/etc/etcd/pki/tls",
 						},
+						{
+							Name:      resources.EtcdPeerTLSCertificateSecretName,
+							MountPath: "/etc/etcd/pki/peer",
+						},
 						{
 							Name:      resources.CASecretName,
 							MountPath: "/etc/etcd/pki/ca",
@@ -323,14 +352,38 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 			hostAntiAffinityType := data.Cluster().Spec.ComponentsOverride.Etcd.HostAntiAffinity
 			set.Spec.Template.Spec.Affinity = resources.HostnameAntiAffinity(resources.EtcdStatefulSetName, hostAntiAffinityType)
 
+			set.Spec.Template.Spec.TopologySpreadConstraints = nil
+
 			if data.SupportsFailureDomainZoneAntiAffinity() {
 				zoneAntiAffinityType := data.Cluster().Spec.ComponentsOverride.Etcd.ZoneAntiAffinity
+
+				// Only spread etcd members across zones if there are enough zones to place every member
+				// into its own zone. Otherwise a required zone anti-affinity could never be satisfied, so
+				// fall back to spreading across hosts and only prefer distinct zones.
+				if data.FailureDomainZones() >= int(targetClusterSize(data)) {
+					set.Spec.Template.Spec.TopologySpreadConstraints = resources.FailureDomainZoneSpreadConstraints(resources.EtcdStatefulSetName, zoneAntiAffinityType)
+				} else {
+					zoneAntiAffinityType = kubermaticv1.AntiAffinityTypePreferred
+				}
+
 				failureDomainZoneAntiAffinity := resources.FailureDomainZoneAntiAffinity(resources.EtcdStatefulSetName, zoneAntiAffinityType)
 				set.Spec.Template.Spec.Affinity = resources.MergeAffinities(set.Spec.Template.Spec.Affinity, failureDomainZoneAntiAffinity)
 			}
 
 			set.Spec.Template.Spec.NodeSelector = data.Cluster().Spec.ComponentsOverride.Etcd.NodeSelector
 
+			// The etcd-launcher serves the metrics with a dedicated certificate that it reloads
+			// from disk. The volume is added after calculating the pod labels, so that rotating
+			// the certificate does not roll etcd.
+			if launcherEnabled {
+				volumes = append(volumes, metricsTLSVolume())
+				set.Spec.Template.Spec.Containers[0].VolumeMounts = append(set.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+					Name:      resources.EtcdMetricsTLSCertificateSecretName,
+					MountPath: "/etc/etcd/pki/metrics",
+					ReadOnly:  true,
+				})
+			}
+
 			set.Spec.Template.Spec.Volumes = volumes
 
 			// Make sure we don't change volume claim template of existing sts
@@ -339,11 +392,7 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 				if storageClass == "" {
 					storageClass = "kubermatic-fast"
 				}
-				diskSize := data.Cluster().Spec.ComponentsOverride.Etcd.DiskSize
-				if diskSize == nil {
-					d := data.EtcdDiskSize()
-					diskSize = &d
-				}
+				diskSize := data.EtcdDiskSize()
 				set.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
 					{
 						ObjectMeta: metav1.ObjectMeta{
@@ -353,18 +402,150 @@ func StatefulSetReconciler(data etcdStatefulSetReconcilerData, enableDataCorrupt
 							StorageClassName: resources.String(storageClass),
 							AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
 							Resources: corev1.VolumeResourceRequirements{
-								Requests: corev1.ResourceList{corev1.ResourceStorage: *diskSize},
+								Requests: corev1.ResourceList{corev1.ResourceStorage: diskSize},
 							},
 						},
 					},
 				}
 			}
 
+			if quota := data.EtcdQuotaBackendBytes(); quota > 0 {
+				diskSize := set.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests[corev1.ResourceStorage]
+				if err := ValidateQuotaBackendBytes(*resource.NewQuantity(quota, resource.BinarySI), diskSize); err != nil {
+					return nil, err
+				}
+			}
+
+			mode := kubermaticv1.EtcdAutoCompactionMode(data.EtcdAutoCompactionMode())
+			if err := ValidateAutoCompaction(mode, data.EtcdAutoCompactionRetention()); err != nil {
+				return nil, err
+			}
+
+			if err := ValidateCipherSuites(data.EtcdCipherSuites()); err != nil {
+				return nil, err
+			}
+
 			return set, nil
 		}
 	}
 }
 
+// ValidateQuotaBackendBytes ensures that the etcd database quota leaves enough room on
+// the etcd volume for the WAL, snapshots and compaction.
+func ValidateQuotaBackendBytes(quota, diskSize resource.Quantity) error {
+	if quota.Sign() <= 0 {
+		return fmt.Errorf("quota must be positive")
+	}
+
+	// keep at least 20% of the volume free
+	if quota.Value() > diskSize.Value()/5*4 {
+		return fmt.Errorf("quota %s must not exceed 80%% of the etcd disk size %s", quota.String(), diskSize.String())
+	}
+
+	return nil
+}
+
+// ValidateAutoCompaction ensures that the etcd auto-compaction retention can be
+// interpreted in the given auto-compaction mode.
+func ValidateAutoCompaction(mode kubermaticv1.EtcdAutoCompactionMode, retention string) error {
+	switch mode {
+	case "", kubermaticv1.EtcdAutoCompactionModePeriodic:
+		if retention == "" {
+			return nil
+		}
+
+		// etcd interprets plain numbers as hours
+		if hours, err := strconv.ParseInt(retention, 10, 64); err == nil {
+			if hours <= 0 {
+				return fmt.Errorf("retention must be positive, got %q", retention)
+			}
+			return nil
+		}
+
+		duration, err := time.ParseDuration(retention)
+		if err != nil {
+			return fmt.Errorf("retention %q must be a duration or a number of hours in periodic mode", retention)
+		}
+		if duration <= 0 {
+			return fmt.Errorf("retention must be positive, got %q", retention)
+		}
+
+	case kubermaticv1.EtcdAutoCompactionModeRevision:
+		if retention == "" {
+			return errors.New("retention must be set in revision mode")
+		}
+
+		revisions, err := strconv.ParseInt(retention, 10, 64)
+		if err != nil {
+			return fmt.Errorf("retention %q must be a number of revisions in revision mode", retention)
+		}
+		if revisions <= 0 {
+			return fmt.Errorf("retention must be positive, got %q", retention)
+		}
+
+	default:
+		return fmt.Errorf("unsupported auto-compaction mode %q", mode)
+	}
+
+	return nil
+}
+
+// ValidateCipherSuites ensures that all cipher suites are secure TLS 1.2 cipher suites
+// known to Go. As etcd serves RSA certificates and the apiserver's etcd client does not
+// offer RSA key exchange, at least one ECDHE_RSA cipher suite is required. An empty list
+// is valid and results in the default cipher suites.
+func ValidateCipherSuites(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	secure := map[string]*tls.CipherSuite{}
+	for _, suite := range tls.CipherSuites() {
+		secure[suite.Name] = suite
+	}
+
+	insecure := map[string]struct{}{}
+	for _, suite := range tls.InsecureCipherSuites() {
+		insecure[suite.Name] = struct{}{}
+	}
+
+	hasECDHERSA := false
+	for _, name := range names {
+		if _, ok := insecure[name]; ok {
+			return fmt.Errorf("cipher suite %q is insecure", name)
+		}
+
+		suite, ok := secure[name]
+		if !ok {
+			return fmt.Errorf("unknown cipher suite %q", name)
+		}
+
+		if !supportsTLS12(suite) {
+			return fmt.Errorf("cipher suite %q is only used by TLS 1.3, whose cipher suites cannot be configured", name)
+		}
+
+		if strings.HasPrefix(name, "TLS_ECDHE_RSA_") {
+			hasECDHERSA = true
+		}
+	}
+
+	if !hasECDHERSA {
+		return errors.New("at least one TLS_ECDHE_RSA cipher suite is required")
+	}
+
+	return nil
+}
+
+func supportsTLS12(suite *tls.CipherSuite) bool {
+	for _, version := range suite.SupportedVersions {
+		if version == tls.VersionTLS12 {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getVolumes() []corev1.Volume {
 	return []corev1.Volume{
 		{
@@ -375,6 +556,14 @@ func getVolumes() []corev1.Volume {
 				},
 			},
 		},
+		{
+			Name: resources.EtcdPeerTLSCertificateSecretName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: resources.EtcdPeerTLSCertificateSecretName,
+				},
+			},
+		},
 		{
 			Name: resources.CASecretName,
 			VolumeSource: corev1.VolumeSource{
@@ -406,6 +595,17 @@ func getVolumes() []corev1.Volume {
 	}
 }
 
+func metricsTLSVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: resources.EtcdMetricsTLSCertificateSecretName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: resources.EtcdMetricsTLSCertificateSecretName,
+			},
+		},
+	}
+}
+
 func GetBasePodLabels(cluster *kubermaticv1.Cluster) map[string]string {
 	additionalLabels := map[string]string{
 		"cluster": cluster.Name,
@@ -431,11 +631,21 @@ func ImageTag(c *kubermaticv1.Cluster) string {
 	return "v3.5.9"
 }
 
+// targetClusterSize returns the number of etcd members the cluster is supposed to have
+// once any scaling operation has finished.
+func targetClusterSize(data etcdStatefulSetReconcilerData) int32 {
+	if !data.Cluster().Spec.Features[kubermaticv1.ClusterFeatureEtcdLauncher] {
+		return kubermaticv1.DefaultEtcdClusterSize
+	}
+
+	return getClusterSize(data.Cluster().Spec.ComponentsOverride.Etcd)
+}
+
 func computeReplicas(data etcdStatefulSetReconcilerData, set *appsv1.StatefulSet) int32 {
 	if !data.Cluster().Spec.Features[kubermaticv1.ClusterFeatureEtcdLauncher] {
 		return kubermaticv1.DefaultEtcdClusterSize
 	}
-	etcdClusterSize := getClusterSize(data.Cluster().Spec.ComponentsOverride.Etcd)
+	etcdClusterSize := targetClusterSize(data)
 	if set.Spec.Replicas == nil { // new replicaset
 		return etcdClusterSize
 	}
@@ -467,7 +677,11 @@ func getClusterSize(settings kubermaticv1.EtcdStatefulSetSettings) int32 {
 	return *settings.ClusterSize
 }
 
-func getEtcdCommand(cluster *kubermaticv1.Cluster, enableCorruptionCheck, launcherEnabled bool) []string {
+func isSafeToEvict(settings kubermaticv1.EtcdStatefulSetSettings) bool {
+	return settings.SafeToEvict != nil && *settings.SafeToEvict
+}
+
+func getEtcdCommand(cluster *kubermaticv1.Cluster, enableCorruptionCheck, launcherEnabled bool, quotaBackendBytes int64, autoCompactionMode, autoCompactionRetention string, cipherSuites []string) []string {
 	if launcherEnabled {
 		command := []string{"/opt/bin/etcd-launcher",
 			"run",
@@ -476,15 +690,36 @@ func getEtcdCommand(cluster *kubermaticv1.Cluster, enableCorruptionCheck, launch
 			"--pod-ip", "$(POD_IP)",
 			"--api-version", "$(ETCDCTL_API)",
 			"--token", "$(TOKEN)",
+			"--metrics-tls-port", strconv.Itoa(resources.EtcdMetricsTLSPort),
 		}
 
 		if enableCorruptionCheck {
 			command = append(command, "--enable-corruption-check")
 		}
 
+		if quotaBackendBytes > 0 {
+			command = append(command, "--quota-backend-bytes", strconv.FormatInt(quotaBackendBytes, 10))
+		}
+
+		if autoCompactionMode != "" {
+			command = append(command, "--auto-compaction-mode", autoCompactionMode)
+		}
+
+		if autoCompactionRetention != "" {
+			command = append(command, "--auto-compaction-retention", autoCompactionRetention)
+		}
+
+		if len(cipherSuites) > 0 {
+			command = append(command, "--cipher-suites", strings.Join(cipherSuites, ","))
+		}
+
 		return command
 	}
 
+	if autoCompactionRetention == "" {
+		autoCompactionRetention = defaultAutoCompactionRetention
+	}
+
 	// construct command for "plain" etcd usage.
 
 	command := []string{
@@ -500,7 +735,7 @@ func getEtcdCommand(cluster *kubermaticv1.Cluster, enableCorruptionCheck, launch
 		"--initial-cluster-state",
 		"new",
 		"--advertise-client-urls",
-		fmt.Sprintf("https://$(POD_NAME).%s.%s.svc.cluster.local:2379,https://$(POD_IP):2379", resources.EtcdServiceName, cluster.Status.NamespaceName),
+		fmt.Sprintf("https://$(POD_NAME).%s.%s.svc.cluster.local:2379,https://$(POD_IP):2379", resources.EtcdServiceName, resources.EtcdNamespaceName(cluster)),
 		"--listen-client-urls",
 		"https://$(POD_IP):2379,https://127.0.0.1:2379",
 		"--listen-peer-urls",
@@ -508,7 +743,7 @@ func getEtcdCommand(cluster *kubermaticv1.Cluster, enableCorruptionCheck, launch
 		"--listen-metrics-urls",
 		"http://$(POD_IP):2378,http://127.0.0.1:2378",
 		"--initial-advertise-peer-urls",
-		fmt.Sprintf("http://$(POD_NAME).%s.%s.svc.cluster.local:2380", resources.EtcdServiceName, cluster.Status.NamespaceName),
+		fmt.Sprintf("http://$(POD_NAME).%s.%s.svc.cluster.local:2380", resources.EtcdServiceName, resources.EtcdNamespaceName(cluster)),
 		"--trusted-ca-file",
 		"/etc/etcd/pki/ca/ca.crt",
 		"--client-cert-auth",
@@ -517,7 +752,11 @@ func getEtcdCommand(cluster *kubermaticv1.Cluster, enableCorruptionCheck, launch
 		"--key-file",
 		"/etc/etcd/pki/tls/etcd-tls.key",
 		"--auto-compaction-retention",
-		"8",
+		autoCompactionRetention,
+	}
+
+	if autoCompactionMode != "" {
+		command = append(command, "--auto-compaction-mode", autoCompactionMode)
 	}
 
 	if enableCorruptionCheck {
@@ -525,5 +764,13 @@ func getEtcdCommand(cluster *kubermaticv1.Cluster, enableCorruptionCheck, launch
 		command = append(command, "--experimental-corrupt-check-time", "240m")
 	}
 
+	if quotaBackendBytes > 0 {
+		command = append(command, "--quota-backend-bytes", strconv.FormatInt(quotaBackendBytes, 10))
+	}
+
+	if len(cipherSuites) > 0 {
+		command = append(command, "--cipher-suites", strings.Join(cipherSuites, ","))
+	}
+
 	return command
 }
@@ -19,13 +19,20 @@ package etcd
 import (
 	"flag"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	testhelper "k8c.io/kubermatic/v2/pkg/test"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 var update = flag.Bool("update", false, "update .golden files")
@@ -36,6 +43,10 @@ func TestGetEtcdCommand(t *testing.T) {
 		cluster               *kubermaticv1.Cluster
 		enableCorruptionCheck bool
 		launcherEnabled       bool
+		quotaBackendBytes     int64
+		compactionMode        string
+		compactionRetention   string
+		cipherSuites          []string
 		expectedArgs          int
 	}{
 		{
@@ -49,7 +60,7 @@ func TestGetEtcdCommand(t *testing.T) {
 				},
 			},
 			launcherEnabled: true,
-			expectedArgs:    12,
+			expectedArgs:    14,
 		},
 		{
 			name: "with-corruption-flags",
@@ -65,11 +76,97 @@ func TestGetEtcdCommand(t *testing.T) {
 			launcherEnabled:       false,
 			expectedArgs:          33,
 		},
+		{
+			name: "with-launcher-and-quota",
+			cluster: &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "62m9k9tqlm",
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: "cluster-62m9k9tqlm",
+				},
+			},
+			launcherEnabled:   true,
+			quotaBackendBytes: 8 * 1024 * 1024 * 1024,
+			expectedArgs:      16,
+		},
+		{
+			name: "with-quota",
+			cluster: &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "lg69pmx8wf",
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: "cluster-lg69pmx8wf",
+				},
+			},
+			launcherEnabled:   false,
+			quotaBackendBytes: 8 * 1024 * 1024 * 1024,
+			expectedArgs:      32,
+		},
+		{
+			name: "with-launcher-and-compaction",
+			cluster: &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "62m9k9tqlm",
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: "cluster-62m9k9tqlm",
+				},
+			},
+			launcherEnabled:     true,
+			compactionMode:      "revision",
+			compactionRetention: "10000",
+			expectedArgs:        18,
+		},
+		{
+			name: "with-compaction",
+			cluster: &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "lg69pmx8wf",
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: "cluster-lg69pmx8wf",
+				},
+			},
+			launcherEnabled:     false,
+			compactionMode:      "periodic",
+			compactionRetention: "30m",
+			expectedArgs:        32,
+		},
+		{
+			name: "with-launcher-and-cipher-suites",
+			cluster: &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "62m9k9tqlm",
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: "cluster-62m9k9tqlm",
+				},
+			},
+			launcherEnabled: true,
+			cipherSuites:    []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+			expectedArgs:    16,
+		},
+		{
+			name: "with-cipher-suites",
+			cluster: &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "lg69pmx8wf",
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: "cluster-lg69pmx8wf",
+				},
+			},
+			launcherEnabled: false,
+			cipherSuites:    []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+			expectedArgs:    32,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			args := getEtcdCommand(test.cluster, test.enableCorruptionCheck, test.launcherEnabled)
+			args := getEtcdCommand(test.cluster, test.enableCorruptionCheck, test.launcherEnabled, test.quotaBackendBytes, test.compactionMode, test.compactionRetention, test.cipherSuites)
 
 			if len(args) != test.expectedArgs {
 				t.Fatalf("got less/more arguments than expected. got %d expected %d: %s", len(args), test.expectedArgs, strings.Join(args, " "))
@@ -80,3 +177,674 @@ func TestGetEtcdCommand(t *testing.T) {
 		})
 	}
 }
+
+type fakeStatefulSetReconcilerData struct {
+	cluster            *kubermaticv1.Cluster
+	failureDomainZones int
+	podLabelVolumes    []corev1.Volume
+}
+
+func (f *fakeStatefulSetReconcilerData) Cluster() *kubermaticv1.Cluster {
+	return f.cluster
+}
+
+func (f *fakeStatefulSetReconcilerData) GetPodTemplateLabels(_ string, volumes []corev1.Volume, additionalLabels map[string]string) (map[string]string, error) {
+	f.podLabelVolumes = volumes
+	return additionalLabels, nil
+}
+
+func (f *fakeStatefulSetReconcilerData) RewriteImage(image string) (string, error) {
+	return image, nil
+}
+
+func (f *fakeStatefulSetReconcilerData) ComponentImage(_, image string) (string, error) {
+	return image, nil
+}
+
+func (f *fakeStatefulSetReconcilerData) ControlPlanePriorityClassName() string {
+	return resources.ControlPlanePriorityClassName(f.cluster.Spec.Tier)
+}
+
+func (f *fakeStatefulSetReconcilerData) EtcdDiskSize() resource.Quantity {
+	if size := f.cluster.Spec.ComponentsOverride.Etcd.DiskSize; size != nil {
+		return *size
+	}
+
+	return resource.MustParse("5Gi")
+}
+
+func (f *fakeStatefulSetReconcilerData) EtcdQuotaBackendBytes() int64 {
+	if quota := f.cluster.Spec.ComponentsOverride.Etcd.QuotaBackendBytes; quota != nil {
+		return quota.Value()
+	}
+	return 0
+}
+
+func (f *fakeStatefulSetReconcilerData) EtcdAutoCompactionMode() string {
+	return string(f.cluster.Spec.ComponentsOverride.Etcd.AutoCompactionMode)
+}
+
+func (f *fakeStatefulSetReconcilerData) EtcdAutoCompactionRetention() string {
+	return f.cluster.Spec.ComponentsOverride.Etcd.AutoCompactionRetention
+}
+
+func (f *fakeStatefulSetReconcilerData) EtcdCipherSuites() []string {
+	if suites := f.cluster.Spec.ComponentsOverride.Etcd.CipherSuites; len(suites) > 0 {
+		return suites
+	}
+	return resources.GetDefaultEtcdCipherSuites()
+}
+
+func (f *fakeStatefulSetReconcilerData) EtcdLauncherImage() string {
+	return "quay.io/kubermatic/etcd-launcher"
+}
+
+func (f *fakeStatefulSetReconcilerData) EtcdLauncherTag() string {
+	return "v0.0.0"
+}
+
+func (f *fakeStatefulSetReconcilerData) GetClusterRef() metav1.OwnerReference {
+	return metav1.OwnerReference{}
+}
+
+func (f *fakeStatefulSetReconcilerData) SupportsFailureDomainZoneAntiAffinity() bool {
+	return f.failureDomainZones > 0
+}
+
+func (f *fakeStatefulSetReconcilerData) FailureDomainZones() int {
+	return f.failureDomainZones
+}
+
+func TestSafeToEvictAnnotation(t *testing.T) {
+	data := &fakeStatefulSetReconcilerData{
+		cluster: &kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "62m9k9tqlm",
+			},
+			Status: kubermaticv1.ClusterStatus{
+				NamespaceName: "cluster-62m9k9tqlm",
+			},
+		},
+	}
+
+	reconcile := func() *appsv1.StatefulSet {
+		_, reconciler := StatefulSetReconciler(data, false, false)()
+
+		set, err := reconciler(&appsv1.StatefulSet{})
+		if err != nil {
+			t.Fatalf("Failed to reconcile StatefulSet: %v", err)
+		}
+
+		return set
+	}
+
+	tests := []struct {
+		name        string
+		safeToEvict *bool
+		expected    string
+	}{
+		{
+			name:     "protected by default",
+			expected: "false",
+		},
+		{
+			name:        "explicitly protected",
+			safeToEvict: ptr.To(false),
+			expected:    "false",
+		},
+		{
+			name:        "explicitly evictable",
+			safeToEvict: ptr.To(true),
+			expected:    "true",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data.cluster.Spec.ComponentsOverride.Etcd.SafeToEvict = test.safeToEvict
+
+			set := reconcile()
+
+			annotation, ok := set.Spec.Template.Annotations[resources.ClusterAutoscalerSafeToEvictAnnotation]
+			if !ok {
+				t.Fatalf("Expected Pod template to have the %q annotation.", resources.ClusterAutoscalerSafeToEvictAnnotation)
+			}
+
+			if annotation != test.expected {
+				t.Errorf("Expected annotation to be %q, got %q.", test.expected, annotation)
+			}
+		})
+	}
+}
+
+func TestMetricsTLSVolume(t *testing.T) {
+	tests := []struct {
+		name            string
+		launcherEnabled bool
+	}{
+		{
+			name:            "etcd-launcher serves metrics via TLS",
+			launcherEnabled: true,
+		},
+		{
+			name:            "plain etcd has no metrics listener",
+			launcherEnabled: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := &fakeStatefulSetReconcilerData{
+				cluster: &kubermaticv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "62m9k9tqlm",
+					},
+					Spec: kubermaticv1.ClusterSpec{
+						Features: map[string]bool{
+							kubermaticv1.ClusterFeatureEtcdLauncher: test.launcherEnabled,
+						},
+					},
+					Status: kubermaticv1.ClusterStatus{
+						NamespaceName: "cluster-62m9k9tqlm",
+					},
+				},
+			}
+
+			_, reconciler := StatefulSetReconciler(data, false, false)()
+
+			set, err := reconciler(&appsv1.StatefulSet{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile StatefulSet: %v", err)
+			}
+
+			hasVolume := slices.ContainsFunc(set.Spec.Template.Spec.Volumes, func(v corev1.Volume) bool {
+				return v.Name == resources.EtcdMetricsTLSCertificateSecretName
+			})
+			if hasVolume != test.launcherEnabled {
+				t.Errorf("Expected metrics TLS volume to be present: %v, got %v", test.launcherEnabled, hasVolume)
+			}
+
+			hasMount := slices.ContainsFunc(set.Spec.Template.Spec.Containers[0].VolumeMounts, func(m corev1.VolumeMount) bool {
+				return m.Name == resources.EtcdMetricsTLSCertificateSecretName && m.ReadOnly
+			})
+			if hasMount != test.launcherEnabled {
+				t.Errorf("Expected read-only metrics TLS volume mount to be present: %v, got %v", test.launcherEnabled, hasMount)
+			}
+
+			// rotating the metrics certificate must not roll etcd
+			for _, volume := range data.podLabelVolumes {
+				if volume.Name == resources.EtcdMetricsTLSCertificateSecretName {
+					t.Error("Expected metrics TLS volume to not be considered for the pod template labels")
+				}
+			}
+		})
+	}
+}
+
+func TestZoneSpreading(t *testing.T) {
+	tests := []struct {
+		name                      string
+		failureDomainZones        int
+		zoneAntiAffinity          kubermaticv1.AntiAffinityType
+		expectedWhenUnsatisfiable corev1.UnsatisfiableConstraintAction
+		expectedRequiredZoneTerms int
+	}{
+		{
+			name:               "single host seed",
+			failureDomainZones: 0,
+			zoneAntiAffinity:   kubermaticv1.AntiAffinityTypeRequired,
+		},
+		{
+			name:                      "multi-zone seed prefers spreading",
+			failureDomainZones:        3,
+			zoneAntiAffinity:          kubermaticv1.AntiAffinityTypePreferred,
+			expectedWhenUnsatisfiable: corev1.ScheduleAnyway,
+		},
+		{
+			name:                      "multi-zone seed requires spreading",
+			failureDomainZones:        3,
+			zoneAntiAffinity:          kubermaticv1.AntiAffinityTypeRequired,
+			expectedWhenUnsatisfiable: corev1.DoNotSchedule,
+			expectedRequiredZoneTerms: 1,
+		},
+		{
+			name:               "fewer zones than members falls back to host spreading",
+			failureDomainZones: 2,
+			zoneAntiAffinity:   kubermaticv1.AntiAffinityTypeRequired,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := &fakeStatefulSetReconcilerData{
+				cluster: &kubermaticv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "62m9k9tqlm",
+					},
+					Spec: kubermaticv1.ClusterSpec{
+						ComponentsOverride: kubermaticv1.ComponentSettings{
+							Etcd: kubermaticv1.EtcdStatefulSetSettings{
+								HostAntiAffinity: kubermaticv1.AntiAffinityTypeRequired,
+								ZoneAntiAffinity: test.zoneAntiAffinity,
+							},
+						},
+					},
+					Status: kubermaticv1.ClusterStatus{
+						NamespaceName: "cluster-62m9k9tqlm",
+					},
+				},
+				failureDomainZones: test.failureDomainZones,
+			}
+
+			_, reconciler := StatefulSetReconciler(data, false, false)()
+
+			set, err := reconciler(&appsv1.StatefulSet{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile StatefulSet: %v", err)
+			}
+
+			podSpec := set.Spec.Template.Spec
+
+			var requiredZoneTerms int
+			for _, term := range podSpec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+				if term.TopologyKey == resources.TopologyKeyZone {
+					requiredZoneTerms++
+				}
+			}
+
+			if requiredZoneTerms != test.expectedRequiredZoneTerms {
+				t.Errorf("Expected %d required zone anti-affinity terms, got %d.", test.expectedRequiredZoneTerms, requiredZoneTerms)
+			}
+
+			// host anti-affinity must always be in place
+			var requiredHostTerms int
+			for _, term := range podSpec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+				if term.TopologyKey == resources.TopologyKeyHostname {
+					requiredHostTerms++
+				}
+			}
+
+			if requiredHostTerms != 1 {
+				t.Errorf("Expected 1 required host anti-affinity term, got %d.", requiredHostTerms)
+			}
+
+			if test.expectedWhenUnsatisfiable == "" {
+				if len(podSpec.TopologySpreadConstraints) > 0 {
+					t.Fatalf("Expected no topology spread constraints, got %v.", podSpec.TopologySpreadConstraints)
+				}
+				return
+			}
+
+			if len(podSpec.TopologySpreadConstraints) != 1 {
+				t.Fatalf("Expected exactly one topology spread constraint, got %v.", podSpec.TopologySpreadConstraints)
+			}
+
+			constraint := podSpec.TopologySpreadConstraints[0]
+			if constraint.TopologyKey != resources.TopologyKeyZone {
+				t.Errorf("Expected topology key %q, got %q.", resources.TopologyKeyZone, constraint.TopologyKey)
+			}
+
+			if constraint.WhenUnsatisfiable != test.expectedWhenUnsatisfiable {
+				t.Errorf("Expected whenUnsatisfiable to be %q, got %q.", test.expectedWhenUnsatisfiable, constraint.WhenUnsatisfiable)
+			}
+		})
+	}
+}
+
+func TestQuotaBackendBytes(t *testing.T) {
+	tests := []struct {
+		name      string
+		quota     string
+		diskSize  string
+		expectErr bool
+	}{
+		{
+			name:     "quota well below the disk size",
+			quota:    "4Gi",
+			diskSize: "10Gi",
+		},
+		{
+			name:      "quota too close to the disk size",
+			quota:     "4.5Gi",
+			diskSize:  "5Gi",
+			expectErr: true,
+		},
+		{
+			name:      "quota exceeding the disk size",
+			quota:     "8Gi",
+			diskSize:  "5Gi",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			quota := resource.MustParse(test.quota)
+			diskSize := resource.MustParse(test.diskSize)
+
+			data := &fakeStatefulSetReconcilerData{
+				cluster: &kubermaticv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "62m9k9tqlm",
+					},
+					Spec: kubermaticv1.ClusterSpec{
+						ComponentsOverride: kubermaticv1.ComponentSettings{
+							Etcd: kubermaticv1.EtcdStatefulSetSettings{
+								DiskSize:          &diskSize,
+								QuotaBackendBytes: &quota,
+							},
+						},
+					},
+					Status: kubermaticv1.ClusterStatus{
+						NamespaceName: "cluster-62m9k9tqlm",
+					},
+				},
+			}
+
+			_, reconciler := StatefulSetReconciler(data, false, false)()
+
+			set, err := reconciler(&appsv1.StatefulSet{})
+			if test.expectErr {
+				if err == nil {
+					t.Fatal("Expected an error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to reconcile StatefulSet: %v", err)
+			}
+
+			expected := fmt.Sprintf("--quota-backend-bytes %d", quota.Value())
+			if cmd := strings.Join(set.Spec.Template.Spec.Containers[0].Command, " "); !strings.Contains(cmd, expected) {
+				t.Errorf("Expected etcd command to contain %q, got %q", expected, cmd)
+			}
+		})
+	}
+}
+
+func TestAutoCompaction(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      kubermaticv1.EtcdAutoCompactionMode
+		retention string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "default",
+			expected: "--auto-compaction-retention 8",
+		},
+		{
+			name:      "number of hours",
+			retention: "2",
+			expected:  "--auto-compaction-retention 2",
+		},
+		{
+			name:      "periodic with duration",
+			mode:      kubermaticv1.EtcdAutoCompactionModePeriodic,
+			retention: "30m",
+			expected:  "--auto-compaction-retention 30m --auto-compaction-mode periodic",
+		},
+		{
+			name:      "revision with number of revisions",
+			mode:      kubermaticv1.EtcdAutoCompactionModeRevision,
+			retention: "10000",
+			expected:  "--auto-compaction-retention 10000 --auto-compaction-mode revision",
+		},
+		{
+			name:      "revision without retention",
+			mode:      kubermaticv1.EtcdAutoCompactionModeRevision,
+			expectErr: true,
+		},
+		{
+			name:      "revision with duration",
+			mode:      kubermaticv1.EtcdAutoCompactionModeRevision,
+			retention: "1h",
+			expectErr: true,
+		},
+		{
+			name:      "periodic with malformed retention",
+			mode:      kubermaticv1.EtcdAutoCompactionModePeriodic,
+			retention: "eight",
+			expectErr: true,
+		},
+		{
+			name:      "zero retention",
+			retention: "0",
+			expectErr: true,
+		},
+		{
+			name:      "unknown mode",
+			mode:      "size",
+			retention: "8",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := &fakeStatefulSetReconcilerData{
+				cluster: &kubermaticv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "62m9k9tqlm",
+					},
+					Spec: kubermaticv1.ClusterSpec{
+						ComponentsOverride: kubermaticv1.ComponentSettings{
+							Etcd: kubermaticv1.EtcdStatefulSetSettings{
+								AutoCompactionMode:      test.mode,
+								AutoCompactionRetention: test.retention,
+							},
+						},
+					},
+					Status: kubermaticv1.ClusterStatus{
+						NamespaceName: "cluster-62m9k9tqlm",
+					},
+				},
+			}
+
+			_, reconciler := StatefulSetReconciler(data, false, false)()
+
+			set, err := reconciler(&appsv1.StatefulSet{})
+			if test.expectErr {
+				if err == nil {
+					t.Fatal("Expected an error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to reconcile StatefulSet: %v", err)
+			}
+
+			if cmd := strings.Join(set.Spec.Template.Spec.Containers[0].Command, " "); !strings.Contains(cmd, test.expected) {
+				t.Errorf("Expected etcd command to contain %q, got %q", test.expected, cmd)
+			}
+		})
+	}
+}
+
+func TestCipherSuites(t *testing.T) {
+	tests := []struct {
+		name         string
+		cipherSuites []string
+		expected     string
+		expectErr    bool
+	}{
+		{
+			name:     "default",
+			expected: "--cipher-suites " + strings.Join(resources.GetDefaultEtcdCipherSuites(), ","),
+		},
+		{
+			name:         "custom cipher suites",
+			cipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+			expected:     "--cipher-suites TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+		},
+		{
+			name:         "unknown cipher suite",
+			cipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384", "TLS_FANCY_CIPHER"},
+			expectErr:    true,
+		},
+		{
+			name:         "insecure cipher suite",
+			cipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384", "TLS_RSA_WITH_RC4_128_SHA"},
+			expectErr:    true,
+		},
+		{
+			name:         "TLS 1.3 cipher suite",
+			cipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384", "TLS_AES_128_GCM_SHA256"},
+			expectErr:    true,
+		},
+		{
+			name:         "no cipher suite for RSA certificates",
+			cipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384"},
+			expectErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := &fakeStatefulSetReconcilerData{
+				cluster: &kubermaticv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "62m9k9tqlm",
+					},
+					Spec: kubermaticv1.ClusterSpec{
+						ComponentsOverride: kubermaticv1.ComponentSettings{
+							Etcd: kubermaticv1.EtcdStatefulSetSettings{
+								CipherSuites: test.cipherSuites,
+							},
+						},
+					},
+					Status: kubermaticv1.ClusterStatus{
+						NamespaceName: "cluster-62m9k9tqlm",
+					},
+				},
+			}
+
+			_, reconciler := StatefulSetReconciler(data, false, false)()
+
+			set, err := reconciler(&appsv1.StatefulSet{})
+			if test.expectErr {
+				if err == nil {
+					t.Fatal("Expected an error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to reconcile StatefulSet: %v", err)
+			}
+
+			if cmd := strings.Join(set.Spec.Template.Spec.Containers[0].Command, " "); !strings.Contains(cmd, test.expected) {
+				t.Errorf("Expected etcd command to contain %q, got %q", test.expected, cmd)
+			}
+		})
+	}
+}
+
+func TestPodDisruptionBudgetReconciler(t *testing.T) {
+	tests := []struct {
+		name                   string
+		clusterSize            *int32
+		safeToEvict            *bool
+		expectedMinAvailable   *int
+		expectedMaxUnavailable *int
+	}{
+		{
+			name:                 "protected etcd keeps its quorum",
+			clusterSize:          ptr.To[int32](5),
+			expectedMinAvailable: ptr.To(3),
+		},
+		{
+			name:                   "evictable etcd is disrupted one member at a time",
+			clusterSize:            ptr.To[int32](5),
+			safeToEvict:            ptr.To(true),
+			expectedMaxUnavailable: ptr.To(1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "62m9k9tqlm",
+				},
+			}
+			cluster.Spec.ComponentsOverride.Etcd.ClusterSize = test.clusterSize
+			cluster.Spec.ComponentsOverride.Etcd.SafeToEvict = test.safeToEvict
+
+			_, reconciler := PodDisruptionBudgetReconciler(&fakeStatefulSetReconcilerData{cluster: cluster})()
+
+			pdb, err := reconciler(&policyv1.PodDisruptionBudget{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile PodDisruptionBudget: %v", err)
+			}
+
+			if test.expectedMinAvailable == nil {
+				if pdb.Spec.MinAvailable != nil {
+					t.Errorf("Expected no minAvailable, got %v.", pdb.Spec.MinAvailable)
+				}
+			} else if pdb.Spec.MinAvailable == nil || pdb.Spec.MinAvailable.IntValue() != *test.expectedMinAvailable {
+				t.Errorf("Expected minAvailable to be %d, got %v.", *test.expectedMinAvailable, pdb.Spec.MinAvailable)
+			}
+
+			if test.expectedMaxUnavailable == nil {
+				if pdb.Spec.MaxUnavailable != nil {
+					t.Errorf("Expected no maxUnavailable, got %v.", pdb.Spec.MaxUnavailable)
+				}
+			} else if pdb.Spec.MaxUnavailable == nil || pdb.Spec.MaxUnavailable.IntValue() != *test.expectedMaxUnavailable {
+				t.Errorf("Expected maxUnavailable to be %d, got %v.", *test.expectedMaxUnavailable, pdb.Spec.MaxUnavailable)
+			}
+		})
+	}
+}
+
+func TestPriorityClassByClusterTier(t *testing.T) {
+	tests := []struct {
+		name              string
+		tier              kubermaticv1.ClusterTier
+		expectedClassName string
+	}{
+		{
+			name:              "no tier",
+			expectedClassName: "",
+		},
+		{
+			name:              "production tier",
+			tier:              kubermaticv1.ClusterTierProduction,
+			expectedClassName: resources.ProductionControlPlanePriorityClassName,
+		},
+		{
+			name:              "development tier",
+			tier:              kubermaticv1.ClusterTierDevelopment,
+			expectedClassName: resources.DevelopmentControlPlanePriorityClassName,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := &fakeStatefulSetReconcilerData{
+				cluster: &kubermaticv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "62m9k9tqlm",
+					},
+					Spec: kubermaticv1.ClusterSpec{
+						Tier: test.tier,
+					},
+					Status: kubermaticv1.ClusterStatus{
+						NamespaceName: "cluster-62m9k9tqlm",
+					},
+				},
+			}
+
+			_, reconciler := StatefulSetReconciler(data, false, false)()
+
+			set, err := reconciler(&appsv1.StatefulSet{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile StatefulSet: %v", err)
+			}
+
+			if className := set.Spec.Template.Spec.PriorityClassName; className != test.expectedClassName {
+				t.Errorf("Expected PriorityClass %q, got %q", test.expectedClassName, className)
+			}
+		})
+	}
+}
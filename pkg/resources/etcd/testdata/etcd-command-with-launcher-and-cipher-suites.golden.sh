@@ -0,0 +1 @@
+/opt/bin/etcd-launcher run --cluster 62m9k9tqlm --pod-name $(POD_NAME) --pod-ip $(POD_IP) --api-version $(ETCDCTL_API) --token $(TOKEN) --metrics-tls-port 2382 --cipher-suites TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384
@@ -0,0 +1 @@
+/opt/bin/etcd-launcher run --cluster 62m9k9tqlm --pod-name $(POD_NAME) --pod-ip $(POD_IP) --api-version $(ETCDCTL_API) --token $(TOKEN) --metrics-tls-port 2382 --quota-backend-bytes 8589934592
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"crypto/x509"
+	"slices"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+type fakeTLSCertificateReconcilerData struct {
+	cluster *kubermaticv1.Cluster
+	ca      *triple.KeyPair
+}
+
+func (f *fakeTLSCertificateReconcilerData) Cluster() *kubermaticv1.Cluster {
+	return f.cluster
+}
+
+func (f *fakeTLSCertificateReconcilerData) GetRootCA() (*triple.KeyPair, error) {
+	return f.ca, nil
+}
+
+func TestTLSCertificates(t *testing.T) {
+	ca, err := triple.NewCA("etcd-test-ca")
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	data := &fakeTLSCertificateReconcilerData{
+		cluster: &kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Status:     kubermaticv1.ClusterStatus{NamespaceName: "cluster-test"},
+		},
+		ca: ca,
+	}
+
+	testCases := []struct {
+		name           string
+		reconciler     func(tlsCertificateReconcilerData) reconciling.NamedSecretReconcilerFactory
+		certKey        string
+		expectedCN     string
+		expectLoopback bool
+		expectedUsages []x509.ExtKeyUsage
+	}{
+		{
+			name:           "serving certificate",
+			reconciler:     TLSCertificateReconciler,
+			certKey:        resources.EtcdTLSCertSecretKey,
+			expectedCN:     "etcd",
+			expectLoopback: true,
+			expectedUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		},
+		{
+			name:           "peer certificate",
+			reconciler:     PeerTLSCertificateReconciler,
+			certKey:        resources.EtcdPeerTLSCertSecretKey,
+			expectedCN:     "etcd-peer",
+			expectLoopback: false,
+			expectedUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, reconcile := tc.reconciler(data)()
+
+			secret, err := reconcile(&corev1.Secret{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile secret: %v", err)
+			}
+
+			certs, err := certutil.ParseCertsPEM(secret.Data[tc.certKey])
+			if err != nil {
+				t.Fatalf("Failed to parse certificate: %v", err)
+			}
+			cert := certs[0]
+
+			if cert.Subject.CommonName != tc.expectedCN {
+				t.Errorf("Expected common name %q, got %q", tc.expectedCN, cert.Subject.CommonName)
+			}
+
+			if hasLoopback := slices.Contains(cert.DNSNames, "localhost") || len(cert.IPAddresses) > 0; hasLoopback != tc.expectLoopback {
+				t.Errorf("Expected loopback SANs to be present: %v, got DNS names %v and IPs %v", tc.expectLoopback, cert.DNSNames, cert.IPAddresses)
+			}
+
+			for _, name := range memberDNSNames(data.cluster) {
+				if !slices.Contains(cert.DNSNames, name) {
+					t.Errorf("Expected certificate to be valid for %q", name)
+				}
+			}
+
+			if !slices.Equal(cert.ExtKeyUsage, tc.expectedUsages) {
+				t.Errorf("Expected extended key usages %v, got %v", tc.expectedUsages, cert.ExtKeyUsage)
+			}
+
+			// reconciling again must keep the existing certificate
+			reconciled, err := reconcile(secret.DeepCopy())
+			if err != nil {
+				t.Fatalf("Failed to reconcile existing secret: %v", err)
+			}
+			if string(reconciled.Data[tc.certKey]) != string(secret.Data[tc.certKey]) {
+				t.Error("Expected existing valid certificate to be kept")
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+type metricsTLSCertificateReconcilerData interface {
+	Cluster() *kubermaticv1.Cluster
+	GetEtcdMetricsCA() (*triple.KeyPair, error)
+}
+
+// MetricsTLSCertificateReconciler returns a function to create/update the secret with the
+// certificate the etcd-launcher uses to serve the etcd metrics. The certificate is issued
+// by the etcd metrics CA, which is also included to verify scraping clients.
+func MetricsTLSCertificateReconciler(data metricsTLSCertificateReconcilerData) reconciling.NamedSecretReconcilerFactory {
+	return func() (string, reconciling.SecretReconciler) {
+		return resources.EtcdMetricsTLSCertificateSecretName, func(se *corev1.Secret) (*corev1.Secret, error) {
+			ca, err := data.GetEtcdMetricsCA()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get etcd metrics ca: %w", err)
+			}
+
+			if se.Data == nil {
+				se.Data = map[string][]byte{}
+			}
+
+			// always keep the CA up-to-date, clients are verified against it
+			se.Data[resources.CACertSecretKey] = triple.EncodeCertPEM(ca.Cert)
+
+			altNames := certutil.AltNames{
+				DNSNames: append([]string{"localhost"}, memberDNSNames(data.Cluster())...),
+				IPs: []net.IP{
+					net.ParseIP("127.0.0.1"),
+				},
+			}
+
+			if b, exists := se.Data[resources.EtcdMetricsTLSCertSecretKey]; exists {
+				certs, err := certutil.ParseCertsPEM(b)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse certificate (key=%s) from existing secret %s: %w", resources.EtcdMetricsTLSCertSecretKey, resources.EtcdMetricsTLSCertificateSecretName, err)
+				}
+
+				if resources.IsServerCertificateValidForAllOf(certs[0], "etcd-metrics", altNames, ca.Cert) {
+					return se, nil
+				}
+			}
+
+			key, err := triple.NewPrivateKey()
+			if err != nil {
+				return nil, fmt.Errorf("failed to create private key for etcd metrics tls certificate: %w", err)
+			}
+
+			config := certutil.Config{
+				CommonName: "etcd-metrics",
+				AltNames:   altNames,
+				Usages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			}
+
+			cert, err := triple.NewSignedCert(config, key, ca.Cert, ca.Key)
+			if err != nil {
+				return nil, fmt.Errorf("unable to sign the etcd metrics certificate: %w", err)
+			}
+
+			se.Data[resources.EtcdMetricsTLSKeySecretKey] = triple.EncodePrivateKeyPEM(key)
+			se.Data[resources.EtcdMetricsTLSCertSecretKey] = triple.EncodeCertPEM(cert)
+
+			return se, nil
+		}
+	}
+}
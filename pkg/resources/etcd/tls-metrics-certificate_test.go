@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"crypto/x509"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+type fakeMetricsTLSCertificateReconcilerData struct {
+	cluster   *kubermaticv1.Cluster
+	metricsCA *triple.KeyPair
+}
+
+func (f *fakeMetricsTLSCertificateReconcilerData) Cluster() *kubermaticv1.Cluster {
+	return f.cluster
+}
+
+func (f *fakeMetricsTLSCertificateReconcilerData) GetEtcdMetricsCA() (*triple.KeyPair, error) {
+	return f.metricsCA, nil
+}
+
+func parseCertificate(t *testing.T, secret *corev1.Secret, key string) *x509.Certificate {
+	certs, err := certutil.ParseCertsPEM(secret.Data[key])
+	if err != nil {
+		t.Fatalf("Failed to parse certificate %q: %v", key, err)
+	}
+
+	return certs[0]
+}
+
+func verifies(cert *x509.Certificate, ca *x509.Certificate, usage x509.ExtKeyUsage) bool {
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	_, err := cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{usage}})
+	return err == nil
+}
+
+func TestMetricsTLSCertificateIsLeastPrivilege(t *testing.T) {
+	rootCA, err := triple.NewCA("root-ca")
+	if err != nil {
+		t.Fatalf("Failed to create root CA: %v", err)
+	}
+
+	metricsCA, err := triple.NewCA("etcd-metrics-ca")
+	if err != nil {
+		t.Fatalf("Failed to create etcd metrics CA: %v", err)
+	}
+
+	data := &fakeMetricsTLSCertificateReconcilerData{
+		cluster: &kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Status:     kubermaticv1.ClusterStatus{NamespaceName: "cluster-test"},
+		},
+		metricsCA: metricsCA,
+	}
+
+	_, reconcileServing := MetricsTLSCertificateReconciler(data)()
+	servingSecret, err := reconcileServing(&corev1.Secret{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile metrics serving certificate: %v", err)
+	}
+
+	_, reconcileClient := certificates.GetClientCertificateReconciler(
+		resources.PrometheusEtcdMetricsClientCertificateSecretName,
+		resources.EtcdMetricsScraperCommonName, nil,
+		resources.PrometheusEtcdMetricsClientCertSecretKey,
+		resources.PrometheusEtcdMetricsClientKeySecretKey,
+		data.GetEtcdMetricsCA,
+	)()
+	clientSecret, err := reconcileClient(&corev1.Secret{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile Prometheus scrape certificate: %v", err)
+	}
+
+	serving := parseCertificate(t, servingSecret, resources.EtcdMetricsTLSCertSecretKey)
+	client := parseCertificate(t, clientSecret, resources.PrometheusEtcdMetricsClientCertSecretKey)
+
+	if !verifies(serving, metricsCA.Cert, x509.ExtKeyUsageServerAuth) {
+		t.Error("Expected metrics serving certificate to be signed by the etcd metrics CA")
+	}
+	if verifies(serving, rootCA.Cert, x509.ExtKeyUsageServerAuth) {
+		t.Error("Expected metrics serving certificate to not be signed by the cluster root CA")
+	}
+	if verifies(serving, metricsCA.Cert, x509.ExtKeyUsageClientAuth) {
+		t.Error("Expected metrics serving certificate to not be usable as a client certificate")
+	}
+
+	if !verifies(client, metricsCA.Cert, x509.ExtKeyUsageClientAuth) {
+		t.Error("Expected Prometheus scrape certificate to be signed by the etcd metrics CA")
+	}
+	if verifies(client, rootCA.Cert, x509.ExtKeyUsageClientAuth) {
+		t.Error("Expected Prometheus scrape certificate to not be accepted by the etcd client endpoint")
+	}
+	if client.Subject.CommonName != resources.EtcdMetricsScraperCommonName {
+		t.Errorf("Expected Prometheus scrape certificate common name %q, got %q", resources.EtcdMetricsScraperCommonName, client.Subject.CommonName)
+	}
+
+	// the serving secret carries the CA to verify the scraper against
+	if string(servingSecret.Data[resources.CACertSecretKey]) != string(triple.EncodeCertPEM(metricsCA.Cert)) {
+		t.Error("Expected metrics serving secret to contain the etcd metrics CA")
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"crypto/x509"
+	"fmt"
+	"slices"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+// PeerTLSCertificateReconciler returns a function to create/update the secret with the certificate
+// etcd members use to authenticate each other. Unlike the client-facing certificate it is only
+// valid for the members' DNS names, not for localhost.
+func PeerTLSCertificateReconciler(data tlsCertificateReconcilerData) reconciling.NamedSecretReconcilerFactory {
+	return func() (string, reconciling.SecretReconciler) {
+		return resources.EtcdPeerTLSCertificateSecretName, func(se *corev1.Secret) (*corev1.Secret, error) {
+			ca, err := data.GetRootCA()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get cluster ca: %w", err)
+			}
+
+			altNames := certutil.AltNames{
+				DNSNames: memberDNSNames(data.Cluster()),
+			}
+
+			if b, exists := se.Data[resources.EtcdPeerTLSCertSecretKey]; exists {
+				certs, err := certutil.ParseCertsPEM(b)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse certificate (key=%s) from existing secret %s: %w", resources.EtcdPeerTLSCertSecretKey, resources.EtcdPeerTLSCertificateSecretName, err)
+				}
+
+				if resources.IsServerCertificateValidForAllOf(certs[0], "etcd-peer", altNames, ca.Cert) && slices.Contains(certs[0].ExtKeyUsage, x509.ExtKeyUsageClientAuth) {
+					return se, nil
+				}
+			}
+
+			key, err := triple.NewPrivateKey()
+			if err != nil {
+				return nil, fmt.Errorf("failed to create private key for etcd peer tls certificate: %w", err)
+			}
+
+			config := certutil.Config{
+				CommonName: "etcd-peer",
+				AltNames:   altNames,
+				// members act as both server and client when talking to each other
+				Usages: []x509.ExtKeyUsage{
+					x509.ExtKeyUsageServerAuth,
+					x509.ExtKeyUsageClientAuth,
+				},
+			}
+
+			cert, err := triple.NewSignedCert(config, key, ca.Cert, ca.Key)
+			if err != nil {
+				return nil, fmt.Errorf("unable to sign the peer certificate: %w", err)
+			}
+
+			se.Data = map[string][]byte{
+				resources.EtcdPeerTLSKeySecretKey:  triple.EncodePrivateKeyPEM(key),
+				resources.EtcdPeerTLSCertSecretKey: triple.EncodeCertPEM(cert),
+			}
+
+			return se, nil
+		}
+	}
+}
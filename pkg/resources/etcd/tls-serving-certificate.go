@@ -45,26 +45,11 @@ func TLSCertificateReconciler(data tlsCertificateReconcilerData) reconciling.Nam
 			}
 
 			altNames := certutil.AltNames{
-				DNSNames: []string{
-					"localhost",
-				},
+				DNSNames: append([]string{"localhost"}, memberDNSNames(data.Cluster())...),
 				IPs: []net.IP{
 					net.ParseIP("127.0.0.1"),
 				},
 			}
-			etcdClusterSize := kubermaticv1.DefaultEtcdClusterSize
-			if data.Cluster().Spec.Features[kubermaticv1.ClusterFeatureEtcdLauncher] {
-				etcdClusterSize = kubermaticv1.MaxEtcdClusterSize
-			}
-			for i := range etcdClusterSize {
-				// Member name
-				podName := fmt.Sprintf("etcd-%d", i)
-				altNames.DNSNames = append(altNames.DNSNames, podName)
-
-				// Pod DNS name
-				absolutePodDNSName := fmt.Sprintf("etcd-%d.%s.%s.svc.cluster.local", i, resources.EtcdServiceName, data.Cluster().Status.NamespaceName)
-				altNames.DNSNames = append(altNames.DNSNames, absolutePodDNSName)
-			}
 
 			if b, exists := se.Data[resources.EtcdTLSCertSecretKey]; exists {
 				certs, err := certutil.ParseCertsPEM(b)
@@ -109,3 +94,23 @@ func TLSCertificateReconciler(data tlsCertificateReconcilerData) reconciling.Nam
 		}
 	}
 }
+
+// memberDNSNames returns the member names and pod DNS names of all etcd members
+// the cluster can have.
+func memberDNSNames(cluster *kubermaticv1.Cluster) []string {
+	etcdClusterSize := kubermaticv1.DefaultEtcdClusterSize
+	if cluster.Spec.Features[kubermaticv1.ClusterFeatureEtcdLauncher] {
+		etcdClusterSize = kubermaticv1.MaxEtcdClusterSize
+	}
+
+	var names []string
+	for i := range etcdClusterSize {
+		// Member name
+		names = append(names, fmt.Sprintf("etcd-%d", i))
+
+		// Pod DNS name
+		names = append(names, fmt.Sprintf("etcd-%d.%s.%s.svc.cluster.local", i, resources.EtcdServiceName, resources.EtcdNamespaceName(cluster)))
+	}
+
+	return names
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventexporter
+
+import (
+	"fmt"
+	"net/url"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	configKey      = "config.yaml"
+	receiverName   = "sink"
+	clusterHeader  = "X-Kubermatic-Cluster"
+	maxEventAgeSec = 60
+)
+
+type configMapData interface {
+	Cluster() *kubermaticv1.Cluster
+	EventExporterSink() string
+}
+
+// ConfigMapReconciler returns the function to create and update the ConfigMap configuring the
+// event-exporter to forward all events of the user cluster to the sink endpoint.
+func ConfigMapReconciler(data configMapData) reconciling.NamedConfigMapReconcilerFactory {
+	return func() (string, reconciling.ConfigMapReconciler) {
+		return resources.EventExporterConfigMapName, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			sink := data.EventExporterSink()
+			if err := ValidateSink(sink); err != nil {
+				return nil, fmt.Errorf("invalid event-exporter sink: %w", err)
+			}
+
+			config, err := yaml.Marshal(map[string]interface{}{
+				"logLevel":           "warn",
+				"logFormat":          "json",
+				"maxEventAgeSeconds": maxEventAgeSec,
+				"route": map[string]interface{}{
+					"routes": []interface{}{
+						map[string]interface{}{
+							"match": []interface{}{
+								map[string]interface{}{"receiver": receiverName},
+							},
+						},
+					},
+				},
+				"receivers": []interface{}{
+					map[string]interface{}{
+						"name": receiverName,
+						"webhook": map[string]interface{}{
+							"endpoint": sink,
+							// the sink receives the events of all clusters of the seed
+							"headers": map[string]string{
+								clusterHeader: data.Cluster().Name,
+							},
+						},
+					},
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode event-exporter configuration: %w", err)
+			}
+
+			if cm.Data == nil {
+				cm.Data = map[string]string{}
+			}
+			cm.Data[configKey] = string(config)
+
+			return cm, nil
+		}
+	}
+}
+
+// ValidateSink checks that the sink is an absolute HTTP(S) URL the events can be posted to.
+func ValidateSink(sink string) error {
+	if sink == "" {
+		return fmt.Errorf("no sink endpoint configured")
+	}
+
+	parsed, err := url.Parse(sink)
+	if err != nil {
+		return fmt.Errorf("failed to parse %q: %w", sink, err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("%q must use the http or https scheme", sink)
+	}
+
+	if parsed.Host == "" {
+		return fmt.Errorf("%q has no host", sink)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventexporter
+
+import (
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/registry"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	name    = "event-exporter"
+	version = "v1.7"
+
+	configVolumeName     = "config"
+	configDir            = "/etc/event-exporter"
+	kubeconfigVolumeName = "kubeconfig"
+	kubeconfigDir        = "/etc/kubernetes/kubeconfig"
+)
+
+var defaultResourceRequirements = map[string]*corev1.ResourceRequirements{
+	name: {
+		Requests: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("32Mi"),
+			corev1.ResourceCPU:    resource.MustParse("10m"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("128Mi"),
+			corev1.ResourceCPU:    resource.MustParse("100m"),
+		},
+	},
+}
+
+type deploymentData interface {
+	Cluster() *kubermaticv1.Cluster
+	RewriteImage(string) (string, error)
+	GetPodTemplateLabels(string, []corev1.Volume, map[string]string) (map[string]string, error)
+}
+
+// DeploymentReconciler returns the function to create and update the event-exporter Deployment.
+// It runs in the cluster namespace and watches the events of the user cluster through the internal
+// admin kubeconfig. As its configuration is mounted from a ConfigMap, changing the sink rolls it.
+func DeploymentReconciler(data deploymentData) reconciling.NamedDeploymentReconcilerFactory {
+	return func() (string, reconciling.DeploymentReconciler) {
+		return resources.EventExporterDeploymentName, func(dep *appsv1.Deployment) (*appsv1.Deployment, error) {
+			baseLabels := resources.BaseAppLabels(name, nil)
+			kubernetes.EnsureLabels(dep, baseLabels)
+
+			dep.Spec.Replicas = resources.Int32(1)
+			dep.Spec.Selector = &metav1.LabelSelector{
+				MatchLabels: baseLabels,
+			}
+
+			volumes := []corev1.Volume{
+				{
+					Name: configVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: resources.EventExporterConfigMapName,
+							},
+						},
+					},
+				},
+				{
+					Name: kubeconfigVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: resources.InternalUserClusterAdminKubeconfigSecretName,
+						},
+					},
+				},
+			}
+
+			podLabels, err := data.GetPodTemplateLabels(name, volumes, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create pod labels: %w", err)
+			}
+
+			kubernetes.EnsureLabels(&dep.Spec.Template, podLabels)
+
+			dep.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: resources.ImagePullSecretName}}
+			dep.Spec.Template.Spec.AutomountServiceAccountToken = ptr.To(false)
+			dep.Spec.Template.Spec.Volumes = volumes
+			dep.Spec.Template.Spec.Containers = []corev1.Container{
+				{
+					Name:    name,
+					Image:   registry.Must(data.RewriteImage(resources.RegistryGHCR + "/resmoio/kubernetes-event-exporter:" + version)),
+					Command: []string{"/kubernetes-event-exporter"},
+					Args:    []string{"-conf", fmt.Sprintf("%s/%s", configDir, configKey)},
+					Env: []corev1.EnvVar{
+						{
+							Name:  "KUBECONFIG",
+							Value: fmt.Sprintf("%s/%s", kubeconfigDir, resources.KubeconfigSecretKey),
+						},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      configVolumeName,
+							MountPath: configDir,
+							ReadOnly:  true,
+						},
+						{
+							Name:      kubeconfigVolumeName,
+							MountPath: kubeconfigDir,
+							ReadOnly:  true,
+						},
+					},
+					SecurityContext: &corev1.SecurityContext{
+						AllowPrivilegeEscalation: resources.Bool(false),
+						ReadOnlyRootFilesystem:   resources.Bool(true),
+						RunAsNonRoot:             resources.Bool(true),
+						RunAsUser:                resources.Int64(65534),
+						Capabilities: &corev1.Capabilities{
+							Drop: []corev1.Capability{"ALL"},
+						},
+					},
+				},
+			}
+
+			err = resources.SetResourceRequirements(dep.Spec.Template.Spec.Containers, defaultResourceRequirements, nil, dep.Annotations)
+			if err != nil {
+				return nil, fmt.Errorf("failed to set resource requirements: %w", err)
+			}
+
+			return dep, nil
+		}
+	}
+}
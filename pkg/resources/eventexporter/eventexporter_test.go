@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventexporter
+
+import (
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+type fakeEventExporterData struct {
+	cluster *kubermaticv1.Cluster
+	sink    string
+}
+
+func (f *fakeEventExporterData) Cluster() *kubermaticv1.Cluster {
+	return f.cluster
+}
+
+func (f *fakeEventExporterData) EventExporterSink() string {
+	return f.sink
+}
+
+func (f *fakeEventExporterData) RewriteImage(image string) (string, error) {
+	return strings.Replace(image, resources.RegistryGHCR, "registry.example.com", 1), nil
+}
+
+func (f *fakeEventExporterData) GetPodTemplateLabels(appName string, _ []corev1.Volume, _ map[string]string) (map[string]string, error) {
+	return resources.BaseAppLabels(appName, nil), nil
+}
+
+func newFakeEventExporterData(sink string) *fakeEventExporterData {
+	return &fakeEventExporterData{
+		cluster: &kubermaticv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "abcd1234"}},
+		sink:    sink,
+	}
+}
+
+func TestDeploymentReconciler(t *testing.T) {
+	name, reconciler := DeploymentReconciler(newFakeEventExporterData("https://events.example.com"))()
+	if name != resources.EventExporterDeploymentName {
+		t.Fatalf("Expected Deployment name %q, got %q", resources.EventExporterDeploymentName, name)
+	}
+
+	dep, err := reconciler(&appsv1.Deployment{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile Deployment: %v", err)
+	}
+
+	podSpec := dep.Spec.Template.Spec
+	if podSpec.AutomountServiceAccountToken == nil || *podSpec.AutomountServiceAccountToken {
+		t.Error("Expected service account token not to be mounted, as the user cluster is watched instead of the seed")
+	}
+
+	secrets := map[string]string{}
+	configMaps := map[string]string{}
+	for _, volume := range podSpec.Volumes {
+		switch {
+		case volume.Secret != nil:
+			secrets[volume.Name] = volume.Secret.SecretName
+		case volume.ConfigMap != nil:
+			configMaps[volume.Name] = volume.ConfigMap.Name
+		}
+	}
+
+	if secrets[kubeconfigVolumeName] != resources.InternalUserClusterAdminKubeconfigSecretName {
+		t.Errorf("Expected the %q secret to be mounted, got %v", resources.InternalUserClusterAdminKubeconfigSecretName, secrets)
+	}
+	if configMaps[configVolumeName] != resources.EventExporterConfigMapName {
+		t.Errorf("Expected the %q ConfigMap to be mounted, got %v", resources.EventExporterConfigMapName, configMaps)
+	}
+
+	if len(podSpec.Containers) != 1 {
+		t.Fatalf("Expected a single container, got %d", len(podSpec.Containers))
+	}
+
+	container := podSpec.Containers[0]
+	if !strings.HasPrefix(container.Image, "registry.example.com/") {
+		t.Errorf("Expected image to be rewritten, got %q", container.Image)
+	}
+
+	if args := strings.Join(container.Args, " "); args != "-conf "+configDir+"/"+configKey {
+		t.Errorf("Expected the mounted configuration to be used, got args %q", args)
+	}
+
+	expectedKubeconfig := kubeconfigDir + "/" + resources.KubeconfigSecretKey
+	if len(container.Env) != 1 || container.Env[0].Name != "KUBECONFIG" || container.Env[0].Value != expectedKubeconfig {
+		t.Errorf("Expected KUBECONFIG to point to %q, got %v", expectedKubeconfig, container.Env)
+	}
+
+	if container.Resources.Limits.Memory().IsZero() {
+		t.Error("Expected resource requirements to be set")
+	}
+}
+
+func TestConfigMapReconciler(t *testing.T) {
+	testCases := []struct {
+		name        string
+		sink        string
+		expectedErr bool
+	}{
+		{
+			name: "https sink",
+			sink: "https://events.example.com/ingest?token=abc",
+		},
+		{
+			name: "http sink",
+			sink: "http://event-sink.monitoring.svc:8080",
+		},
+		{
+			name:        "no sink",
+			expectedErr: true,
+		},
+		{
+			name:        "unsupported scheme",
+			sink:        "tcp://events.example.com",
+			expectedErr: true,
+		},
+		{
+			name:        "relative URL",
+			sink:        "/ingest",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := newFakeEventExporterData(tc.sink)
+
+			name, reconciler := ConfigMapReconciler(data)()
+			if name != resources.EventExporterConfigMapName {
+				t.Fatalf("Expected ConfigMap name %q, got %q", resources.EventExporterConfigMapName, name)
+			}
+
+			cm, err := reconciler(&corev1.ConfigMap{})
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("Expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to reconcile ConfigMap: %v", err)
+			}
+
+			config := struct {
+				Route struct {
+					Routes []struct {
+						Match []struct {
+							Receiver string `json:"receiver"`
+						} `json:"match"`
+					} `json:"routes"`
+				} `json:"route"`
+				Receivers []struct {
+					Name    string `json:"name"`
+					Webhook struct {
+						Endpoint string            `json:"endpoint"`
+						Headers  map[string]string `json:"headers"`
+					} `json:"webhook"`
+				} `json:"receivers"`
+			}{}
+			if err := yaml.Unmarshal([]byte(cm.Data[configKey]), &config); err != nil {
+				t.Fatalf("Failed to parse configuration: %v", err)
+			}
+
+			if len(config.Receivers) != 1 || config.Receivers[0].Webhook.Endpoint != tc.sink {
+				t.Fatalf("Expected a single receiver posting to %q, got %+v", tc.sink, config.Receivers)
+			}
+			if header := config.Receivers[0].Webhook.Headers[clusterHeader]; header != data.cluster.Name {
+				t.Errorf("Expected events to be labelled with cluster %q, got %q", data.cluster.Name, header)
+			}
+
+			if len(config.Route.Routes) != 1 || len(config.Route.Routes[0].Match) != 1 || config.Route.Routes[0].Match[0].Receiver != config.Receivers[0].Name {
+				t.Errorf("Expected all events to be routed to the sink, got %+v", config.Route)
+			}
+		})
+	}
+}
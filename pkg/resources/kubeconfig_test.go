@@ -30,6 +30,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 func TestGetBaseKubeconfig(t *testing.T) {
@@ -93,10 +94,16 @@ func TestGetInternalKubeconfigReconcilerWithOrgs(t *testing.T) {
 
 // fakeDataProvider provides just enough for testing kubeconfig creation.
 type fakeDataProvider struct {
-	caPair *triple.KeyPair
+	caPair  *triple.KeyPair
+	cluster *kubermaticv1.Cluster
 }
 
-func (fake *fakeDataProvider) Cluster() *kubermaticv1.Cluster { return &kubermaticv1.Cluster{} }
+func (fake *fakeDataProvider) Cluster() *kubermaticv1.Cluster {
+	if fake.cluster != nil {
+		return fake.cluster
+	}
+	return &kubermaticv1.Cluster{}
+}
 
 func (fake *fakeDataProvider) ExternalIP() (*net.IP, error) { return nil, nil }
 
@@ -134,3 +141,44 @@ func checkKubeConfigRegeneration(t *testing.T, orgs []string) {
 	// kubeconfig should be unmodified
 	assert.Equal(t, string(secret.Data[KubeconfigSecretKey]), string(secret2.Data[KubeconfigSecretKey]))
 }
+
+func TestGetInternalKubeconfigReconcilerAddressChange(t *testing.T) {
+	ca, err := triple.NewCA("test-ca")
+	if err != nil {
+		t.Fatalf("Failed to generate test root ca: %v", err)
+	}
+
+	cluster := &kubermaticv1.Cluster{}
+	cluster.Status.Address.InternalName = "apiserver-external.cluster-old.svc.cluster.local."
+	data := &fakeDataProvider{caPair: ca, cluster: cluster}
+
+	_, create := GetInternalKubeconfigReconciler("some-namespace", MachineControllerKubeconfigSecretName, MachineControllerCertUsername, nil, data, zap.NewNop().Sugar())()
+	secret, err := create(&corev1.Secret{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldKubeconfig := string(secret.Data[KubeconfigSecretKey])
+
+	cluster.Status.Address.InternalName = "apiserver-external.cluster-new.svc.cluster.local."
+
+	secret, err = create(secret.DeepCopy())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(secret.Data[KubeconfigSecretKey]) == oldKubeconfig {
+		t.Fatal("Expected kubeconfig to be regenerated after the apiserver address changed")
+	}
+
+	config, err := clientcmd.Load(secret.Data[KubeconfigSecretKey])
+	if err != nil {
+		t.Fatalf("Failed to load kubeconfig: %v", err)
+	}
+
+	expectedServer := "https://" + cluster.Status.Address.InternalName
+	for name, c := range config.Clusters {
+		if c.Server != expectedServer {
+			t.Errorf("Expected cluster %q to point to %q, got %q", name, expectedServer, c.Server)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"k8c.io/reconciler/pkg/reconciling"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// LeaderElectionRoleName returns the name of the Role granting access to the given leader election Lease.
+func LeaderElectionRoleName(leaseName string) string {
+	return "kubermatic:" + leaseName + ":leader-election"
+}
+
+// LeaderElectionRoleReconciler returns a function to create the Role which grants access to
+// nothing but the given leader election Lease. Creating a Lease cannot be restricted by name,
+// so the Role allows creating Leases in general.
+func LeaderElectionRoleReconciler(leaseName string) reconciling.NamedRoleReconcilerFactory {
+	return func() (string, reconciling.RoleReconciler) {
+		return LeaderElectionRoleName(leaseName), func(r *rbacv1.Role) (*rbacv1.Role, error) {
+			r.Rules = []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{coordinationv1.GroupName},
+					Resources: []string{"leases"},
+					Verbs:     []string{"create"},
+				},
+				{
+					APIGroups:     []string{coordinationv1.GroupName},
+					Resources:     []string{"leases"},
+					ResourceNames: []string{leaseName},
+					Verbs:         []string{"get", "update"},
+				},
+			}
+			return r, nil
+		}
+	}
+}
+
+// LeaderElectionRoleBindingReconciler returns a function to create the RoleBinding which grants
+// the given user access to the given leader election Lease.
+func LeaderElectionRoleBindingReconciler(leaseName, username string) reconciling.NamedRoleBindingReconcilerFactory {
+	return func() (string, reconciling.RoleBindingReconciler) {
+		return LeaderElectionRoleName(leaseName), func(rb *rbacv1.RoleBinding) (*rbacv1.RoleBinding, error) {
+			rb.RoleRef = rbacv1.RoleRef{
+				Name:     LeaderElectionRoleName(leaseName),
+				Kind:     "Role",
+				APIGroup: rbacv1.GroupName,
+			}
+			rb.Subjects = []rbacv1.Subject{
+				{
+					Kind:     "User",
+					Name:     username,
+					APIGroup: rbacv1.GroupName,
+				},
+			}
+			return rb, nil
+		}
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestLeaderElectionRoleReconciler(t *testing.T) {
+	for _, leaseName := range []string{SchedulerLeaderElectionLeaseName, ControllerManagerLeaderElectionLeaseName} {
+		t.Run(leaseName, func(t *testing.T) {
+			name, reconciler := LeaderElectionRoleReconciler(leaseName)()
+			if name != LeaderElectionRoleName(leaseName) {
+				t.Errorf("Expected Role to be named %q, got %q", LeaderElectionRoleName(leaseName), name)
+			}
+
+			role, err := reconciler(&rbacv1.Role{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile Role: %v", err)
+			}
+
+			for _, rule := range role.Rules {
+				if len(rule.APIGroups) != 1 || rule.APIGroups[0] != coordinationv1.GroupName {
+					t.Errorf("Expected rule to only grant access to the %s API group, got %v", coordinationv1.GroupName, rule.APIGroups)
+				}
+
+				if len(rule.Resources) != 1 || rule.Resources[0] != "leases" {
+					t.Errorf("Expected rule to only grant access to leases, got %v", rule.Resources)
+				}
+
+				// only creating a Lease cannot be restricted to a name
+				if len(rule.Verbs) == 1 && rule.Verbs[0] == "create" {
+					continue
+				}
+
+				if len(rule.ResourceNames) != 1 || rule.ResourceNames[0] != leaseName {
+					t.Errorf("Expected rule granting %v to be restricted to the %q Lease, got %v", rule.Verbs, leaseName, rule.ResourceNames)
+				}
+			}
+
+			_, bindingReconciler := LeaderElectionRoleBindingReconciler(leaseName, "system:"+leaseName)()
+
+			binding, err := bindingReconciler(&rbacv1.RoleBinding{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile RoleBinding: %v", err)
+			}
+
+			if binding.RoleRef.Kind != "Role" || binding.RoleRef.Name != name {
+				t.Errorf("Expected RoleBinding to reference Role %q, got %v", name, binding.RoleRef)
+			}
+
+			if len(binding.Subjects) != 1 || binding.Subjects[0].Name != "system:"+leaseName {
+				t.Errorf("Expected RoleBinding to bind user %q, got %v", "system:"+leaseName, binding.Subjects)
+			}
+		})
+	}
+}
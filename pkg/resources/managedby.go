@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ManagedByLabel marks the objects of a user cluster control plane that are reconciled
+	// by KKP and can therefore safely be cleaned up by it.
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+	// ManagedByKKPValue is the value of the ManagedByLabel for objects reconciled by KKP.
+	ManagedByKKPValue = "kubermatic"
+)
+
+// ManagedByModifier returns an ObjectModifier that labels every reconciled object as managed
+// by KKP. Objects created by older KKP versions without the label are adopted on their next
+// reconciliation. As the modifier only sees objects that are part of the reconciled set, no
+// object of a different name is ever touched, and objects that are explicitly managed by
+// someone else keep their managed-by label.
+func ManagedByModifier() reconciling.ObjectModifier {
+	return func(create reconciling.ObjectReconciler) reconciling.ObjectReconciler {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			// reconcilers usually replace the labels, so the current owner is remembered beforehand
+			managedBy := existing.GetLabels()[ManagedByLabel]
+			if managedBy == "" {
+				managedBy = ManagedByKKPValue
+			}
+
+			obj, err := create(existing)
+			if err != nil {
+				return obj, err
+			}
+
+			kubernetes.EnsureLabels(obj, map[string]string{ManagedByLabel: managedBy})
+
+			return obj, nil
+		}
+	}
+}
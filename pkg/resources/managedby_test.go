@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestManagedByModifier(t *testing.T) {
+	const namespace = "cluster-test"
+
+	configMap := func(name string, labels map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    labels,
+			},
+			Data: map[string]string{"config": "old"},
+		}
+	}
+
+	// reconcilers replace the labels of the existing object, like most KKP reconcilers do
+	reconciler := func(name string) reconciling.NamedConfigMapReconcilerFactory {
+		return func() (string, reconciling.ConfigMapReconciler) {
+			return name, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+				cm.Labels = map[string]string{AppLabelKey: name}
+				cm.Data = map[string]string{"config": "new"}
+				return cm, nil
+			}
+		}
+	}
+
+	client := fake.NewClientBuilder().WithObjects(
+		configMap("legacy", nil),
+		configMap("labelled", map[string]string{ManagedByLabel: ManagedByKKPValue}),
+		configMap("helm-managed", map[string]string{ManagedByLabel: "Helm"}),
+		configMap("foreign", nil),
+	).Build()
+
+	ctx := context.Background()
+	factories := []reconciling.NamedConfigMapReconcilerFactory{
+		reconciler("new"),
+		reconciler("legacy"),
+		reconciler("labelled"),
+		reconciler("helm-managed"),
+	}
+
+	if err := reconciling.ReconcileConfigMaps(ctx, factories, namespace, client, ManagedByModifier()); err != nil {
+		t.Fatalf("Failed to reconcile ConfigMaps: %v", err)
+	}
+
+	testCases := []struct {
+		name            string
+		expectedManager string
+	}{
+		{
+			name:            "new",
+			expectedManager: ManagedByKKPValue,
+		},
+		{
+			name:            "legacy",
+			expectedManager: ManagedByKKPValue,
+		},
+		{
+			name:            "labelled",
+			expectedManager: ManagedByKKPValue,
+		},
+		{
+			name:            "helm-managed",
+			expectedManager: "Helm",
+		},
+		{
+			name: "foreign",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm := &corev1.ConfigMap{}
+			if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: tc.name}, cm); err != nil {
+				t.Fatalf("Failed to get ConfigMap: %v", err)
+			}
+
+			if manager := cm.Labels[ManagedByLabel]; manager != tc.expectedManager {
+				t.Errorf("Expected ConfigMap to be managed by %q, got %q", tc.expectedManager, manager)
+			}
+
+			if tc.name == "foreign" && cm.Data["config"] != "old" {
+				t.Errorf("Expected foreign ConfigMap to be left untouched, got %v", cm.Data)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+)
+
+const (
+	// ClusterNamespacePrefix is prepended to the cluster name to form the cluster namespace.
+	ClusterNamespacePrefix = "cluster-"
+
+	// EtcdNamespaceSuffix is appended to the cluster namespace to form the namespace
+	// etcd is placed into when the cluster uses a split control plane.
+	EtcdNamespaceSuffix = "-etcd"
+)
+
+// NamespaceName returns the cluster namespace. This is the namespace recorded in the
+// cluster status or, if it has not been created yet, the namespace derived from the
+// cluster name.
+func NamespaceName(cluster *kubermaticv1.Cluster) string {
+	if cluster.Status.NamespaceName != "" {
+		return cluster.Status.NamespaceName
+	}
+
+	return ClusterNamespacePrefix + cluster.Name
+}
+
+// IsSplitControlPlane returns true if the control plane of the cluster is spread
+// across the cluster namespace and a dedicated etcd namespace.
+func IsSplitControlPlane(cluster *kubermaticv1.Cluster) bool {
+	return cluster.Spec.Features[kubermaticv1.ClusterFeatureSplitEtcdNamespace]
+}
+
+// EtcdNamespaceName returns the namespace etcd is placed into. This is the
+// cluster namespace, unless the cluster uses a split control plane.
+func EtcdNamespaceName(cluster *kubermaticv1.Cluster) string {
+	if IsSplitControlPlane(cluster) && cluster.Status.NamespaceName != "" {
+		return cluster.Status.NamespaceName + EtcdNamespaceSuffix
+	}
+
+	return cluster.Status.NamespaceName
+}
+
+// ControlPlaneNamespaces returns all namespaces the control plane of the cluster
+// is placed into, starting with the cluster namespace.
+func ControlPlaneNamespaces(cluster *kubermaticv1.Cluster) []string {
+	namespaces := []string{cluster.Status.NamespaceName}
+
+	if etcdNamespace := EtcdNamespaceName(cluster); etcdNamespace != cluster.Status.NamespaceName {
+		namespaces = append(namespaces, etcdNamespace)
+	}
+
+	return namespaces
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNamespaceName(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		expected  string
+	}{
+		{
+			name:     "namespace not yet created",
+			expected: "cluster-62m9k9tqlm",
+		},
+		{
+			name:      "namespace recorded in the status",
+			namespace: "cluster-custom",
+			expected:  "cluster-custom",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "62m9k9tqlm"},
+				Status:     kubermaticv1.ClusterStatus{NamespaceName: test.namespace},
+			}
+
+			if namespace := NamespaceName(cluster); namespace != test.expected {
+				t.Errorf("Expected namespace %q, got %q", test.expected, namespace)
+			}
+		})
+	}
+}
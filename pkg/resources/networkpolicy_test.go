@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+func TestControlPlaneIngressAllowReconciler(t *testing.T) {
+	testCases := []struct {
+		name               string
+		split              bool
+		expectedNamespaces []string
+	}{
+		{
+			name:               "shared control plane namespace",
+			expectedNamespaces: []string{"cluster-test", "kubermatic"},
+		},
+		{
+			name:               "split control plane",
+			split:              true,
+			expectedNamespaces: []string{"cluster-test", "cluster-test" + EtcdNamespaceSuffix, "kubermatic"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{}
+			cluster.Status.NamespaceName = "cluster-test"
+			cluster.Spec.Features = map[string]bool{kubermaticv1.ClusterFeatureSplitEtcdNamespace: tc.split}
+
+			_, reconciler := ControlPlaneIngressAllowReconciler(cluster, "kubermatic")()
+
+			np, err := reconciler(&networkingv1.NetworkPolicy{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile NetworkPolicy: %v", err)
+			}
+
+			if len(np.Spec.PodSelector.MatchLabels) > 0 || len(np.Spec.PodSelector.MatchExpressions) > 0 {
+				t.Errorf("Expected policy to select all pods, got %v", np.Spec.PodSelector)
+			}
+
+			if len(np.Spec.Ingress) != 1 || len(np.Spec.Ingress[0].From) != 1 || np.Spec.Ingress[0].From[0].NamespaceSelector == nil {
+				t.Fatalf("Expected a single rule allowing traffic from namespaces, got %v", np.Spec.Ingress)
+			}
+
+			namespaces := np.Spec.Ingress[0].From[0].NamespaceSelector.MatchExpressions[0].Values
+			if !equality.Semantic.DeepEqual(namespaces, tc.expectedNamespaces) {
+				t.Errorf("Expected ingress from namespaces %v, got %v", tc.expectedNamespaces, namespaces)
+			}
+		})
+	}
+}
@@ -31,6 +31,8 @@ import (
 type serverClientConfigsData interface {
 	Cluster() *kubermaticv1.Cluster
 	NodeAccessNetwork() string
+	OpenVPNCompression() kubermaticv1.OpenVPNCompression
+	ServiceCIDRs() []string
 }
 
 // ServerClientConfigsConfigMapReconciler returns a ConfigMap containing the ClientConfig for the OpenVPN server. It lives inside the seed-cluster.
@@ -53,17 +55,16 @@ func ServerClientConfigsConfigMapReconciler(data serverClientConfigsData) reconc
 				podNet.IP.String(),
 				net.IP(podNet.Mask).String()))
 
-			// iroute for service network
-			if len(data.Cluster().Spec.ClusterNetwork.Services.CIDRBlocks) < 1 {
-				return nil, fmt.Errorf("cluster.Spec.ClusterNetwork.Services.CIDRBlocks must contain at least one entry")
-			}
-			_, serviceNet, err := net.ParseCIDR(data.Cluster().Spec.ClusterNetwork.Services.CIDRBlocks[0])
+			// iroutes for service networks
+			serviceNets, err := serviceNetworks(data.ServiceCIDRs())
 			if err != nil {
 				return nil, err
 			}
-			iroutes = append(iroutes, fmt.Sprintf("iroute %s %s",
-				serviceNet.IP.String(),
-				net.IP(serviceNet.Mask).String()))
+			for _, serviceNet := range serviceNets {
+				iroutes = append(iroutes, fmt.Sprintf("iroute %s %s",
+					serviceNet.IP.String(),
+					net.IP(serviceNet.Mask).String()))
+			}
 
 			_, nodeAccessNetwork, err := net.ParseCIDR(data.NodeAccessNetwork())
 			if err != nil {
@@ -73,15 +74,56 @@ func ServerClientConfigsConfigMapReconciler(data serverClientConfigsData) reconc
 				nodeAccessNetwork.IP.String(),
 				net.IP(nodeAccessNetwork.Mask).String()))
 
-			if cm.Data == nil {
-				cm.Data = map[string]string{}
+			// the compression is pushed to every client
+			var push string
+			if compression := data.OpenVPNCompression(); compression != "" && compression != kubermaticv1.OpenVPNCompressionDisabled {
+				push = fmt.Sprintf("push \"compress %s\"\n", compression)
 			}
 
 			// trailing newline
 			iroutes = append(iroutes, "")
-			cm.Data["user-cluster-client"] = strings.Join(iroutes, "\n")
+
+			// the data is rebuilt from scratch, so that the configs of removed machine
+			// networks are dropped and the OpenVPN server is rolled without them
+			cm.Data = map[string]string{
+				"user-cluster-client": strings.Join(iroutes, "\n") + push,
+			}
+
+			for _, network := range data.Cluster().Spec.MachineNetworks {
+				_, machineNet, err := net.ParseCIDR(network.CIDR)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse machine network %s: %w", network.CIDR, err)
+				}
+
+				cm.Data[MachineNetworkClientName(network.CIDR)] = fmt.Sprintf("iroute %s %s\n",
+					machineNet.IP.String(),
+					net.IP(machineNet.Mask).String()) + push
+			}
 
 			return cm, nil
 		}
 	}
 }
+
+// serviceNetworks parses the IPv4 service networks of the user cluster, which all have to be
+// routed through the tunnel. The tunnel does not carry IPv6 traffic.
+func serviceNetworks(cidrs []string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse service network %s: %w", cidr, err)
+		}
+
+		if network.IP.To4() != nil {
+			networks = append(networks, network)
+		}
+	}
+
+	if len(networks) == 0 {
+		return nil, fmt.Errorf("cluster.Spec.ClusterNetwork.Services.CIDRBlocks must contain at least one IPv4 entry")
+	}
+
+	return networks, nil
+}
@@ -73,7 +73,11 @@ type openVPNDeploymentReconcilerData interface {
 	Cluster() *kubermaticv1.Cluster
 	GetPodTemplateLabels(string, []corev1.Volume, map[string]string) (map[string]string, error)
 	NodeAccessNetwork() string
+	OpenVPNCipher() string
+	OpenVPNCompression() kubermaticv1.OpenVPNCompression
+	OpenVPNTunnelMTU() int32
 	RewriteImage(string) (string, error)
+	ServiceCIDRs() []string
 }
 
 // DeploymentReconciler returns the function to create and update the openvpn server deployment.
@@ -122,17 +126,25 @@ func DeploymentReconciler(data openVPNDeploymentReconcilerData) reconciling.Name
 				return nil, err
 			}
 
-			_, serviceNet, err := net.ParseCIDR(data.Cluster().Spec.ClusterNetwork.Services.CIDRBlocks[0])
+			serviceNets, err := serviceNetworks(data.ServiceCIDRs())
 			if err != nil {
 				return nil, err
 			}
 
 			pushRoutes := []string{
-				// pod and service routes
+				// pod route
 				"--push", fmt.Sprintf("route %s %s", podNet.IP.String(), net.IP(podNet.Mask).String()),
 				"--route", podNet.IP.String(), net.IP(podNet.Mask).String(),
-				"--push", fmt.Sprintf("route %s %s", serviceNet.IP.String(), net.IP(serviceNet.Mask).String()),
-				"--route", serviceNet.IP.String(), net.IP(serviceNet.Mask).String(),
+			}
+
+			// service routes, which make services like admission webhooks reachable from the apiserver
+			var serviceForwardRules string
+			for _, serviceNet := range serviceNets {
+				pushRoutes = append(pushRoutes,
+					"--push", fmt.Sprintf("route %s %s", serviceNet.IP.String(), net.IP(serviceNet.Mask).String()),
+					"--route", serviceNet.IP.String(), net.IP(serviceNet.Mask).String(),
+				)
+				serviceForwardRules += "iptables -A FORWARD -i tun0 -o tun0 -s 10.20.0.0/24 -d " + serviceNet.String() + " -j ACCEPT\n"
 			}
 
 			// node access network route
@@ -161,8 +173,7 @@ iptables -t nat -A POSTROUTING -o tun0 -s 10.20.0.0/24 -j MASQUERADE
 iptables -P FORWARD DROP
 iptables -A FORWARD -m state --state ESTABLISHED,RELATED -j ACCEPT
 iptables -A FORWARD -i tun0 -o tun0 -s 10.20.0.0/24 -d ` + podNet.String() + ` -j ACCEPT
-iptables -A FORWARD -i tun0 -o tun0 -s 10.20.0.0/24 -d ` + serviceNet.String() + ` -j ACCEPT
-iptables -A FORWARD -i tun0 -o tun0 -s 10.20.0.0/24 -d ` + nodeAccessNetwork.String() + ` -j ACCEPT
+` + serviceForwardRules + `iptables -A FORWARD -i tun0 -o tun0 -s 10.20.0.0/24 -d ` + nodeAccessNetwork.String() + ` -j ACCEPT
 
 iptables -A INPUT -m state --state ESTABLISHED,RELATED -j ACCEPT
 iptables -A INPUT -i tun0 -p icmp -j ACCEPT
@@ -196,16 +207,20 @@ iptables -A INPUT -i tun0 -j DROP
 				"--client-config-dir", "/etc/openvpn/clients",
 				"--status", statusPath,
 				"--status-version", "3",
-				"--cipher", "AES-256-GCM",
-				"--auth", "SHA1",
-				"--keysize", "256",
+			}
+			vpnArgs = append(vpnArgs, serverCryptoArgs(data.OpenVPNCipher(), data.OpenVPNCompression())...)
+			vpnArgs = append(vpnArgs,
 				"--script-security", "2",
 				"--ping", "5",
 				"--verb", "3",
 				"--log", "/dev/stdout",
-			}
+			)
 			vpnArgs = append(vpnArgs, pushRoutes...)
 
+			if mtu := data.OpenVPNTunnelMTU(); mtu > 0 {
+				vpnArgs = append(vpnArgs, "--tun-mtu", fmt.Sprint(mtu))
+			}
+
 			dep.Spec.Template.Spec.Containers = []corev1.Container{
 				{
 					Name:    name,
@@ -353,3 +368,20 @@ func getVolumes() []corev1.Volume {
 		},
 	}
 }
+
+// serverCryptoArgs returns the cipher and compression flags of the OpenVPN server. A custom
+// cipher is enforced via the negotiable data ciphers, so that clients cannot fall back to a
+// weaker one.
+func serverCryptoArgs(cipher string, compression kubermaticv1.OpenVPNCompression) []string {
+	var args []string
+	for _, option := range resources.OpenVPNCryptoOptions(cipher, compression) {
+		args = append(args, "--"+option[0])
+		args = append(args, option[1:]...)
+	}
+
+	if compression != "" && compression != kubermaticv1.OpenVPNCompressionDisabled {
+		args = append(args, "--compress", string(compression))
+	}
+
+	return args
+}
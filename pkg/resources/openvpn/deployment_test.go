@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openvpn
+
+import (
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func (f *fakeOpenVPNData) GetPodTemplateLabels(_ string, _ []corev1.Volume, additionalLabels map[string]string) (map[string]string, error) {
+	return additionalLabels, nil
+}
+
+func (f *fakeOpenVPNData) RewriteImage(image string) (string, error) {
+	return image, nil
+}
+
+func (f *fakeOpenVPNData) OpenVPNTunnelMTU() int32 {
+	if mtu := f.cluster.Spec.ClusterNetwork.TunnelMTU; mtu != nil {
+		return *mtu
+	}
+	return 0
+}
+
+func (f *fakeOpenVPNData) OpenVPNCipher() string {
+	return resources.GetOpenVPNCipher(f.cluster)
+}
+
+func (f *fakeOpenVPNData) OpenVPNCompression() kubermaticv1.OpenVPNCompression {
+	return f.cluster.Spec.ClusterNetwork.TunnelCompression
+}
+
+func (f *fakeOpenVPNData) ServiceCIDRs() []string {
+	return f.cluster.Spec.ClusterNetwork.Services.CIDRBlocks
+}
+
+func TestDeploymentTunnelMTU(t *testing.T) {
+	tests := []struct {
+		name     string
+		mtu      *int32
+		expected string
+	}{
+		{
+			name: "default MTU",
+		},
+		{
+			name:     "custom MTU",
+			mtu:      ptr.To[int32](1400),
+			expected: "--tun-mtu 1400",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := newFakeOpenVPNData(t)
+			data.cluster.Spec.ClusterNetwork.TunnelMTU = test.mtu
+
+			_, reconciler := DeploymentReconciler(data)()
+
+			dep, err := reconciler(&appsv1.Deployment{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile Deployment: %v", err)
+			}
+
+			args := strings.Join(dep.Spec.Template.Spec.Containers[0].Args, " ")
+			if test.expected == "" {
+				if strings.Contains(args, "--tun-mtu") {
+					t.Errorf("Expected no tunnel MTU, got %q", args)
+				}
+				return
+			}
+
+			if !strings.Contains(args, test.expected) {
+				t.Errorf("Expected OpenVPN server args to contain %q, got %q", test.expected, args)
+			}
+		})
+	}
+}
+
+func TestTunnelCryptoSettings(t *testing.T) {
+	tests := []struct {
+		name             string
+		cipher           string
+		compression      kubermaticv1.OpenVPNCompression
+		expectedArgs     []string
+		unexpectedArgs   []string
+		expectedPushLine string
+	}{
+		{
+			name:           "defaults",
+			expectedArgs:   []string{"--cipher AES-256-GCM --auth SHA1 --keysize 256"},
+			unexpectedArgs: []string{"--data-ciphers", "--compress", "--allow-compression"},
+		},
+		{
+			name:           "custom cipher",
+			cipher:         "CHACHA20-POLY1305",
+			expectedArgs:   []string{"--cipher CHACHA20-POLY1305 --data-ciphers CHACHA20-POLY1305 --auth SHA1"},
+			unexpectedArgs: []string{"--keysize"},
+		},
+		{
+			name:           "compression disabled",
+			compression:    kubermaticv1.OpenVPNCompressionDisabled,
+			expectedArgs:   []string{"--allow-compression no"},
+			unexpectedArgs: []string{"--compress"},
+		},
+		{
+			name:             "lz4 compression",
+			compression:      kubermaticv1.OpenVPNCompressionLZ4v2,
+			expectedArgs:     []string{"--allow-compression yes --compress lz4-v2"},
+			expectedPushLine: `push "compress lz4-v2"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := newFakeOpenVPNData(t, "192.168.1.0/24")
+			data.cluster.Spec.ClusterNetwork.TunnelCipher = test.cipher
+			data.cluster.Spec.ClusterNetwork.TunnelCompression = test.compression
+
+			_, reconciler := DeploymentReconciler(data)()
+
+			dep, err := reconciler(&appsv1.Deployment{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile Deployment: %v", err)
+			}
+
+			args := strings.Join(dep.Spec.Template.Spec.Containers[0].Args, " ")
+			for _, expected := range test.expectedArgs {
+				if !strings.Contains(args, expected) {
+					t.Errorf("Expected OpenVPN server args to contain %q, got %q", expected, args)
+				}
+			}
+			for _, unexpected := range test.unexpectedArgs {
+				if strings.Contains(args, unexpected) {
+					t.Errorf("Expected OpenVPN server args not to contain %q, got %q", unexpected, args)
+				}
+			}
+
+			_, cmReconciler := ServerClientConfigsConfigMapReconciler(data)()
+
+			cm, err := cmReconciler(&corev1.ConfigMap{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile ConfigMap: %v", err)
+			}
+
+			for key, config := range cm.Data {
+				hasPush := strings.Contains(config, "push ")
+				if test.expectedPushLine == "" && hasPush {
+					t.Errorf("Expected client config %q not to push any options, got %q", key, config)
+				}
+				if test.expectedPushLine != "" && !strings.Contains(config, test.expectedPushLine) {
+					t.Errorf("Expected client config %q to contain %q, got %q", key, test.expectedPushLine, config)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceNetworkRoutes(t *testing.T) {
+	tests := []struct {
+		name             string
+		serviceCIDRs     []string
+		expectedRoutes   []string
+		unexpectedRoutes []string
+	}{
+		{
+			name:           "single service network",
+			serviceCIDRs:   []string{"10.240.16.0/20"},
+			expectedRoutes: []string{"10.240.16.0 255.255.240.0"},
+		},
+		{
+			name:             "dual-stack service networks",
+			serviceCIDRs:     []string{"10.240.16.0/20", "fd02::/120"},
+			expectedRoutes:   []string{"10.240.16.0 255.255.240.0"},
+			unexpectedRoutes: []string{"fd02::"},
+		},
+		{
+			name:           "multiple IPv4 service networks",
+			serviceCIDRs:   []string{"10.240.16.0/20", "10.241.0.0/16"},
+			expectedRoutes: []string{"10.240.16.0 255.255.240.0", "10.241.0.0 255.255.0.0"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := newFakeOpenVPNData(t)
+			data.cluster.Spec.ClusterNetwork.Services.CIDRBlocks = test.serviceCIDRs
+
+			_, reconciler := DeploymentReconciler(data)()
+
+			dep, err := reconciler(&appsv1.Deployment{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile Deployment: %v", err)
+			}
+
+			args := strings.Join(dep.Spec.Template.Spec.Containers[0].Args, " ")
+			iptables := strings.Join(dep.Spec.Template.Spec.InitContainers[0].Args, " ")
+
+			_, cmReconciler := ServerClientConfigsConfigMapReconciler(data)()
+
+			cm, err := cmReconciler(&corev1.ConfigMap{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile ConfigMap: %v", err)
+			}
+
+			for _, route := range test.expectedRoutes {
+				if !strings.Contains(args, "--push route "+route) {
+					t.Errorf("Expected route %q to be pushed to clients, got %q", route, args)
+				}
+				if !strings.Contains(args, "--route "+route) {
+					t.Errorf("Expected route %q to be added to the server, got %q", route, args)
+				}
+				if !strings.Contains(cm.Data["user-cluster-client"], "iroute "+route+"\n") {
+					t.Errorf("Expected route %q to be routed to the user cluster client, got %q", route, cm.Data["user-cluster-client"])
+				}
+			}
+
+			for _, cidr := range test.serviceCIDRs {
+				if strings.Contains(cidr, ":") {
+					continue
+				}
+				if !strings.Contains(iptables, "-d "+cidr+" -j ACCEPT") {
+					t.Errorf("Expected traffic to %s to be forwarded, got %q", cidr, iptables)
+				}
+			}
+
+			for _, route := range test.unexpectedRoutes {
+				if strings.Contains(args, route) || strings.Contains(cm.Data["user-cluster-client"], route) {
+					t.Errorf("Expected route %q not to be configured", route)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openvpn
+
+import (
+	"strings"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MachineNetworkClientAppName is the app label of the Secrets containing the client
+// certificates for the machine networks of a cluster.
+const MachineNetworkClientAppName = "openvpn-machine-network-client"
+
+var machineNetworkNameReplacer = strings.NewReplacer(".", "-", ":", "-", "/", "-")
+
+type machineNetworkClientCertificateReconcilerData interface {
+	Cluster() *kubermaticv1.Cluster
+	GetOpenVPNCA() (*resources.ECDSAKeyPair, error)
+}
+
+// MachineNetworkClientName returns the common name of the OpenVPN client for the machine network
+// with the given CIDR. It is also the name of the client's entry in the client configs ConfigMap.
+func MachineNetworkClientName(cidr string) string {
+	return "machine-network-" + machineNetworkNameReplacer.Replace(strings.ToLower(cidr))
+}
+
+// MachineNetworkClientCertificatesSecretName returns the name of the Secret containing the client
+// certificate for the machine network with the given CIDR.
+func MachineNetworkClientCertificatesSecretName(cidr string) string {
+	return "openvpn-" + MachineNetworkClientName(cidr) + "-client-certificates"
+}
+
+// MachineNetworkClientLabels returns the labels of the Secrets containing the client certificates
+// for machine networks. They are used to find the certificates of removed machine networks.
+func MachineNetworkClientLabels() map[string]string {
+	return resources.BaseAppLabels(MachineNetworkClientAppName, nil)
+}
+
+// MachineNetworkClientCertificateReconcilers returns the functions to create/update a dedicated client
+// certificate for every machine network of the cluster, so that access can be revoked per network.
+func MachineNetworkClientCertificateReconcilers(data machineNetworkClientCertificateReconcilerData) []reconciling.NamedSecretReconcilerFactory {
+	var creators []reconciling.NamedSecretReconcilerFactory
+
+	for _, network := range data.Cluster().Spec.MachineNetworks {
+		secretName := MachineNetworkClientCertificatesSecretName(network.CIDR)
+		reconciler := certificates.GetECDSAClientCertificateReconciler(
+			secretName,
+			MachineNetworkClientName(network.CIDR),
+			[]string{},
+			resources.OpenVPNInternalClientCertSecretKey,
+			resources.OpenVPNInternalClientKeySecretKey,
+			data.GetOpenVPNCA,
+		)
+
+		creators = append(creators, func() (string, reconciling.SecretReconciler) {
+			return secretName, func(se *corev1.Secret) (*corev1.Secret, error) {
+				kubernetes.EnsureLabels(se, MachineNetworkClientLabels())
+
+				return reconciler(se)
+			}
+		})
+	}
+
+	return creators
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openvpn
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/keyutil"
+)
+
+type fakeOpenVPNData struct {
+	cluster *kubermaticv1.Cluster
+	ca      *resources.ECDSAKeyPair
+}
+
+func (f *fakeOpenVPNData) Cluster() *kubermaticv1.Cluster {
+	return f.cluster
+}
+
+func (f *fakeOpenVPNData) NodeAccessNetwork() string {
+	return kubermaticv1.DefaultNodeAccessNetwork
+}
+
+func (f *fakeOpenVPNData) GetOpenVPNCA() (*resources.ECDSAKeyPair, error) {
+	return f.ca, nil
+}
+
+func newFakeOpenVPNData(t *testing.T, machineNetworks ...string) *fakeOpenVPNData {
+	certPEM, keyPEM, err := certificates.GetECDSACACertAndKey()
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+
+	certs, err := certutil.ParseCertsPEM(certPEM)
+	if err != nil {
+		t.Fatalf("Failed to parse CA certificate: %v", err)
+	}
+
+	key, err := keyutil.ParsePrivateKeyPEM(keyPEM)
+	if err != nil {
+		t.Fatalf("Failed to parse CA key: %v", err)
+	}
+
+	cluster := &kubermaticv1.Cluster{}
+	cluster.Spec.ClusterNetwork.Pods.CIDRBlocks = []string{"172.25.0.0/16"}
+	cluster.Spec.ClusterNetwork.Services.CIDRBlocks = []string{"10.240.16.0/20"}
+	for _, cidr := range machineNetworks {
+		cluster.Spec.MachineNetworks = append(cluster.Spec.MachineNetworks, kubermaticv1.MachineNetworkingConfig{CIDR: cidr})
+	}
+
+	return &fakeOpenVPNData{
+		cluster: cluster,
+		ca: &resources.ECDSAKeyPair{
+			Cert: certs[0],
+			Key:  key.(*ecdsa.PrivateKey),
+		},
+	}
+}
+
+func TestMachineNetworkClientCertificates(t *testing.T) {
+	tests := []struct {
+		name            string
+		machineNetworks []string
+		expectedSecrets []string
+		expectedConfigs []string
+	}{
+		{
+			name:            "no machine networks",
+			expectedConfigs: []string{"user-cluster-client"},
+		},
+		{
+			name:            "single machine network",
+			machineNetworks: []string{"192.168.1.0/24"},
+			expectedSecrets: []string{"openvpn-machine-network-192-168-1-0-24-client-certificates"},
+			expectedConfigs: []string{"machine-network-192-168-1-0-24", "user-cluster-client"},
+		},
+		{
+			name:            "multiple machine networks",
+			machineNetworks: []string{"192.168.1.0/24", "10.10.0.0/16"},
+			expectedSecrets: []string{
+				"openvpn-machine-network-10-10-0-0-16-client-certificates",
+				"openvpn-machine-network-192-168-1-0-24-client-certificates",
+			},
+			expectedConfigs: []string{"machine-network-10-10-0-0-16", "machine-network-192-168-1-0-24", "user-cluster-client"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := newFakeOpenVPNData(t, test.machineNetworks...)
+
+			secretNames := []string{}
+			for _, factory := range MachineNetworkClientCertificateReconcilers(data) {
+				name, reconcile := factory()
+
+				secret, err := reconcile(&corev1.Secret{})
+				if err != nil {
+					t.Fatalf("Failed to reconcile Secret %q: %v", name, err)
+				}
+
+				certs, err := certutil.ParseCertsPEM(secret.Data[resources.OpenVPNInternalClientCertSecretKey])
+				if err != nil {
+					t.Fatalf("Failed to parse client certificate of %q: %v", name, err)
+				}
+
+				if expected := "openvpn-" + certs[0].Subject.CommonName + "-client-certificates"; expected != name {
+					t.Errorf("Expected Secret %q to contain a certificate for its network, got common name %q", name, certs[0].Subject.CommonName)
+				}
+
+				if secret.Labels[resources.AppLabelKey] != MachineNetworkClientAppName {
+					t.Errorf("Expected Secret %q to be labelled as machine network client certificate, got labels %v", name, secret.Labels)
+				}
+
+				secretNames = append(secretNames, name)
+			}
+
+			if !sets.New(secretNames...).Equal(sets.New(test.expectedSecrets...)) {
+				t.Errorf("Expected Secrets %v, got %v", test.expectedSecrets, secretNames)
+			}
+
+			_, reconcile := ServerClientConfigsConfigMapReconciler(data)()
+
+			// start with the configs of a machine network that has been removed in the meantime
+			cm, err := reconcile(&corev1.ConfigMap{Data: map[string]string{"machine-network-10-20-0-0-16": "iroute 10.20.0.0 255.255.0.0\n"}})
+			if err != nil {
+				t.Fatalf("Failed to reconcile ConfigMap: %v", err)
+			}
+
+			configs := sets.List(sets.KeySet(cm.Data))
+			if !sets.New(configs...).Equal(sets.New(test.expectedConfigs...)) {
+				t.Errorf("Expected client configs %v, got %v", test.expectedConfigs, configs)
+			}
+		})
+	}
+}
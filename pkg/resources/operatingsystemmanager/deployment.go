@@ -65,12 +65,13 @@ type operatingSystemManagerData interface {
 	GetGlobalSecretKeySelectorValue(configVar *providerconfig.GlobalSecretKeySelector, key string) (string, error)
 	Cluster() *kubermaticv1.Cluster
 	RewriteImage(string) (string, error)
-	NodeLocalDNSCacheEnabled() bool
+	NodeClusterDNSIP() (string, error)
 	GetCSIMigrationFeatureGates(version *semverlib.Version) []string
 	DC() *kubermaticv1.Datacenter
 	ComputedNodePortRange() string
 	OperatingSystemManagerImageTag() string
 	OperatingSystemManagerImageRepository() string
+	NodeRuntimeConfig() string
 }
 
 // DeploymentReconciler returns the function to create and update the operating system manager deployment.
@@ -110,6 +111,21 @@ func DeploymentReconcilerWithoutInitWrapper(data operatingSystemManagerData) rec
 			}
 
 			volumes := []corev1.Volume{getKubeconfigVolume()}
+			volumeMounts := []corev1.VolumeMount{
+				{
+					Name:      resources.OperatingSystemManagerKubeconfigSecretName,
+					MountPath: "/etc/kubernetes/kubeconfig",
+					ReadOnly:  true,
+				},
+			}
+
+			// the runtime config volume is part of the pod labels, so changing
+			// the config rolls out OSM and new nodes pick it up
+			hasNodeRuntimeConfig := data.NodeRuntimeConfig() != ""
+			if hasNodeRuntimeConfig {
+				volumes = append(volumes, getNodeRuntimeConfigVolume())
+				volumeMounts = append(volumeMounts, getNodeRuntimeConfigVolumeMount())
+			}
 			dep.Spec.Template.Spec.Volumes = volumes
 
 			podLabels, err := data.GetPodTemplateLabels(resources.OperatingSystemManagerDeploymentName, volumes, nil)
@@ -125,12 +141,9 @@ func DeploymentReconcilerWithoutInitWrapper(data operatingSystemManagerData) rec
 				resources.ClusterLastRestartAnnotation: data.Cluster().Annotations[resources.ClusterLastRestartAnnotation],
 			})
 
-			clusterDNSIP := resources.NodeLocalDNSCacheAddress
-			if !data.NodeLocalDNSCacheEnabled() {
-				clusterDNSIP, err = resources.UserClusterDNSResolverIP(data.Cluster())
-				if err != nil {
-					return nil, err
-				}
+			clusterDNSIP, err := data.NodeClusterDNSIP()
+			if err != nil {
+				return nil, err
 			}
 
 			envVars, err := getEnvVars(data)
@@ -151,12 +164,13 @@ func DeploymentReconcilerWithoutInitWrapper(data operatingSystemManagerData) rec
 			}
 
 			cs := &clusterSpec{
-				Name:             data.Cluster().Name,
-				clusterDNSIP:     clusterDNSIP,
-				containerRuntime: data.Cluster().Spec.ContainerRuntime,
-				cloudProvider:    cloudProviderName,
-				podCidr:          podCidr,
-				nodePortRange:    data.ComputedNodePortRange(),
+				Name:              data.Cluster().Name,
+				clusterDNSIP:      clusterDNSIP,
+				containerRuntime:  data.Cluster().Spec.ContainerRuntime,
+				cloudProvider:     cloudProviderName,
+				podCidr:           podCidr,
+				nodePortRange:     data.ComputedNodePortRange(),
+				nodeRuntimeConfig: hasNodeRuntimeConfig,
 			}
 
 			repository := registry.Must(data.RewriteImage(resources.RegistryQuay + "/kubermatic/operating-system-manager"))
@@ -213,13 +227,7 @@ func DeploymentReconcilerWithoutInitWrapper(data operatingSystemManagerData) rec
 						SuccessThreshold:    1,
 						TimeoutSeconds:      15,
 					},
-					VolumeMounts: []corev1.VolumeMount{
-						{
-							Name:      resources.OperatingSystemManagerKubeconfigSecretName,
-							MountPath: "/etc/kubernetes/kubeconfig",
-							ReadOnly:  true,
-						},
-					},
+					VolumeMounts: volumeMounts,
 					SecurityContext: &corev1.SecurityContext{
 						AllowPrivilegeEscalation: resources.Bool(false),
 						ReadOnlyRootFilesystem:   resources.Bool(true),
@@ -249,12 +257,13 @@ func DeploymentReconcilerWithoutInitWrapper(data operatingSystemManagerData) rec
 }
 
 type clusterSpec struct {
-	Name             string
-	clusterDNSIP     string
-	containerRuntime string
-	cloudProvider    string
-	nodePortRange    string
-	podCidr          string
+	Name              string
+	clusterDNSIP      string
+	containerRuntime  string
+	cloudProvider     string
+	nodePortRange     string
+	podCidr           string
+	nodeRuntimeConfig bool
 }
 
 func getFlags(nodeSettings *kubermaticv1.NodeSettings, cs *clusterSpec, externalCloudProvider bool, csiMigrationFeatureGates []string, imagePullSecret *corev1.SecretReference) []string {
@@ -303,6 +312,10 @@ func getFlags(nodeSettings *kubermaticv1.NodeSettings, cs *clusterSpec, external
 		flags = append(flags, "-container-runtime", cs.containerRuntime)
 	}
 
+	if cs.nodeRuntimeConfig {
+		flags = append(flags, "-node-containerd-config-file", nodeRuntimeConfigFile())
+	}
+
 	return flags
 }
 
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operatingsystemmanager
+
+import (
+	"path/filepath"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const nodeRuntimeConfigMountPath = "/etc/kubermatic/node-runtime-config"
+
+type nodeRuntimeConfigData interface {
+	NodeRuntimeConfig() string
+}
+
+// NodeRuntimeConfigConfigMapReconciler returns the function to create and update the ConfigMap
+// holding the custom container runtime configuration, which OSM renders into the provisioning
+// configs of new nodes.
+func NodeRuntimeConfigConfigMapReconciler(data nodeRuntimeConfigData) reconciling.NamedConfigMapReconcilerFactory {
+	return func() (string, reconciling.ConfigMapReconciler) {
+		return resources.NodeRuntimeConfigConfigMapName, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			cm.Data = map[string]string{
+				resources.NodeRuntimeConfigConfigMapKey: data.NodeRuntimeConfig(),
+			}
+
+			return cm, nil
+		}
+	}
+}
+
+func getNodeRuntimeConfigVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: resources.NodeRuntimeConfigConfigMapName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: resources.NodeRuntimeConfigConfigMapName,
+				},
+			},
+		},
+	}
+}
+
+func getNodeRuntimeConfigVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      resources.NodeRuntimeConfigConfigMapName,
+		MountPath: nodeRuntimeConfigMountPath,
+		ReadOnly:  true,
+	}
+}
+
+func nodeRuntimeConfigFile() string {
+	return filepath.Join(nodeRuntimeConfigMountPath, resources.NodeRuntimeConfigConfigMapKey)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operatingsystemmanager
+
+import (
+	"slices"
+	"testing"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+type fakeNodeRuntimeConfigData struct {
+	config string
+}
+
+func (f *fakeNodeRuntimeConfigData) NodeRuntimeConfig() string {
+	return f.config
+}
+
+func TestNodeRuntimeConfigConfigMapReconciler(t *testing.T) {
+	data := &fakeNodeRuntimeConfigData{config: "version = 2\n"}
+
+	name, reconciler := NodeRuntimeConfigConfigMapReconciler(data)()
+	if name != resources.NodeRuntimeConfigConfigMapName {
+		t.Errorf("Expected ConfigMap to be named %q, got %q", resources.NodeRuntimeConfigConfigMapName, name)
+	}
+
+	cm, err := reconciler(&corev1.ConfigMap{Data: map[string]string{"stale": "value"}})
+	if err != nil {
+		t.Fatalf("Failed to reconcile ConfigMap: %v", err)
+	}
+
+	if cm.Data[resources.NodeRuntimeConfigConfigMapKey] != data.config {
+		t.Errorf("Expected ConfigMap to contain %q, got %q", data.config, cm.Data[resources.NodeRuntimeConfigConfigMapKey])
+	}
+
+	if _, ok := cm.Data["stale"]; ok {
+		t.Error("Expected stale keys to be removed from the ConfigMap")
+	}
+}
+
+func TestNodeRuntimeConfigFlag(t *testing.T) {
+	testCases := []struct {
+		name          string
+		runtimeConfig bool
+		expectFlag    bool
+	}{
+		{
+			name: "no runtime config",
+		},
+		{
+			name:          "runtime config",
+			runtimeConfig: true,
+			expectFlag:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := getFlags(nil, &clusterSpec{nodeRuntimeConfig: tc.runtimeConfig}, false, nil, nil)
+
+			idx := slices.Index(flags, "-node-containerd-config-file")
+			if tc.expectFlag != (idx >= 0) {
+				t.Fatalf("Expected flag to be present: %v, got flags %v", tc.expectFlag, flags)
+			}
+
+			if tc.expectFlag && flags[idx+1] != nodeRuntimeConfigFile() {
+				t.Errorf("Expected config file %q, got %q", nodeRuntimeConfigFile(), flags[idx+1])
+			}
+		})
+	}
+}
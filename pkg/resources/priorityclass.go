@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+)
+
+const (
+	// ProductionControlPlanePriorityClassName is the PriorityClass of control plane pods of production clusters.
+	ProductionControlPlanePriorityClassName = "kubermatic-control-plane-production"
+	// DevelopmentControlPlanePriorityClassName is the PriorityClass of control plane pods of development clusters.
+	DevelopmentControlPlanePriorityClassName = "kubermatic-control-plane-development"
+)
+
+// ControlPlanePriorityClassName returns the name of the PriorityClass for control plane pods of
+// clusters in the given tier. Clusters without a tier do not use a PriorityClass.
+func ControlPlanePriorityClassName(tier kubermaticv1.ClusterTier) string {
+	switch tier {
+	case kubermaticv1.ClusterTierProduction:
+		return ProductionControlPlanePriorityClassName
+	case kubermaticv1.ClusterTierDevelopment:
+		return DevelopmentControlPlanePriorityClassName
+	default:
+		return ""
+	}
+}
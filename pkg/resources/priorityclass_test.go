@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+)
+
+func TestControlPlanePriorityClassName(t *testing.T) {
+	testCases := []struct {
+		tier     kubermaticv1.ClusterTier
+		expected string
+	}{
+		{
+			tier:     "",
+			expected: "",
+		},
+		{
+			tier:     kubermaticv1.ClusterTierProduction,
+			expected: ProductionControlPlanePriorityClassName,
+		},
+		{
+			tier:     kubermaticv1.ClusterTierDevelopment,
+			expected: DevelopmentControlPlanePriorityClassName,
+		},
+		{
+			tier:     "unknown",
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.tier), func(t *testing.T) {
+			if name := ControlPlanePriorityClassName(tc.tier); name != tc.expected {
+				t.Errorf("Expected PriorityClass %q for tier %q, got %q", tc.expected, tc.tier, name)
+			}
+		})
+	}
+}
@@ -119,6 +119,15 @@ groups:
     labels:
       severity: warning
 
+  - alert: EtcdDatabaseQuotaApproaching
+    annotations:
+      message: 'Etcd cluster "{{ $labels.job }}": database size on etcd instance {{ $labels.instance }} is {{ $value | humanize }}% of the defined quota, please defrag or increase the quota before writes to etcd are disabled.'
+    expr: |
+      (last_over_time(etcd_mvcc_db_total_size_in_bytes[5m]) / last_over_time(etcd_server_quota_backend_bytes[5m]))*100 > 80
+    for: 10m
+    labels:
+      severity: warning
+
   - alert: EtcdDatabaseQuotaLowSpace
     annotations:
       message: 'Etcd cluster "{{ $labels.job }}": database size exceeds the defined quota on etcd instance {{ $labels.instance }}, please defrag or increase the quota as the writes to etcd will be disabled when it is full.'
@@ -137,6 +146,17 @@ groups:
     labels:
       severity: warning
 
+  - alert: EtcdDatabaseGrowingDespiteCompaction
+    annotations:
+      message: 'Etcd cluster "{{ $labels.job }}": database size in use on etcd instance {{ $labels.instance }} has kept growing for six hours although compactions are running, please check the auto-compaction retention of the cluster.'
+    expr: |
+      deriv(etcd_mvcc_db_total_size_in_use_in_bytes{job="etcd"}[6h]) > 0
+      and on (job, instance)
+      increase(etcd_debugging_mvcc_db_compaction_total_duration_milliseconds_count{job="etcd"}[6h]) > 0
+    for: 6h
+    labels:
+      severity: warning
+
   - alert: EtcdDatabaseHighFragmentationRatio
     annotations:
       message: 'Etcd cluster "{{ $labels.job }}": database size in use on instance {{ $labels.instance }} is {{ $value | humanizePercentage }} of the actual allocated disk space, please run defragmentation (e.g. etcdctl defrag) to retrieve the unused fragmented disk space.'
@@ -45,7 +45,9 @@ type TLSConfig struct {
 type CustomizationData struct {
 	Cluster                  *kubermaticv1.Cluster
 	APIServerHost            string
+	EtcdNamespace            string
 	EtcdTLS                  TLSConfig
+	EtcdPort                 int
 	ApiserverTLS             TLSConfig
 	ScrapingAnnotationPrefix string
 }
@@ -54,6 +56,8 @@ type configTemplateData struct {
 	TemplateData          interface{}
 	APIServerHost         string
 	EtcdTLSConfig         string
+	EtcdNamespace         string
+	EtcdPort              int
 	ApiserverTLSConfig    string
 	CustomScrapingConfigs string
 	// ScrapingAnnotationPrefix is normalized to fit into a Prometheus rewrite rule.
@@ -73,6 +77,18 @@ func ConfigMapReconciler(data *resources.TemplateData) reconciling.NamedConfigMa
 				CertFile: "/etc/etcd/pki/client/apiserver-etcd-client.crt",
 				KeyFile:  "/etc/etcd/pki/client/apiserver-etcd-client.key",
 			}
+			etcdPort := 2379
+
+			// the etcd-launcher serves the metrics on a dedicated listener, which only
+			// accepts the Prometheus scrape certificate signed by the etcd metrics CA
+			if cluster.Spec.Features[kubermaticv1.ClusterFeatureEtcdLauncher] {
+				etcdTLS = TLSConfig{
+					CAFile:   "/etc/etcd/pki/metrics-client/ca.crt",
+					CertFile: "/etc/etcd/pki/metrics-client/" + resources.PrometheusEtcdMetricsClientCertSecretKey,
+					KeyFile:  "/etc/etcd/pki/metrics-client/" + resources.PrometheusEtcdMetricsClientKeySecretKey,
+				}
+				etcdPort = resources.EtcdMetricsTLSPort
+			}
 
 			apiserverTLS := TLSConfig{
 				CAFile:   "/etc/kubernetes/ca.crt",
@@ -87,7 +103,9 @@ func ConfigMapReconciler(data *resources.TemplateData) reconciling.NamedConfigMa
 			customData := &CustomizationData{
 				Cluster:                  cluster,
 				APIServerHost:            cluster.Status.Address.InternalName,
+				EtcdNamespace:            data.EtcdNamespace(),
 				EtcdTLS:                  etcdTLS,
+				EtcdPort:                 etcdPort,
 				ApiserverTLS:             apiserverTLS,
 				ScrapingAnnotationPrefix: scrapeAnnotationPrefix,
 			}
@@ -119,6 +137,8 @@ func ConfigMapReconciler(data *resources.TemplateData) reconciling.NamedConfigMa
 				APIServerHost:            customData.APIServerHost,
 				CustomScrapingConfigs:    customScrapingConfigs,
 				EtcdTLSConfig:            strings.TrimSpace(string(etcdTLSYaml)),
+				EtcdNamespace:            data.EtcdNamespace(),
+				EtcdPort:                 etcdPort,
 				ApiserverTLSConfig:       strings.TrimSpace(string(apiserverTLSYaml)),
 				ScrapingAnnotationPrefix: scrapeAnnotationPrefix,
 			}
@@ -216,9 +236,9 @@ scrape_configs:
 
   static_configs:
   - targets:
-    - 'etcd-0.etcd.{{ .TemplateData.Cluster.Status.NamespaceName }}.svc.cluster.local:2379'
-    - 'etcd-1.etcd.{{ .TemplateData.Cluster.Status.NamespaceName }}.svc.cluster.local:2379'
-    - 'etcd-2.etcd.{{ .TemplateData.Cluster.Status.NamespaceName }}.svc.cluster.local:2379'
+    - 'etcd-0.etcd.{{ .EtcdNamespace }}.svc.cluster.local:{{ .EtcdPort }}'
+    - 'etcd-1.etcd.{{ .EtcdNamespace }}.svc.cluster.local:{{ .EtcdPort }}'
+    - 'etcd-2.etcd.{{ .EtcdNamespace }}.svc.cluster.local:{{ .EtcdPort }}'
 
   relabel_configs:
   - source_labels: [__address__]
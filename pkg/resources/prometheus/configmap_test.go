@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestConfigMapEtcdScrapeTargets(t *testing.T) {
+	tests := []struct {
+		name              string
+		splitNamespace    bool
+		expectedNamespace string
+	}{
+		{
+			name:              "etcd in the cluster namespace",
+			expectedNamespace: testNamespace,
+		},
+		{
+			name:              "etcd in a dedicated namespace",
+			splitNamespace:    true,
+			expectedNamespace: testNamespace + "-etcd",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := testTemplateData("")
+			data.Cluster().Spec.Features = map[string]bool{
+				kubermaticv1.ClusterFeatureSplitEtcdNamespace: test.splitNamespace,
+			}
+
+			_, reconciler := ConfigMapReconciler(data)()
+
+			cm, err := reconciler(&corev1.ConfigMap{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile ConfigMap: %v", err)
+			}
+
+			config := cm.Data["prometheus.yaml"]
+			for _, member := range []string{"etcd-0", "etcd-1", "etcd-2"} {
+				target := member + ".etcd." + test.expectedNamespace + ".svc.cluster.local:2379"
+				if !strings.Contains(config, target) {
+					t.Errorf("Expected scrape target %q in Prometheus config:\n%s", target, config)
+				}
+			}
+
+			// the remaining control plane is still scraped in the cluster namespace
+			if !strings.Contains(config, `- "`+testNamespace+`"`) {
+				t.Errorf("Expected control plane pods in %q to be scraped", testNamespace)
+			}
+		})
+	}
+}
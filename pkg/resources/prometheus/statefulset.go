@@ -18,7 +18,10 @@ package prometheus
 
 import (
 	"fmt"
+	"slices"
+	"time"
 
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/registry"
@@ -37,21 +40,7 @@ const (
 
 	volumeConfigName = "config"
 	volumeDataName   = "data"
-)
-
-var (
-	defaultResourceRequirements = map[string]*corev1.ResourceRequirements{
-		name: {
-			Requests: corev1.ResourceList{
-				corev1.ResourceMemory: resource.MustParse("256Mi"),
-				corev1.ResourceCPU:    resource.MustParse("100m"),
-			},
-			Limits: corev1.ResourceList{
-				corev1.ResourceMemory: resource.MustParse("1Gi"),
-				corev1.ResourceCPU:    resource.MustParse("500m"),
-			},
-		},
-	}
+	volumeTokenName  = "service-account-token"
 )
 
 // StatefulSetReconciler returns the function to reconcile the Prometheus StatefulSet.
@@ -76,18 +65,33 @@ func StatefulSetReconciler(data *resources.TemplateData) reconciling.NamedStatef
 			set.Spec.Replicas = resources.Int32(1)
 			set.Spec.UpdateStrategy.Type = appsv1.RollingUpdateStatefulSetStrategyType
 
-			volumes := getVolumes()
+			sizing := data.PrometheusSizing()
+
+			persistent, err := ensureDataVolumeClaimTemplate(set, sizing.StorageSize)
+			if err != nil {
+				return nil, err
+			}
+
+			etcdMetricsTLS := data.Cluster().Spec.Features[kubermaticv1.ClusterFeatureEtcdLauncher]
+
+			tokenExpiration := data.PrometheusTokenExpiration()
+
+			volumes := getVolumes(persistent, sizing.StorageSize, etcdMetricsTLS, tokenExpiration)
 			podLabels, err := data.GetPodTemplateLabels(name, volumes, requiredBaseLabels)
 			if err != nil {
 				return nil, fmt.Errorf("failed to create pod labels: %w", err)
 			}
 
-			kubernetes.EnsureLabels(&set.Spec.Template, podLabels)
-			kubernetes.EnsureAnnotations(&set.Spec.Template, map[string]string{
+			podAnnotations := map[string]string{
 				resources.ClusterLastRestartAnnotation: data.Cluster().Annotations[resources.ClusterLastRestartAnnotation],
+			}
+			if !persistent {
 				// these volumes should not block the autoscaler from evicting the pod
-				resources.ClusterAutoscalerSafeToEvictVolumesAnnotation: volumeDataName,
-			})
+				podAnnotations[resources.ClusterAutoscalerSafeToEvictVolumesAnnotation] = volumeDataName
+			}
+
+			kubernetes.EnsureLabels(&set.Spec.Template, podLabels)
+			kubernetes.EnsureAnnotations(&set.Spec.Template, podAnnotations)
 
 			set.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyAlways
 			set.Spec.Template.Spec.SecurityContext = &corev1.PodSecurityContext{
@@ -97,9 +101,19 @@ func StatefulSetReconciler(data *resources.TemplateData) reconciling.NamedStatef
 			}
 			set.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: resources.ImagePullSecretName}}
 			set.Spec.Template.Spec.ServiceAccountName = resources.PrometheusServiceAccountName
-			// We don't persist data, so there's no need for a graceful shutdown.
-			// The faster restart time is preferable
-			set.Spec.Template.Spec.TerminationGracePeriodSeconds = resources.Int64(0)
+			set.Spec.Template.Spec.AutomountServiceAccountToken = nil
+			if tokenExpiration > 0 {
+				// The token is projected with a custom lifetime instead, the kubelet refreshes it before it expires.
+				set.Spec.Template.Spec.AutomountServiceAccountToken = resources.Bool(false)
+			}
+			if persistent {
+				// give Prometheus the chance to flush its head block to the volume
+				set.Spec.Template.Spec.TerminationGracePeriodSeconds = resources.Int64(60)
+			} else {
+				// We don't persist data, so there's no need for a graceful shutdown.
+				// The faster restart time is preferable
+				set.Spec.Template.Spec.TerminationGracePeriodSeconds = resources.Int64(0)
+			}
 
 			set.Spec.Template.Spec.Containers = []corev1.Container{
 				{
@@ -110,7 +124,7 @@ func StatefulSetReconciler(data *resources.TemplateData) reconciling.NamedStatef
 						"--storage.tsdb.path=/var/prometheus/data",
 						"--storage.tsdb.min-block-duration=15m",
 						"--storage.tsdb.max-block-duration=30m",
-						"--storage.tsdb.retention.time=1h",
+						fmt.Sprintf("--storage.tsdb.retention.time=%dh", int64(sizing.Retention/time.Hour)),
 						"--web.enable-lifecycle",
 						"--storage.tsdb.no-lockfile",
 						"--web.route-prefix=/",
@@ -132,11 +146,7 @@ func StatefulSetReconciler(data *resources.TemplateData) reconciling.NamedStatef
 							Name:      volumeDataName,
 							MountPath: "/var/prometheus/data",
 						},
-						{
-							Name:      resources.ApiserverEtcdClientCertificateSecretName,
-							MountPath: "/etc/etcd/pki/client",
-							ReadOnly:  true,
-						},
+						getEtcdVolumeMount(etcdMetricsTLS),
 						{
 							Name:      resources.PrometheusApiserverClientCertificateSecretName,
 							MountPath: "/etc/kubernetes",
@@ -173,6 +183,16 @@ func StatefulSetReconciler(data *resources.TemplateData) reconciling.NamedStatef
 					},
 				},
 			}
+			if tokenExpiration > 0 {
+				set.Spec.Template.Spec.Containers[0].VolumeMounts = append(set.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+					Name:      volumeTokenName,
+					MountPath: "/var/run/secrets/kubernetes.io/serviceaccount",
+					ReadOnly:  true,
+				})
+			}
+			defaultResourceRequirements := map[string]*corev1.ResourceRequirements{
+				name: sizing.Resources.DeepCopy(),
+			}
 			err = resources.SetResourceRequirements(set.Spec.Template.Spec.Containers, defaultResourceRequirements, resources.GetOverrides(data.Cluster().Spec.ComponentsOverride), set.Annotations)
 			if err != nil {
 				return nil, fmt.Errorf("failed to set resource requirements: %w", err)
@@ -184,8 +204,72 @@ func StatefulSetReconciler(data *resources.TemplateData) reconciling.NamedStatef
 	}
 }
 
-func getVolumes() []corev1.Volume {
-	return []corev1.Volume{
+// ensureDataVolumeClaimTemplate adds the volume claim template for the Prometheus data to new
+// StatefulSets and returns whether the data is kept on a persistent volume. Volume claim templates
+// are immutable, so StatefulSets created with an emptyDir keep it, and changes to the storage size
+// of existing StatefulSets are refused with an error that describes the required migration.
+func ensureDataVolumeClaimTemplate(set *appsv1.StatefulSet, storageSize resource.Quantity) (bool, error) {
+	if len(set.Spec.VolumeClaimTemplates) > 0 {
+		current := set.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests[corev1.ResourceStorage]
+		if current.Cmp(storageSize) != 0 {
+			desired := storageSize.String()
+			if storageSize.IsZero() {
+				desired = "ephemeral storage"
+			}
+
+			return true, fmt.Errorf("cannot change the Prometheus storage size from %s to %s because volume claim templates are immutable; delete the %s StatefulSet and its PersistentVolumeClaim to migrate", current.String(), desired, resources.PrometheusStatefulSetName)
+		}
+
+		return true, nil
+	}
+
+	if storageSize.IsZero() || !set.CreationTimestamp.IsZero() {
+		return false, nil
+	}
+
+	set.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: volumeDataName,
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: storageSize},
+				},
+			},
+		},
+	}
+
+	return true, nil
+}
+
+// getEtcdVolumeMount returns the mount for the certificate used to scrape etcd. Clusters
+// using the etcd-launcher serve the etcd metrics with a dedicated TLS identity, so Prometheus
+// does not need to hold a certificate for the etcd client endpoint.
+func getEtcdVolumeMount(etcdMetricsTLS bool) corev1.VolumeMount {
+	if etcdMetricsTLS {
+		return corev1.VolumeMount{
+			Name:      resources.PrometheusEtcdMetricsClientCertificateSecretName,
+			MountPath: "/etc/etcd/pki/metrics-client",
+			ReadOnly:  true,
+		}
+	}
+
+	return corev1.VolumeMount{
+		Name:      resources.ApiserverEtcdClientCertificateSecretName,
+		MountPath: "/etc/etcd/pki/client",
+		ReadOnly:  true,
+	}
+}
+
+func getVolumes(persistent bool, storageSize resource.Quantity, etcdMetricsTLS bool, tokenExpiration time.Duration) []corev1.Volume {
+	etcdSecretName := resources.ApiserverEtcdClientCertificateSecretName
+	if etcdMetricsTLS {
+		etcdSecretName = resources.PrometheusEtcdMetricsClientCertificateSecretName
+	}
+
+	volumes := []corev1.Volume{
 		{
 			Name: volumeConfigName,
 			VolumeSource: corev1.VolumeSource{
@@ -197,16 +281,10 @@ func getVolumes() []corev1.Volume {
 			},
 		},
 		{
-			Name: volumeDataName,
-			VolumeSource: corev1.VolumeSource{
-				EmptyDir: &corev1.EmptyDirVolumeSource{},
-			},
-		},
-		{
-			Name: resources.ApiserverEtcdClientCertificateSecretName,
+			Name: etcdSecretName,
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
-					SecretName: resources.ApiserverEtcdClientCertificateSecretName,
+					SecretName: etcdSecretName,
 				},
 			},
 		},
@@ -219,4 +297,61 @@ func getVolumes() []corev1.Volume {
 			},
 		},
 	}
+
+	if tokenExpiration > 0 {
+		volumes = append(volumes, getTokenVolume(tokenExpiration))
+	}
+
+	if !persistent {
+		dataVolume := corev1.Volume{
+			Name: volumeDataName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		}
+		if !storageSize.IsZero() {
+			dataVolume.EmptyDir.SizeLimit = &storageSize
+		}
+
+		// keep the data volume at its original position to not roll existing Prometheus pods
+		volumes = slices.Insert(volumes, 1, dataVolume)
+	}
+
+	return volumes
+}
+
+// getTokenVolume returns a volume with the same content as the automounted ServiceAccount token
+// volume, but with a token that expires after the given duration.
+func getTokenVolume(expiration time.Duration) corev1.Volume {
+	return corev1.Volume{
+		Name: volumeTokenName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{
+					{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Path:              corev1.ServiceAccountTokenKey,
+							ExpirationSeconds: resources.Int64(int64(expiration / time.Second)),
+						},
+					},
+					{
+						ConfigMap: &corev1.ConfigMapProjection{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "kube-root-ca.crt"},
+							Items:                []corev1.KeyToPath{{Key: corev1.ServiceAccountRootCAKey, Path: corev1.ServiceAccountRootCAKey}},
+						},
+					},
+					{
+						DownwardAPI: &corev1.DownwardAPIProjection{
+							Items: []corev1.DownwardAPIVolumeFile{
+								{
+									Path:     corev1.ServiceAccountNamespaceKey,
+									FieldRef: &corev1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.namespace"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
 }
@@ -0,0 +1,307 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheus
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testNamespace = "cluster-62m9k9tqlm"
+
+func testTemplateData(tier kubermaticv1.ClusterTier) *resources.TemplateData {
+	objects := []ctrlruntimeclient.Object{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: resources.PrometheusConfigConfigMapName, ResourceVersion: "1"}},
+	}
+
+	for _, name := range []string{
+		resources.ApiserverEtcdClientCertificateSecretName,
+		resources.PrometheusEtcdMetricsClientCertificateSecretName,
+		resources.PrometheusApiserverClientCertificateSecretName,
+	} {
+		objects = append(objects, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: name, ResourceVersion: "1"}})
+	}
+
+	return resources.NewTemplateDataBuilder().
+		WithContext(context.Background()).
+		WithClient(fakectrlruntimeclient.NewClientBuilder().WithObjects(objects...).Build()).
+		WithCluster(&kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "62m9k9tqlm",
+			},
+			Spec: kubermaticv1.ClusterSpec{
+				Tier: tier,
+			},
+			Status: kubermaticv1.ClusterStatus{
+				NamespaceName: testNamespace,
+			},
+		}).
+		WithSeed(&kubermaticv1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "test-seed"}}).
+		WithKubermaticConfiguration(&kubermaticv1.KubermaticConfiguration{}).
+		Build()
+}
+
+func dataVolume(set *appsv1.StatefulSet) *corev1.Volume {
+	for i, volume := range set.Spec.Template.Spec.Volumes {
+		if volume.Name == volumeDataName {
+			return &set.Spec.Template.Spec.Volumes[i]
+		}
+	}
+
+	return nil
+}
+
+func TestStatefulSetSizingByClusterTier(t *testing.T) {
+	tests := []struct {
+		name              string
+		tier              kubermaticv1.ClusterTier
+		expectedRetention string
+		expectedMemory    string
+		expectedStorage   string
+	}{
+		{
+			name:              "no tier",
+			expectedRetention: "1h",
+			expectedMemory:    "1Gi",
+		},
+		{
+			name:              "production tier",
+			tier:              kubermaticv1.ClusterTierProduction,
+			expectedRetention: "24h",
+			expectedMemory:    "4Gi",
+			expectedStorage:   "20Gi",
+		},
+		{
+			name:              "development tier",
+			tier:              kubermaticv1.ClusterTierDevelopment,
+			expectedRetention: "6h",
+			expectedMemory:    "1Gi",
+			expectedStorage:   "5Gi",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, reconciler := StatefulSetReconciler(testTemplateData(test.tier))()
+
+			set, err := reconciler(&appsv1.StatefulSet{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile StatefulSet: %v", err)
+			}
+
+			container := set.Spec.Template.Spec.Containers[0]
+
+			retentionArg := "--storage.tsdb.retention.time=" + test.expectedRetention
+			if !strings.Contains(strings.Join(container.Args, " "), retentionArg) {
+				t.Errorf("Expected args to contain %q, got %v", retentionArg, container.Args)
+			}
+
+			if memory := container.Resources.Limits[corev1.ResourceMemory]; memory.String() != test.expectedMemory {
+				t.Errorf("Expected memory limit %s, got %s", test.expectedMemory, memory.String())
+			}
+
+			if test.expectedStorage == "" {
+				if len(set.Spec.VolumeClaimTemplates) != 0 {
+					t.Errorf("Expected no volume claim templates, got %v", set.Spec.VolumeClaimTemplates)
+				}
+				if volume := dataVolume(set); volume == nil || volume.EmptyDir == nil {
+					t.Errorf("Expected an emptyDir data volume, got %v", volume)
+				}
+				return
+			}
+
+			if len(set.Spec.VolumeClaimTemplates) != 1 {
+				t.Fatalf("Expected one volume claim template, got %d", len(set.Spec.VolumeClaimTemplates))
+			}
+			if storage := set.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests[corev1.ResourceStorage]; storage.String() != test.expectedStorage {
+				t.Errorf("Expected storage size %s, got %s", test.expectedStorage, storage.String())
+			}
+			if volume := dataVolume(set); volume != nil {
+				t.Errorf("Expected no data volume in the pod template, got %v", volume)
+			}
+		})
+	}
+}
+
+func TestEtcdScrapeCertificate(t *testing.T) {
+	tests := []struct {
+		name              string
+		launcherEnabled   bool
+		expectedSecret    string
+		unexpectedSecret  string
+		expectedMountPath string
+	}{
+		{
+			name:              "etcd-launcher is scraped with the dedicated metrics certificate",
+			launcherEnabled:   true,
+			expectedSecret:    resources.PrometheusEtcdMetricsClientCertificateSecretName,
+			unexpectedSecret:  resources.ApiserverEtcdClientCertificateSecretName,
+			expectedMountPath: "/etc/etcd/pki/metrics-client",
+		},
+		{
+			name:              "plain etcd is scraped with the etcd client certificate",
+			launcherEnabled:   false,
+			expectedSecret:    resources.ApiserverEtcdClientCertificateSecretName,
+			unexpectedSecret:  resources.PrometheusEtcdMetricsClientCertificateSecretName,
+			expectedMountPath: "/etc/etcd/pki/client",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := testTemplateData("")
+			data.Cluster().Spec.Features = map[string]bool{
+				kubermaticv1.ClusterFeatureEtcdLauncher: test.launcherEnabled,
+			}
+
+			_, reconciler := StatefulSetReconciler(data)()
+
+			set, err := reconciler(&appsv1.StatefulSet{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile StatefulSet: %v", err)
+			}
+
+			secretNames := map[string]bool{}
+			for _, volume := range set.Spec.Template.Spec.Volumes {
+				if volume.Secret != nil {
+					secretNames[volume.Secret.SecretName] = true
+				}
+			}
+
+			if !secretNames[test.expectedSecret] {
+				t.Errorf("Expected Secret %q to be mounted", test.expectedSecret)
+			}
+			if secretNames[test.unexpectedSecret] {
+				t.Errorf("Expected Secret %q to not be mounted", test.unexpectedSecret)
+			}
+
+			mounted := false
+			for _, mount := range set.Spec.Template.Spec.Containers[0].VolumeMounts {
+				if mount.Name == test.expectedSecret {
+					mounted = mount.MountPath == test.expectedMountPath && mount.ReadOnly
+				}
+			}
+			if !mounted {
+				t.Errorf("Expected Secret %q to be mounted read-only at %s", test.expectedSecret, test.expectedMountPath)
+			}
+
+			// rotating the scrape certificate must roll Prometheus
+			revisionLabel := test.expectedSecret + "-secret-revision"
+			if _, ok := set.Spec.Template.Labels[revisionLabel]; !ok {
+				t.Errorf("Expected pod template to have the %q label", revisionLabel)
+			}
+		})
+	}
+}
+
+func TestStatefulSetStorageSizeChange(t *testing.T) {
+	existingClaim := func(size string) []corev1.PersistentVolumeClaim {
+		return []corev1.PersistentVolumeClaim{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: volumeDataName},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		tier                  kubermaticv1.ClusterTier
+		existing              *appsv1.StatefulSet
+		expectedErr           bool
+		expectedEmptyDirLimit string
+	}{
+		{
+			name: "unchanged storage size",
+			tier: kubermaticv1.ClusterTierDevelopment,
+			existing: &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Now()},
+				Spec:       appsv1.StatefulSetSpec{VolumeClaimTemplates: existingClaim("5Gi")},
+			},
+		},
+		{
+			name: "changed storage size",
+			tier: kubermaticv1.ClusterTierProduction,
+			existing: &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Now()},
+				Spec:       appsv1.StatefulSetSpec{VolumeClaimTemplates: existingClaim("5Gi")},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "tier removed",
+			existing: &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Now()},
+				Spec:       appsv1.StatefulSetSpec{VolumeClaimTemplates: existingClaim("20Gi")},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "tier added to ephemeral Prometheus",
+			tier: kubermaticv1.ClusterTierProduction,
+			existing: &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Now()},
+			},
+			expectedEmptyDirLimit: "20Gi",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, reconciler := StatefulSetReconciler(testTemplateData(test.tier))()
+
+			set, err := reconciler(test.existing)
+			if (err != nil) != test.expectedErr {
+				t.Fatalf("Expected error = %v, got %v", test.expectedErr, err)
+			}
+			if test.expectedErr {
+				return
+			}
+
+			if test.expectedEmptyDirLimit == "" {
+				return
+			}
+
+			if len(set.Spec.VolumeClaimTemplates) != 0 {
+				t.Errorf("Expected no volume claim templates to be added, got %v", set.Spec.VolumeClaimTemplates)
+			}
+
+			volume := dataVolume(set)
+			if volume == nil || volume.EmptyDir == nil || volume.EmptyDir.SizeLimit == nil {
+				t.Fatalf("Expected a size-limited emptyDir data volume, got %v", volume)
+			}
+			if limit := volume.EmptyDir.SizeLimit.String(); limit != test.expectedEmptyDirLimit {
+				t.Errorf("Expected emptyDir size limit %s, got %s", test.expectedEmptyDirLimit, limit)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// PrometheusSizing describes the resources, storage and retention of the Prometheus
+// running in the cluster namespace.
+type PrometheusSizing struct {
+	// Resources are the default resource requirements of the Prometheus container. They
+	// can still be overridden per cluster via the components override.
+	Resources corev1.ResourceRequirements
+	// StorageSize is the size of the persistent data volume. A zero value means that
+	// Prometheus keeps its data in an emptyDir volume.
+	StorageSize resource.Quantity
+	// Retention is how long Prometheus keeps its samples, in whole hours.
+	Retention time.Duration
+}
+
+// PrometheusSizingForTier returns the Prometheus sizing for clusters in the given tier.
+// Clusters without a tier keep the ephemeral, minimal Prometheus.
+func PrometheusSizingForTier(tier kubermaticv1.ClusterTier) PrometheusSizing {
+	switch tier {
+	case kubermaticv1.ClusterTierProduction:
+		return PrometheusSizing{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+					corev1.ResourceCPU:    resource.MustParse("250m"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+					corev1.ResourceCPU:    resource.MustParse("1"),
+				},
+			},
+			StorageSize: resource.MustParse("20Gi"),
+			Retention:   24 * time.Hour,
+		}
+
+	case kubermaticv1.ClusterTierDevelopment:
+		return PrometheusSizing{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("256Mi"),
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+					corev1.ResourceCPU:    resource.MustParse("500m"),
+				},
+			},
+			StorageSize: resource.MustParse("5Gi"),
+			Retention:   6 * time.Hour,
+		}
+
+	default:
+		return PrometheusSizing{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("256Mi"),
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+					corev1.ResourceCPU:    resource.MustParse("500m"),
+				},
+			},
+			Retention: time.Hour,
+		}
+	}
+}
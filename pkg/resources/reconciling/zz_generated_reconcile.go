@@ -29,6 +29,9 @@ import (
 	appskubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/apps.kubermatic/v1"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	osmv1alpha1 "k8c.io/operating-system-manager/pkg/crd/osm/v1alpha1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	autoscalingk8siov1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
@@ -1145,3 +1148,151 @@ func ReconcileBackupStorageLocations(ctx context.Context, namedFactories []Named
 
 	return nil
 }
+
+// PriorityClassReconciler defines an interface to create/update PriorityClasss.
+type PriorityClassReconciler = func(existing *schedulingv1.PriorityClass) (*schedulingv1.PriorityClass, error)
+
+// NamedPriorityClassReconcilerFactory returns the name of the resource and the corresponding Reconciler function.
+type NamedPriorityClassReconcilerFactory = func() (name string, reconciler PriorityClassReconciler)
+
+// PriorityClassObjectWrapper adds a wrapper so the PriorityClassReconciler matches ObjectReconciler.
+// This is needed as Go does not support function interface matching.
+func PriorityClassObjectWrapper(reconciler PriorityClassReconciler) reconciling.ObjectReconciler {
+	return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		if existing != nil {
+			return reconciler(existing.(*schedulingv1.PriorityClass))
+		}
+		return reconciler(&schedulingv1.PriorityClass{})
+	}
+}
+
+// ReconcilePriorityClasss will create and update the PriorityClasss coming from the passed PriorityClassReconciler slice.
+func ReconcilePriorityClasss(ctx context.Context, namedFactories []NamedPriorityClassReconcilerFactory, namespace string, client ctrlruntimeclient.Client, objectModifiers ...reconciling.ObjectModifier) error {
+	for _, factory := range namedFactories {
+		name, reconciler := factory()
+		reconcileObject := PriorityClassObjectWrapper(reconciler)
+		reconcileObject = reconciling.CreateWithNamespace(reconcileObject, namespace)
+		reconcileObject = reconciling.CreateWithName(reconcileObject, name)
+
+		for _, objectModifier := range objectModifiers {
+			reconcileObject = objectModifier(reconcileObject)
+		}
+
+		if err := reconciling.EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, reconcileObject, client, &schedulingv1.PriorityClass{}, false); err != nil {
+			return fmt.Errorf("failed to ensure PriorityClass %s/%s: %w", namespace, name, err)
+		}
+	}
+
+	return nil
+}
+
+// FlowSchemaReconciler defines an interface to create/update FlowSchemas.
+type FlowSchemaReconciler = func(existing *flowcontrolv1.FlowSchema) (*flowcontrolv1.FlowSchema, error)
+
+// NamedFlowSchemaReconcilerFactory returns the name of the resource and the corresponding Reconciler function.
+type NamedFlowSchemaReconcilerFactory = func() (name string, reconciler FlowSchemaReconciler)
+
+// FlowSchemaObjectWrapper adds a wrapper so the FlowSchemaReconciler matches ObjectReconciler.
+// This is needed as Go does not support function interface matching.
+func FlowSchemaObjectWrapper(reconciler FlowSchemaReconciler) reconciling.ObjectReconciler {
+	return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		if existing != nil {
+			return reconciler(existing.(*flowcontrolv1.FlowSchema))
+		}
+		return reconciler(&flowcontrolv1.FlowSchema{})
+	}
+}
+
+// ReconcileFlowSchemas will create and update the FlowSchemas coming from the passed FlowSchemaReconciler slice.
+func ReconcileFlowSchemas(ctx context.Context, namedFactories []NamedFlowSchemaReconcilerFactory, namespace string, client ctrlruntimeclient.Client, objectModifiers ...reconciling.ObjectModifier) error {
+	for _, factory := range namedFactories {
+		name, reconciler := factory()
+		reconcileObject := FlowSchemaObjectWrapper(reconciler)
+		reconcileObject = reconciling.CreateWithNamespace(reconcileObject, namespace)
+		reconcileObject = reconciling.CreateWithName(reconcileObject, name)
+
+		for _, objectModifier := range objectModifiers {
+			reconcileObject = objectModifier(reconcileObject)
+		}
+
+		if err := reconciling.EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, reconcileObject, client, &flowcontrolv1.FlowSchema{}, false); err != nil {
+			return fmt.Errorf("failed to ensure FlowSchema %s/%s: %w", namespace, name, err)
+		}
+	}
+
+	return nil
+}
+
+// PriorityLevelConfigurationReconciler defines an interface to create/update PriorityLevelConfigurations.
+type PriorityLevelConfigurationReconciler = func(existing *flowcontrolv1.PriorityLevelConfiguration) (*flowcontrolv1.PriorityLevelConfiguration, error)
+
+// NamedPriorityLevelConfigurationReconcilerFactory returns the name of the resource and the corresponding Reconciler function.
+type NamedPriorityLevelConfigurationReconcilerFactory = func() (name string, reconciler PriorityLevelConfigurationReconciler)
+
+// PriorityLevelConfigurationObjectWrapper adds a wrapper so the PriorityLevelConfigurationReconciler matches ObjectReconciler.
+// This is needed as Go does not support function interface matching.
+func PriorityLevelConfigurationObjectWrapper(reconciler PriorityLevelConfigurationReconciler) reconciling.ObjectReconciler {
+	return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		if existing != nil {
+			return reconciler(existing.(*flowcontrolv1.PriorityLevelConfiguration))
+		}
+		return reconciler(&flowcontrolv1.PriorityLevelConfiguration{})
+	}
+}
+
+// ReconcilePriorityLevelConfigurations will create and update the PriorityLevelConfigurations coming from the passed PriorityLevelConfigurationReconciler slice.
+func ReconcilePriorityLevelConfigurations(ctx context.Context, namedFactories []NamedPriorityLevelConfigurationReconcilerFactory, namespace string, client ctrlruntimeclient.Client, objectModifiers ...reconciling.ObjectModifier) error {
+	for _, factory := range namedFactories {
+		name, reconciler := factory()
+		reconcileObject := PriorityLevelConfigurationObjectWrapper(reconciler)
+		reconcileObject = reconciling.CreateWithNamespace(reconcileObject, namespace)
+		reconcileObject = reconciling.CreateWithName(reconcileObject, name)
+
+		for _, objectModifier := range objectModifiers {
+			reconcileObject = objectModifier(reconcileObject)
+		}
+
+		if err := reconciling.EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, reconcileObject, client, &flowcontrolv1.PriorityLevelConfiguration{}, false); err != nil {
+			return fmt.Errorf("failed to ensure PriorityLevelConfiguration %s/%s: %w", namespace, name, err)
+		}
+	}
+
+	return nil
+}
+
+// HorizontalPodAutoscalerReconciler defines an interface to create/update HorizontalPodAutoscalers.
+type HorizontalPodAutoscalerReconciler = func(existing *autoscalingv2.HorizontalPodAutoscaler) (*autoscalingv2.HorizontalPodAutoscaler, error)
+
+// NamedHorizontalPodAutoscalerReconcilerFactory returns the name of the resource and the corresponding Reconciler function.
+type NamedHorizontalPodAutoscalerReconcilerFactory = func() (name string, reconciler HorizontalPodAutoscalerReconciler)
+
+// HorizontalPodAutoscalerObjectWrapper adds a wrapper so the HorizontalPodAutoscalerReconciler matches ObjectReconciler.
+// This is needed as Go does not support function interface matching.
+func HorizontalPodAutoscalerObjectWrapper(reconciler HorizontalPodAutoscalerReconciler) reconciling.ObjectReconciler {
+	return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		if existing != nil {
+			return reconciler(existing.(*autoscalingv2.HorizontalPodAutoscaler))
+		}
+		return reconciler(&autoscalingv2.HorizontalPodAutoscaler{})
+	}
+}
+
+// ReconcileHorizontalPodAutoscalers will create and update the HorizontalPodAutoscalers coming from the passed HorizontalPodAutoscalerReconciler slice.
+func ReconcileHorizontalPodAutoscalers(ctx context.Context, namedFactories []NamedHorizontalPodAutoscalerReconcilerFactory, namespace string, client ctrlruntimeclient.Client, objectModifiers ...reconciling.ObjectModifier) error {
+	for _, factory := range namedFactories {
+		name, reconciler := factory()
+		reconcileObject := HorizontalPodAutoscalerObjectWrapper(reconciler)
+		reconcileObject = reconciling.CreateWithNamespace(reconcileObject, namespace)
+		reconcileObject = reconciling.CreateWithName(reconcileObject, name)
+
+		for _, objectModifier := range objectModifiers {
+			reconcileObject = objectModifier(reconcileObject)
+		}
+
+		if err := reconciling.EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, reconcileObject, client, &autoscalingv2.HorizontalPodAutoscaler{}, false); err != nil {
+			return fmt.Errorf("failed to ensure HorizontalPodAutoscaler %s/%s: %w", namespace, name, err)
+		}
+	}
+
+	return nil
+}
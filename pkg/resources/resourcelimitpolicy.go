@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"k8c.io/reconciler/pkg/reconciling"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResourceLimitPolicyModifier returns an ObjectModifier that shapes the resource requirements of
+// all containers of the reconciled Deployment or StatefulSet according to the given policy. It
+// has to run after all other modifiers that change resource requirements. As the requirements
+// are part of the pod template, changing the policy rolls the affected component.
+func ResourceLimitPolicyModifier(policy kubermaticv1.ControlPlaneResourceLimitPolicy) reconciling.ObjectModifier {
+	return func(create reconciling.ObjectReconciler) reconciling.ObjectReconciler {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			obj, err := create(existing)
+			if err != nil {
+				return obj, err
+			}
+
+			template := podTemplate(obj)
+			if template == nil {
+				return obj, nil
+			}
+
+			for i := range template.Spec.InitContainers {
+				applyResourceLimitPolicy(policy, &template.Spec.InitContainers[i].Resources)
+			}
+
+			for i := range template.Spec.Containers {
+				applyResourceLimitPolicy(policy, &template.Spec.Containers[i].Resources)
+			}
+
+			return obj, nil
+		}
+	}
+}
+
+func applyResourceLimitPolicy(policy kubermaticv1.ControlPlaneResourceLimitPolicy, requirements *corev1.ResourceRequirements) {
+	switch policy {
+	case kubermaticv1.ControlPlaneResourceLimitPolicyRequestsOnly:
+		// Kubernetes defaults missing requests to the limits, so these have to be kept
+		// as requests to not lose the scheduling information.
+		for name, limit := range requirements.Limits {
+			if _, ok := requirements.Requests[name]; !ok {
+				if requirements.Requests == nil {
+					requirements.Requests = corev1.ResourceList{}
+				}
+				requirements.Requests[name] = limit.DeepCopy()
+			}
+		}
+		requirements.Limits = nil
+
+	case kubermaticv1.ControlPlaneResourceLimitPolicyGuaranteed:
+		// The limits are what a component is allowed to consume at most, so these are
+		// reserved for it. Resources without a limit are capped at their request.
+		for name, request := range requirements.Requests {
+			if _, ok := requirements.Limits[name]; !ok {
+				if requirements.Limits == nil {
+					requirements.Limits = corev1.ResourceList{}
+				}
+				requirements.Limits[name] = request.DeepCopy()
+			}
+		}
+		if len(requirements.Limits) > 0 {
+			requirements.Requests = requirements.Limits.DeepCopy()
+		}
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func apiserverTestDeployment(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+	dep := existing.(*appsv1.Deployment)
+	dep.Name = ApiserverDeploymentName
+	dep.Spec.Template.Spec.Containers = []corev1.Container{
+		{
+			Name: KonnectivityServerContainer,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("50m"),
+					corev1.ResourceMemory: resource.MustParse("50Mi"),
+				},
+			},
+		},
+		{
+			Name: ApiserverDeploymentName,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+					corev1.ResourceMemory: resource.MustParse("256Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+			},
+		},
+		{
+			Name: "kms-plugin",
+			Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("64Mi"),
+				},
+			},
+		},
+	}
+
+	return dep, nil
+}
+
+func TestResourceLimitPolicyModifier(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   kubermaticv1.ControlPlaneResourceLimitPolicy
+		expected map[string]corev1.ResourceRequirements
+	}{
+		{
+			name:   "requests and limits",
+			policy: kubermaticv1.ControlPlaneResourceLimitPolicyRequestsAndLimits,
+			expected: map[string]corev1.ResourceRequirements{
+				KonnectivityServerContainer: {
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("50m"),
+						corev1.ResourceMemory: resource.MustParse("50Mi"),
+					},
+				},
+				ApiserverDeploymentName: {
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("100m"),
+						corev1.ResourceMemory: resource.MustParse("256Mi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2"),
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					},
+				},
+				"kms-plugin": {
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("64Mi"),
+					},
+				},
+			},
+		},
+		{
+			name:   "requests only",
+			policy: kubermaticv1.ControlPlaneResourceLimitPolicyRequestsOnly,
+			expected: map[string]corev1.ResourceRequirements{
+				KonnectivityServerContainer: {
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("50m"),
+						corev1.ResourceMemory: resource.MustParse("50Mi"),
+					},
+				},
+				ApiserverDeploymentName: {
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("100m"),
+						corev1.ResourceMemory: resource.MustParse("256Mi"),
+					},
+				},
+				"kms-plugin": {
+					Requests: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("64Mi"),
+					},
+				},
+			},
+		},
+		{
+			name:   "guaranteed",
+			policy: kubermaticv1.ControlPlaneResourceLimitPolicyGuaranteed,
+			expected: map[string]corev1.ResourceRequirements{
+				KonnectivityServerContainer: {
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("50m"),
+						corev1.ResourceMemory: resource.MustParse("50Mi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("50m"),
+						corev1.ResourceMemory: resource.MustParse("50Mi"),
+					},
+				},
+				ApiserverDeploymentName: {
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2"),
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2"),
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					},
+				},
+				"kms-plugin": {
+					Requests: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("64Mi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("64Mi"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj, err := ResourceLimitPolicyModifier(tc.policy)(apiserverTestDeployment)(&appsv1.Deployment{})
+			if err != nil {
+				t.Fatalf("Failed to reconcile Deployment: %v", err)
+			}
+
+			for _, container := range obj.(*appsv1.Deployment).Spec.Template.Spec.Containers {
+				expected := tc.expected[container.Name]
+				if !equality.Semantic.DeepEqual(container.Resources, expected) {
+					t.Errorf("Expected container %q to have resources %v, got %v", container.Name, expected, container.Resources)
+				}
+			}
+		})
+	}
+}
+
+func TestResourceLimitPolicy(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{}
+	data := NewTemplateDataBuilder().WithCluster(cluster).Build()
+
+	if policy := data.ResourceLimitPolicy(); policy != kubermaticv1.ControlPlaneResourceLimitPolicyRequestsAndLimits {
+		t.Errorf("Expected requests and limits to be kept by default, got %q", policy)
+	}
+
+	cluster.Spec.ControlPlaneResourceLimitPolicy = kubermaticv1.ControlPlaneResourceLimitPolicyGuaranteed
+	if policy := data.ResourceLimitPolicy(); policy != kubermaticv1.ControlPlaneResourceLimitPolicyGuaranteed {
+		t.Errorf("Expected configured policy to be used, got %q", policy)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// ResourceOverrides are overrides for the resource requirements of control plane containers.
+// They are keyed by the name of the Deployment or StatefulSet and then by the container name.
+type ResourceOverrides map[string]map[string]corev1.ResourceRequirements
+
+// GetResourceOverrides reads the optional resource override ConfigMap from the cluster namespace.
+// Every key in the ConfigMap is the name of a component, its value a YAML map of container names
+// to resource requirements, for example:
+//
+//	apiserver: |
+//	  apiserver:
+//	    requests:
+//	      cpu: 500m
+func GetResourceOverrides(ctx context.Context, client ctrlruntimeclient.Client, namespace string) (ResourceOverrides, error) {
+	cm := &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ResourceOverridesConfigMapName}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get ConfigMap %s: %w", ResourceOverridesConfigMapName, err)
+	}
+
+	overrides, err := ParseResourceOverrides(cm.Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ConfigMap %s: %w", ResourceOverridesConfigMapName, err)
+	}
+
+	return overrides, nil
+}
+
+// ParseResourceOverrides parses and validates the data of a resource override ConfigMap.
+func ParseResourceOverrides(data map[string]string) (ResourceOverrides, error) {
+	overrides := ResourceOverrides{}
+
+	for component, content := range data {
+		containers := map[string]corev1.ResourceRequirements{}
+		if err := yaml.UnmarshalStrict([]byte(content), &containers); err != nil {
+			return nil, fmt.Errorf("failed to parse overrides for %q: %w", component, err)
+		}
+
+		for container, requirements := range containers {
+			if err := validateResourceOverride(requirements); err != nil {
+				return nil, fmt.Errorf("invalid override for container %q of %q: %w", container, component, err)
+			}
+		}
+
+		overrides[component] = containers
+	}
+
+	return overrides, nil
+}
+
+func validateResourceOverride(requirements corev1.ResourceRequirements) error {
+	for _, list := range []corev1.ResourceList{requirements.Requests, requirements.Limits} {
+		for name, quantity := range list {
+			if quantity.Sign() < 0 {
+				return fmt.Errorf("%s must not be negative, got %s", name, quantity.String())
+			}
+		}
+	}
+
+	for name, request := range requirements.Requests {
+		if limit, ok := requirements.Limits[name]; ok && request.Cmp(limit) > 0 {
+			return fmt.Errorf("%s request %s must not be greater than its limit %s", name, request.String(), limit.String())
+		}
+	}
+
+	return nil
+}
+
+// ResourceOverridesModifier returns an ObjectModifier that merges the resource overrides for the
+// reconciled Deployment or StatefulSet over the requirements set by its reconciler. Only the given
+// quantities are replaced, all others keep their defaults. As the overrides are part of the pod
+// template, changing them rolls the affected component.
+func ResourceOverridesModifier(overrides ResourceOverrides) reconciling.ObjectModifier {
+	return func(create reconciling.ObjectReconciler) reconciling.ObjectReconciler {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			obj, err := create(existing)
+			if err != nil {
+				return obj, err
+			}
+
+			containers := overrides[obj.GetName()]
+			if len(containers) == 0 {
+				return obj, nil
+			}
+
+			template := podTemplate(obj)
+			if template == nil {
+				return obj, nil
+			}
+
+			for i, container := range template.Spec.Containers {
+				override, ok := containers[container.Name]
+				if !ok {
+					continue
+				}
+
+				template.Spec.Containers[i].Resources.Requests = mergeResourceList(container.Resources.Requests, override.Requests)
+				template.Spec.Containers[i].Resources.Limits = mergeResourceList(container.Resources.Limits, override.Limits)
+			}
+
+			return obj, nil
+		}
+	}
+}
+
+func mergeResourceList(base, override corev1.ResourceList) corev1.ResourceList {
+	if len(override) == 0 {
+		return base
+	}
+
+	merged := base.DeepCopy()
+	if merged == nil {
+		merged = corev1.ResourceList{}
+	}
+
+	for name, quantity := range override {
+		merged[name] = quantity.DeepCopy()
+	}
+
+	return merged
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestParseResourceOverrides(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        map[string]string
+		expectedErr bool
+	}{
+		{
+			name: "valid overrides",
+			data: map[string]string{
+				ApiserverDeploymentName: "apiserver:\n  requests:\n    cpu: 500m\n  limits:\n    memory: 2Gi\n",
+				EtcdStatefulSetName:     "etcd:\n  requests:\n    memory: 1Gi\n",
+			},
+		},
+		{
+			name: "invalid quantity",
+			data: map[string]string{
+				ApiserverDeploymentName: "apiserver:\n  requests:\n    cpu: lots\n",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative quantity",
+			data: map[string]string{
+				ApiserverDeploymentName: "apiserver:\n  requests:\n    cpu: -1\n",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "request greater than limit",
+			data: map[string]string{
+				ApiserverDeploymentName: "apiserver:\n  requests:\n    memory: 2Gi\n  limits:\n    memory: 1Gi\n",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unknown field",
+			data: map[string]string{
+				ApiserverDeploymentName: "apiserver:\n  request:\n    cpu: 500m\n",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseResourceOverrides(tc.data)
+			if tc.expectedErr != (err != nil) {
+				t.Errorf("Expected error: %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestGetResourceOverrides(t *testing.T) {
+	const namespace = "cluster-test"
+
+	testCases := []struct {
+		name              string
+		objects           []ctrlruntimeclient.Object
+		expectedOverrides int
+		expectedErr       bool
+	}{
+		{
+			name: "no ConfigMap",
+		},
+		{
+			name: "ConfigMap with overrides",
+			objects: []ctrlruntimeclient.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: ResourceOverridesConfigMapName},
+					Data: map[string]string{
+						SchedulerDeploymentName: "scheduler:\n  requests:\n    cpu: 200m\n",
+					},
+				},
+			},
+			expectedOverrides: 1,
+		},
+		{
+			name: "invalid ConfigMap",
+			objects: []ctrlruntimeclient.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: ResourceOverridesConfigMapName},
+					Data: map[string]string{
+						SchedulerDeploymentName: "scheduler:\n  requests:\n    cpu: fast\n",
+					},
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fakectrlruntimeclient.NewClientBuilder().WithObjects(tc.objects...).Build()
+
+			overrides, err := GetResourceOverrides(context.Background(), client, namespace)
+			if tc.expectedErr != (err != nil) {
+				t.Fatalf("Expected error: %v, got %v", tc.expectedErr, err)
+			}
+
+			if len(overrides) != tc.expectedOverrides {
+				t.Errorf("Expected %d overrides, got %d", tc.expectedOverrides, len(overrides))
+			}
+		})
+	}
+}
+
+func TestResourceOverridesModifier(t *testing.T) {
+	reconciler := func(name string) func(ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			dep := existing.(*appsv1.Deployment)
+			dep.Name = name
+			dep.Spec.Template.Spec.Containers = []corev1.Container{
+				{
+					Name: name,
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("100m"),
+							corev1.ResourceMemory: resource.MustParse("256Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				},
+				{
+					Name: "sidecar",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("10m"),
+						},
+					},
+				},
+			}
+			return dep, nil
+		}
+	}
+
+	reconcile := func(name string, overrides ResourceOverrides) *appsv1.Deployment {
+		obj, err := ResourceOverridesModifier(overrides)(reconciler(name))(&appsv1.Deployment{})
+		if err != nil {
+			t.Fatalf("Failed to reconcile Deployment: %v", err)
+		}
+
+		return obj.(*appsv1.Deployment)
+	}
+
+	overrides, err := ParseResourceOverrides(map[string]string{
+		SchedulerDeploymentName: "scheduler:\n  requests:\n    cpu: 500m\n  limits:\n    memory: 2Gi\n",
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse overrides: %v", err)
+	}
+
+	defaults := reconcile(SchedulerDeploymentName, nil)
+	overridden := reconcile(SchedulerDeploymentName, overrides)
+
+	container := overridden.Spec.Template.Spec.Containers[0]
+	expected := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("500m"),
+			corev1.ResourceMemory: resource.MustParse("256Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("1"),
+			corev1.ResourceMemory: resource.MustParse("2Gi"),
+		},
+	}
+	if !equality.Semantic.DeepEqual(container.Resources, expected) {
+		t.Errorf("Expected overrides to be merged over the defaults, expected %v, got %v", expected, container.Resources)
+	}
+
+	if !equality.Semantic.DeepEqual(overridden.Spec.Template.Spec.Containers[1], defaults.Spec.Template.Spec.Containers[1]) {
+		t.Error("Expected containers without overrides to keep their defaults")
+	}
+
+	// changing the overrides changes the pod template and thereby rolls the component
+	if equality.Semantic.DeepEqual(overridden.Spec.Template, defaults.Spec.Template) {
+		t.Error("Expected overrides to change the pod template")
+	}
+
+	// other components are not affected
+	controllerManager := reconcile(ControllerManagerDeploymentName, overrides)
+	if !equality.Semantic.DeepEqual(controllerManager.Spec.Template, reconcile(ControllerManagerDeploymentName, nil).Spec.Template) {
+		t.Error("Expected overrides to not change the pod templates of other components")
+	}
+}
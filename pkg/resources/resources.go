@@ -25,7 +25,9 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/minio/minio-go/v7"
@@ -59,6 +61,10 @@ const (
 const (
 	// ApiserverDeploymentName is the name of the apiserver deployment.
 	ApiserverDeploymentName = "apiserver"
+	// ApiserverExternalProbeDeploymentName is the name of the deployment probing the apiserver through its external address.
+	ApiserverExternalProbeDeploymentName = "apiserver-external-probe"
+	// EventExporterDeploymentName is the name of the deployment forwarding the events of the user cluster to a sink.
+	EventExporterDeploymentName = "event-exporter"
 	// ControllerManagerDeploymentName is the name for the controller manager deployment.
 	ControllerManagerDeploymentName = "controller-manager"
 	// SchedulerDeploymentName is the name for the scheduler deployment.
@@ -109,12 +115,22 @@ const (
 	EtcdStatefulSetName = "etcd"
 	// EtcdDefaultBackupConfigName is the name for the default (preinstalled) EtcdBackupConfig of a cluster.
 	EtcdDefaultBackupConfigName = "default-backups"
+	// EtcdHibernationBackupConfigPrefix is the name prefix for the one-shot EtcdBackupConfigs
+	// taken before a cluster's control plane is hibernated.
+	EtcdHibernationBackupConfigPrefix = "hibernation-"
+	// EtcdSnapshotBackupConfigPrefix is the name prefix for the one-shot EtcdBackupConfigs
+	// taking on-demand snapshots requested via the EtcdSnapshotAnnotation.
+	EtcdSnapshotBackupConfigPrefix = "snapshot-"
 	// EtcdTLSEnabledAnnotation is the annotation assigned to etcd Pods that run with a TLS peer endpoint.
 	EtcdTLSEnabledAnnotation = "etcd.kubermatic.k8c.io/tls-peer-enabled"
 	// EncryptionConfigurationSecretName is the name of secret storing the API server's EncryptionConfiguration.
 	EncryptionConfigurationSecretName = "apiserver-encryption-configuration"
 	// EncryptionConfigurationKeyName is the name of the secret key that is used to store the configuration file for encryption-at-rest.
 	EncryptionConfigurationKeyName = "encryption-configuration.yaml"
+	// AuthorizationWebhookSecretName is the name of the secret storing the kubeconfig the API server uses to call the webhook authorizer.
+	AuthorizationWebhookSecretName = "apiserver-authorization-webhook"
+	// AuthorizationWebhookKubeconfigSecretKey is the name of the secret key that holds the webhook authorizer kubeconfig.
+	AuthorizationWebhookKubeconfigSecretKey = "webhook.kubeconfig"
 	// NodePortProxyEnvoyDeploymentName is the name of the nodeport-proxy deployment in the user cluster.
 	NodePortProxyEnvoyDeploymentName = "nodeport-proxy-envoy"
 	// NodePortProxyEnvoyContainerName is the name of the envoy container in the nodeport-proxy deployment.
@@ -133,6 +149,19 @@ const (
 	EtcdServiceName = "etcd"
 	// EtcdDefragCronJobName is the name for the defrag cronjob deployment.
 	EtcdDefragCronJobName = "etcd-defragger"
+	// NodeRuntimeConfigConfigMapName is the name of the ConfigMap holding the custom container
+	// runtime configuration for the nodes of a cluster.
+	NodeRuntimeConfigConfigMapName = "node-runtime-config"
+	// NodeRuntimeConfigConfigMapKey is the key of the runtime configuration in the ConfigMap.
+	NodeRuntimeConfigConfigMapKey = "config.toml"
+	// ResourceOverridesConfigMapName is the name of the optional ConfigMap in the cluster namespace
+	// that overrides the resource requirements of control plane containers.
+	ResourceOverridesConfigMapName = "resource-overrides"
+	// EventExporterConfigMapName is the name of the ConfigMap containing the configuration of the event-exporter.
+	EventExporterConfigMapName = "event-exporter-config"
+	// EtcdBackupStorageSecretName is the name of the Secret holding the object-storage
+	// credentials for etcd backups, copied from the seed's default backup destination.
+	EtcdBackupStorageSecretName = "etcd-backup-storage"
 	// OpenVPNServerServiceName is the name for the openvpn server service.
 	OpenVPNServerServiceName = "openvpn-server"
 	// MachineControllerWebhookServiceName is the name of the machine-controller webhook service.
@@ -180,6 +209,10 @@ const (
 	OperatingSystemManagerWebhookServingCertKeyKeyName = "tls.key"
 	// PrometheusApiserverClientCertificateSecretName is the name for the secret containing the client certificate used by prometheus to access the apiserver.
 	PrometheusApiserverClientCertificateSecretName = "prometheus-apiserver-certificate"
+	// PrometheusEtcdMetricsClientCertificateSecretName is the name for the secret containing the client certificate used by prometheus to scrape the etcd metrics.
+	PrometheusEtcdMetricsClientCertificateSecretName = "prometheus-etcd-metrics-certificate"
+	// PrometheusTokenSecretName is the name of the legacy secret which contained the ServiceAccount token used by prometheus.
+	PrometheusTokenSecretName = "prometheus-token"
 	// ClusterAutoscalerKubeconfigSecretName is the name of the kubeconfig secret used for
 	// the cluster-autoscaler.
 	ClusterAutoscalerKubeconfigSecretName = "cluster-autoscaler-kubeconfig"
@@ -193,8 +226,12 @@ const (
 
 	// FrontProxyCASecretName is the name for the secret containing the front proxy ca.
 	FrontProxyCASecretName = "front-proxy-ca"
+	// EtcdMetricsCASecretName is the name for the secret containing the CA for the etcd metrics endpoint.
+	EtcdMetricsCASecretName = "etcd-metrics-ca"
 	// CASecretName is the name for the secret containing the root ca.
 	CASecretName = "ca"
+	// ClusterCABundleSecretName is the name for the secret containing both the root ca and the front proxy ca.
+	ClusterCABundleSecretName = "cluster-ca-bundle"
 	// ApiserverTLSSecretName is the name for the secrets required for the apiserver tls.
 	ApiserverTLSSecretName = "apiserver-tls"
 	// KubeletClientCertificatesSecretName is the name for the secret containing the kubelet client certificates.
@@ -205,6 +242,9 @@ const (
 	TokensSecretName = "tokens"
 	// ViewerTokenSecretName is the name for the secret containing the viewer token.
 	ViewerTokenSecretName = "viewer-token"
+	// ApiserverHealthCheckTokenSecretName is the name for the secret containing the token used
+	// by the apiserver's health probes.
+	ApiserverHealthCheckTokenSecretName = "apiserver-health-check-token"
 	// OpenVPNCASecretName is the name of the secret that contains the OpenVPN CA.
 	OpenVPNCASecretName = "openvpn-ca"
 	// OpenVPNServerCertificatesSecretName is the name for the secret containing the openvpn server certificates.
@@ -217,10 +257,16 @@ const (
 	CSICloudConfigSecretName = "cloud-config-csi"
 	// EtcdTLSCertificateSecretName is the name for the secret containing the etcd tls certificate used for transport security.
 	EtcdTLSCertificateSecretName = "etcd-tls-certificate"
+	// EtcdMetricsTLSCertificateSecretName is the name for the secret containing the etcd tls certificate used to serve metrics.
+	EtcdMetricsTLSCertificateSecretName = "etcd-metrics-tls-certificate"
+	// EtcdPeerTLSCertificateSecretName is the name for the secret containing the etcd tls certificate used for member-to-member communication.
+	EtcdPeerTLSCertificateSecretName = "etcd-peer-tls-certificate"
 	// ApiserverEtcdClientCertificateSecretName is the name for the secret containing the client certificate used by the apiserver for authenticating against etcd.
 	ApiserverEtcdClientCertificateSecretName = "apiserver-etcd-client-certificate"
 	// ApiserverFrontProxyClientCertificateSecretName is the name for the secret containing the apiserver's client certificate for proxy auth.
 	ApiserverFrontProxyClientCertificateSecretName = "apiserver-proxy-client-certificate"
+	// ApiserverFrontProxyClientCertificateCommonName is the common name of the apiserver's client certificate for proxy auth.
+	ApiserverFrontProxyClientCertificateCommonName = "apiserver-aggregator"
 	// GoogleServiceAccountSecretName is the name of the secret that contains the Google Service Account.
 	GoogleServiceAccountSecretName = "google-service-account"
 	// GoogleServiceAccountVolumeName is the name of the volume containing the Google Service Account secret.
@@ -262,10 +308,20 @@ const (
 
 	// AuditWebhookVolumeName is the name of the volume that contains the audit webhook configuration mounted into kube-apisever.
 	AuditWebhookVolumeName = "audit-webhook-backend"
+	// AuditWebhookConfigSecretName is the name of the secret containing the audit webhook configuration
+	// generated from the endpoint configured in the cluster's audit webhook backend settings.
+	AuditWebhookConfigSecretName = "audit-webhook-config"
+	// AuditWebhookConfigSecretKey is the name of the secret key that holds the audit webhook configuration.
+	AuditWebhookConfigSecretKey = "webhook.yaml"
 
 	// AdmissionControlConfigMapName is the name for the configmap that contains the Admission Controller config file.
 	AdmissionControlConfigMapName = "adm-control"
 
+	// SchedulerConfigMapName is the name for the configmap that contains the custom KubeSchedulerConfiguration.
+	SchedulerConfigMapName = "scheduler-config"
+	// SchedulerConfigMapKey is the key in the scheduler configmap holding the configuration file.
+	SchedulerConfigMapKey = "config.yaml"
+
 	// PrometheusServiceAccountName is the name for the Prometheus serviceaccount.
 	PrometheusServiceAccountName = "prometheus"
 
@@ -327,6 +383,10 @@ const (
 	OperatingSystemManagerRoleBindingName = "operating-system-manager"
 	// ClusterInfoAnonymousRoleBindingName is the name for the RoleBinding giving access to the cluster-info ConfigMap to anonymous users.
 	ClusterInfoAnonymousRoleBindingName = "cluster-info"
+	// SchedulerLeaderElectionLeaseName is the name of the Lease the scheduler uses for leader election.
+	SchedulerLeaderElectionLeaseName = "kube-scheduler"
+	// ControllerManagerLeaderElectionLeaseName is the name of the Lease the controller-manager uses for leader election.
+	ControllerManagerLeaderElectionLeaseName = "kube-controller-manager"
 	// MetricsServerAuthReaderRoleName is the name for the metrics server role.
 	MetricsServerAuthReaderRoleName = "metrics-server-auth-reader"
 	// MachineControllerClusterRoleName is the name for the MachineController cluster role.
@@ -366,9 +426,16 @@ const (
 	EtcdPodDisruptionBudgetName = "etcd"
 	// ApiserverPodDisruptionBudgetName is the name of the PDB for the apiserver deployment.
 	ApiserverPodDisruptionBudgetName = "apiserver"
+	// ControllerManagerPodDisruptionBudgetName is the name of the PDB for the controller-manager deployment.
+	ControllerManagerPodDisruptionBudgetName = "controller-manager"
+	// SchedulerPodDisruptionBudgetName is the name of the PDB for the scheduler deployment.
+	SchedulerPodDisruptionBudgetName = "scheduler"
 	// MetricsServerPodDisruptionBudgetName is the name of the PDB for the metrics-server deployment.
 	MetricsServerPodDisruptionBudgetName = "metrics-server"
 
+	// ApiserverHorizontalPodAutoscalerName is the name of the HPA for the apiserver deployment.
+	ApiserverHorizontalPodAutoscalerName = "apiserver"
+
 	// KubermaticNamespace is the main kubermatic namespace.
 	KubermaticNamespace = "kubermatic"
 	// KubermaticWebhookServiceName is the name of the kuberamtic webhook service in seed cluster.
@@ -439,6 +506,8 @@ const (
 	RegistryQuay = "quay.io"
 	// RegistryMCR defines the image registry at Microsoft.
 	RegistryMCR = "mcr.microsoft.com"
+	// RegistryGHCR defines the GitHub container registry.
+	RegistryGHCR = "ghcr.io"
 	// RegistryAnexia defines the anexia specific docker registry.
 	RegistryAnexia = "anx-cr.io"
 
@@ -454,6 +523,10 @@ const (
 	// for more information.
 	ClusterAutoscalerSafeToEvictVolumesAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict-local-volumes"
 
+	// ClusterAutoscalerSafeToEvictAnnotation is an annotation that controls whether the
+	// cluster-autoscaler is allowed to evict a pod when scaling down a node.
+	ClusterAutoscalerSafeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+
 	// MachineCRDName defines the CRD name for machine objects.
 	MachineCRDName = "machines.cluster.k8s.io"
 	// MachineSetCRDName defines the CRD name for machineset objects.
@@ -539,6 +612,23 @@ const (
 	// a UNIX timestamp (or similar) value to trigger cluster control plane restarts. The value of this
 	// annotation is copied into control plane components.
 	ClusterLastRestartAnnotation = "kubermatic.k8c.io/last-restart"
+
+	// ServiceAccountKeyRotationAnnotation is an optional annotation on Cluster objects. Whenever its
+	// value changes, a new service account signing key is generated. The previous key remains valid
+	// for verifying tokens until the rotation grace period has passed.
+	ServiceAccountKeyRotationAnnotation = "kubermatic.k8c.io/rotate-service-account-key"
+
+	// EtcdSnapshotAnnotation is an optional annotation on Cluster objects. Setting it to a new,
+	// unique value takes a one-off etcd snapshot. Once the snapshot has finished, it is recorded
+	// in the cluster status and the annotation is removed again.
+	EtcdSnapshotAnnotation = "kubermatic.io/snapshot-now"
+
+	// SecretRotatedAtAnnotation is set on control plane Secrets and contains the time at
+	// which their data (e.g. certificates or kubeconfigs) was last generated.
+	SecretRotatedAtAnnotation = "kubermatic.k8c.io/rotated-at"
+	// SecretPreviouslyRotatedAtAnnotation contains the value SecretRotatedAtAnnotation had
+	// before the most recent rotation.
+	SecretPreviouslyRotatedAtAnnotation = "kubermatic.k8c.io/previously-rotated-at"
 )
 
 const (
@@ -546,6 +636,8 @@ const (
 	CAKeySecretKey = "ca.key"
 	// CACertSecretKey ca.crt.
 	CACertSecretKey = "ca.crt"
+	// ClusterCABundleSecretKey ca-bundle.crt.
+	ClusterCABundleSecretKey = "ca-bundle.crt"
 	// ApiserverTLSKeySecretKey apiserver-tls.key.
 	ApiserverTLSKeySecretKey = "apiserver-tls.key"
 	// ApiserverTLSCertSecretKey apiserver-tls.crt.
@@ -558,12 +650,29 @@ const (
 	ServiceAccountKeySecretKey = "sa.key"
 	// ServiceAccountKeyPublicKey is the public key for the service account signer key.
 	ServiceAccountKeyPublicKey = "sa.pub"
+	// ServiceAccountVerificationKeysSecretKey contains all public keys that are accepted for verifying
+	// service account tokens, i.e. the current one and all retired ones still in their grace period.
+	ServiceAccountVerificationKeysSecretKey = "sa-verification.pub"
+	// ServiceAccountRetiredPublicKeyPrefix is the prefix of the keys under which public keys of rotated
+	// service account signer keys are kept, followed by the UNIX timestamp of their rotation.
+	ServiceAccountRetiredPublicKeyPrefix = "sa-retired-"
 	// KubeconfigSecretKey kubeconfig.
 	KubeconfigSecretKey = "kubeconfig"
 	// TokensSecretKey tokens.csv.
 	TokensSecretKey = "tokens.csv"
 	// ViewersTokenSecretKey viewersToken.
 	ViewerTokenSecretKey = "viewerToken"
+	// ApiserverHealthCheckTokenSecretKey is the key in the apiserver health check token Secret.
+	ApiserverHealthCheckTokenSecretKey = "token"
+	// NodeBootstrapTokenSecretKey is the key in the tokens Secret under which older versions
+	// stored the current node bootstrap token. It is only used to clean up the Secret.
+	NodeBootstrapTokenSecretKey = "nodeBootstrapToken"
+	// NodeBootstrapTokenSecretKeyPrefix is the prefix of the keys in the tokens Secret under
+	// which older versions stored all valid node bootstrap tokens. It is only used to clean up the Secret.
+	NodeBootstrapTokenSecretKeyPrefix = "node-bootstrap-token-"
+	// NodeBootstrapTokenAnnotationPrefix is the prefix of the annotations on the tokens Secret
+	// which older versions used to record when each node bootstrap token was created.
+	NodeBootstrapTokenAnnotationPrefix = "node-bootstrap-token.kubermatic.k8c.io/"
 	// OpenVPNCACertKey cert.pem, must match CACertSecretKey, otherwise getClusterCAFromLister doesn't work as it has
 	// the key hardcoded.
 	OpenVPNCACertKey = CACertSecretKey
@@ -582,6 +691,10 @@ const (
 	EtcdTLSCertSecretKey = "etcd-tls.crt"
 	// EtcdTLSKeySecretKey etcd-tls.key.
 	EtcdTLSKeySecretKey = "etcd-tls.key"
+	// EtcdPeerTLSCertSecretKey etcd-peer-tls.crt.
+	EtcdPeerTLSCertSecretKey = "etcd-peer-tls.crt"
+	// EtcdPeerTLSKeySecretKey etcd-peer-tls.key.
+	EtcdPeerTLSKeySecretKey = "etcd-peer-tls.key"
 
 	EtcdBackupAndRestoreS3AccessKeyIDKey        = "ACCESS_KEY_ID"
 	EtcdBackupAndRestoreS3SecretKeyAccessKeyKey = "SECRET_ACCESS_KEY"
@@ -605,6 +718,14 @@ const (
 	// BackupEtcdClientCertificateKeySecretKey backup-etcd-client.key.
 	BackupEtcdClientCertificateKeySecretKey = "backup-etcd-client.key"
 
+	// EtcdMetricsTLSCertSecretKey etcd-metrics-tls.crt.
+	EtcdMetricsTLSCertSecretKey = "etcd-metrics-tls.crt"
+	// EtcdMetricsTLSKeySecretKey etcd-metrics-tls.key.
+	EtcdMetricsTLSKeySecretKey = "etcd-metrics-tls.key"
+	// PrometheusEtcdMetricsClientCertSecretKey prometheus-etcd-metrics-client.crt.
+	PrometheusEtcdMetricsClientCertSecretKey = "prometheus-etcd-metrics-client.crt"
+	// PrometheusEtcdMetricsClientKeySecretKey prometheus-etcd-metrics-client.key.
+	PrometheusEtcdMetricsClientKeySecretKey = "prometheus-etcd-metrics-client.key"
 	// PrometheusClientCertificateCertSecretKey prometheus-client.crt.
 	PrometheusClientCertificateCertSecretKey = "prometheus-client.crt"
 	// PrometheusClientCertificateKeySecretKey prometheus-client.key.
@@ -770,6 +891,8 @@ const (
 	EnvoyAgentDeviceSetupImage                 = "kubermatic/network-interface-manager"
 	// Default tunneling agent IP address.
 	DefaultTunnelingAgentIP = "100.64.30.10"
+	// DefaultOpenVPNCipher is the data channel cipher of the OpenVPN tunnel if none is configured.
+	DefaultOpenVPNCipher = "AES-256-GCM"
 )
 
 const (
@@ -885,11 +1008,22 @@ const (
 	EtcdCertFile      = "/etc/etcd/pki/tls/etcd-tls.crt"
 	EtcdKeyFile       = "/etc/etcd/pki/tls/etcd-tls.key"
 
-	EtcdPeerCertFile = "/etc/etcd/pki/tls/etcd-tls.crt"
-	EtcdPeerKeyFile  = "/etc/etcd/pki/tls/etcd-tls.key"
+	EtcdPeerCertFile = "/etc/etcd/pki/peer/etcd-peer-tls.crt"
+	EtcdPeerKeyFile  = "/etc/etcd/pki/peer/etcd-peer-tls.key"
 
 	EtcdClientCertFile = "/etc/etcd/pki/client/apiserver-etcd-client.crt"
 	EtcdClientKeyFile  = "/etc/etcd/pki/client/apiserver-etcd-client.key"
+
+	EtcdMetricsCertFile     = "/etc/etcd/pki/metrics/etcd-metrics-tls.crt"
+	EtcdMetricsKeyFile      = "/etc/etcd/pki/metrics/etcd-metrics-tls.key"
+	EtcdMetricsClientCAFile = "/etc/etcd/pki/metrics/ca.crt"
+
+	// EtcdMetricsTLSPort is the port on which the etcd-launcher serves the etcd metrics
+	// over TLS, only to clients presenting a certificate issued by the etcd metrics CA.
+	EtcdMetricsTLSPort = 2382
+	// EtcdMetricsScraperCommonName is the common name of the client certificate Prometheus
+	// uses to scrape the etcd metrics.
+	EtcdMetricsScraperCommonName = "prometheus-etcd-metrics"
 )
 
 const (
@@ -1037,6 +1171,15 @@ const (
 	NetworkPolicyApiserverInternalAllow             = "apiserver-internal-allow"
 )
 
+const (
+	NetworkPolicyDefaultDenyAllIngress     = "default-deny-all-ingress"
+	NetworkPolicyControlPlaneIngressAllow  = "control-plane-ingress-allow"
+	NetworkPolicyApiserverIngressAllow     = "apiserver-ingress-allow"
+	NetworkPolicyOpenVPNServerIngressAllow = "openvpn-server-ingress-allow"
+	NetworkPolicyEtcdBackupIngressAllow    = "etcd-backup-ingress-allow"
+	NetworkPolicyNodePortProxyIngressAllow = "nodeport-proxy-ingress-allow"
+)
+
 const (
 	UserClusterWebhookDeploymentName        = "usercluster-webhook"
 	UserClusterWebhookServiceName           = "usercluster-webhook"
@@ -1110,6 +1253,17 @@ var allowedTLSCipherSuites = []string{
 	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305",
 }
 
+// defaultEtcdCipherSuites are the TLS 1.2 cipher suites etcd accepts unless configured
+// otherwise. TLS 1.3 cipher suites cannot be restricted and are always available.
+var defaultEtcdCipherSuites = []string{
+	"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305",
+	"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305",
+}
+
 // ECDSAKeyPair is a ECDSA x509 certificate and private key.
 type ECDSAKeyPair struct {
 	Key  *ecdsa.PrivateKey
@@ -1127,6 +1281,11 @@ func GetAllowedTLSCipherSuites() []string {
 	return allowedTLSCipherSuites
 }
 
+// GetDefaultEtcdCipherSuites returns the TLS cipher suites etcd accepts by default.
+func GetDefaultEtcdCipherSuites() []string {
+	return defaultEtcdCipherSuites
+}
+
 // GetClusterExternalIP returns a net.IP for the given Cluster.
 func GetClusterExternalIP(cluster *kubermaticv1.Cluster) (*net.IP, error) {
 	address := cluster.Status.Address
@@ -1185,15 +1344,53 @@ func UserClusterDNSResolverIP(cluster *kubermaticv1.Cluster) (string, error) {
 		return "", fmt.Errorf("failed to get cluster dns ip for cluster `%s`: empty CIDRBlocks", cluster.Name)
 	}
 	block := cluster.Spec.ClusterNetwork.Services.CIDRBlocks[0]
-	_, ipnet, err := net.ParseCIDR(block)
+	ip, err := ClusterDNSIPFromServiceCIDR(block)
 	if err != nil {
-		return "", fmt.Errorf("failed to get cluster dns ip for cluster `%s`: %w", block, err)
+		return "", fmt.Errorf("failed to get cluster dns ip for cluster `%s`: %w", cluster.Name, err)
 	}
-	ip := ipnet.IP
-	ip[len(ip)-1] = ip[len(ip)-1] + 10
+	return ip, nil
+}
+
+// ClusterDNSIPFromServiceCIDR returns the cluster DNS IP for the given service CIDR,
+// which is the 10th address of the CIDR. An error is returned if the CIDR is too
+// small to contain it.
+func ClusterDNSIPFromServiceCIDR(block string) (string, error) {
+	prefix, err := netip.ParsePrefix(block)
+	if err != nil {
+		return "", fmt.Errorf("invalid service cidr %s: %w", block, err)
+	}
+	prefix = prefix.Masked()
+
+	ip := prefix.Addr()
+	for range 10 {
+		ip = ip.Next()
+	}
+
+	if !prefix.Contains(ip) {
+		return "", fmt.Errorf("service cidr %s is too small to contain the cluster dns ip", block)
+	}
+
 	return ip.String(), nil
 }
 
+// ControlPlaneHAReplicas is the number of replicas of the apiserver, controller-manager
+// and scheduler for clusters in the "ha" control plane tier.
+const ControlPlaneHAReplicas int32 = 2
+
+// ControlPlaneReplicas returns the number of replicas for a control plane component,
+// based on the cluster's HA tier. An explicit override always takes precedence.
+func ControlPlaneReplicas(cluster *kubermaticv1.Cluster, override *int32) int32 {
+	if override != nil {
+		return *override
+	}
+
+	if cluster.Spec.ControlPlaneHATier == kubermaticv1.ControlPlaneHATierHA {
+		return ControlPlaneHAReplicas
+	}
+
+	return 1
+}
+
 // InClusterApiserverIP returns the first usable IP of the service cidr.
 // Its the in cluster IP for the apiserver.
 func InClusterApiserverIP(cluster *kubermaticv1.Cluster) (*net.IP, error) {
@@ -1426,6 +1623,11 @@ func GetClusterFrontProxyCA(ctx context.Context, namespace string, client ctrlru
 	return getRSAClusterCAFromLister(ctx, namespace, FrontProxyCASecretName, client)
 }
 
+// GetClusterEtcdMetricsCA returns the CA for the etcd metrics endpoint of the cluster from the lister.
+func GetClusterEtcdMetricsCA(ctx context.Context, namespace string, client ctrlruntimeclient.Client) (*triple.KeyPair, error) {
+	return getRSAClusterCAFromLister(ctx, namespace, EtcdMetricsCASecretName, client)
+}
+
 // GetOpenVPNCA returns the OpenVPN CA of the cluster from the lister.
 func GetOpenVPNCA(ctx context.Context, namespace string, client ctrlruntimeclient.Client) (*ECDSAKeyPair, error) {
 	return getECDSAClusterCAFromLister(ctx, namespace, OpenVPNCASecretName, client)
@@ -1460,6 +1662,40 @@ func GetAbsoluteServiceDNSName(service, namespace string) string {
 	return fmt.Sprintf("%s.%s.svc.cluster.local.", service, namespace)
 }
 
+// GetOpenVPNCipher returns the data channel cipher of the OpenVPN tunnel of the given cluster.
+func GetOpenVPNCipher(cluster *kubermaticv1.Cluster) string {
+	if cipher := cluster.Spec.ClusterNetwork.TunnelCipher; cipher != "" {
+		return cipher
+	}
+
+	return DefaultOpenVPNCipher
+}
+
+// OpenVPNCryptoOptions returns the cipher and compression options shared by the OpenVPN server
+// and its clients, each as the option name followed by its arguments, so that callers can
+// render them as flags or as lines of a config file. The compression algorithm itself is
+// pushed by the server, both sides only have to allow it.
+func OpenVPNCryptoOptions(cipher string, compression kubermaticv1.OpenVPNCompression) [][]string {
+	options := [][]string{{"cipher", cipher}}
+
+	// the key size only fits the default cipher, all others use their cipher-specific default
+	if cipher == DefaultOpenVPNCipher {
+		options = append(options, []string{"auth", "SHA1"}, []string{"keysize", "256"})
+	} else {
+		options = append(options, []string{"data-ciphers", cipher}, []string{"auth", "SHA1"})
+	}
+
+	switch compression {
+	case "":
+	case kubermaticv1.OpenVPNCompressionDisabled:
+		options = append(options, []string{"allow-compression", "no"})
+	default:
+		options = append(options, []string{"allow-compression", "yes"})
+	}
+
+	return options
+}
+
 // SecretRevision returns the resource version of the Secret specified by name.
 func SecretRevision(ctx context.Context, key types.NamespacedName, client ctrlruntimeclient.Client) (string, error) {
 	secret := &corev1.Secret{}
@@ -1535,14 +1771,56 @@ func GetPodTemplateLabels(
 	return podLabels, nil
 }
 
-func GetHTTPProxyEnvVarsFromSeed(seed *kubermaticv1.Seed, inClusterAPIServerURL string) []corev1.EnvVar {
-	if seed.Spec.ProxySettings.Empty() {
+// ImageOverrideComponents are the control plane components whose image can be
+// overridden per cluster.
+var ImageOverrideComponents = sets.New(ApiserverDeploymentName, ControllerManagerDeploymentName, SchedulerDeploymentName, EtcdStatefulSetName)
+
+// LogVerbosityComponents are the control plane components whose log verbosity can be
+// configured per cluster.
+var LogVerbosityComponents = sets.New(ApiserverDeploymentName, ControllerManagerDeploymentName, SchedulerDeploymentName)
+
+// EtcdBackupStorageCredentialsKey returns the key of the shared Secret holding the
+// object-storage credentials of the given backup destination. Credentials without a
+// namespace are looked up in kube-system, where the etcd backup jobs run.
+func EtcdBackupStorageCredentialsKey(destination *kubermaticv1.BackupDestination) (types.NamespacedName, bool) {
+	if destination == nil || destination.Credentials == nil || destination.Credentials.Name == "" {
+		return types.NamespacedName{}, false
+	}
+
+	namespace := destination.Credentials.Namespace
+	if namespace == "" {
+		namespace = metav1.NamespaceSystem
+	}
+
+	return types.NamespacedName{Namespace: namespace, Name: destination.Credentials.Name}, true
+}
+
+// GetControlPlaneProxySettings returns the proxy settings for the control plane components of
+// the given cluster. Settings configured on the cluster take precedence over the seed's.
+func GetControlPlaneProxySettings(seed *kubermaticv1.Seed, cluster *kubermaticv1.Cluster) *kubermaticv1.ProxySettings {
+	settings := &kubermaticv1.ProxySettings{}
+	if cluster.Spec.ControlPlaneProxySettings != nil {
+		settings = cluster.Spec.ControlPlaneProxySettings.DeepCopy()
+	}
+	if seed.Spec.ProxySettings != nil {
+		seed.Spec.ProxySettings.Merge(settings)
+	}
+
+	return settings
+}
+
+// GetHTTPProxyEnvVars returns the proxy environment variables for the control plane components
+// of the given cluster. NO_PROXY always contains the in-cluster apiserver address and the pod
+// and service networks of the user cluster, so that traffic to them is never sent to the proxy.
+func GetHTTPProxyEnvVars(seed *kubermaticv1.Seed, cluster *kubermaticv1.Cluster) []corev1.EnvVar {
+	settings := GetControlPlaneProxySettings(seed, cluster)
+	if settings.Empty() {
 		return nil
 	}
 	var envVars []corev1.EnvVar
 
-	if !seed.Spec.ProxySettings.HTTPProxy.Empty() {
-		value := seed.Spec.ProxySettings.HTTPProxy.String()
+	if !settings.HTTPProxy.Empty() {
+		value := settings.HTTPProxy.String()
 		envVars = []corev1.EnvVar{
 			{
 				Name:  "HTTP_PROXY",
@@ -1563,10 +1841,14 @@ func GetHTTPProxyEnvVarsFromSeed(seed *kubermaticv1.Seed, inClusterAPIServerURL
 		}
 	}
 
-	noProxyValue := inClusterAPIServerURL
-	if !seed.Spec.ProxySettings.NoProxy.Empty() {
-		noProxyValue += "," + seed.Spec.ProxySettings.NoProxy.String()
+	noProxy := []string{cluster.Status.Address.InternalName}
+	noProxy = append(noProxy, cluster.Spec.ClusterNetwork.Services.CIDRBlocks...)
+	noProxy = append(noProxy, cluster.Spec.ClusterNetwork.Pods.CIDRBlocks...)
+	if !settings.NoProxy.Empty() {
+		noProxy = append(noProxy, settings.NoProxy.String())
 	}
+
+	noProxyValue := strings.Join(noProxy, ",")
 	envVars = append(envVars,
 		corev1.EnvVar{Name: "NO_PROXY", Value: noProxyValue},
 		corev1.EnvVar{Name: "no_proxy", Value: noProxyValue},
@@ -1676,6 +1958,29 @@ func SupportsFailureDomainZoneAntiAffinity(ctx context.Context, client ctrlrunti
 	return len(nodeList.Items) != 0, nil
 }
 
+// FailureDomainZones returns the number of distinct availability zones the
+// nodes of the seed cluster are spread across, based on the TopologyKeyZone label.
+func FailureDomainZones(ctx context.Context, client ctrlruntimeclient.Client) (int, error) {
+	selector, err := labels.Parse(TopologyKeyZone)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse selector: %w", err)
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := client.List(ctx, nodeList, &ctrlruntimeclient.ListOptions{LabelSelector: selector}); err != nil {
+		return 0, fmt.Errorf("failed to list nodes having the %s label: %w", TopologyKeyZone, err)
+	}
+
+	zones := sets.New[string]()
+	for _, node := range nodeList.Items {
+		if zone := node.Labels[TopologyKeyZone]; zone != "" {
+			zones.Insert(zone)
+		}
+	}
+
+	return zones.Len(), nil
+}
+
 // BackupCABundleConfigMapName returns the name of the ConfigMap in the kube-system namespace
 // that holds the CA bundle for a given cluster. As the CA bundle technically can be different
 // per usercluster, this is not a constant.
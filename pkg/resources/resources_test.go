@@ -62,11 +62,62 @@ func TestInClusterApiserverIP(t *testing.T) {
 	}
 }
 
+func TestControlPlaneReplicas(t *testing.T) {
+	testCases := []struct {
+		name             string
+		tier             kubermaticv1.ControlPlaneHATier
+		override         *int32
+		expectedReplicas int32
+	}{
+		{
+			name:             "no tier",
+			expectedReplicas: 1,
+		},
+		{
+			name:             "single tier",
+			tier:             kubermaticv1.ControlPlaneHATierSingle,
+			expectedReplicas: 1,
+		},
+		{
+			name:             "ha tier",
+			tier:             kubermaticv1.ControlPlaneHATierHA,
+			expectedReplicas: ControlPlaneHAReplicas,
+		},
+		{
+			name:             "override takes precedence over ha tier",
+			tier:             kubermaticv1.ControlPlaneHATierHA,
+			override:         Int32(3),
+			expectedReplicas: 3,
+		},
+		{
+			name:             "override takes precedence over single tier",
+			tier:             kubermaticv1.ControlPlaneHATierSingle,
+			override:         Int32(2),
+			expectedReplicas: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					ControlPlaneHATier: tc.tier,
+				},
+			}
+
+			if replicas := ControlPlaneReplicas(cluster, tc.override); replicas != tc.expectedReplicas {
+				t.Errorf("Expected %d replicas, got %d", tc.expectedReplicas, replicas)
+			}
+		})
+	}
+}
+
 func TestUserClusterDNSResolverIP(t *testing.T) {
 	testCases := []struct {
 		name           string
 		cidr           string
 		expectedResult string
+		expectedErr    bool
 	}{
 		{
 			name:           "Parse /24",
@@ -78,6 +129,21 @@ func TestUserClusterDNSResolverIP(t *testing.T) {
 			cidr:           "10.240.20.0/20",
 			expectedResult: "10.240.16.10",
 		},
+		{
+			name:           "Parse /28",
+			cidr:           "10.10.10.240/28",
+			expectedResult: "10.10.10.250",
+		},
+		{
+			name:        "CIDR too small for the DNS IP",
+			cidr:        "10.10.10.248/29",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid CIDR",
+			cidr:        "10.10.10.0",
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -86,6 +152,12 @@ func TestUserClusterDNSResolverIP(t *testing.T) {
 			cluster.Spec.ClusterNetwork.Services.CIDRBlocks = []string{tc.cidr}
 
 			result, err := UserClusterDNSResolverIP(cluster)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("Expected error, got %s", result)
+				}
+				return
+			}
 			if err != nil {
 				t.Fatalf("error: %v", err)
 			}
@@ -628,3 +700,71 @@ func TestGetNodePortsAllowedIPRanges(t *testing.T) {
 		})
 	}
 }
+
+func TestGetHTTPProxyEnvVars(t *testing.T) {
+	proxyVars := func(proxy, noProxy string) []corev1.EnvVar {
+		return []corev1.EnvVar{
+			{Name: "HTTP_PROXY", Value: proxy},
+			{Name: "HTTPS_PROXY", Value: proxy},
+			{Name: "http_proxy", Value: proxy},
+			{Name: "https_proxy", Value: proxy},
+			{Name: "NO_PROXY", Value: noProxy},
+			{Name: "no_proxy", Value: noProxy},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		seedSettings    *kubermaticv1.ProxySettings
+		clusterSettings *kubermaticv1.ProxySettings
+		expectedResult  []corev1.EnvVar
+	}{
+		{
+			name: "No proxy configured",
+		},
+		{
+			name: "Seed proxy",
+			seedSettings: &kubermaticv1.ProxySettings{
+				HTTPProxy: kubermaticv1.NewProxyValue("http://seed-proxy"),
+			},
+			expectedResult: proxyVars("http://seed-proxy", "apiserver-external.cluster-test.svc.cluster.local.,10.240.16.0/20,172.25.0.0/16"),
+		},
+		{
+			name: "Cluster proxy takes precedence over seed proxy",
+			seedSettings: &kubermaticv1.ProxySettings{
+				HTTPProxy: kubermaticv1.NewProxyValue("http://seed-proxy"),
+				NoProxy:   kubermaticv1.NewProxyValue("seed.example.com"),
+			},
+			clusterSettings: &kubermaticv1.ProxySettings{
+				HTTPProxy: kubermaticv1.NewProxyValue("http://cluster-proxy"),
+			},
+			expectedResult: proxyVars("http://cluster-proxy", "apiserver-external.cluster-test.svc.cluster.local.,10.240.16.0/20,172.25.0.0/16,seed.example.com"),
+		},
+		{
+			name: "Cluster proxy without seed proxy",
+			clusterSettings: &kubermaticv1.ProxySettings{
+				HTTPProxy: kubermaticv1.NewProxyValue("http://cluster-proxy"),
+				NoProxy:   kubermaticv1.NewProxyValue("*.example.com"),
+			},
+			expectedResult: proxyVars("http://cluster-proxy", "apiserver-external.cluster-test.svc.cluster.local.,10.240.16.0/20,172.25.0.0/16,*.example.com"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			seed := &kubermaticv1.Seed{}
+			seed.Spec.ProxySettings = tc.seedSettings
+
+			cluster := &kubermaticv1.Cluster{}
+			cluster.Spec.ControlPlaneProxySettings = tc.clusterSettings
+			cluster.Spec.ClusterNetwork.Services.CIDRBlocks = []string{"10.240.16.0/20"}
+			cluster.Spec.ClusterNetwork.Pods.CIDRBlocks = []string{"172.25.0.0/16"}
+			cluster.Status.Address.InternalName = "apiserver-external.cluster-test.svc.cluster.local."
+
+			result := GetHTTPProxyEnvVars(seed, cluster)
+			if !diff.SemanticallyEqual(tc.expectedResult, result) {
+				t.Errorf("Expected env vars do not match:\n%v", diff.ObjectDiff(tc.expectedResult, result))
+			}
+		})
+	}
+}
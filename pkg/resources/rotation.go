@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"bytes"
+	"maps"
+	"time"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SecretRotationModifier returns an ObjectModifier that records when the data of a Secret
+// was (re)generated in the SecretRotatedAtAnnotation, keeping the previous timestamp in
+// the SecretPreviouslyRotatedAtAnnotation. The annotations are only touched when the data
+// actually changes, so they never cause reconcile loops on their own.
+func SecretRotationModifier() reconciling.ObjectModifier {
+	return func(create reconciling.ObjectReconciler) reconciling.ObjectReconciler {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			// reconcilers usually modify the existing object in-place
+			var oldData map[string][]byte
+			if secret, ok := existing.(*corev1.Secret); ok {
+				oldData = maps.Clone(secret.Data)
+			}
+
+			obj, err := create(existing)
+			if err != nil {
+				return obj, err
+			}
+
+			secret, ok := obj.(*corev1.Secret)
+			if !ok || maps.EqualFunc(oldData, secret.Data, bytes.Equal) {
+				return obj, nil
+			}
+
+			if secret.Annotations == nil {
+				secret.Annotations = map[string]string{}
+			}
+			if previous, ok := secret.Annotations[SecretRotatedAtAnnotation]; ok {
+				secret.Annotations[SecretPreviouslyRotatedAtAnnotation] = previous
+			}
+			secret.Annotations[SecretRotatedAtAnnotation] = time.Now().UTC().Format(time.RFC3339)
+
+			return obj, nil
+		}
+	}
+}
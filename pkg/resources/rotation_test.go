@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestSecretRotationModifier(t *testing.T) {
+	const previous = "2026-01-01T00:00:00Z"
+
+	testCases := []struct {
+		name             string
+		existing         *corev1.Secret
+		data             map[string][]byte
+		expectRotated    bool
+		expectedPrevious string
+	}{
+		{
+			name:          "new secret is stamped",
+			existing:      &corev1.Secret{},
+			data:          map[string][]byte{"tls.crt": []byte("new")},
+			expectRotated: true,
+		},
+		{
+			name: "rotated secret keeps history",
+			existing: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{SecretRotatedAtAnnotation: previous}},
+				Data:       map[string][]byte{"tls.crt": []byte("old")},
+			},
+			data:             map[string][]byte{"tls.crt": []byte("new")},
+			expectRotated:    true,
+			expectedPrevious: previous,
+		},
+		{
+			name: "unchanged secret is left alone",
+			existing: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{SecretRotatedAtAnnotation: previous}},
+				Data:       map[string][]byte{"tls.crt": []byte("old")},
+			},
+			data: map[string][]byte{"tls.crt": []byte("old")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var reconciler reconciling.ObjectReconciler = func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+				secret := existing.(*corev1.Secret)
+				secret.Data = tc.data
+				return secret, nil
+			}
+
+			obj, err := SecretRotationModifier()(reconciler)(tc.existing)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			annotations := obj.GetAnnotations()
+			rotatedAt := annotations[SecretRotatedAtAnnotation]
+
+			if tc.expectRotated {
+				if rotatedAt == "" || rotatedAt == previous {
+					t.Errorf("Expected a new rotation timestamp, got %q", rotatedAt)
+				}
+			} else if rotatedAt != previous {
+				t.Errorf("Expected rotation timestamp to remain %q, got %q", previous, rotatedAt)
+			}
+
+			if got := annotations[SecretPreviouslyRotatedAtAnnotation]; got != tc.expectedPrevious {
+				t.Errorf("Expected previous rotation timestamp %q, got %q", tc.expectedPrevious, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	configAPIVersion = "kubescheduler.config.k8s.io/v1"
+	configKind       = "KubeSchedulerConfiguration"
+)
+
+// ConfigMapReconciler returns a function to create the ConfigMap containing the
+// KubeSchedulerConfiguration from the cluster spec.
+func ConfigMapReconciler(data *resources.TemplateData) reconciling.NamedConfigMapReconcilerFactory {
+	return func() (string, reconciling.ConfigMapReconciler) {
+		return resources.SchedulerConfigMapName, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			config := data.Cluster().Spec.ComponentsOverride.Scheduler.Configuration
+			if err := ValidateConfiguration(config); err != nil {
+				return nil, fmt.Errorf("invalid scheduler configuration: %w", err)
+			}
+
+			if cm.Data == nil {
+				cm.Data = map[string]string{}
+			}
+			cm.Data[resources.SchedulerConfigMapKey] = config
+
+			return cm, nil
+		}
+	}
+}
+
+// ValidateConfiguration checks that the given YAML is a KubeSchedulerConfiguration
+// the scheduler understands. The content itself is validated by kube-scheduler.
+func ValidateConfiguration(config string) error {
+	var typeMeta metav1.TypeMeta
+	if err := yaml.Unmarshal([]byte(config), &typeMeta); err != nil {
+		return fmt.Errorf("failed to parse configuration: %w", err)
+	}
+
+	if typeMeta.APIVersion != configAPIVersion {
+		return fmt.Errorf("apiVersion must be %q, got %q", configAPIVersion, typeMeta.APIVersion)
+	}
+
+	if typeMeta.Kind != configKind {
+		return fmt.Errorf("kind must be %q, got %q", configKind, typeMeta.Kind)
+	}
+
+	return nil
+}
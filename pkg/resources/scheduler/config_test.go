@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const validConfig = `apiVersion: kubescheduler.config.k8s.io/v1
+kind: KubeSchedulerConfiguration
+profiles:
+- schedulerName: default-scheduler
+  pluginConfig:
+  - name: NodeResourcesFit
+    args:
+      scoringStrategy:
+        type: MostAllocated
+`
+
+func TestValidateConfiguration(t *testing.T) {
+	testCases := []struct {
+		name      string
+		config    string
+		expectErr bool
+	}{
+		{
+			name:   "valid configuration",
+			config: validConfig,
+		},
+		{
+			name:      "malformed YAML",
+			config:    "apiVersion: [",
+			expectErr: true,
+		},
+		{
+			name:      "deprecated apiVersion",
+			config:    "apiVersion: kubescheduler.config.k8s.io/v1beta2\nkind: KubeSchedulerConfiguration\n",
+			expectErr: true,
+		},
+		{
+			name:      "wrong kind",
+			config:    "apiVersion: kubescheduler.config.k8s.io/v1\nkind: KubeProxyConfiguration\n",
+			expectErr: true,
+		},
+		{
+			name:      "empty configuration",
+			config:    "",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateConfiguration(tc.config)
+			if tc.expectErr && err == nil {
+				t.Error("Expected an error, but got none")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("Expected no error, but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestConfigMapReconciler(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{}
+	cluster.Spec.ComponentsOverride.Scheduler.Configuration = validConfig
+
+	data := resources.NewTemplateDataBuilder().WithCluster(cluster).Build()
+
+	name, reconcile := ConfigMapReconciler(data)()
+	if name != resources.SchedulerConfigMapName {
+		t.Errorf("Expected ConfigMap name %q, got %q", resources.SchedulerConfigMapName, name)
+	}
+
+	cm, err := reconcile(&corev1.ConfigMap{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile ConfigMap: %v", err)
+	}
+
+	if cm.Data[resources.SchedulerConfigMapKey] != validConfig {
+		t.Errorf("Expected ConfigMap to contain the configuration, got %q", cm.Data[resources.SchedulerConfigMapKey])
+	}
+
+	cluster.Spec.ComponentsOverride.Scheduler.Configuration = "kind: Pod"
+	if _, err := reconcile(cm); err == nil {
+		t.Error("Expected an error for an invalid configuration, but got none")
+	}
+}
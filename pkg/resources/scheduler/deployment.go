@@ -82,17 +82,22 @@ func DeploymentReconciler(data *resources.TemplateData) reconciling.NamedDeploym
 				flags = append(flags, "--leader-elect-retry-period", fmt.Sprintf("%ds", *rps))
 			}
 
-			dep.Spec.Replicas = resources.Int32(1)
-			if data.Cluster().Spec.ComponentsOverride.Scheduler.Replicas != nil {
-				dep.Spec.Replicas = data.Cluster().Spec.ComponentsOverride.Scheduler.Replicas
+			hasConfig := data.Cluster().Spec.ComponentsOverride.Scheduler.Configuration != ""
+			if hasConfig {
+				flags = append(flags, "--config", "/etc/kubernetes/scheduler/"+resources.SchedulerConfigMapKey)
 			}
 
+			flags = append(flags, data.LogVerbosityFlags(resources.SchedulerDeploymentName)...)
+			flags = append(flags, data.LoggingFlags()...)
+
+			dep.Spec.Replicas = resources.Int32(getReplicas(data.Cluster()))
+
 			dep.Spec.Selector = &metav1.LabelSelector{
 				MatchLabels: baseLabels,
 			}
 
-			volumes := getVolumes(data.IsKonnectivityEnabled())
-			volumeMounts := getVolumeMounts()
+			volumes := getVolumes(data.IsKonnectivityEnabled(), hasConfig)
+			volumeMounts := getVolumeMounts(hasConfig)
 
 			podLabels, err := data.GetPodTemplateLabels(name, volumes, map[string]string{
 				resources.VersionLabel: version.String(),
@@ -110,6 +115,7 @@ func DeploymentReconciler(data *resources.TemplateData) reconciling.NamedDeploym
 			})
 
 			dep.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: resources.ImagePullSecretName}}
+			dep.Spec.Template.Spec.PriorityClassName = data.ControlPlanePriorityClassName()
 
 			dep.Spec.Template.Spec.DNSPolicy, dep.Spec.Template.Spec.DNSConfig, err = resources.UserClusterDNSPolicyAndConfig(data)
 			if err != nil {
@@ -128,7 +134,7 @@ func DeploymentReconciler(data *resources.TemplateData) reconciling.NamedDeploym
 			dep.Spec.Template.Spec.Containers = []corev1.Container{
 				{
 					Name:    resources.SchedulerDeploymentName,
-					Image:   registry.Must(data.RewriteImage(resources.RegistryK8S + "/kube-scheduler:v" + version.String())),
+					Image:   registry.Must(data.ComponentImage(resources.SchedulerDeploymentName, resources.RegistryK8S+"/kube-scheduler:v"+version.String())),
 					Command: []string{"/usr/local/bin/kube-scheduler"},
 					Args:    flags,
 					Env: []corev1.EnvVar{
@@ -189,8 +195,8 @@ func DeploymentReconciler(data *resources.TemplateData) reconciling.NamedDeploym
 	}
 }
 
-func getVolumeMounts() []corev1.VolumeMount {
-	return []corev1.VolumeMount{
+func getVolumeMounts(hasConfig bool) []corev1.VolumeMount {
+	mounts := []corev1.VolumeMount{
 		{
 			Name:      resources.SchedulerKubeconfigSecretName,
 			MountPath: "/etc/kubernetes/kubeconfig",
@@ -207,9 +213,17 @@ func getVolumeMounts() []corev1.VolumeMount {
 			ReadOnly:  true,
 		},
 	}
+	if hasConfig {
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      resources.SchedulerConfigMapName,
+			MountPath: "/etc/kubernetes/scheduler",
+			ReadOnly:  true,
+		})
+	}
+	return mounts
 }
 
-func getVolumes(isKonnectivityEnabled bool) []corev1.Volume {
+func getVolumes(isKonnectivityEnabled, hasConfig bool) []corev1.Volume {
 	vs := []corev1.Volume{
 		{
 			Name: resources.CASecretName,
@@ -254,5 +268,22 @@ func getVolumes(isKonnectivityEnabled bool) []corev1.Volume {
 			},
 		})
 	}
+	if hasConfig {
+		vs = append(vs, corev1.Volume{
+			Name: resources.SchedulerConfigMapName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: resources.SchedulerConfigMapName,
+					},
+				},
+			},
+		})
+	}
 	return vs
 }
+
+// getReplicas returns the number of scheduler replicas for the given cluster.
+func getReplicas(cluster *kubermaticv1.Cluster) int32 {
+	return resources.ControlPlaneReplicas(cluster, cluster.Spec.ComponentsOverride.Scheduler.Replicas)
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// PodDisruptionBudgetReconciler returns a func to create/update the scheduler PodDisruptionBudget.
+// It is only used for clusters running more than one replica, see NeedsPodDisruptionBudget.
+func PodDisruptionBudgetReconciler() reconciling.NamedPodDisruptionBudgetReconcilerFactory {
+	return func() (string, reconciling.PodDisruptionBudgetReconciler) {
+		return resources.SchedulerPodDisruptionBudgetName, func(pdb *policyv1.PodDisruptionBudget) (*policyv1.PodDisruptionBudget, error) {
+			maxUnavailable := intstr.FromInt(1)
+			pdb.Spec = policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: resources.BaseAppLabels(name, nil),
+				},
+				MaxUnavailable: &maxUnavailable,
+			}
+
+			return pdb, nil
+		}
+	}
+}
+
+// NeedsPodDisruptionBudget returns true if the scheduler runs with multiple replicas.
+// A PodDisruptionBudget for a single replica would block draining seed nodes.
+func NeedsPodDisruptionBudget(cluster *kubermaticv1.Cluster) bool {
+	return getReplicas(cluster) > 1
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// InjectedSidecarsAnnotation is set on pod templates and lists the names of all containers
+// that were injected by a SidecarInjection, so they can be removed again later on.
+const InjectedSidecarsAnnotation = "kubermatic.k8c.io/injected-sidecars"
+
+// SidecarInjection describes additional containers (e.g. for logging or a service mesh)
+// that are injected into the pods of control plane components.
+type SidecarInjection struct {
+	// Components are the names of the Deployments and StatefulSets in the cluster
+	// namespace, for example "apiserver" or "etcd".
+	Components []string `json:"components"`
+	// Containers are the sidecar containers to inject.
+	Containers []corev1.Container `json:"containers"`
+}
+
+// LoadSidecarInjections reads and validates a YAML file containing a list of SidecarInjections.
+func LoadSidecarInjections(filename string) ([]SidecarInjection, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var injections []SidecarInjection
+	if err := yaml.UnmarshalStrict(content, &injections); err != nil {
+		return nil, fmt.Errorf("failed to parse file as YAML: %w", err)
+	}
+
+	if err := ValidateSidecarInjections(injections); err != nil {
+		return nil, err
+	}
+
+	return injections, nil
+}
+
+// ValidateSidecarInjections ensures that every sidecar has a name and image and that no
+// component gets two sidecars with the same name.
+func ValidateSidecarInjections(injections []SidecarInjection) error {
+	injected := map[string]sets.Set[string]{}
+
+	for i, injection := range injections {
+		if len(injection.Components) == 0 {
+			return fmt.Errorf("sidecar injection %d does not name any components", i)
+		}
+
+		for _, container := range injection.Containers {
+			if container.Name == "" {
+				return fmt.Errorf("sidecar injection %d contains a container without a name", i)
+			}
+
+			if container.Image == "" {
+				return fmt.Errorf("sidecar container %q has no image", container.Name)
+			}
+
+			for _, component := range injection.Components {
+				if injected[component] == nil {
+					injected[component] = sets.New[string]()
+				}
+
+				if injected[component].Has(container.Name) {
+					return fmt.Errorf("sidecar container %q is injected into %q more than once", container.Name, component)
+				}
+
+				injected[component].Insert(container.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// SidecarInjectionModifier returns an ObjectModifier that appends the configured sidecar
+// containers to the pod templates of matching Deployments and StatefulSets. Sidecars that
+// are no longer configured are removed again. Injection fails if a sidecar has the same
+// name as a container of the component itself.
+func SidecarInjectionModifier(injections []SidecarInjection) reconciling.ObjectModifier {
+	return func(create reconciling.ObjectReconciler) reconciling.ObjectReconciler {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			// reconcilers usually modify the existing object in-place, so remember
+			// the procMount settings the API server defaulted before calling it
+			procMounts := map[string]*corev1.ProcMountType{}
+			if template := podTemplate(existing); template != nil {
+				for _, container := range template.Spec.Containers {
+					if container.SecurityContext != nil {
+						procMounts[container.Name] = container.SecurityContext.ProcMount
+					}
+				}
+			}
+
+			obj, err := create(existing)
+			if err != nil {
+				return obj, err
+			}
+
+			template := podTemplate(obj)
+			if template == nil {
+				return obj, nil
+			}
+
+			var sidecars []corev1.Container
+			for _, injection := range injections {
+				if slices.Contains(injection.Components, obj.GetName()) {
+					sidecars = append(sidecars, injection.Containers...)
+				}
+			}
+
+			if err := injectSidecars(template, sidecars, procMounts); err != nil {
+				return nil, fmt.Errorf("failed to inject sidecars into %s: %w", obj.GetName(), err)
+			}
+
+			return obj, nil
+		}
+	}
+}
+
+func podTemplate(obj ctrlruntimeclient.Object) *corev1.PodTemplateSpec {
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		return &o.Spec.Template
+	case *appsv1.StatefulSet:
+		return &o.Spec.Template
+	default:
+		return nil
+	}
+}
+
+func injectSidecars(template *corev1.PodTemplateSpec, sidecars []corev1.Container, procMounts map[string]*corev1.ProcMountType) error {
+	// Reconcilers that only patch their own containers keep sidecars from previous
+	// reconciliations around, so these have to be removed first.
+	previous := sets.New[string]()
+	if names := template.Annotations[InjectedSidecarsAnnotation]; names != "" {
+		previous.Insert(strings.Split(names, ",")...)
+	}
+
+	containers := slices.DeleteFunc(template.Spec.Containers, func(c corev1.Container) bool {
+		return previous.Has(c.Name)
+	})
+
+	var injected []string
+	for _, sidecar := range sidecars {
+		if slices.ContainsFunc(containers, func(c corev1.Container) bool { return c.Name == sidecar.Name }) {
+			return fmt.Errorf("a container named %q already exists", sidecar.Name)
+		}
+
+		sidecar = *sidecar.DeepCopy()
+		reconciling.DefaultContainer(&sidecar, procMounts[sidecar.Name])
+
+		containers = append(containers, sidecar)
+		injected = append(injected, sidecar.Name)
+	}
+
+	template.Spec.Containers = containers
+
+	if len(injected) == 0 {
+		delete(template.Annotations, InjectedSidecarsAnnotation)
+		return nil
+	}
+
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[InjectedSidecarsAnnotation] = strings.Join(injected, ",")
+
+	return nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var loggingSidecar = corev1.Container{
+	Name:  "logging",
+	Image: "registry.example.com/logging-agent:1.0",
+}
+
+// apiserverReconciler mimics the apiserver reconciler, which replaces all containers
+// of the pod template on each reconciliation.
+func apiserverReconciler(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+	dep := existing.(*appsv1.Deployment)
+	dep.Name = ApiserverDeploymentName
+	dep.Spec.Template.Spec.Containers = []corev1.Container{{
+		Name:  ApiserverDeploymentName,
+		Image: "registry.k8s.io/kube-apiserver:v1.30.0",
+	}}
+
+	return dep, nil
+}
+
+// patchingReconciler only updates its own container and keeps all others.
+func patchingReconciler(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+	dep := existing.(*appsv1.Deployment)
+	dep.Name = ApiserverDeploymentName
+	if len(dep.Spec.Template.Spec.Containers) == 0 {
+		dep.Spec.Template.Spec.Containers = []corev1.Container{{Name: ApiserverDeploymentName}}
+	}
+	dep.Spec.Template.Spec.Containers[0].Image = "registry.k8s.io/kube-apiserver:v1.30.0"
+
+	return dep, nil
+}
+
+func containerNames(dep *appsv1.Deployment) []string {
+	var names []string
+	for _, c := range dep.Spec.Template.Spec.Containers {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+func TestSidecarInjectionModifier(t *testing.T) {
+	testCases := []struct {
+		name               string
+		reconciler         reconciling.ObjectReconciler
+		existing           []SidecarInjection
+		injections         []SidecarInjection
+		expectedContainers []string
+		expectErr          bool
+	}{
+		{
+			name:               "no injections",
+			reconciler:         apiserverReconciler,
+			expectedContainers: []string{ApiserverDeploymentName},
+		},
+		{
+			name:       "inject into apiserver",
+			reconciler: apiserverReconciler,
+			injections: []SidecarInjection{{
+				Components: []string{ApiserverDeploymentName},
+				Containers: []corev1.Container{loggingSidecar},
+			}},
+			expectedContainers: []string{ApiserverDeploymentName, "logging"},
+		},
+		{
+			name:       "ignore other components",
+			reconciler: apiserverReconciler,
+			injections: []SidecarInjection{{
+				Components: []string{EtcdStatefulSetName},
+				Containers: []corev1.Container{loggingSidecar},
+			}},
+			expectedContainers: []string{ApiserverDeploymentName},
+		},
+		{
+			name:       "remove sidecar kept by the reconciler",
+			reconciler: patchingReconciler,
+			existing: []SidecarInjection{{
+				Components: []string{ApiserverDeploymentName},
+				Containers: []corev1.Container{loggingSidecar},
+			}},
+			expectedContainers: []string{ApiserverDeploymentName},
+		},
+		{
+			name:       "replace sidecar kept by the reconciler",
+			reconciler: patchingReconciler,
+			existing: []SidecarInjection{{
+				Components: []string{ApiserverDeploymentName},
+				Containers: []corev1.Container{loggingSidecar},
+			}},
+			injections: []SidecarInjection{{
+				Components: []string{ApiserverDeploymentName},
+				Containers: []corev1.Container{{Name: "mesh", Image: "registry.example.com/mesh-proxy:1.0"}},
+			}},
+			expectedContainers: []string{ApiserverDeploymentName, "mesh"},
+		},
+		{
+			name:       "name collision with the apiserver container",
+			reconciler: apiserverReconciler,
+			injections: []SidecarInjection{{
+				Components: []string{ApiserverDeploymentName},
+				Containers: []corev1.Container{{Name: ApiserverDeploymentName, Image: "registry.example.com/logging-agent:1.0"}},
+			}},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			existing, err := SidecarInjectionModifier(tc.existing)(tc.reconciler)(&appsv1.Deployment{})
+			if err != nil {
+				t.Fatalf("Failed to create existing Deployment: %v", err)
+			}
+
+			reconcile := SidecarInjectionModifier(tc.injections)(tc.reconciler)
+
+			obj, err := reconcile(existing.DeepCopyObject().(ctrlruntimeclient.Object))
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("Expected an error, but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to reconcile: %v", err)
+			}
+
+			dep := obj.(*appsv1.Deployment)
+			if names := containerNames(dep); !equality.Semantic.DeepEqual(names, tc.expectedContainers) {
+				t.Errorf("Expected containers %v, got %v", tc.expectedContainers, names)
+			}
+
+			// reconciling again must not lead to any changes
+			again, err := reconcile(dep.DeepCopy())
+			if err != nil {
+				t.Fatalf("Failed to reconcile again: %v", err)
+			}
+			if !equality.Semantic.DeepEqual(again, dep) {
+				t.Error("Expected injection to be idempotent, but the Deployment changed")
+			}
+		})
+	}
+}
+
+func TestValidateSidecarInjections(t *testing.T) {
+	testCases := []struct {
+		name       string
+		injections []SidecarInjection
+		expectErr  bool
+	}{
+		{
+			name: "valid",
+			injections: []SidecarInjection{
+				{
+					Components: []string{ApiserverDeploymentName, EtcdStatefulSetName},
+					Containers: []corev1.Container{loggingSidecar},
+				},
+			},
+		},
+		{
+			name: "no components",
+			injections: []SidecarInjection{
+				{Containers: []corev1.Container{loggingSidecar}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "container without image",
+			injections: []SidecarInjection{
+				{
+					Components: []string{ApiserverDeploymentName},
+					Containers: []corev1.Container{{Name: "logging"}},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "same sidecar injected twice into the apiserver",
+			injections: []SidecarInjection{
+				{
+					Components: []string{ApiserverDeploymentName},
+					Containers: []corev1.Container{loggingSidecar},
+				},
+				{
+					Components: []string{ApiserverDeploymentName},
+					Containers: []corev1.Container{loggingSidecar},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateSidecarInjections(tc.injections)
+			if tc.expectErr && err == nil {
+				t.Error("Expected an error, but got none")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("Expected no error, but got: %v", err)
+			}
+		})
+	}
+}
@@ -469,6 +469,16 @@ func TestLoadFiles(t *testing.T) {
 									Namespace:       cluster.Status.NamespaceName,
 								},
 							},
+							&corev1.Secret{
+								ObjectMeta: metav1.ObjectMeta{
+									ResourceVersion: "123456",
+									Name:            resources.ApiserverHealthCheckTokenSecretName,
+									Namespace:       cluster.Status.NamespaceName,
+								},
+								Data: map[string][]byte{
+									resources.ApiserverHealthCheckTokenSecretKey: []byte("health-check-token"),
+								},
+							},
 							&corev1.Secret{
 								ObjectMeta: metav1.ObjectMeta{
 									ResourceVersion: "123456",
@@ -525,6 +535,13 @@ func TestLoadFiles(t *testing.T) {
 									Namespace:       cluster.Status.NamespaceName,
 								},
 							},
+							&corev1.Secret{
+								ObjectMeta: metav1.ObjectMeta{
+									ResourceVersion: "123456",
+									Name:            resources.EtcdPeerTLSCertificateSecretName,
+									Namespace:       cluster.Status.NamespaceName,
+								},
+							},
 							&corev1.Secret{
 								ObjectMeta: metav1.ObjectMeta{
 									ResourceVersion: "123456",
@@ -609,6 +626,13 @@ func TestLoadFiles(t *testing.T) {
 									Namespace:       cluster.Status.NamespaceName,
 								},
 							},
+							&corev1.Secret{
+								ObjectMeta: metav1.ObjectMeta{
+									ResourceVersion: "123456",
+									Name:            resources.PrometheusEtcdMetricsClientCertificateSecretName,
+									Namespace:       cluster.Status.NamespaceName,
+								},
+							},
 							&corev1.Secret{
 								ObjectMeta: metav1.ObjectMeta{
 									ResourceVersion: "123456",
@@ -835,6 +859,8 @@ func TestLoadFiles(t *testing.T) {
 						WithNodeAccessNetwork("192.0.2.0/24").
 						WithEtcdDiskSize(resource.MustParse("5Gi")).
 						WithBackupPeriod(20 * time.Minute).
+						WithBackupCount(defaulting.DefaultBackupCount).
+						WithEtcdDefragSchedule(defaulting.DefaultEtcdDefragSchedule).
 						WithUserClusterMLAEnabled(true).
 						WithCABundle(caBundle).
 						WithOIDCIssuerURL("https://dev.kubermatic.io/dex").
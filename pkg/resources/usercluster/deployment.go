@@ -64,7 +64,11 @@ type userclusterControllerData interface {
 	RewriteImage(string) (string, error)
 	Cluster() *kubermaticv1.Cluster
 	NodeLocalDNSCacheEnabled() bool
+	ClusterDNSIP() (string, error)
 	GetOpenVPNServerPort() (int32, error)
+	OpenVPNTunnelMTU() int32
+	OpenVPNCipher() string
+	OpenVPNCompression() kubermaticv1.OpenVPNCompression
 	GetKonnectivityServerPort() (int32, error)
 	GetKonnectivityKeepAliveTime() string
 	GetTunnelingAgentIP() string
@@ -130,7 +134,7 @@ func DeploymentReconciler(data userclusterControllerData) reconciling.NamedDeplo
 
 			dep.Spec.Template.Spec.Volumes = volumes
 
-			dnsClusterIP, err := resources.UserClusterDNSResolverIP(data.Cluster())
+			dnsClusterIP, err := data.ClusterDNSIP()
 			if err != nil {
 				return nil, err
 			}
@@ -187,12 +191,28 @@ func DeploymentReconciler(data userclusterControllerData) reconciling.NamedDeplo
 					return nil, err
 				}
 				args = append(args, "-openvpn-server-port", fmt.Sprint(openvpnServerPort))
+
+				if mtu := data.OpenVPNTunnelMTU(); mtu > 0 {
+					args = append(args, "-openvpn-tunnel-mtu", fmt.Sprint(mtu))
+				}
+
+				if cipher := data.OpenVPNCipher(); cipher != resources.DefaultOpenVPNCipher {
+					args = append(args, "-openvpn-cipher", cipher)
+				}
+
+				if compression := data.OpenVPNCompression(); compression != "" {
+					args = append(args, "-openvpn-compression", string(compression))
+				}
 			}
 
 			if data.Cluster().Spec.Features[kubermaticv1.KubeSystemNetworkPolicies] {
 				args = append(args, "-enable-network-policies")
 			}
 
+			if data.Cluster().Spec.Features[kubermaticv1.ClusterFeatureAPIPriorityAndFairness] {
+				args = append(args, "-enable-api-priority-and-fairness")
+			}
+
 			if data.Cluster().Spec.ExposeStrategy == kubermaticv1.ExposeStrategyTunneling {
 				args = append(args, "-tunneling-agent-ip", data.GetTunnelingAgentIP())
 				args = append(args, "-kas-secure-port", fmt.Sprint(resources.APIServerSecurePort))
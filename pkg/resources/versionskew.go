@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	semverlib "github.com/Masterminds/semver/v3"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/semver"
+
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// ValidateControlPlaneVersions ensures that the apiserver, controller-manager and scheduler are
+// deployed with versions that match the cluster's declared version. During an update, the update
+// controller advances the apiserver one minor release at a time and the controller-manager and
+// scheduler follow afterwards, so these may lag one minor release behind the apiserver, but must
+// never be ahead of it. No component may be newer than the declared version. Image overrides that
+// are tagged with a Kubernetes version must not pin a different minor release than the component
+// would otherwise run.
+func ValidateControlPlaneVersions(cluster *kubermaticv1.Cluster, imageOverrides map[string]string) error {
+	declared := cluster.Spec.Version.Semver()
+	if declared == nil {
+		return fmt.Errorf("invalid cluster version %q", cluster.Spec.Version)
+	}
+
+	versions := map[string]semver.Semver{
+		ApiserverDeploymentName:         cluster.Status.Versions.Apiserver,
+		ControllerManagerDeploymentName: cluster.Status.Versions.ControllerManager,
+		SchedulerDeploymentName:         cluster.Status.Versions.Scheduler,
+	}
+
+	var errs []error
+
+	parsedVersions := map[string]*semverlib.Version{}
+
+	for _, component := range []string{ApiserverDeploymentName, ControllerManagerDeploymentName, SchedulerDeploymentName} {
+		version := versions[component]
+
+		parsed := version.Semver()
+		if parsed == nil {
+			errs = append(errs, fmt.Errorf("%s: invalid version %q", component, version))
+			continue
+		}
+		parsedVersions[component] = parsed
+
+		if parsed.GreaterThan(declared) {
+			errs = append(errs, fmt.Errorf("%s: version %s is newer than the cluster version %s", component, parsed, declared))
+		}
+
+		if override, ok := imageOverrides[component]; ok {
+			if tag := imageVersion(override); tag != nil && (tag.Major() != parsed.Major() || tag.Minor() != parsed.Minor()) {
+				errs = append(errs, fmt.Errorf("%s: image override %q does not match version %s", component, override, parsed))
+			}
+		}
+	}
+
+	if apiserver := parsedVersions[ApiserverDeploymentName]; apiserver != nil {
+		for _, component := range []string{ControllerManagerDeploymentName, SchedulerDeploymentName} {
+			version := parsedVersions[component]
+			if version == nil {
+				continue
+			}
+
+			if version.Major() != apiserver.Major() || version.GreaterThan(apiserver) || apiserver.Minor()-version.Minor() > 1 {
+				errs = append(errs, fmt.Errorf("%s: version %s is incompatible with apiserver version %s, it must be at most one minor release older", component, version, apiserver))
+			}
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// imageVersion returns the Kubernetes version an image is tagged with, or nil if its tag
+// is not a version.
+func imageVersion(image string) *semverlib.Version {
+	image, _, _ = strings.Cut(image, "@")
+
+	// a colon before the last slash separates the registry port, not the tag
+	idx := strings.LastIndex(image, ":")
+	if idx < 0 || idx < strings.LastIndex(image, "/") {
+		return nil
+	}
+
+	version, err := semverlib.NewVersion(image[idx+1:])
+	if err != nil {
+		return nil
+	}
+
+	return version
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/semver"
+)
+
+func TestValidateControlPlaneVersions(t *testing.T) {
+	testCases := []struct {
+		name              string
+		declared          string
+		apiserver         string
+		controllerManager string
+		scheduler         string
+		imageOverrides    map[string]string
+		expectedErr       bool
+	}{
+		{
+			name:              "all components at the declared version",
+			declared:          "1.31.2",
+			apiserver:         "1.31.2",
+			controllerManager: "1.31.2",
+			scheduler:         "1.31.2",
+		},
+		{
+			name:              "update in progress, apiserver updated first",
+			declared:          "1.32.1",
+			apiserver:         "1.32.1",
+			controllerManager: "1.31.2",
+			scheduler:         "1.31.2",
+		},
+		{
+			name:              "multi-step update in progress",
+			declared:          "1.33.0",
+			apiserver:         "1.32.1",
+			controllerManager: "1.32.1",
+			scheduler:         "1.31.2",
+		},
+		{
+			name:              "apiserver newer than the declared version",
+			declared:          "1.31.2",
+			apiserver:         "1.32.0",
+			controllerManager: "1.31.2",
+			scheduler:         "1.31.2",
+			expectedErr:       true,
+		},
+		{
+			name:              "controller-manager ahead of the apiserver",
+			declared:          "1.32.1",
+			apiserver:         "1.31.2",
+			controllerManager: "1.32.1",
+			scheduler:         "1.31.2",
+			expectedErr:       true,
+		},
+		{
+			name:              "scheduler two minor releases behind the apiserver",
+			declared:          "1.32.1",
+			apiserver:         "1.32.1",
+			controllerManager: "1.32.1",
+			scheduler:         "1.30.5",
+			expectedErr:       true,
+		},
+		{
+			name:              "missing version",
+			declared:          "1.31.2",
+			apiserver:         "1.31.2",
+			controllerManager: "1.31.2",
+			expectedErr:       true,
+		},
+		{
+			name:              "image override with matching minor release",
+			declared:          "1.31.2",
+			apiserver:         "1.31.2",
+			controllerManager: "1.31.2",
+			scheduler:         "1.31.2",
+			imageOverrides: map[string]string{
+				ApiserverDeploymentName:         "registry.example.com:5000/kube-apiserver:v1.31.3-canary.1",
+				ControllerManagerDeploymentName: "registry.example.com/kube-controller-manager:custom-build",
+			},
+		},
+		{
+			name:              "image override with skewed minor release",
+			declared:          "1.31.2",
+			apiserver:         "1.31.2",
+			controllerManager: "1.31.2",
+			scheduler:         "1.31.2",
+			imageOverrides: map[string]string{
+				SchedulerDeploymentName: "registry.example.com/kube-scheduler:v1.30.0@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					Version: semver.Semver(tc.declared),
+				},
+				Status: kubermaticv1.ClusterStatus{
+					Versions: kubermaticv1.ClusterVersionsStatus{
+						Apiserver:         semver.Semver(tc.apiserver),
+						ControllerManager: semver.Semver(tc.controllerManager),
+						Scheduler:         semver.Semver(tc.scheduler),
+					},
+				},
+			}
+
+			err := ValidateControlPlaneVersions(cluster, tc.imageOverrides)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("Expected error to be %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package vpnsidecar
 
 import (
+	"fmt"
+
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/registry"
@@ -49,31 +51,42 @@ type openvpnData interface {
 // Also required but not provided by this func:
 // * volumes: resources.OpenVPNClientCertificatesSecretName, resources.CACertSecretName.
 func OpenVPNSidecarContainer(data openvpnData, name string) (*corev1.Container, error) {
+	// the tunnel MTU has to match the one of the server, so it replaces the default link MTU
+	mtuArgs := []string{"--link-mtu", "1432"}
+	if mtu := data.Cluster().Spec.ClusterNetwork.TunnelMTU; mtu != nil {
+		mtuArgs = []string{"--tun-mtu", fmt.Sprint(*mtu)}
+	}
+
+	args := []string{
+		"--client",
+		"--proto", "tcp",
+		"--dev", "tun",
+		"--auth-nocache",
+		"--remote", resources.GetAbsoluteServiceDNSName(resources.OpenVPNServerServiceName, data.Cluster().Status.NamespaceName), "1194",
+		"--nobind",
+		"--connect-timeout", "5",
+		"--connect-retry", "1",
+		"--ca", "/etc/openvpn/pki/client/ca.crt",
+		"--cert", "/etc/openvpn/pki/client/client.crt",
+		"--key", "/etc/openvpn/pki/client/client.key",
+		"--remote-cert-tls", "server",
+	}
+	args = append(args, mtuArgs...)
+	for _, option := range resources.OpenVPNCryptoOptions(resources.GetOpenVPNCipher(data.Cluster()), data.Cluster().Spec.ClusterNetwork.TunnelCompression) {
+		args = append(args, "--"+option[0])
+		args = append(args, option[1:]...)
+	}
+	args = append(args,
+		"--script-security", "2",
+		"--status", "/run/openvpn-status",
+		"--log", "/dev/stdout",
+	)
+
 	return &corev1.Container{
 		Name:    name,
 		Image:   registry.Must(data.RewriteImage(resources.RegistryQuay + "/kubermatic/openvpn:v2.5.2-r0")),
 		Command: []string{"/usr/sbin/openvpn"},
-		Args: []string{
-			"--client",
-			"--proto", "tcp",
-			"--dev", "tun",
-			"--auth-nocache",
-			"--remote", resources.GetAbsoluteServiceDNSName(resources.OpenVPNServerServiceName, data.Cluster().Status.NamespaceName), "1194",
-			"--nobind",
-			"--connect-timeout", "5",
-			"--connect-retry", "1",
-			"--ca", "/etc/openvpn/pki/client/ca.crt",
-			"--cert", "/etc/openvpn/pki/client/client.crt",
-			"--key", "/etc/openvpn/pki/client/client.key",
-			"--remote-cert-tls", "server",
-			"--link-mtu", "1432",
-			"--cipher", "AES-256-GCM",
-			"--auth", "SHA1",
-			"--keysize", "256",
-			"--script-security", "2",
-			"--status", "/run/openvpn-status",
-			"--log", "/dev/stdout",
-		},
+		Args:    args,
 		SecurityContext: &corev1.SecurityContext{
 			Privileged: resources.Bool(true),
 		},
@@ -249,10 +249,10 @@ func testBody(t *testing.T, ctx context.Context, log *zap.SugaredLogger, seedCli
 	}
 
 	log.Info("Checking that all the needed components are up and running...")
-	err = wait.PollUntilContextTimeout(ctx, utils.UserClusterPollInterval, utils.CustomTestTimeout, false, func(ctx context.Context) (bool, error) {
-		return scenario.CheckComponents(ctx, cluster, userClient)
-	})
-	if err != nil {
+	componentsCtx, cancel := context.WithTimeout(ctx, utils.CustomTestTimeout)
+	defer cancel()
+
+	if err := scenario.CheckComponents(componentsCtx, cluster, userClient); err != nil {
 		return fmt.Errorf("failed to wait for components: %w", err)
 	}
 
@@ -18,7 +18,6 @@ package providers
 
 import (
 	"context"
-	"fmt"
 
 	"go.uber.org/zap"
 
@@ -26,7 +25,6 @@ import (
 	"k8c.io/kubermatic/v2/pkg/resources/cloudcontroller"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/jig"
 
-	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -56,19 +54,9 @@ func NewAWSScenario(log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client,
 	}
 }
 
-func (c *AWSScenario) CheckComponents(ctx context.Context, cluster *kubermaticv1.Cluster, userClient ctrlruntimeclient.Client) (bool, error) {
-	ccmDeploy := &appsv1.Deployment{}
-	if err := c.seedClient.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: cluster.Status.NamespaceName, Name: awsCCMDeploymentName}, ccmDeploy); err != nil {
-		return false, fmt.Errorf("failed to get %s deployment: %w", awsCCMDeploymentName, err)
-	}
-	if ccmDeploy.Status.AvailableReplicas == 1 {
-		return true, nil
-	}
-
-	nodeDaemonSet := &appsv1.DaemonSet{}
-	if err := userClient.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: metav1.NamespaceSystem, Name: awsCSIDaemonSetName}, nodeDaemonSet); err != nil {
-		return false, fmt.Errorf("failed to get %s daemonset: %w", awsCSIDaemonSetName, err)
-	}
-
-	return nodeDaemonSet.Status.NumberReady == nodeDaemonSet.Status.DesiredNumberScheduled, nil
+func (c *AWSScenario) CheckComponents(ctx context.Context, cluster *kubermaticv1.Cluster, userClient ctrlruntimeclient.Client) error {
+	return waitForComponents(ctx,
+		deploymentAvailable(c.seedClient, cluster.Status.NamespaceName, awsCCMDeploymentName),
+		daemonSetReady(userClient, metav1.NamespaceSystem, awsCSIDaemonSetName),
+	)
 }
@@ -18,15 +18,14 @@ package providers
 
 import (
 	"context"
-	"fmt"
 
 	"go.uber.org/zap"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/cloudcontroller"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/jig"
 
-	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -56,19 +55,9 @@ func NewGCPScenario(log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client,
 	}
 }
 
-func (c *GCPScenario) CheckComponents(ctx context.Context, cluster *kubermaticv1.Cluster, userClient ctrlruntimeclient.Client) (bool, error) {
-	ccmDeploy := &appsv1.Deployment{}
-	if err := c.seedClient.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: fmt.Sprintf("cluster-%s", cluster.Name), Name: gcpCCMDeploymentName}, ccmDeploy); err != nil {
-		return false, fmt.Errorf("failed to get %s deployment: %w", gcpCCMDeploymentName, err)
-	}
-	if ccmDeploy.Status.AvailableReplicas == 1 {
-		return true, nil
-	}
-
-	nodeDaemonSet := &appsv1.DaemonSet{}
-	if err := userClient.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: metav1.NamespaceSystem, Name: gcpCSIDaemonSetName}, nodeDaemonSet); err != nil {
-		return false, fmt.Errorf("failed to get %s daemonset: %w", gcpCSIDaemonSetName, err)
-	}
-
-	return nodeDaemonSet.Status.NumberReady == nodeDaemonSet.Status.DesiredNumberScheduled, nil
+func (c *GCPScenario) CheckComponents(ctx context.Context, cluster *kubermaticv1.Cluster, userClient ctrlruntimeclient.Client) error {
+	return waitForComponents(ctx,
+		deploymentAvailable(c.seedClient, resources.NamespaceName(cluster), gcpCCMDeploymentName),
+		daemonSetReady(userClient, metav1.NamespaceSystem, gcpCSIDaemonSetName),
+	)
 }
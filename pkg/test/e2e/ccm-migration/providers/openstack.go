@@ -18,15 +18,14 @@ package providers
 
 import (
 	"context"
-	"fmt"
 
 	"go.uber.org/zap"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/cloudcontroller"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/jig"
 
-	appsv1 "k8s.io/api/apps/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -54,14 +53,8 @@ func NewOpenstackScenario(log *zap.SugaredLogger, seedClient ctrlruntimeclient.C
 	}
 }
 
-func (c *OpenstackScenario) CheckComponents(ctx context.Context, cluster *kubermaticv1.Cluster, userClient ctrlruntimeclient.Client) (bool, error) {
-	ccmDeploy := &appsv1.Deployment{}
-	if err := c.seedClient.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: fmt.Sprintf("cluster-%s", cluster.Name), Name: osCCMDeploymentName}, ccmDeploy); err != nil {
-		return false, fmt.Errorf("failed to get %s deployment: %w", osCCMDeploymentName, err)
-	}
-	if ccmDeploy.Status.AvailableReplicas == 1 {
-		return true, nil
-	}
-
-	return false, nil
+func (c *OpenstackScenario) CheckComponents(ctx context.Context, cluster *kubermaticv1.Cluster, userClient ctrlruntimeclient.Client) error {
+	return waitForComponents(ctx,
+		deploymentAvailable(c.seedClient, resources.NamespaceName(cluster), osCCMDeploymentName),
+	)
 }
@@ -21,8 +21,12 @@ import (
 	"fmt"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/e2e/ccm-migration/utils"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/jig"
 
+	appsv1 "k8s.io/api/apps/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -38,7 +42,9 @@ type TestScenario interface {
 	ClusterJig() *jig.ClusterJig
 	MachineJig() *jig.MachineJig
 	Setup(ctx context.Context) (*kubermaticv1.Cluster, error)
-	CheckComponents(ctx context.Context, cluster *kubermaticv1.Cluster, userClient ctrlruntimeclient.Client) (bool, error)
+	// CheckComponents waits until all provider specific components are ready or the context
+	// expires; in the latter case, the returned error lists all components that are not ready.
+	CheckComponents(ctx context.Context, cluster *kubermaticv1.Cluster, userClient ctrlruntimeclient.Client) error
 	Cleanup(ctx context.Context, cluster *kubermaticv1.Cluster, userClient ctrlruntimeclient.Client) error
 }
 
@@ -72,3 +78,59 @@ func (s *commonScenario) Cleanup(ctx context.Context, cluster *kubermaticv1.Clus
 
 	return p.err
 }
+
+// componentCheck returns an error describing why a component is not ready, or nil if it is.
+type componentCheck func(ctx context.Context) error
+
+// waitForComponents polls all checks until they all succeed or the context expires.
+func waitForComponents(ctx context.Context, checks ...componentCheck) error {
+	var notReady []error
+
+	err := wait.PollUntilContextCancel(ctx, utils.UserClusterPollInterval, true, func(ctx context.Context) (bool, error) {
+		notReady = nil
+		for _, check := range checks {
+			if err := check(ctx); err != nil {
+				notReady = append(notReady, err)
+			}
+		}
+
+		return len(notReady) == 0, nil
+	})
+	if err != nil {
+		return fmt.Errorf("components are not ready: %w", kerrors.NewAggregate(notReady))
+	}
+
+	return nil
+}
+
+// deploymentAvailable checks that the Deployment has at least one available replica.
+func deploymentAvailable(client ctrlruntimeclient.Client, namespace, name string) componentCheck {
+	return func(ctx context.Context) error {
+		deployment := &appsv1.Deployment{}
+		if err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: namespace, Name: name}, deployment); err != nil {
+			return fmt.Errorf("failed to get %s deployment: %w", name, err)
+		}
+
+		if deployment.Status.AvailableReplicas < 1 {
+			return fmt.Errorf("%s deployment has no available replicas", name)
+		}
+
+		return nil
+	}
+}
+
+// daemonSetReady checks that all scheduled Pods of the DaemonSet are ready.
+func daemonSetReady(client ctrlruntimeclient.Client, namespace, name string) componentCheck {
+	return func(ctx context.Context) error {
+		daemonSet := &appsv1.DaemonSet{}
+		if err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: namespace, Name: name}, daemonSet); err != nil {
+			return fmt.Errorf("failed to get %s daemonset: %w", name, err)
+		}
+
+		if daemonSet.Status.NumberReady != daemonSet.Status.DesiredNumberScheduled {
+			return fmt.Errorf("%s daemonset has %d/%d ready pods", name, daemonSet.Status.NumberReady, daemonSet.Status.DesiredNumberScheduled)
+		}
+
+		return nil
+	}
+}
@@ -18,15 +18,14 @@ package providers
 
 import (
 	"context"
-	"fmt"
 
 	"go.uber.org/zap"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/cloudcontroller"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/jig"
 
-	appsv1 "k8s.io/api/apps/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -54,11 +53,8 @@ func NewVSphereScenario(log *zap.SugaredLogger, seedClient ctrlruntimeclient.Cli
 	}
 }
 
-func (c *VSphereScenario) CheckComponents(ctx context.Context, cluster *kubermaticv1.Cluster, userClient ctrlruntimeclient.Client) (bool, error) {
-	ccmDeploy := &appsv1.Deployment{}
-	if err := c.seedClient.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: fmt.Sprintf("cluster-%s", cluster.Name), Name: vsphereCCMDeploymentName}, ccmDeploy); err != nil {
-		return false, fmt.Errorf("failed to get %s deployment: %w", vsphereCCMDeploymentName, err)
-	}
-
-	return ccmDeploy.Status.AvailableReplicas == 1, nil
+func (c *VSphereScenario) CheckComponents(ctx context.Context, cluster *kubermaticv1.Cluster, userClient ctrlruntimeclient.Client) error {
+	return waitForComponents(ctx,
+		deploymentAvailable(c.seedClient, resources.NamespaceName(cluster), vsphereCCMDeploymentName),
+	)
 }
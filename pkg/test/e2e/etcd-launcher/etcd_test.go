@@ -31,6 +31,7 @@ import (
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/jig"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/utils"
 
@@ -727,7 +728,7 @@ func getStsReadyPodsCount(ctx context.Context, client ctrlruntimeclient.Client,
 }
 
 func clusterNamespace(cluster *kubermaticv1.Cluster) string {
-	return fmt.Sprintf("cluster-%s", cluster.Name)
+	return resources.NamespaceName(cluster)
 }
 
 type patchFunc func(cluster *kubermaticv1.Cluster) error
@@ -22,10 +22,15 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
+	"path"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/BurntSushi/toml"
 	semverlib "github.com/Masterminds/semver/v3"
+	"github.com/distribution/reference"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
@@ -35,6 +40,9 @@ import (
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/provider/cloud/gcp"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	encryptionresources "k8c.io/kubermatic/v2/pkg/resources/encryption"
+	"k8c.io/kubermatic/v2/pkg/resources/etcd"
+	"k8c.io/kubermatic/v2/pkg/resources/scheduler"
 	"k8c.io/kubermatic/v2/pkg/semver"
 	"k8c.io/kubermatic/v2/pkg/version"
 	clusterversion "k8c.io/kubermatic/v2/pkg/version/cluster"
@@ -45,12 +53,33 @@ import (
 	kubenetutil "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	certutil "k8s.io/client-go/util/cert"
 )
 
 var (
 	// ErrCloudChangeNotAllowed describes that it is not allowed to change the cloud provider.
 	ErrCloudChangeNotAllowed  = errors.New("not allowed to change the cloud provider")
 	azureLoadBalancerSKUTypes = sets.New("", string(kubermaticv1.AzureStandardLBSKU), string(kubermaticv1.AzureBasicLBSKU))
+	ipvsSchedulers            = sets.New("", "rr", "lc", "dh", "sh", "sed", "nq")
+	logFormats                = sets.New("", string(kubermaticv1.ControlPlaneLogFormatText), string(kubermaticv1.ControlPlaneLogFormatJSON))
+	resourceLimitPolicies     = sets.New("",
+		string(kubermaticv1.ControlPlaneResourceLimitPolicyRequestsOnly),
+		string(kubermaticv1.ControlPlaneResourceLimitPolicyRequestsAndLimits),
+		string(kubermaticv1.ControlPlaneResourceLimitPolicyGuaranteed),
+	)
+	controlPlaneDNSPolicies = sets.New("", string(corev1.DNSClusterFirst), string(corev1.DNSDefault), string(corev1.DNSNone))
+	// openVPNCiphers are the data channel ciphers supported by OpenVPN 2.5.
+	openVPNCiphers = sets.New("",
+		"AES-128-CBC", "AES-192-CBC", "AES-256-CBC",
+		"AES-128-GCM", "AES-192-GCM", "AES-256-GCM",
+		"CHACHA20-POLY1305",
+	)
+	openVPNCompressions = sets.New("",
+		string(kubermaticv1.OpenVPNCompressionDisabled),
+		string(kubermaticv1.OpenVPNCompressionLZ4v2),
+		string(kubermaticv1.OpenVPNCompressionLZ4),
+		string(kubermaticv1.OpenVPNCompressionLZO),
+	)
 
 	errPodSecurityPolicyAdmissionPluginWithVersionGte125 = errors.New("admission plugin \"PodSecurityPolicy\" is not supported in Kubernetes v1.25 and later")
 )
@@ -75,7 +104,17 @@ const (
 	// EARKeyLength is required key length for encryption at rest.
 	EARKeyLength = 32
 
+	// minTunnelMTU is the minimum MTU every IPv4 host must support, maxTunnelMTU
+	// the Ethernet MTU the tunnel has to fit into.
+	minTunnelMTU = 576
+	maxTunnelMTU = 1500
+
 	podSecurityPolicyAdmissionPluginName = "PodSecurityPolicy"
+
+	// Kubernetes defaults for kube-controller-manager's --node-monitor-grace-period
+	// and the apiserver's --default-*-toleration-seconds.
+	defaultNodeMonitorGracePeriod = 40 * time.Second
+	defaultPodEvictionTimeout     = 5 * time.Minute
 )
 
 // ValidateClusterSpec validates the given cluster spec. If this is not called from within another validation
@@ -98,6 +137,10 @@ func ValidateClusterSpec(spec *kubermaticv1.ClusterSpec, dc *kubermaticv1.Datace
 		allErrs = append(allErrs, field.Invalid(parentFieldPath.Child("containerRuntime"), spec.ContainerRuntime, fmt.Sprintf("failed to validate container runtime: %s", err)))
 	}
 
+	if err := ValidateUpdateWindow(spec.ControlPlaneMaintenanceWindow); err != nil {
+		allErrs = append(allErrs, field.Invalid(parentFieldPath.Child("controlPlaneMaintenanceWindow"), spec.ControlPlaneMaintenanceWindow, err.Error()))
+	}
+
 	if !kubermaticv1.AllExposeStrategies.Has(spec.ExposeStrategy) {
 		allErrs = append(allErrs, field.NotSupported(parentFieldPath.Child("exposeStrategy"), spec.ExposeStrategy, kubermaticv1.AllExposeStrategies.Items()))
 	}
@@ -137,7 +180,27 @@ func ValidateClusterSpec(spec *kubermaticv1.ClusterSpec, dc *kubermaticv1.Datace
 	}
 
 	allErrs = append(allErrs, ValidateLeaderElectionSettings(&spec.ComponentsOverride.ControllerManager.LeaderElectionSettings, parentFieldPath.Child("componentsOverride", "controllerManager", "leaderElection"))...)
+	allErrs = append(allErrs, ValidateNodeLifecycleSettings(&spec.ComponentsOverride.ControllerManager, parentFieldPath.Child("componentsOverride", "controllerManager"))...)
 	allErrs = append(allErrs, ValidateLeaderElectionSettings(&spec.ComponentsOverride.Scheduler.LeaderElectionSettings, parentFieldPath.Child("componentsOverride", "scheduler", "leaderElection"))...)
+	if config := spec.ComponentsOverride.Scheduler.Configuration; config != "" {
+		if err := scheduler.ValidateConfiguration(config); err != nil {
+			allErrs = append(allErrs, field.Invalid(parentFieldPath.Child("componentsOverride", "scheduler", "configuration"), config, err.Error()))
+		}
+	}
+	allErrs = append(allErrs, validateEtcdSettings(&spec.ComponentsOverride.Etcd, parentFieldPath.Child("componentsOverride", "etcd"))...)
+	allErrs = append(allErrs, validateImageOverrides(spec.ImageOverrides, parentFieldPath.Child("imageOverrides"))...)
+	allErrs = append(allErrs, validateLogVerbosity(spec.LogVerbosity, parentFieldPath.Child("logVerbosity"))...)
+	allErrs = append(allErrs, validateLogging(spec, parentFieldPath)...)
+
+	if err := validateResourceLimitPolicy(spec.ControlPlaneResourceLimitPolicy, parentFieldPath.Child("controlPlaneResourceLimitPolicy")); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	allErrs = append(allErrs, validateControlPlaneDNS(spec.ControlPlaneDNS, parentFieldPath.Child("controlPlaneDNS"))...)
+
+	if err := validateNodeRuntimeConfig(spec.NodeRuntimeConfig, parentFieldPath.Child("nodeRuntimeConfig")); err != nil {
+		allErrs = append(allErrs, err)
+	}
 
 	externalCCM := false
 	if val, ok := spec.Features[kubermaticv1.ClusterFeatureExternalCloudProvider]; ok {
@@ -162,6 +225,19 @@ func ValidateClusterSpec(spec *kubermaticv1.ClusterSpec, dc *kubermaticv1.Datace
 		allErrs = append(allErrs, err)
 	}
 
+	allErrs = append(allErrs, validateAPIServerRequestLimits(spec, parentFieldPath.Child("componentsOverride", "apiserver"))...)
+	allErrs = append(allErrs, validateControllerManagerConcurrentSyncs(spec.ComponentsOverride.ControllerManager.ConcurrentSyncs, parentFieldPath.Child("componentsOverride", "controllerManager", "concurrentSyncs"))...)
+
+	if err := validateAPIServerTLSSettings(&spec.ComponentsOverride.Apiserver, parentFieldPath.Child("componentsOverride", "apiserver")); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateAPIServerExtraArgs(spec.ComponentsOverride.Apiserver.ExtraArgs, parentFieldPath.Child("componentsOverride", "apiserver", "extraArgs")); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	allErrs = append(allErrs, validateAPIServerAutoscaling(spec.ComponentsOverride.Apiserver.Autoscaling, parentFieldPath.Child("componentsOverride", "apiserver", "autoscaling"))...)
+
 	if errs := validateEncryptionConfiguration(spec, parentFieldPath.Child("encryptionConfiguration")); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -175,9 +251,196 @@ func ValidateClusterSpec(spec *kubermaticv1.ClusterSpec, dc *kubermaticv1.Datace
 		allErrs = append(allErrs, err)
 	}
 
+	if err := validateServiceAccountIssuerFormat(spec.ServiceAccount, parentFieldPath.Child("serviceAccount", "issuer")); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if errs := validateAuthorizationSettings(spec.Authorization, parentFieldPath.Child("authorization")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if spec.AuditLogging != nil {
+		if errs := validateAuditWebhookBackend(spec.AuditLogging.WebhookBackend, parentFieldPath.Child("auditLogging", "webhookBackend")); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		}
+
+		if errs := validateAuditLogRotation(spec.AuditLogging.Rotation, parentFieldPath.Child("auditLogging", "rotation")); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateAuditWebhookBackend ensures that the audit webhook config file is either referenced or
+// generated from an https endpoint, but not both.
+func validateAuditWebhookBackend(webhook *kubermaticv1.AuditWebhookBackendSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if webhook == nil {
+		return allErrs
+	}
+
+	switch {
+	case webhook.Endpoint == "" && webhook.AuditWebhookConfig == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "either auditWebhookConfig or endpoint must be set"))
+	case webhook.Endpoint != "" && webhook.AuditWebhookConfig != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("auditWebhookConfig"), "must not be set together with endpoint"))
+	}
+
+	if webhook.Endpoint != "" {
+		if u, err := url.Parse(webhook.Endpoint); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("endpoint"), webhook.Endpoint, fmt.Sprintf("failed to parse URL: %v", err)))
+		} else if u.Scheme != "https" || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("endpoint"), webhook.Endpoint, "endpoint must be an absolute https URL"))
+		}
+	}
+
+	if webhook.CABundle != "" {
+		if webhook.Endpoint == "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("caBundle"), "can only be set together with endpoint"))
+		} else if _, err := certutil.ParseCertsPEM([]byte(webhook.CABundle)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("caBundle"), "<redacted>", fmt.Sprintf("failed to parse CA bundle: %v", err)))
+		}
+	}
+
+	if batch := webhook.Batch; batch != nil {
+		batchPath := fldPath.Child("batch")
+
+		if batch.MaxSize != nil && *batch.MaxSize <= 0 {
+			allErrs = append(allErrs, field.Invalid(batchPath.Child("maxSize"), *batch.MaxSize, "must be positive"))
+		}
+
+		if batch.MaxWait != nil && batch.MaxWait.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(batchPath.Child("maxWait"), batch.MaxWait.Duration.String(), "must be positive"))
+		}
+
+		if batch.ThrottleQPS != nil && *batch.ThrottleQPS <= 0 {
+			allErrs = append(allErrs, field.Invalid(batchPath.Child("throttleQPS"), *batch.ThrottleQPS, "must be positive"))
+		}
+
+		if batch.ThrottleBurst != nil && *batch.ThrottleBurst <= 0 {
+			allErrs = append(allErrs, field.Invalid(batchPath.Child("throttleBurst"), *batch.ThrottleBurst, "must be positive"))
+		}
+	}
+
 	return allErrs
 }
 
+// validateAuditLogRotation ensures that all configured rotation settings of the audit log are positive.
+func validateAuditLogRotation(rotation *kubermaticv1.AuditLogRotationSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rotation == nil {
+		return allErrs
+	}
+
+	if rotation.MaxAge != nil && *rotation.MaxAge <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxAge"), *rotation.MaxAge, "must be positive"))
+	}
+
+	if rotation.MaxBackup != nil && *rotation.MaxBackup <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackup"), *rotation.MaxBackup, "must be positive"))
+	}
+
+	if rotation.MaxSize != nil && *rotation.MaxSize <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSize"), *rotation.MaxSize, "must be positive"))
+	}
+
+	return allErrs
+}
+
+// validateServiceAccountIssuerFormat ensures that a custom service account issuer is an absolute
+// https URL, as required for OIDC discovery by external token consumers. Only the format is
+// validated; the issuer is not probed, as it is usually served by the cluster's own apiserver,
+// which does not exist yet when a cluster is created.
+func validateServiceAccountIssuerFormat(settings *kubermaticv1.ServiceAccountSettings, fldPath *field.Path) *field.Error {
+	if settings == nil || settings.Issuer == "" {
+		return nil
+	}
+
+	u, err := url.Parse(settings.Issuer)
+	if err != nil {
+		return field.Invalid(fldPath, settings.Issuer, fmt.Sprintf("failed to parse issuer URL: %v", err))
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return field.Invalid(fldPath, settings.Issuer, "issuer must be an absolute https URL")
+	}
+
+	if u.RawQuery != "" || u.Fragment != "" {
+		return field.Invalid(fldPath, settings.Issuer, "issuer must not contain a query or fragment")
+	}
+
+	return nil
+}
+
+// validateAuthorizationSettings ensures that a webhook authorizer is reached via https and that
+// its CA bundle, if given, contains valid certificates.
+func validateAuthorizationSettings(settings *kubermaticv1.AuthorizationSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if settings == nil || settings.Webhook == nil {
+		return allErrs
+	}
+
+	webhook := settings.Webhook
+	webhookPath := fldPath.Child("webhook")
+
+	if webhook.URL == "" {
+		allErrs = append(allErrs, field.Required(webhookPath.Child("url"), "webhook URL is required"))
+	} else if u, err := url.Parse(webhook.URL); err != nil {
+		allErrs = append(allErrs, field.Invalid(webhookPath.Child("url"), webhook.URL, fmt.Sprintf("failed to parse URL: %v", err)))
+	} else if u.Scheme != "https" || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(webhookPath.Child("url"), webhook.URL, "webhook URL must be an absolute https URL"))
+	}
+
+	if webhook.CABundle != "" {
+		if _, err := certutil.ParseCertsPEM([]byte(webhook.CABundle)); err != nil {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("caBundle"), "<redacted>", fmt.Sprintf("failed to parse CA bundle: %v", err)))
+		}
+	}
+
+	if webhook.AuthorizedTTL != nil && webhook.AuthorizedTTL.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(webhookPath.Child("authorizedTTL"), webhook.AuthorizedTTL.Duration.String(), "must not be negative"))
+	}
+
+	if webhook.UnauthorizedTTL != nil && webhook.UnauthorizedTTL.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(webhookPath.Child("unauthorizedTTL"), webhook.UnauthorizedTTL.Duration.String(), "must not be negative"))
+	}
+
+	return allErrs
+}
+
+// validateSplitEtcdNamespaceUpdate ensures that the SplitEtcdNamespace feature flag is never changed,
+// as this would require moving etcd and its data between namespaces.
+func validateSplitEtcdNamespaceUpdate(newCluster, oldCluster *kubermaticv1.Cluster, fldPath *field.Path) *field.Error {
+	vOld := oldCluster.Spec.Features[kubermaticv1.ClusterFeatureSplitEtcdNamespace]
+	v := newCluster.Spec.Features[kubermaticv1.ClusterFeatureSplitEtcdNamespace]
+
+	if vOld != v {
+		return field.Invalid(fldPath, v, fmt.Sprintf("feature gate %q cannot be changed after the cluster has been created", kubermaticv1.ClusterFeatureSplitEtcdNamespace))
+	}
+
+	return nil
+}
+
+// validateEtcdDiskSizeUpdate ensures that the etcd disk size is not changed once the etcd
+// StatefulSet may exist, as the etcd volumes are created from its immutable volumeClaimTemplates.
+// The StatefulSet is created as soon as the cluster namespace exists, so until then the disk
+// size can still be set.
+func validateEtcdDiskSizeUpdate(newCluster, oldCluster *kubermaticv1.Cluster, fldPath *field.Path) field.ErrorList {
+	if oldCluster.Spec.ComponentsOverride.Etcd.DiskSize == nil && oldCluster.Status.NamespaceName == "" {
+		return nil
+	}
+
+	return apimachineryvalidation.ValidateImmutableField(
+		newCluster.Spec.ComponentsOverride.Etcd.DiskSize,
+		oldCluster.Spec.ComponentsOverride.Etcd.DiskSize,
+		fldPath,
+	)
+}
+
 func ValidateNewClusterSpec(ctx context.Context, spec *kubermaticv1.ClusterSpec, dc *kubermaticv1.Datacenter, cloudProvider provider.CloudProvider, versionManager *version.Manager, enabledFeatures features.FeatureGate, parentFieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -219,7 +482,7 @@ func ValidateClusterUpdate(ctx context.Context, newCluster, oldCluster *kubermat
 	}
 
 	// ensure neither cloud nor datacenter were changed
-	if err := ValidateCloudChange(newCluster.Spec.Cloud, oldCluster.Spec.Cloud); err != nil {
+	if err := ValidateCloudChange(newCluster.Spec.Cloud, oldCluster.Spec.Cloud, dc); err != nil {
 		allErrs = append(allErrs, field.Forbidden(specPath.Child("cloud"), err.Error()))
 	}
 
@@ -243,6 +506,10 @@ func ValidateClusterUpdate(ctx context.Context, newCluster, oldCluster *kubermat
 		allErrs = append(allErrs, field.Invalid(specPath.Child("features").Key(kubermaticv1.ClusterFeatureEtcdLauncher), v, fmt.Sprintf("feature gate %q cannot be disabled once it's enabled", kubermaticv1.ClusterFeatureEtcdLauncher)))
 	}
 
+	if err := validateSplitEtcdNamespaceUpdate(newCluster, oldCluster, specPath.Child("features").Key(kubermaticv1.ClusterFeatureSplitEtcdNamespace)); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	// Validate datacenter setting for disabling CSI driver installation if true, is not being over-written.
 	if dc.Spec.DisableCSIDriver && !newCluster.Spec.DisableCSIDriver {
 		allErrs = append(allErrs, field.Forbidden(specPath.Child("DisableCSIDriver"), "CSI driver installation is disabled on the datacenter, can't be enabled on cluster"))
@@ -276,6 +543,8 @@ func ValidateClusterUpdate(ctx context.Context, newCluster, oldCluster *kubermat
 		)...)
 	}
 
+	allErrs = append(allErrs, validateEtcdDiskSizeUpdate(newCluster, oldCluster, specPath.Child("componentsOverride", "etcd", "diskSize"))...)
+
 	if oldCluster.Spec.EnableUserSSHKeyAgent != nil {
 		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(
 			newCluster.Spec.EnableUserSSHKeyAgent,
@@ -389,6 +658,11 @@ func ValidateClusterNetworkConfig(n *kubermaticv1.ClusterNetworkingConfig, dc *k
 	}
 	if err := validateClusterCIDRBlocks(n.Services.CIDRBlocks, fldPath.Child("services", "cidrBlocks")); err != nil {
 		allErrs = append(allErrs, err)
+	} else if len(n.Services.CIDRBlocks) > 0 {
+		// the cluster DNS IP handed to the nodes must be part of the service CIDR
+		if _, err := resources.ClusterDNSIPFromServiceCIDR(n.Services.CIDRBlocks[0]); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("services", "cidrBlocks").Index(0), n.Services.CIDRBlocks[0], err.Error()))
+		}
 	}
 
 	// Verify that IP family is consistent with provided pod CIDRs
@@ -442,6 +716,20 @@ func ValidateClusterNetworkConfig(n *kubermaticv1.ClusterNetworkingConfig, dc *k
 		)
 	}
 
+	if n.TunnelMTU != nil && (*n.TunnelMTU < minTunnelMTU || *n.TunnelMTU > maxTunnelMTU) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("tunnelMTU"), *n.TunnelMTU,
+			fmt.Sprintf("tunnel MTU must be between %d and %d", minTunnelMTU, maxTunnelMTU)),
+		)
+	}
+
+	if !openVPNCiphers.Has(n.TunnelCipher) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("tunnelCipher"), n.TunnelCipher, sets.List(openVPNCiphers)))
+	}
+
+	if !openVPNCompressions.Has(string(n.TunnelCompression)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("tunnelCompression"), n.TunnelCompression, sets.List(openVPNCompressions)))
+	}
+
 	return allErrs
 }
 
@@ -463,6 +751,19 @@ func validateProxyMode(n *kubermaticv1.ClusterNetworkingConfig, cni *kubermaticv
 			fmt.Sprintf("%s proxy mode can be used only when Konnectivity is enabled", resources.EBPFProxyMode)))
 	}
 
+	if n.IPVS != nil && n.IPVS.Scheduler != "" {
+		schedulerPath := fldPath.Child("ipvs", "scheduler")
+
+		if !ipvsSchedulers.Has(n.IPVS.Scheduler) {
+			allErrs = append(allErrs, field.NotSupported(schedulerPath, n.IPVS.Scheduler, sets.List(ipvsSchedulers)))
+		}
+
+		if n.ProxyMode != resources.IPVSProxyMode {
+			allErrs = append(allErrs, field.Invalid(schedulerPath, n.IPVS.Scheduler,
+				fmt.Sprintf("IPVS scheduler can only be configured in %s proxy mode", resources.IPVSProxyMode)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -475,12 +776,16 @@ func validateEncryptionConfiguration(spec *kubermaticv1.ClusterSpec, fieldPath *
 				fmt.Sprintf("cannot enable encryption configuration if feature gate '%s' is not set", kubermaticv1.ClusterFeatureEncryptionAtRest)))
 		}
 
-		// TODO: Update with implementations of other encryption providers (KMS)
-
-		if spec.EncryptionConfiguration.Secretbox == nil {
+		switch {
+		case spec.EncryptionConfiguration.Secretbox == nil && spec.EncryptionConfiguration.KMS == nil:
 			allErrs = append(allErrs, field.Required(fieldPath.Child("secretbox"),
 				"exactly one encryption provider (secretbox, kms) needs to be configured"))
-		} else {
+		case spec.EncryptionConfiguration.Secretbox != nil && spec.EncryptionConfiguration.KMS != nil:
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("kms"),
+				"exactly one encryption provider (secretbox, kms) needs to be configured"))
+		case spec.EncryptionConfiguration.KMS != nil:
+			allErrs = append(allErrs, validateKMSConfiguration(spec.EncryptionConfiguration.KMS, fieldPath.Child("kms"))...)
+		default:
 			for i, key := range spec.EncryptionConfiguration.Secretbox.Keys {
 				childPath := fieldPath.Child("secretbox", "keys").Index(i)
 				if key.Name == "" {
@@ -505,8 +810,31 @@ func validateEncryptionConfiguration(spec *kubermaticv1.ClusterSpec, fieldPath *
 				}
 			}
 		}
+	}
+
+	return allErrs
+}
+
+func validateKMSConfiguration(kms *kubermaticv1.KMSEncryptionConfiguration, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if kms.Name == "" {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("name"), "KMS provider name is required"))
+	} else if strings.Contains(kms.Name, "/") {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("name"), kms.Name, "KMS provider name must not contain '/'"))
+	}
 
-		// END TODO
+	if kms.Image == "" {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("image"), "KMS plugin image is required"))
+	}
+
+	if kms.KeyID == "" {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("keyID"), "KMS key ID is required"))
+	}
+
+	if kms.Endpoint != "" && path.Dir(path.Clean(kms.Endpoint)) != encryptionresources.KMSSocketDir {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("endpoint"), kms.Endpoint,
+			fmt.Sprintf("KMS plugin socket must be located in %s", encryptionresources.KMSSocketDir)))
 	}
 
 	return allErrs
@@ -622,11 +950,13 @@ func validateMachineNetworksFromClusterSpec(spec *kubermaticv1.ClusterSpec, pare
 		allErrs = append(allErrs, field.Invalid(basePath, networks, "machine networks are only supported with the vSphere provider"))
 	}
 
+	parsed := make([]*net.IPNet, len(networks))
 	for i, network := range networks {
-		_, _, err := net.ParseCIDR(network.CIDR)
+		_, ipNet, err := net.ParseCIDR(network.CIDR)
 		if err != nil {
 			allErrs = append(allErrs, field.Invalid(basePath.Index(i), network.CIDR, fmt.Sprintf("could not parse CIDR: %v", err)))
 		}
+		parsed[i] = ipNet
 
 		if net.ParseIP(network.Gateway) == nil {
 			allErrs = append(allErrs, field.Invalid(basePath.Index(i), network.Gateway, fmt.Sprintf("could not parse gateway: %v", err)))
@@ -641,12 +971,69 @@ func validateMachineNetworksFromClusterSpec(spec *kubermaticv1.ClusterSpec, pare
 		}
 	}
 
+	for i := range parsed {
+		for j := i + 1; j < len(parsed); j++ {
+			if parsed[i] == nil || parsed[j] == nil {
+				continue
+			}
+			if parsed[i].Contains(parsed[j].IP) || parsed[j].Contains(parsed[i].IP) {
+				allErrs = append(allErrs, field.Invalid(basePath.Index(j).Child("cidr"), networks[j].CIDR, fmt.Sprintf("machine network overlaps with %q", networks[i].CIDR)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateMachineNetworksUpdate ensures that a change to the machine networks
+// does not orphan any of the given IPs that are already allocated to machines.
+// An IP is orphaned if it was part of one of the old networks, but is not part
+// of any of the new networks anymore, which happens when a network is removed
+// or shrunk.
+func ValidateMachineNetworksUpdate(newNetworks, oldNetworks []kubermaticv1.MachineNetworkingConfig, allocatedIPs []net.IP, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	oldNets := parseMachineNetworks(oldNetworks)
+	newNets := parseMachineNetworks(newNetworks)
+
+	for _, ip := range allocatedIPs {
+		oldNetwork := machineNetworkContaining(oldNets, ip)
+		if oldNetwork == nil || machineNetworkContaining(newNets, ip) != nil {
+			continue
+		}
+
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("IP %s is allocated from machine network %q and would no longer be part of any machine network; remove the machines using it first", ip, oldNetwork.String())))
+	}
+
 	return allErrs
 }
 
-// ValidateCloudChange validates if the cloud provider has been changed.
-func ValidateCloudChange(newSpec, oldSpec kubermaticv1.CloudSpec) error {
-	if newSpec.DatacenterName != oldSpec.DatacenterName {
+func parseMachineNetworks(networks []kubermaticv1.MachineNetworkingConfig) []*net.IPNet {
+	result := []*net.IPNet{}
+	for _, network := range networks {
+		// invalid CIDRs are reported by validateMachineNetworksFromClusterSpec
+		if _, ipNet, err := net.ParseCIDR(network.CIDR); err == nil {
+			result = append(result, ipNet)
+		}
+	}
+
+	return result
+}
+
+func machineNetworkContaining(networks []*net.IPNet, ip net.IP) *net.IPNet {
+	for _, ipNet := range networks {
+		if ipNet.Contains(ip) {
+			return ipNet
+		}
+	}
+
+	return nil
+}
+
+// ValidateCloudChange validates if the cloud provider has been changed. Changing the datacenter
+// name is only allowed to migrate from a previous name of the given datacenter to its current one.
+func ValidateCloudChange(newSpec, oldSpec kubermaticv1.CloudSpec, dc *kubermaticv1.Datacenter) error {
+	if newSpec.DatacenterName != oldSpec.DatacenterName && (dc == nil || !slices.Contains(dc.PreviousNames, oldSpec.DatacenterName)) {
 		return errors.New("changing the datacenter is not allowed")
 	}
 
@@ -1199,6 +1586,283 @@ func ValidateLeaderElectionSettings(l *kubermaticv1.LeaderElectionSettings, fldP
 	return allErrs
 }
 
+// ValidateNodeLifecycleSettings ensures that nodes are marked as NotReady before pods
+// get evicted from them.
+func ValidateNodeLifecycleSettings(s *kubermaticv1.ControllerManagerSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	gracePeriod := defaultNodeMonitorGracePeriod
+	if s.NodeMonitorGracePeriod != nil {
+		gracePeriod = s.NodeMonitorGracePeriod.Duration
+		if gracePeriod <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeMonitorGracePeriod"), gracePeriod.String(), "node monitor grace period must be positive"))
+		}
+	}
+
+	evictionTimeout := defaultPodEvictionTimeout
+	if s.PodEvictionTimeout != nil {
+		evictionTimeout = s.PodEvictionTimeout.Duration
+		if evictionTimeout <= 0 || evictionTimeout%time.Second != 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("podEvictionTimeout"), evictionTimeout.String(), "pod eviction timeout must be a positive number of whole seconds"))
+		}
+	}
+
+	if len(allErrs) == 0 && gracePeriod >= evictionTimeout {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("node monitor grace period (%v) must be less than the pod eviction timeout (%v)", gracePeriod, evictionTimeout)))
+	}
+
+	return allErrs
+}
+
+func validateEtcdSettings(e *kubermaticv1.EtcdStatefulSetSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if quota := e.QuotaBackendBytes; quota != nil {
+		fld := fldPath.Child("quotaBackendBytes")
+
+		switch {
+		case quota.Sign() <= 0:
+			allErrs = append(allErrs, field.Invalid(fld, quota.String(), "quota must be positive"))
+
+		// the disk size is inherited from the seed if not set, so the quota can
+		// only be compared against it if both are configured on the cluster
+		case e.DiskSize != nil:
+			if err := etcd.ValidateQuotaBackendBytes(*quota, *e.DiskSize); err != nil {
+				allErrs = append(allErrs, field.Invalid(fld, quota.String(), err.Error()))
+			}
+		}
+	}
+
+	if err := etcd.ValidateAutoCompaction(e.AutoCompactionMode, e.AutoCompactionRetention); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("autoCompactionRetention"), e.AutoCompactionRetention, err.Error()))
+	}
+
+	if err := etcd.ValidateCipherSuites(e.CipherSuites); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cipherSuites"), e.CipherSuites, err.Error()))
+	}
+
+	return allErrs
+}
+
+func validateLogVerbosity(verbosity map[string]int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, component := range sets.List(sets.KeySet(verbosity)) {
+		fld := fldPath.Key(component)
+
+		if !resources.LogVerbosityComponents.Has(component) {
+			allErrs = append(allErrs, field.NotSupported(fld, component, sets.List(resources.LogVerbosityComponents)))
+			continue
+		}
+
+		if v := verbosity[component]; v < 0 || v > 10 {
+			allErrs = append(allErrs, field.Invalid(fld, v, "must be between 0 and 10"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateLogging(spec *kubermaticv1.ClusterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !logFormats.Has(string(spec.LogFormat)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("logFormat"), spec.LogFormat, sets.List(logFormats)))
+	}
+
+	if frequency := spec.LogFlushFrequency; frequency != nil && frequency.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("logFlushFrequency"), frequency.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+// validateAPIServerRequestLimits checks the in-flight request ceilings the apiserver
+// will effectively run with, i.e. the tier defaults combined with any overrides.
+func validateAPIServerRequestLimits(spec *kubermaticv1.ClusterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	limits := resources.GetAPIServerRequestLimits(&kubermaticv1.Cluster{Spec: *spec})
+
+	if limits.MaxRequestsInflight <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxRequestsInflight"), limits.MaxRequestsInflight, "must be positive"))
+	}
+
+	if limits.MaxMutatingRequestsInflight <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxMutatingRequestsInflight"), limits.MaxMutatingRequestsInflight, "must be positive"))
+	} else if limits.MaxMutatingRequestsInflight >= limits.MaxRequestsInflight {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxMutatingRequestsInflight"), limits.MaxMutatingRequestsInflight, fmt.Sprintf("must be lower than maxRequestsInflight (%d)", limits.MaxRequestsInflight)))
+	}
+
+	return allErrs
+}
+
+func validateControllerManagerConcurrentSyncs(syncs *kubermaticv1.ControllerManagerConcurrentSyncs, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if syncs == nil {
+		return allErrs
+	}
+
+	values := map[string]*int32{
+		"deployment":       syncs.Deployment,
+		"replicaSet":       syncs.ReplicaSet,
+		"statefulSet":      syncs.StatefulSet,
+		"daemonSet":        syncs.DaemonSet,
+		"endpoint":         syncs.Endpoint,
+		"namespace":        syncs.Namespace,
+		"garbageCollector": syncs.GarbageCollector,
+	}
+
+	for _, name := range sets.List(sets.KeySet(values)) {
+		if value := values[name]; value != nil && *value <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *value, "must be positive"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateAPIServerAutoscaling checks the bounds of the apiserver's HorizontalPodAutoscaler.
+// Fields that are not set are taken from the datacenter and cannot be validated here.
+func validateAPIServerAutoscaling(settings *kubermaticv1.APIServerAutoscalingSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if settings == nil {
+		return allErrs
+	}
+
+	if settings.MinReplicas != nil && *settings.MinReplicas <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *settings.MinReplicas, "must be positive"))
+	}
+
+	if settings.MaxReplicas != nil {
+		if *settings.MaxReplicas <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *settings.MaxReplicas, "must be positive"))
+		} else if settings.MinReplicas != nil && *settings.MaxReplicas < *settings.MinReplicas {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *settings.MaxReplicas, fmt.Sprintf("must not be lower than minReplicas (%d)", *settings.MinReplicas)))
+		}
+	}
+
+	if settings.TargetCPUUtilizationPercentage != nil && *settings.TargetCPUUtilizationPercentage <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetCPUUtilizationPercentage"), *settings.TargetCPUUtilizationPercentage, "must be positive"))
+	}
+
+	return allErrs
+}
+
+// validateAPIServerTLSSettings ensures that the apiserver accepts the configured TLS version
+// and cipher suites and that they are secure.
+func validateAPIServerTLSSettings(settings *kubermaticv1.APIServerSettings, fldPath *field.Path) *field.Error {
+	if err := resources.ValidateAPIServerTLSSettings(settings.TLSMinVersion, nil); err != nil {
+		return field.Invalid(fldPath.Child("tlsMinVersion"), settings.TLSMinVersion, err.Error())
+	}
+
+	if err := resources.ValidateAPIServerTLSSettings(settings.TLSMinVersion, settings.TLSCipherSuites); err != nil {
+		return field.Invalid(fldPath.Child("tlsCipherSuites"), settings.TLSCipherSuites, err.Error())
+	}
+
+	return nil
+}
+
+// validateAPIServerExtraArgs rejects additional apiserver flags which would weaken its authentication.
+func validateAPIServerExtraArgs(args []string, fldPath *field.Path) *field.Error {
+	if err := resources.ValidateAPIServerHardeningFlags(args); err != nil {
+		return field.Forbidden(fldPath, err.Error())
+	}
+
+	return nil
+}
+
+func validateNodeRuntimeConfig(config string, fldPath *field.Path) *field.Error {
+	if config == "" {
+		return nil
+	}
+
+	// the config is merged into the containerd config of the nodes, so a malformed
+	// config would break the container runtime of every new node
+	parsed := map[string]interface{}{}
+	if _, err := toml.Decode(config, &parsed); err != nil {
+		return field.Invalid(fldPath, "<omitted>", fmt.Sprintf("must be valid TOML: %v", err))
+	}
+
+	return nil
+}
+
+func validateResourceLimitPolicy(policy kubermaticv1.ControlPlaneResourceLimitPolicy, fldPath *field.Path) *field.Error {
+	if !resourceLimitPolicies.Has(string(policy)) {
+		return field.NotSupported(fldPath, policy, sets.List(resourceLimitPolicies))
+	}
+
+	return nil
+}
+
+func validateControlPlaneDNS(settings *kubermaticv1.ControlPlaneDNSSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if settings == nil {
+		return allErrs
+	}
+
+	if !controlPlaneDNSPolicies.Has(string(settings.Policy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("policy"), settings.Policy, sets.List(controlPlaneDNSPolicies)))
+	}
+
+	if settings.Config == nil {
+		if settings.Policy == corev1.DNSNone {
+			allErrs = append(allErrs, field.Required(fldPath.Child("config"), "must be set if the policy is None"))
+		}
+		return allErrs
+	}
+
+	if settings.Policy == corev1.DNSNone && len(settings.Config.Nameservers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("config", "nameservers"), "at least one nameserver is required if the policy is None"))
+	}
+
+	for i, nameserver := range settings.Config.Nameservers {
+		if net.ParseIP(nameserver) == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("config", "nameservers").Index(i), nameserver, "must be a valid IP address"))
+		}
+	}
+
+	for i, option := range settings.Config.Options {
+		if option.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("config", "options").Index(i).Child("name"), ""))
+		}
+	}
+
+	return allErrs
+}
+
+func validateImageOverrides(overrides map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, component := range sets.List(sets.KeySet(overrides)) {
+		fld := fldPath.Key(component)
+		image := overrides[component]
+
+		if !resources.ImageOverrideComponents.Has(component) {
+			allErrs = append(allErrs, field.NotSupported(fld, component, sets.List(resources.ImageOverrideComponents)))
+			continue
+		}
+
+		named, err := reference.ParseNormalizedNamed(image)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fld, image, err.Error()))
+			continue
+		}
+
+		// overrides are not rewritten, so they must point to a specific image
+		_, tagged := named.(reference.Tagged)
+		_, digested := named.(reference.Digested)
+		if !tagged && !digested {
+			allErrs = append(allErrs, field.Invalid(fld, image, "image must have a tag or digest"))
+		}
+	}
+
+	return allErrs
+}
+
 func ValidateNodePortRange(nodePortRange string, fldPath *field.Path) *field.Error {
 	if nodePortRange == "" {
 		return field.Required(fldPath, "node port range is required")
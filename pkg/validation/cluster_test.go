@@ -23,6 +23,7 @@ import (
 	"net"
 	"strings"
 	"testing"
+	"time"
 
 	semverlib "github.com/Masterminds/semver/v3"
 	"github.com/stretchr/testify/assert"
@@ -30,9 +31,13 @@ import (
 	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/features"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/semver"
 	"k8c.io/kubermatic/v2/pkg/version"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
@@ -386,6 +391,78 @@ func TestValidateClusterNetworkingConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "services CIDR too small for the cluster DNS IP",
+			networkConfig: kubermaticv1.ClusterNetworkingConfig{
+				Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+				Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/29"}},
+				DNSDomain:                "cluster.local",
+				ProxyMode:                "ipvs",
+				NodeLocalDNSCacheEnabled: ptr.To(true),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid tunnel MTU",
+			networkConfig: kubermaticv1.ClusterNetworkingConfig{
+				Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+				Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+				DNSDomain:                "cluster.local",
+				ProxyMode:                "ipvs",
+				NodeLocalDNSCacheEnabled: ptr.To(true),
+				TunnelMTU:                ptr.To[int32](1400),
+			},
+			wantErr: false,
+		},
+		{
+			name: "tunnel MTU too large",
+			networkConfig: kubermaticv1.ClusterNetworkingConfig{
+				Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+				Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+				DNSDomain:                "cluster.local",
+				ProxyMode:                "ipvs",
+				NodeLocalDNSCacheEnabled: ptr.To(true),
+				TunnelMTU:                ptr.To[int32](9000),
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid tunnel cipher and compression",
+			networkConfig: kubermaticv1.ClusterNetworkingConfig{
+				Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+				Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+				DNSDomain:                "cluster.local",
+				ProxyMode:                "ipvs",
+				NodeLocalDNSCacheEnabled: ptr.To(true),
+				TunnelCipher:             "CHACHA20-POLY1305",
+				TunnelCompression:        kubermaticv1.OpenVPNCompressionDisabled,
+			},
+			wantErr: false,
+		},
+		{
+			name: "unsupported tunnel cipher",
+			networkConfig: kubermaticv1.ClusterNetworkingConfig{
+				Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+				Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+				DNSDomain:                "cluster.local",
+				ProxyMode:                "ipvs",
+				NodeLocalDNSCacheEnabled: ptr.To(true),
+				TunnelCipher:             "BF-CBC",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported tunnel compression",
+			networkConfig: kubermaticv1.ClusterNetworkingConfig{
+				Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+				Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+				DNSDomain:                "cluster.local",
+				ProxyMode:                "ipvs",
+				NodeLocalDNSCacheEnabled: ptr.To(true),
+				TunnelCompression:        "gzip",
+			},
+			wantErr: true,
+		},
 		{
 			name: "missing pods CIDR",
 			networkConfig: kubermaticv1.ClusterNetworkingConfig{
@@ -655,6 +732,42 @@ func TestValidateClusterNetworkingConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid ipvs scheduler",
+			networkConfig: kubermaticv1.ClusterNetworkingConfig{
+				Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+				Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+				DNSDomain:                "cluster.local",
+				ProxyMode:                "ipvs",
+				IPVS:                     &kubermaticv1.IPVSConfiguration{Scheduler: "lc"},
+				NodeLocalDNSCacheEnabled: ptr.To(true),
+			},
+			wantErr: false,
+		},
+		{
+			name: "unsupported ipvs scheduler",
+			networkConfig: kubermaticv1.ClusterNetworkingConfig{
+				Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+				Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+				DNSDomain:                "cluster.local",
+				ProxyMode:                "ipvs",
+				IPVS:                     &kubermaticv1.IPVSConfiguration{Scheduler: "fifo"},
+				NodeLocalDNSCacheEnabled: ptr.To(true),
+			},
+			wantErr: true,
+		},
+		{
+			name: "ipvs scheduler in iptables mode",
+			networkConfig: kubermaticv1.ClusterNetworkingConfig{
+				Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+				Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+				DNSDomain:                "cluster.local",
+				ProxyMode:                "iptables",
+				IPVS:                     &kubermaticv1.IPVSConfiguration{Scheduler: "rr"},
+				NodeLocalDNSCacheEnabled: ptr.To(true),
+			},
+			wantErr: true,
+		},
 		{
 			name: "valid dual-stack datacenter config (not known ipv6 cloud provider)",
 			networkConfig: kubermaticv1.ClusterNetworkingConfig{
@@ -1369,3 +1482,1077 @@ func TestValidateCoreDNSReplicas(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateServiceAccountIssuerFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *kubermaticv1.ServiceAccountSettings
+		valid    bool
+	}{
+		{
+			name:     "no settings",
+			settings: nil,
+			valid:    true,
+		},
+		{
+			name:     "no custom issuer",
+			settings: &kubermaticv1.ServiceAccountSettings{},
+			valid:    true,
+		},
+		{
+			name:     "https issuer",
+			settings: &kubermaticv1.ServiceAccountSettings{Issuer: "https://abcd1234.europe-west3-c.dev.kubermatic.io:30000"},
+			valid:    true,
+		},
+		{
+			name:     "http issuer",
+			settings: &kubermaticv1.ServiceAccountSettings{Issuer: "http://abcd1234.europe-west3-c.dev.kubermatic.io"},
+			valid:    false,
+		},
+		{
+			name:     "issuer without host",
+			settings: &kubermaticv1.ServiceAccountSettings{Issuer: "https:///issuer"},
+			valid:    false,
+		},
+		{
+			name:     "issuer with query",
+			settings: &kubermaticv1.ServiceAccountSettings{Issuer: "https://example.com/?foo=bar"},
+			valid:    false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateServiceAccountIssuerFormat(test.settings, &field.Path{})
+
+			if (err == nil) != test.valid {
+				t.Errorf("Expected err to be %v, got %v", test.valid, err)
+			}
+		})
+	}
+}
+
+func TestValidateAuditLogRotation(t *testing.T) {
+	tests := []struct {
+		name     string
+		rotation *kubermaticv1.AuditLogRotationSettings
+		valid    bool
+	}{
+		{
+			name:     "no rotation settings",
+			rotation: nil,
+			valid:    true,
+		},
+		{
+			name: "positive values",
+			rotation: &kubermaticv1.AuditLogRotationSettings{
+				MaxAge:    ptr.To[int32](7),
+				MaxBackup: ptr.To[int32](5),
+				MaxSize:   ptr.To[int32](50),
+			},
+			valid: true,
+		},
+		{
+			name:     "zero max backup",
+			rotation: &kubermaticv1.AuditLogRotationSettings{MaxBackup: ptr.To[int32](0)},
+			valid:    false,
+		},
+		{
+			name:     "negative max size",
+			rotation: &kubermaticv1.AuditLogRotationSettings{MaxSize: ptr.To[int32](-1)},
+			valid:    false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateAuditLogRotation(test.rotation, &field.Path{})
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got %v", test.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateAuthorizationSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *kubermaticv1.AuthorizationSettings
+		valid    bool
+	}{
+		{
+			name:     "no settings",
+			settings: nil,
+			valid:    true,
+		},
+		{
+			name:     "no webhook",
+			settings: &kubermaticv1.AuthorizationSettings{},
+			valid:    true,
+		},
+		{
+			name: "https webhook",
+			settings: &kubermaticv1.AuthorizationSettings{
+				Webhook: &kubermaticv1.AuthorizationWebhookSettings{URL: "https://authz.example.com/authorize"},
+			},
+			valid: true,
+		},
+		{
+			name: "http webhook",
+			settings: &kubermaticv1.AuthorizationSettings{
+				Webhook: &kubermaticv1.AuthorizationWebhookSettings{URL: "http://authz.example.com/authorize"},
+			},
+			valid: false,
+		},
+		{
+			name: "webhook without URL",
+			settings: &kubermaticv1.AuthorizationSettings{
+				Webhook: &kubermaticv1.AuthorizationWebhookSettings{},
+			},
+			valid: false,
+		},
+		{
+			name: "webhook with invalid CA bundle",
+			settings: &kubermaticv1.AuthorizationSettings{
+				Webhook: &kubermaticv1.AuthorizationWebhookSettings{URL: "https://authz.example.com/authorize", CABundle: "not-a-certificate"},
+			},
+			valid: false,
+		},
+		{
+			name: "webhook with negative TTL",
+			settings: &kubermaticv1.AuthorizationSettings{
+				Webhook: &kubermaticv1.AuthorizationWebhookSettings{
+					URL:           "https://authz.example.com/authorize",
+					AuthorizedTTL: &metav1.Duration{Duration: -time.Minute},
+				},
+			},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateAuthorizationSettings(test.settings, &field.Path{})
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got errors %v", test.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateNodeLifecycleSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings kubermaticv1.ControllerManagerSettings
+		valid    bool
+	}{
+		{
+			name:  "defaults",
+			valid: true,
+		},
+		{
+			name: "longer grace period and eviction timeout",
+			settings: kubermaticv1.ControllerManagerSettings{
+				NodeMonitorGracePeriod: &metav1.Duration{Duration: 2 * time.Minute},
+				PodEvictionTimeout:     &metav1.Duration{Duration: 10 * time.Minute},
+			},
+			valid: true,
+		},
+		{
+			name: "grace period exceeding the default eviction timeout",
+			settings: kubermaticv1.ControllerManagerSettings{
+				NodeMonitorGracePeriod: &metav1.Duration{Duration: 10 * time.Minute},
+			},
+			valid: false,
+		},
+		{
+			name: "eviction timeout shorter than the grace period",
+			settings: kubermaticv1.ControllerManagerSettings{
+				NodeMonitorGracePeriod: &metav1.Duration{Duration: time.Minute},
+				PodEvictionTimeout:     &metav1.Duration{Duration: 30 * time.Second},
+			},
+			valid: false,
+		},
+		{
+			name: "fractional eviction timeout",
+			settings: kubermaticv1.ControllerManagerSettings{
+				PodEvictionTimeout: &metav1.Duration{Duration: 90500 * time.Millisecond},
+			},
+			valid: false,
+		},
+		{
+			name: "negative grace period",
+			settings: kubermaticv1.ControllerManagerSettings{
+				NodeMonitorGracePeriod: &metav1.Duration{Duration: -time.Second},
+			},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := ValidateNodeLifecycleSettings(&test.settings, &field.Path{})
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got errors %v", test.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateEtcdSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings kubermaticv1.EtcdStatefulSetSettings
+		valid    bool
+	}{
+		{
+			name:  "no quota",
+			valid: true,
+		},
+		{
+			name: "quota without disk size",
+			settings: kubermaticv1.EtcdStatefulSetSettings{
+				QuotaBackendBytes: ptr.To(resource.MustParse("4Gi")),
+			},
+			valid: true,
+		},
+		{
+			name: "quota well below disk size",
+			settings: kubermaticv1.EtcdStatefulSetSettings{
+				QuotaBackendBytes: ptr.To(resource.MustParse("4Gi")),
+				DiskSize:          ptr.To(resource.MustParse("10Gi")),
+			},
+			valid: true,
+		},
+		{
+			name: "quota exceeding disk size",
+			settings: kubermaticv1.EtcdStatefulSetSettings{
+				QuotaBackendBytes: ptr.To(resource.MustParse("8Gi")),
+				DiskSize:          ptr.To(resource.MustParse("5Gi")),
+			},
+			valid: false,
+		},
+		{
+			name: "negative quota",
+			settings: kubermaticv1.EtcdStatefulSetSettings{
+				QuotaBackendBytes: ptr.To(resource.MustParse("-1Gi")),
+			},
+			valid: false,
+		},
+		{
+			name: "periodic compaction with duration retention",
+			settings: kubermaticv1.EtcdStatefulSetSettings{
+				AutoCompactionMode:      kubermaticv1.EtcdAutoCompactionModePeriodic,
+				AutoCompactionRetention: "30m",
+			},
+			valid: true,
+		},
+		{
+			name: "revision compaction with revision count",
+			settings: kubermaticv1.EtcdStatefulSetSettings{
+				AutoCompactionMode:      kubermaticv1.EtcdAutoCompactionModeRevision,
+				AutoCompactionRetention: "10000",
+			},
+			valid: true,
+		},
+		{
+			name: "revision compaction with duration retention",
+			settings: kubermaticv1.EtcdStatefulSetSettings{
+				AutoCompactionMode:      kubermaticv1.EtcdAutoCompactionModeRevision,
+				AutoCompactionRetention: "1h",
+			},
+			valid: false,
+		},
+		{
+			name: "supported cipher suites",
+			settings: kubermaticv1.EtcdStatefulSetSettings{
+				CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+			},
+			valid: true,
+		},
+		{
+			name: "unknown cipher suite",
+			settings: kubermaticv1.EtcdStatefulSetSettings{
+				CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_FANCY_CIPHER"},
+			},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateEtcdSettings(&test.settings, &field.Path{})
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got errors %v", test.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateImageOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		valid     bool
+	}{
+		{
+			name:  "no overrides",
+			valid: true,
+		},
+		{
+			name: "tagged image",
+			overrides: map[string]string{
+				resources.ApiserverDeploymentName: "registry.example.com/kube-apiserver:v1.30.1-canary",
+			},
+			valid: true,
+		},
+		{
+			name: "image with digest",
+			overrides: map[string]string{
+				resources.EtcdStatefulSetName: "gcr.io/etcd-development/etcd@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+			},
+			valid: true,
+		},
+		{
+			name: "image without tag",
+			overrides: map[string]string{
+				resources.SchedulerDeploymentName: "registry.example.com/kube-scheduler",
+			},
+			valid: false,
+		},
+		{
+			name: "malformed image",
+			overrides: map[string]string{
+				resources.ControllerManagerDeploymentName: "registry.example.com/Kube-Controller-Manager:v1.30.0",
+			},
+			valid: false,
+		},
+		{
+			name: "unsupported component",
+			overrides: map[string]string{
+				"machine-controller": "quay.io/kubermatic/machine-controller:v1.0.0",
+			},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateImageOverrides(test.overrides, field.NewPath("spec", "imageOverrides"))
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got errors %v", test.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateSplitEtcdNamespaceUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldEnabled bool
+		newEnabled bool
+		valid      bool
+	}{
+		{
+			name:  "disabled",
+			valid: true,
+		},
+		{
+			name:       "enabled",
+			oldEnabled: true,
+			newEnabled: true,
+			valid:      true,
+		},
+		{
+			name:       "enabling after creation",
+			newEnabled: true,
+			valid:      false,
+		},
+		{
+			name:       "disabling after creation",
+			oldEnabled: true,
+			valid:      false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oldCluster := &kubermaticv1.Cluster{}
+			oldCluster.Spec.Features = map[string]bool{kubermaticv1.ClusterFeatureSplitEtcdNamespace: test.oldEnabled}
+
+			newCluster := &kubermaticv1.Cluster{}
+			newCluster.Spec.Features = map[string]bool{kubermaticv1.ClusterFeatureSplitEtcdNamespace: test.newEnabled}
+
+			err := validateSplitEtcdNamespaceUpdate(newCluster, oldCluster, &field.Path{})
+
+			if (err == nil) != test.valid {
+				t.Errorf("Expected err to be %v, got %v", test.valid, err)
+			}
+		})
+	}
+}
+
+func TestValidateEtcdDiskSizeUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldSize  string
+		newSize  string
+		deployed bool
+		valid    bool
+	}{
+		{
+			name:  "not set",
+			valid: true,
+		},
+		{
+			name:     "not set on deployed cluster",
+			deployed: true,
+			valid:    true,
+		},
+		{
+			name:    "setting before the control plane is deployed",
+			newSize: "10Gi",
+			valid:   true,
+		},
+		{
+			name:     "setting after the control plane is deployed",
+			newSize:  "10Gi",
+			deployed: true,
+			valid:    false,
+		},
+		{
+			name:    "unchanged",
+			oldSize: "10Gi",
+			newSize: "10Gi",
+			valid:   true,
+		},
+		{
+			name:    "same size in different unit",
+			oldSize: "1Gi",
+			newSize: "1024Mi",
+			valid:   true,
+		},
+		{
+			name:    "changed",
+			oldSize: "10Gi",
+			newSize: "20Gi",
+			valid:   false,
+		},
+		{
+			name:    "removed",
+			oldSize: "10Gi",
+			valid:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oldCluster := &kubermaticv1.Cluster{}
+			if test.deployed {
+				oldCluster.Status.NamespaceName = "cluster-test"
+			}
+			if test.oldSize != "" {
+				oldCluster.Spec.ComponentsOverride.Etcd.DiskSize = ptr.To(resource.MustParse(test.oldSize))
+			}
+
+			newCluster := &kubermaticv1.Cluster{}
+			if test.newSize != "" {
+				newCluster.Spec.ComponentsOverride.Etcd.DiskSize = ptr.To(resource.MustParse(test.newSize))
+			}
+
+			errs := validateEtcdDiskSizeUpdate(newCluster, oldCluster, &field.Path{})
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got %v", test.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateMachineNetworksOverlap(t *testing.T) {
+	tests := []struct {
+		name     string
+		networks []kubermaticv1.MachineNetworkingConfig
+		valid    bool
+	}{
+		{
+			name: "disjoint networks",
+			networks: []kubermaticv1.MachineNetworkingConfig{
+				{CIDR: "192.168.1.0/24", Gateway: "192.168.1.1"},
+				{CIDR: "192.168.2.0/24", Gateway: "192.168.2.1"},
+			},
+			valid: true,
+		},
+		{
+			name: "identical networks",
+			networks: []kubermaticv1.MachineNetworkingConfig{
+				{CIDR: "192.168.1.0/24", Gateway: "192.168.1.1"},
+				{CIDR: "192.168.1.0/24", Gateway: "192.168.1.1"},
+			},
+			valid: false,
+		},
+		{
+			name: "network contained in another network",
+			networks: []kubermaticv1.MachineNetworkingConfig{
+				{CIDR: "192.168.2.0/24", Gateway: "192.168.2.1"},
+				{CIDR: "192.168.0.0/16", Gateway: "192.168.0.1"},
+			},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spec := &kubermaticv1.ClusterSpec{
+				Cloud: kubermaticv1.CloudSpec{
+					VSphere: &kubermaticv1.VSphereCloudSpec{},
+				},
+				MachineNetworks: test.networks,
+			}
+
+			errs := validateMachineNetworksFromClusterSpec(spec, &field.Path{})
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got errors %v", test.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateMachineNetworksUpdate(t *testing.T) {
+	oldNetworks := []kubermaticv1.MachineNetworkingConfig{
+		{CIDR: "192.168.0.0/23", Gateway: "192.168.0.1"},
+		{CIDR: "10.0.0.0/24", Gateway: "10.0.0.1"},
+	}
+
+	tests := []struct {
+		name         string
+		newNetworks  []kubermaticv1.MachineNetworkingConfig
+		allocatedIPs []string
+		valid        bool
+	}{
+		{
+			name:         "unchanged networks",
+			newNetworks:  oldNetworks,
+			allocatedIPs: []string{"192.168.1.10", "10.0.0.10"},
+			valid:        true,
+		},
+		{
+			name: "growing a network",
+			newNetworks: []kubermaticv1.MachineNetworkingConfig{
+				{CIDR: "192.168.0.0/22", Gateway: "192.168.0.1"},
+				{CIDR: "10.0.0.0/24", Gateway: "10.0.0.1"},
+			},
+			allocatedIPs: []string{"192.168.1.10", "10.0.0.10"},
+			valid:        true,
+		},
+		{
+			name: "shrinking a network without orphaning IPs",
+			newNetworks: []kubermaticv1.MachineNetworkingConfig{
+				{CIDR: "192.168.0.0/24", Gateway: "192.168.0.1"},
+				{CIDR: "10.0.0.0/24", Gateway: "10.0.0.1"},
+			},
+			allocatedIPs: []string{"192.168.0.10", "10.0.0.10"},
+			valid:        true,
+		},
+		{
+			name: "shrinking a network orphaning an IP",
+			newNetworks: []kubermaticv1.MachineNetworkingConfig{
+				{CIDR: "192.168.0.0/24", Gateway: "192.168.0.1"},
+				{CIDR: "10.0.0.0/24", Gateway: "10.0.0.1"},
+			},
+			allocatedIPs: []string{"192.168.1.10", "10.0.0.10"},
+			valid:        false,
+		},
+		{
+			name: "removing a network in use",
+			newNetworks: []kubermaticv1.MachineNetworkingConfig{
+				{CIDR: "192.168.0.0/23", Gateway: "192.168.0.1"},
+			},
+			allocatedIPs: []string{"10.0.0.10"},
+			valid:        false,
+		},
+		{
+			name: "removing an unused network",
+			newNetworks: []kubermaticv1.MachineNetworkingConfig{
+				{CIDR: "192.168.0.0/23", Gateway: "192.168.0.1"},
+			},
+			allocatedIPs: []string{"192.168.1.10"},
+			valid:        true,
+		},
+		{
+			name:         "ignoring IPs outside of any machine network",
+			newNetworks:  nil,
+			allocatedIPs: []string{"172.16.0.10"},
+			valid:        true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			allocatedIPs := []net.IP{}
+			for _, ip := range test.allocatedIPs {
+				allocatedIPs = append(allocatedIPs, net.ParseIP(ip))
+			}
+
+			errs := ValidateMachineNetworksUpdate(test.newNetworks, oldNetworks, allocatedIPs, &field.Path{})
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got errors %v", test.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateNodeRuntimeConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		valid  bool
+	}{
+		{
+			name:  "no config",
+			valid: true,
+		},
+		{
+			name: "registry mirror and sandbox image",
+			config: `version = 2
+
+[plugins."io.containerd.grpc.v1.cri"]
+  sandbox_image = "registry.example.com/pause:3.9"
+
+[plugins."io.containerd.grpc.v1.cri".registry.mirrors."docker.io"]
+  endpoint = ["https://mirror.example.com"]
+`,
+			valid: true,
+		},
+		{
+			name:   "unterminated table",
+			config: `[plugins."io.containerd.grpc.v1.cri"`,
+			valid:  false,
+		},
+		{
+			name:   "duplicate key",
+			config: "version = 2\nversion = 3\n",
+			valid:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateNodeRuntimeConfig(test.config, field.NewPath("spec", "nodeRuntimeConfig"))
+
+			if (err == nil) != test.valid {
+				t.Errorf("Expected valid to be %v, got %v", test.valid, err)
+			}
+		})
+	}
+}
+
+func TestValidateAPIServerRequestLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		tier     kubermaticv1.ClusterTier
+		total    *int32
+		mutating *int32
+		valid    bool
+	}{
+		{
+			name:  "tier defaults",
+			tier:  kubermaticv1.ClusterTierProduction,
+			valid: true,
+		},
+		{
+			name:     "valid overrides",
+			tier:     kubermaticv1.ClusterTierDevelopment,
+			total:    ptr.To[int32](1000),
+			mutating: ptr.To[int32](500),
+			valid:    true,
+		},
+		{
+			name:  "zero total",
+			total: ptr.To[int32](0),
+			valid: false,
+		},
+		{
+			name:     "negative mutating",
+			mutating: ptr.To[int32](-1),
+			valid:    false,
+		},
+		{
+			name:     "mutating equal to total",
+			total:    ptr.To[int32](300),
+			mutating: ptr.To[int32](300),
+			valid:    false,
+		},
+		{
+			name:  "total below tier mutating default",
+			tier:  kubermaticv1.ClusterTierProduction,
+			total: ptr.To[int32](300),
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spec := &kubermaticv1.ClusterSpec{Tier: test.tier}
+			spec.ComponentsOverride.Apiserver.MaxRequestsInflight = test.total
+			spec.ComponentsOverride.Apiserver.MaxMutatingRequestsInflight = test.mutating
+
+			errs := validateAPIServerRequestLimits(spec, field.NewPath("spec", "componentsOverride", "apiserver"))
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got %v", test.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateAPIServerTLSSettings(t *testing.T) {
+	tests := []struct {
+		name          string
+		settings      kubermaticv1.APIServerSettings
+		expectedField string
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name: "valid overrides",
+			settings: kubermaticv1.APIServerSettings{
+				TLSMinVersion:   "VersionTLS12",
+				TLSCipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+			},
+		},
+		{
+			name: "unsupported version",
+			settings: kubermaticv1.APIServerSettings{
+				TLSMinVersion: "VersionTLS10",
+			},
+			expectedField: "spec.componentsOverride.apiserver.tlsMinVersion",
+		},
+		{
+			name: "insecure cipher suite",
+			settings: kubermaticv1.APIServerSettings{
+				TLSCipherSuites: []string{"TLS_RSA_WITH_3DES_EDE_CBC_SHA"},
+			},
+			expectedField: "spec.componentsOverride.apiserver.tlsCipherSuites",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateAPIServerTLSSettings(&test.settings, field.NewPath("spec", "componentsOverride", "apiserver"))
+
+			switch {
+			case test.expectedField == "" && err != nil:
+				t.Errorf("Expected settings to be valid, got %v", err)
+			case test.expectedField != "" && err == nil:
+				t.Error("Expected settings to be invalid")
+			case test.expectedField != "" && err.Field != test.expectedField:
+				t.Errorf("Expected error on %s, got %v", test.expectedField, err)
+			}
+		})
+	}
+}
+
+func TestValidateAPIServerExtraArgs(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		valid bool
+	}{
+		{
+			name:  "no extra args",
+			valid: true,
+		},
+		{
+			name:  "unrelated flag",
+			args:  []string{"--watch-cache=false"},
+			valid: true,
+		},
+		{
+			name: "anonymous auth enabled",
+			args: []string{"--anonymous-auth=true"},
+		},
+		{
+			name: "anonymous auth enabled as separate argument",
+			args: []string{"--anonymous-auth", "true"},
+		},
+		{
+			name: "insecure port enabled",
+			args: []string{"--insecure-port", "8080"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateAPIServerExtraArgs(test.args, field.NewPath("spec", "componentsOverride", "apiserver", "extraArgs"))
+
+			if (err == nil) != test.valid {
+				t.Errorf("Expected valid to be %v, got error %v", test.valid, err)
+			}
+		})
+	}
+}
+
+func TestValidateAPIServerAutoscaling(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *kubermaticv1.APIServerAutoscalingSettings
+		valid    bool
+	}{
+		{
+			name:  "no autoscaling",
+			valid: true,
+		},
+		{
+			name: "valid bounds",
+			settings: &kubermaticv1.APIServerAutoscalingSettings{
+				MinReplicas:                    ptr.To[int32](2),
+				MaxReplicas:                    ptr.To[int32](5),
+				TargetCPUUtilizationPercentage: ptr.To[int32](80),
+			},
+			valid: true,
+		},
+		{
+			name: "only maximum",
+			settings: &kubermaticv1.APIServerAutoscalingSettings{
+				MaxReplicas: ptr.To[int32](5),
+			},
+			valid: true,
+		},
+		{
+			name: "maximum lower than minimum",
+			settings: &kubermaticv1.APIServerAutoscalingSettings{
+				MinReplicas: ptr.To[int32](3),
+				MaxReplicas: ptr.To[int32](2),
+			},
+			valid: false,
+		},
+		{
+			name: "zero minimum",
+			settings: &kubermaticv1.APIServerAutoscalingSettings{
+				MinReplicas: ptr.To[int32](0),
+				MaxReplicas: ptr.To[int32](2),
+			},
+			valid: false,
+		},
+		{
+			name: "negative CPU target",
+			settings: &kubermaticv1.APIServerAutoscalingSettings{
+				MaxReplicas:                    ptr.To[int32](2),
+				TargetCPUUtilizationPercentage: ptr.To[int32](-10),
+			},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateAPIServerAutoscaling(test.settings, field.NewPath("spec", "componentsOverride", "apiserver", "autoscaling"))
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got %v", test.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateControllerManagerConcurrentSyncs(t *testing.T) {
+	tests := []struct {
+		name  string
+		syncs *kubermaticv1.ControllerManagerConcurrentSyncs
+		valid bool
+	}{
+		{
+			name:  "no overrides",
+			valid: true,
+		},
+		{
+			name: "positive values",
+			syncs: &kubermaticv1.ControllerManagerConcurrentSyncs{
+				Deployment:       ptr.To[int32](20),
+				GarbageCollector: ptr.To[int32](50),
+			},
+			valid: true,
+		},
+		{
+			name: "zero",
+			syncs: &kubermaticv1.ControllerManagerConcurrentSyncs{
+				StatefulSet: ptr.To[int32](0),
+			},
+			valid: false,
+		},
+		{
+			name: "negative",
+			syncs: &kubermaticv1.ControllerManagerConcurrentSyncs{
+				Namespace: ptr.To[int32](-1),
+			},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateControllerManagerConcurrentSyncs(test.syncs, field.NewPath("spec", "componentsOverride", "controllerManager", "concurrentSyncs"))
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got %v", test.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateLogging(t *testing.T) {
+	tests := []struct {
+		name              string
+		logFormat         kubermaticv1.ControlPlaneLogFormat
+		logFlushFrequency *metav1.Duration
+		valid             bool
+	}{
+		{
+			name:  "defaults",
+			valid: true,
+		},
+		{
+			name:              "json format with flush frequency",
+			logFormat:         kubermaticv1.ControlPlaneLogFormatJSON,
+			logFlushFrequency: &metav1.Duration{Duration: 10 * time.Second},
+			valid:             true,
+		},
+		{
+			name:      "text format",
+			logFormat: kubermaticv1.ControlPlaneLogFormatText,
+			valid:     true,
+		},
+		{
+			name:      "unsupported format",
+			logFormat: "logfmt",
+			valid:     false,
+		},
+		{
+			name:              "zero flush frequency",
+			logFlushFrequency: &metav1.Duration{},
+			valid:             false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spec := &kubermaticv1.ClusterSpec{
+				LogFormat:         test.logFormat,
+				LogFlushFrequency: test.logFlushFrequency,
+			}
+
+			errs := validateLogging(spec, field.NewPath("spec"))
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got %v", test.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateResourceLimitPolicy(t *testing.T) {
+	tests := []struct {
+		policy kubermaticv1.ControlPlaneResourceLimitPolicy
+		valid  bool
+	}{
+		{
+			policy: "",
+			valid:  true,
+		},
+		{
+			policy: kubermaticv1.ControlPlaneResourceLimitPolicyRequestsOnly,
+			valid:  true,
+		},
+		{
+			policy: kubermaticv1.ControlPlaneResourceLimitPolicyRequestsAndLimits,
+			valid:  true,
+		},
+		{
+			policy: kubermaticv1.ControlPlaneResourceLimitPolicyGuaranteed,
+			valid:  true,
+		},
+		{
+			policy: "LimitsOnly",
+			valid:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.policy), func(t *testing.T) {
+			err := validateResourceLimitPolicy(test.policy, field.NewPath("spec", "controlPlaneResourceLimitPolicy"))
+
+			if (err == nil) != test.valid {
+				t.Errorf("Expected valid to be %v, got %v", test.valid, err)
+			}
+		})
+	}
+}
+
+func TestValidateControlPlaneDNS(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *kubermaticv1.ControlPlaneDNSSettings
+		valid    bool
+	}{
+		{
+			name:  "no settings",
+			valid: true,
+		},
+		{
+			name: "valid nameservers",
+			settings: &kubermaticv1.ControlPlaneDNSSettings{
+				Policy: corev1.DNSNone,
+				Config: &corev1.PodDNSConfig{
+					Nameservers: []string{"192.0.2.53", "2001:db8::53"},
+					Searches:    []string{"example.com"},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "unsupported policy",
+			settings: &kubermaticv1.ControlPlaneDNSSettings{
+				Policy: corev1.DNSClusterFirstWithHostNet,
+			},
+			valid: false,
+		},
+		{
+			name: "invalid nameserver",
+			settings: &kubermaticv1.ControlPlaneDNSSettings{
+				Config: &corev1.PodDNSConfig{
+					Nameservers: []string{"dns.example.com"},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "no nameserver with the None policy",
+			settings: &kubermaticv1.ControlPlaneDNSSettings{
+				Policy: corev1.DNSNone,
+				Config: &corev1.PodDNSConfig{
+					Searches: []string{"example.com"},
+				},
+			},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateControlPlaneDNS(test.settings, field.NewPath("spec", "controlPlaneDNS"))
+
+			if (len(errs) == 0) != test.valid {
+				t.Errorf("Expected valid to be %v, got %v", test.valid, errs)
+			}
+		})
+	}
+}
@@ -159,48 +159,52 @@ func TestMutator(t *testing.T) {
 							EndpointReconcilingDisabled: ptr.To(true),
 							NodePortRange:               "30000-32768",
 						},
-						ControllerManager: kubermaticv1.ControllerSettings{
-							DeploymentSettings: kubermaticv1.DeploymentSettings{
-								Replicas: ptr.To[int32](2),
-								Resources: &corev1.ResourceRequirements{
-									Requests: map[corev1.ResourceName]resource.Quantity{
-										"memory": resource.MustParse("500M"),
+						ControllerManager: kubermaticv1.ControllerManagerSettings{
+							ControllerSettings: kubermaticv1.ControllerSettings{
+								DeploymentSettings: kubermaticv1.DeploymentSettings{
+									Replicas: ptr.To[int32](2),
+									Resources: &corev1.ResourceRequirements{
+										Requests: map[corev1.ResourceName]resource.Quantity{
+											"memory": resource.MustParse("500M"),
+										},
 									},
-								},
-								Tolerations: []corev1.Toleration{
-									{
-										Key:      "test-no-schedule",
-										Operator: corev1.TolerationOpExists,
-										Effect:   corev1.TaintEffectPreferNoSchedule,
+									Tolerations: []corev1.Toleration{
+										{
+											Key:      "test-no-schedule",
+											Operator: corev1.TolerationOpExists,
+											Effect:   corev1.TaintEffectPreferNoSchedule,
+										},
 									},
 								},
-							},
-							LeaderElectionSettings: kubermaticv1.LeaderElectionSettings{
-								LeaseDurationSeconds: ptr.To[int32](10),
-								RenewDeadlineSeconds: ptr.To[int32](5),
-								RetryPeriodSeconds:   ptr.To[int32](2),
+								LeaderElectionSettings: kubermaticv1.LeaderElectionSettings{
+									LeaseDurationSeconds: ptr.To[int32](10),
+									RenewDeadlineSeconds: ptr.To[int32](5),
+									RetryPeriodSeconds:   ptr.To[int32](2),
+								},
 							},
 						},
-						Scheduler: kubermaticv1.ControllerSettings{
-							DeploymentSettings: kubermaticv1.DeploymentSettings{
-								Replicas: ptr.To[int32](2),
-								Resources: &corev1.ResourceRequirements{
-									Requests: map[corev1.ResourceName]resource.Quantity{
-										"memory": resource.MustParse("500M"),
+						Scheduler: kubermaticv1.SchedulerSettings{
+							ControllerSettings: kubermaticv1.ControllerSettings{
+								DeploymentSettings: kubermaticv1.DeploymentSettings{
+									Replicas: ptr.To[int32](2),
+									Resources: &corev1.ResourceRequirements{
+										Requests: map[corev1.ResourceName]resource.Quantity{
+											"memory": resource.MustParse("500M"),
+										},
 									},
-								},
-								Tolerations: []corev1.Toleration{
-									{
-										Key:      "test-no-schedule",
-										Operator: corev1.TolerationOpExists,
-										Effect:   corev1.TaintEffectPreferNoSchedule,
+									Tolerations: []corev1.Toleration{
+										{
+											Key:      "test-no-schedule",
+											Operator: corev1.TolerationOpExists,
+											Effect:   corev1.TaintEffectPreferNoSchedule,
+										},
 									},
 								},
-							},
-							LeaderElectionSettings: kubermaticv1.LeaderElectionSettings{
-								LeaseDurationSeconds: ptr.To[int32](10),
-								RenewDeadlineSeconds: ptr.To[int32](5),
-								RetryPeriodSeconds:   ptr.To[int32](2),
+								LeaderElectionSettings: kubermaticv1.LeaderElectionSettings{
+									LeaseDurationSeconds: ptr.To[int32](10),
+									RenewDeadlineSeconds: ptr.To[int32](5),
+									RetryPeriodSeconds:   ptr.To[int32](2),
+								},
 							},
 						},
 						Etcd: kubermaticv1.EtcdStatefulSetSettings{
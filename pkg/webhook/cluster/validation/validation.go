@@ -21,16 +21,21 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
 	"k8c.io/kubermatic/v2/pkg/defaulting"
 	"k8c.io/kubermatic/v2/pkg/features"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/provider/cloud"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/validation"
 	"k8c.io/kubermatic/v2/pkg/version"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -40,13 +45,19 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
+// UserClusterClientProvider provides functionality to get a user cluster client.
+type UserClusterClientProvider interface {
+	GetClient(ctx context.Context, c *kubermaticv1.Cluster, options ...clusterclient.ConfigOption) (ctrlruntimeclient.Client, error)
+}
+
 // validator for validating Kubermatic Cluster CRD.
 type validator struct {
-	features     features.FeatureGate
-	client       ctrlruntimeclient.Client
-	seedGetter   provider.SeedGetter
-	configGetter provider.KubermaticConfigurationGetter
-	caBundle     *x509.CertPool
+	features                  features.FeatureGate
+	client                    ctrlruntimeclient.Client
+	seedGetter                provider.SeedGetter
+	configGetter              provider.KubermaticConfigurationGetter
+	caBundle                  *x509.CertPool
+	userClusterClientProvider UserClusterClientProvider
 
 	// disableProviderValidation is only for unit tests, to ensure no
 	// provider would phone home to validate dummy test credentials
@@ -54,13 +65,14 @@ type validator struct {
 }
 
 // NewValidator returns a new cluster validator.
-func NewValidator(client ctrlruntimeclient.Client, seedGetter provider.SeedGetter, configGetter provider.KubermaticConfigurationGetter, features features.FeatureGate, caBundle *x509.CertPool) *validator {
+func NewValidator(client ctrlruntimeclient.Client, seedGetter provider.SeedGetter, configGetter provider.KubermaticConfigurationGetter, features features.FeatureGate, caBundle *x509.CertPool, userClusterClientProvider UserClusterClientProvider) *validator {
 	return &validator{
-		client:       client,
-		features:     features,
-		seedGetter:   seedGetter,
-		configGetter: configGetter,
-		caBundle:     caBundle,
+		client:                    client,
+		features:                  features,
+		seedGetter:                seedGetter,
+		configGetter:              configGetter,
+		caBundle:                  caBundle,
+		userClusterClientProvider: userClusterClientProvider,
 	}
 }
 
@@ -127,11 +139,87 @@ func (v *validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 
 	errs := validation.ValidateClusterUpdate(ctx, newCluster, oldCluster, datacenter, cloudProvider, updateManager, v.features)
 
+	if !equality.Semantic.DeepEqual(newCluster.Spec.MachineNetworks, oldCluster.Spec.MachineNetworks) {
+		allocatedIPs, err := v.getAllocatedMachineIPs(ctx, oldCluster)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine IPs allocated from the machine networks: %w", err)
+		}
+
+		errs = append(errs, validation.ValidateMachineNetworksUpdate(newCluster.Spec.MachineNetworks, oldCluster.Spec.MachineNetworks, allocatedIPs, field.NewPath("spec", "machineNetworks"))...)
+	}
+
 	if err := v.validateProjectRelation(ctx, newCluster, oldCluster); err != nil {
 		errs = append(errs, err)
 	}
 
-	return nil, errs.ToAggregate()
+	var warnings admission.Warnings
+	if newCluster.Spec.ControlPlaneHATier == kubermaticv1.ControlPlaneHATierHA && oldCluster.Spec.ControlPlaneHATier != kubermaticv1.ControlPlaneHATierHA {
+		warning, err := v.controlPlaneHASchedulabilityWarning(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	return warnings, errs.ToAggregate()
+}
+
+// controlPlaneHASchedulabilityWarning returns a warning if the seed does not have enough
+// schedulable nodes to spread the replicas of the "ha" control plane tier.
+func (v *validator) controlPlaneHASchedulabilityWarning(ctx context.Context) (string, error) {
+	nodes := &corev1.NodeList{}
+	if err := v.client.List(ctx, nodes); err != nil {
+		return "", fmt.Errorf("failed to list seed nodes: %w", err)
+	}
+
+	schedulable := 0
+	for _, node := range nodes.Items {
+		if !node.Spec.Unschedulable {
+			schedulable++
+		}
+	}
+
+	if schedulable >= int(resources.ControlPlaneHAReplicas) {
+		return "", nil
+	}
+
+	return fmt.Sprintf("the seed cluster has only %d schedulable node(s), so the %d control plane replicas of the %q tier cannot be spread across nodes and do not protect against node failures",
+		schedulable, resources.ControlPlaneHAReplicas, kubermaticv1.ControlPlaneHATierHA), nil
+}
+
+// getAllocatedMachineIPs returns the internal IPs of all nodes in the user cluster.
+// If the user cluster is not reachable (yet), no IPs can have been allocated to
+// machines that joined the cluster, so an empty list is returned.
+func (v *validator) getAllocatedMachineIPs(ctx context.Context, cluster *kubermaticv1.Cluster) ([]net.IP, error) {
+	if v.userClusterClientProvider == nil || cluster.Status.ExtendedHealth.Apiserver != kubermaticv1.HealthStatusUp {
+		return nil, nil
+	}
+
+	userClusterClient, err := v.userClusterClientProvider.GetClient(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user cluster client: %w", err)
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := userClusterClient.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	allocatedIPs := []net.IP{}
+	for _, node := range nodes.Items {
+		for _, address := range node.Status.Addresses {
+			if address.Type != corev1.NodeInternalIP {
+				continue
+			}
+			if ip := net.ParseIP(address.Address); ip != nil {
+				allocatedIPs = append(allocatedIPs, ip)
+			}
+		}
+	}
+
+	return allocatedIPs, nil
 }
 
 func (v *validator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
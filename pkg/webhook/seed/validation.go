@@ -128,12 +128,13 @@ func (v *validator) validate(ctx context.Context, obj runtime.Object, isDelete b
 	if !isDelete {
 		// this has no effect on the DC uniqueness check, but makes the
 		// cluster-remaining-in-DC check easier
-		subjectDatacenters = sets.KeySet(subject.Spec.Datacenters)
+		subjectDatacenters = kubermaticv1helper.DatacenterNames(subject)
 	}
 
-	// check if the subject introduces a datacenter that already exists
+	// check if the subject introduces a datacenter that already exists; previous names of datacenters
+	// are considered as well, as clusters might still be referencing them
 	for _, existing := range existingSeeds {
-		datacenters := sets.KeySet(existing.Spec.Datacenters)
+		datacenters := kubermaticv1helper.DatacenterNames(existing)
 
 		if duplicates := subjectDatacenters.Intersection(datacenters); duplicates.Len() > 0 {
 			return fmt.Errorf("Seed redefines existing datacenters %v from Seed %q; datacenter names must be globally unique", sets.List(duplicates), existing.Name)
@@ -153,6 +154,12 @@ func (v *validator) validate(ctx context.Context, obj runtime.Object, isDelete b
 			return fmt.Errorf("datacenter %q has no provider defined", dcName)
 		}
 
+		for _, previousName := range dc.PreviousNames {
+			if _, exists := subject.Spec.Datacenters[previousName]; exists {
+				return fmt.Errorf("datacenter %q lists existing datacenter %q as a previous name", dcName, previousName)
+			}
+		}
+
 		if dc.Spec.Kubevirt != nil {
 			if err := validateKubeVirtSupportedOS(dc.Spec.Kubevirt); err != nil {
 				return err